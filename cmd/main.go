@@ -9,24 +9,47 @@ import (
 	_ "go.uber.org/automaxprocs"
 	"go.uber.org/zap"
 
+	"spot-assistant/internal/core/attendance"
 	"spot-assistant/internal/core/booking"
+	"spot-assistant/internal/core/calendar"
+	"spot-assistant/internal/core/character"
 	"spot-assistant/internal/core/communication"
+	"spot-assistant/internal/core/export"
+	"spot-assistant/internal/core/guildmembership"
 	"spot-assistant/internal/core/onlinecheck"
+	"spot-assistant/internal/core/outbox"
+	"spot-assistant/internal/core/reliability"
+	"spot-assistant/internal/core/reminder"
+	"spot-assistant/internal/core/spotrequirements"
 	"spot-assistant/internal/core/summary"
+	"spot-assistant/internal/core/webhook"
+	"spot-assistant/internal/core/worldlist"
 
 	"spot-assistant/internal/common/version"
 
+	attendanceRepository "spot-assistant/internal/infrastructure/attendance/postgresql/sqlc"
 	"spot-assistant/internal/infrastructure/bot"
 	"spot-assistant/internal/infrastructure/bot/formatter"
+	calendarFeedRepository "spot-assistant/internal/infrastructure/calendarfeed/postgresql/sqlc"
+	characterRepository "spot-assistant/internal/infrastructure/character/postgresql/sqlc"
 	"spot-assistant/internal/infrastructure/chart"
 	"spot-assistant/internal/infrastructure/db/postgresql"
+	"spot-assistant/internal/infrastructure/eventbus"
 	"spot-assistant/internal/infrastructure/eventhandler"
+	guildSettingsRepository "spot-assistant/internal/infrastructure/guildsettings/postgresql/sqlc"
 	healthadapter "spot-assistant/internal/infrastructure/health"
 	infrahttp "spot-assistant/internal/infrastructure/http"
 	prommetrics "spot-assistant/internal/infrastructure/metrics/prometheus"
+	notificationRepository "spot-assistant/internal/infrastructure/notification/postgresql/sqlc"
+	reliabilityRepository "spot-assistant/internal/infrastructure/reliability/postgresql/sqlc"
+	reminderRepository "spot-assistant/internal/infrastructure/reminder/postgresql/sqlc"
 	reservationRepository "spot-assistant/internal/infrastructure/reservation/postgresql/sqlc"
 	spotRepository "spot-assistant/internal/infrastructure/spot/postgresql/sqlc"
+	summaryMessageRepository "spot-assistant/internal/infrastructure/summarymessage/postgresql/sqlc"
+	webhookSender "spot-assistant/internal/infrastructure/webhook"
+	webhookRepository "spot-assistant/internal/infrastructure/webhook/postgresql/sqlc"
 	"spot-assistant/internal/infrastructure/worldapi"
+	worldListRepository "spot-assistant/internal/infrastructure/worldlist/postgresql/sqlc"
 	worldNameRepository "spot-assistant/internal/infrastructure/worldname/postgresql/sqlc"
 )
 
@@ -63,38 +86,83 @@ func main() {
 	reservationRepo := reservationRepository.NewReservationRepository(db).WithLogger(log)
 	spotRepo := spotRepository.NewSpotRepository(db)
 	worldNameRepo := worldNameRepository.NewWorldNameRepository(db)
+	summaryMessageRepo := summaryMessageRepository.NewSummaryMessageRepository(db)
+	guildSettingsRepo := guildSettingsRepository.NewGuildSettingsRepository(db)
+	calendarFeedRepo := calendarFeedRepository.NewCalendarFeedRepository(db)
+	reminderRepo := reminderRepository.NewReminderRepository(db)
+	notificationPrefRepo := notificationRepository.NewNotificationPreferenceRepository(db)
+	notificationOutboxRepo := notificationRepository.NewNotificationOutboxRepository(db)
+	webhookRepo := webhookRepository.NewWebhookRepository(db)
+	characterRepo := characterRepository.NewCharacterRepository(db)
+	attendanceRepo := attendanceRepository.NewAttendanceRepository(db)
+	reliabilityRepo := reliabilityRepository.NewReliabilityRepository(db)
+	worldListRepo := worldListRepository.NewWorldListRepository(db)
+
+	// Domain events
+	eventBus := eventbus.New().WithLogger(log)
 
 	// Online Checker
 	tibiaDataBaseURL := os.Getenv("TIBIA_WORLD_API_BASE_URL")
-	worldApi := worldapi.NewHttpWorldService(tibiaDataBaseURL)
-	onlineChecker := onlinecheck.NewAdapter(worldApi, worldNameRepo).WithLogger(log)
+	worldApi := worldapi.NewResilientWorldService(worldapi.NewHttpWorldService(tibiaDataBaseURL)).WithLogger(log)
+	onlineChecker := onlinecheck.NewAdapter(worldApi, worldNameRepo, characterRepo, eventBus).WithLogger(log)
 	if !onlineChecker.IsConfigured() {
 		log.Warn("Online checker is disabled: TIBIA_WORLD_API_BASE_URL not set")
 	}
+	worldListService := worldlist.NewAdapter(worldListRepo, worldApi).WithLogger(log)
 
 	// Summary
 	charter := chart.NewAdapter()
-	summaryService := summary.NewAdapter(charter, onlineChecker) // .WithLogger(log)
+	summaryService := summary.NewAdapter(charter, onlineChecker, guildSettingsRepo) // .WithLogger(log)
+
+	// Calendar feeds
+	calendarService := calendar.NewAdapter(reservationRepo, calendarFeedRepo, os.Getenv("CALENDAR_SECRET"), os.Getenv("CALENDAR_BASE_URL")).WithLogger(log)
+	if !calendarService.IsConfigured() {
+		log.Warn("Calendar feeds are disabled: CALENDAR_SECRET or CALENDAR_BASE_URL not set")
+	}
+
+	// Export
+	exportService := export.NewAdapter(reservationRepo, spotRepo).WithLogger(log)
+
+	// Webhooks
+	webhookService := webhook.NewAdapter(webhookRepo, webhookSender.NewHttpSender()).WithLogger(log)
+	characterService := character.NewAdapter(characterRepo, worldNameRepo, worldApi, eventBus).WithLogger(log)
+	attendanceService := attendance.NewAdapter(attendanceRepo, onlineChecker).WithLogger(log)
+	reliabilityService := reliability.NewAdapter(reliabilityRepo, guildSettingsRepo).WithLogger(log)
+	guildMembershipService := guildmembership.NewAdapter(guildSettingsRepo, characterRepo, worldApi).WithLogger(log)
+	spotRequirementsService := spotrequirements.NewAdapter(guildSettingsRepo, spotRepo, characterRepo, worldApi).WithLogger(log)
 
 	// Discord
 	dcFormatter := formatter.NewFormatter()
-	botService := bot.NewManager(summaryService, reservationRepo, onlineChecker).WithFormatter(dcFormatter).WithLogger(log)
-	communicationService := communication.NewAdapter(botService, botService).WithLogger(log)
+	botService := bot.NewManager(summaryService, reservationRepo, onlineChecker).WithFormatter(dcFormatter).WithSummaryMessageRepository(summaryMessageRepo).WithGuildSettingsRepository(guildSettingsRepo).WithCalendarService(calendarService).WithExportService(exportService).WithReminderRepository(reminderRepo).WithNotificationPreferenceRepository(notificationPrefRepo).WithWebhookService(webhookService).WithCharacterService(characterService).WithAttendanceService(attendanceService).WithReliabilityService(reliabilityService).WithSpotRequirementsService(spotRequirementsService).WithWorldListService(worldListService).WithLogger(log)
+	communicationService := communication.NewAdapter(botService, botService, notificationPrefRepo).WithLogger(log)
 
 	// Bot
-	bookingService := booking.NewAdapter(spotRepo, reservationRepo, communicationService).WithLogger(log)
-	eventHandler := eventhandler.NewHandler(bookingService, reservationRepo, communicationService, summaryService)
+	bookingService := booking.NewAdapter(spotRepo, reservationRepo, characterRepo, eventBus, reliabilityService, guildMembershipService, spotRequirementsService).WithLogger(log)
+	reminderService := reminder.NewAdapter(reminderRepo, communicationService).WithLogger(log)
+	outboxService := outbox.NewAdapter(notificationOutboxRepo, communicationService).WithLogger(log)
+	eventHandler := eventhandler.NewHandler(bookingService, reservationRepo, communicationService, summaryService).WithReminderService(reminderService).WithWebhookService(webhookService).WithOutboxService(outboxService).WithWorldListService(worldListService).WithGuildMembershipService(guildMembershipService)
 
 	// Metrics
 	metrics := prommetrics.New()
 	botService.WithMetrics(metrics)
+	worldApi.WithMetrics(metrics)
 	eventHandler.WithMetrics(metrics)
 
+	eventBus.Subscribe(
+		botService,
+		webhookService,
+		reliabilityService,
+		eventbus.NewMetricsSubscriber(metrics),
+		eventbus.NewAuditLog(log),
+	)
+
 	// Expose Prometheus metrics + health endpoints via infrastructure HTTP server
 	metricsAddr := os.Getenv("METRICS_ADDR")
 	server := infrahttp.NewServerWithMetrics(metricsAddr, log)
 	health := healthadapter.NewAdapter(db, botService).WithLogger(log)
 	server.WithHealthProvider(health)
+	server.WithCalendar(calendarService)
+	server.WithExport(exportService, os.Getenv("EXPORT_TOKEN"))
 	server.Start()
 
 	err = botService.WithEventHandler(eventHandler).Run()
@@ -17,6 +17,7 @@ require (
 	github.com/vicanso/go-charts/v2 v2.6.1
 	go.uber.org/automaxprocs v1.6.0
 	go.uber.org/zap v1.26.0
+	golang.org/x/sync v0.18.0
 )
 
 require (
@@ -51,7 +52,6 @@ require (
 	go.yaml.in/yaml/v2 v2.4.2 // indirect
 	golang.org/x/crypto v0.45.0 // indirect
 	golang.org/x/image v0.18.0 // indirect
-	golang.org/x/sync v0.18.0 // indirect
 	golang.org/x/sys v0.38.0 // indirect
 	golang.org/x/text v0.31.0 // indirect
 	google.golang.org/protobuf v1.36.8 // indirect
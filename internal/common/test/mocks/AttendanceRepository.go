@@ -0,0 +1,198 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"spot-assistant/internal/core/dto/reservation"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAttendanceRepository creates a new instance of MockAttendanceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttendanceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttendanceRepository {
+	mock := &MockAttendanceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAttendanceRepository is an autogenerated mock type for the AttendanceRepository type
+type MockAttendanceRepository struct {
+	mock.Mock
+}
+
+type MockAttendanceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttendanceRepository) EXPECT() *MockAttendanceRepository_Expecter {
+	return &MockAttendanceRepository_Expecter{mock: &_m.Mock}
+}
+
+// RecordAttendanceSample provides a mock function for the type MockAttendanceRepository
+func (_mock *MockAttendanceRepository) RecordAttendanceSample(ctx context.Context, reservationID int64, online bool, now time.Time, sampledBefore time.Time) (bool, error) {
+	ret := _mock.Called(ctx, reservationID, online, now, sampledBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordAttendanceSample")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, bool, time.Time, time.Time) (bool, error)); ok {
+		return returnFunc(ctx, reservationID, online, now, sampledBefore)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, bool, time.Time, time.Time) bool); ok {
+		r0 = returnFunc(ctx, reservationID, online, now, sampledBefore)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, bool, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, reservationID, online, now, sampledBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAttendanceRepository_RecordAttendanceSample_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordAttendanceSample'
+type MockAttendanceRepository_RecordAttendanceSample_Call struct {
+	*mock.Call
+}
+
+// RecordAttendanceSample is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservationID int64
+//   - online bool
+//   - now time.Time
+//   - sampledBefore time.Time
+func (_e *MockAttendanceRepository_Expecter) RecordAttendanceSample(ctx interface{}, reservationID interface{}, online interface{}, now interface{}, sampledBefore interface{}) *MockAttendanceRepository_RecordAttendanceSample_Call {
+	return &MockAttendanceRepository_RecordAttendanceSample_Call{Call: _e.mock.On("RecordAttendanceSample", ctx, reservationID, online, now, sampledBefore)}
+}
+
+func (_c *MockAttendanceRepository_RecordAttendanceSample_Call) Run(run func(ctx context.Context, reservationID int64, online bool, now time.Time, sampledBefore time.Time)) *MockAttendanceRepository_RecordAttendanceSample_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttendanceRepository_RecordAttendanceSample_Call) Return(b bool, err error) *MockAttendanceRepository_RecordAttendanceSample_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockAttendanceRepository_RecordAttendanceSample_Call) RunAndReturn(run func(ctx context.Context, reservationID int64, online bool, now time.Time, sampledBefore time.Time) (bool, error)) *MockAttendanceRepository_RecordAttendanceSample_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectOngoingReservations provides a mock function for the type MockAttendanceRepository
+func (_mock *MockAttendanceRepository) SelectOngoingReservations(ctx context.Context, guildID string, now time.Time) ([]*reservation.Reservation, error) {
+	ret := _mock.Called(ctx, guildID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectOngoingReservations")
+	}
+
+	var r0 []*reservation.Reservation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) ([]*reservation.Reservation, error)); ok {
+		return returnFunc(ctx, guildID, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) []*reservation.Reservation); ok {
+		r0 = returnFunc(ctx, guildID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*reservation.Reservation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, guildID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAttendanceRepository_SelectOngoingReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectOngoingReservations'
+type MockAttendanceRepository_SelectOngoingReservations_Call struct {
+	*mock.Call
+}
+
+// SelectOngoingReservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - now time.Time
+func (_e *MockAttendanceRepository_Expecter) SelectOngoingReservations(ctx interface{}, guildID interface{}, now interface{}) *MockAttendanceRepository_SelectOngoingReservations_Call {
+	return &MockAttendanceRepository_SelectOngoingReservations_Call{Call: _e.mock.On("SelectOngoingReservations", ctx, guildID, now)}
+}
+
+func (_c *MockAttendanceRepository_SelectOngoingReservations_Call) Run(run func(ctx context.Context, guildID string, now time.Time)) *MockAttendanceRepository_SelectOngoingReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttendanceRepository_SelectOngoingReservations_Call) Return(reservations []*reservation.Reservation, err error) *MockAttendanceRepository_SelectOngoingReservations_Call {
+	_c.Call.Return(reservations, err)
+	return _c
+}
+
+func (_c *MockAttendanceRepository_SelectOngoingReservations_Call) RunAndReturn(run func(ctx context.Context, guildID string, now time.Time) ([]*reservation.Reservation, error)) *MockAttendanceRepository_SelectOngoingReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAttendanceService creates a new instance of MockAttendanceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttendanceService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttendanceService {
+	mock := &MockAttendanceService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAttendanceService is an autogenerated mock type for the AttendanceService type
+type MockAttendanceService struct {
+	mock.Mock
+}
+
+type MockAttendanceService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttendanceService) EXPECT() *MockAttendanceService_Expecter {
+	return &MockAttendanceService_Expecter{mock: &_m.Mock}
+}
+
+// SampleAttendance provides a mock function for the type MockAttendanceService
+func (_mock *MockAttendanceService) SampleAttendance(guildID string, now time.Time) {
+	_mock.Called(guildID, now)
+	return
+}
+
+// MockAttendanceService_SampleAttendance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SampleAttendance'
+type MockAttendanceService_SampleAttendance_Call struct {
+	*mock.Call
+}
+
+// SampleAttendance is a helper method to define mock.On call
+//   - guildID string
+//   - now time.Time
+func (_e *MockAttendanceService_Expecter) SampleAttendance(guildID interface{}, now interface{}) *MockAttendanceService_SampleAttendance_Call {
+	return &MockAttendanceService_SampleAttendance_Call{Call: _e.mock.On("SampleAttendance", guildID, now)}
+}
+
+func (_c *MockAttendanceService_SampleAttendance_Call) Run(run func(guildID string, now time.Time)) *MockAttendanceService_SampleAttendance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttendanceService_SampleAttendance_Call) Return() *MockAttendanceService_SampleAttendance_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockAttendanceService_SampleAttendance_Call) RunAndReturn(run func(guildID string, now time.Time)) *MockAttendanceService_SampleAttendance_Call {
+	_c.Run(run)
+	return _c
+}
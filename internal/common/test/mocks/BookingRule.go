@@ -0,0 +1,89 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"spot-assistant/internal/core/dto/book"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockBookingRule creates a new instance of MockBookingRule. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBookingRule(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBookingRule {
+	mock := &MockBookingRule{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBookingRule is an autogenerated mock type for the BookingRule type
+type MockBookingRule struct {
+	mock.Mock
+}
+
+type MockBookingRule_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBookingRule) EXPECT() *MockBookingRule_Expecter {
+	return &MockBookingRule_Expecter{mock: &_m.Mock}
+}
+
+// CheckBooking provides a mock function for the type MockBookingRule
+func (_mock *MockBookingRule) CheckBooking(request book.BookRequest) error {
+	ret := _mock.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBooking")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(book.BookRequest) error); ok {
+		r0 = returnFunc(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBookingRule_CheckBooking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBooking'
+type MockBookingRule_CheckBooking_Call struct {
+	*mock.Call
+}
+
+// CheckBooking is a helper method to define mock.On call
+//   - request book.BookRequest
+func (_e *MockBookingRule_Expecter) CheckBooking(request interface{}) *MockBookingRule_CheckBooking_Call {
+	return &MockBookingRule_CheckBooking_Call{Call: _e.mock.On("CheckBooking", request)}
+}
+
+func (_c *MockBookingRule_CheckBooking_Call) Run(run func(request book.BookRequest)) *MockBookingRule_CheckBooking_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 book.BookRequest
+		if args[0] != nil {
+			arg0 = args[0].(book.BookRequest)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookingRule_CheckBooking_Call) Return(err error) *MockBookingRule_CheckBooking_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBookingRule_CheckBooking_Call) RunAndReturn(run func(request book.BookRequest) error) *MockBookingRule_CheckBooking_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -9,6 +9,7 @@ import (
 	"spot-assistant/internal/core/dto/discord"
 	"spot-assistant/internal/core/dto/guild"
 	"spot-assistant/internal/core/dto/member"
+	"spot-assistant/internal/core/dto/reminder"
 	"spot-assistant/internal/core/dto/reservation"
 	"spot-assistant/internal/core/dto/summary"
 
@@ -110,6 +111,138 @@ func (_c *MockBotPort_FindChannelByName_Call) RunAndReturn(run func(g *guild.Gui
 	return _c
 }
 
+// MentionOverbookedNotification provides a mock function for the type MockBotPort
+func (_mock *MockBotPort) MentionOverbookedNotification(g *guild.Guild, member1 *member.Member, request book.BookRequest, res *reservation.ClippedOrRemovedReservation) error {
+	ret := _mock.Called(g, member1, request, res)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MentionOverbookedNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*guild.Guild, *member.Member, book.BookRequest, *reservation.ClippedOrRemovedReservation) error); ok {
+		r0 = returnFunc(g, member1, request, res)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBotPort_MentionOverbookedNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MentionOverbookedNotification'
+type MockBotPort_MentionOverbookedNotification_Call struct {
+	*mock.Call
+}
+
+// MentionOverbookedNotification is a helper method to define mock.On call
+//   - g *guild.Guild
+//   - member1 *member.Member
+//   - request book.BookRequest
+//   - res *reservation.ClippedOrRemovedReservation
+func (_e *MockBotPort_Expecter) MentionOverbookedNotification(g interface{}, member1 interface{}, request interface{}, res interface{}) *MockBotPort_MentionOverbookedNotification_Call {
+	return &MockBotPort_MentionOverbookedNotification_Call{Call: _e.mock.On("MentionOverbookedNotification", g, member1, request, res)}
+}
+
+func (_c *MockBotPort_MentionOverbookedNotification_Call) Run(run func(g *guild.Guild, member1 *member.Member, request book.BookRequest, res *reservation.ClippedOrRemovedReservation)) *MockBotPort_MentionOverbookedNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *guild.Guild
+		if args[0] != nil {
+			arg0 = args[0].(*guild.Guild)
+		}
+		var arg1 *member.Member
+		if args[1] != nil {
+			arg1 = args[1].(*member.Member)
+		}
+		var arg2 book.BookRequest
+		if args[2] != nil {
+			arg2 = args[2].(book.BookRequest)
+		}
+		var arg3 *reservation.ClippedOrRemovedReservation
+		if args[3] != nil {
+			arg3 = args[3].(*reservation.ClippedOrRemovedReservation)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBotPort_MentionOverbookedNotification_Call) Return(err error) *MockBotPort_MentionOverbookedNotification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBotPort_MentionOverbookedNotification_Call) RunAndReturn(run func(g *guild.Guild, member1 *member.Member, request book.BookRequest, res *reservation.ClippedOrRemovedReservation) error) *MockBotPort_MentionOverbookedNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MentionReminder provides a mock function for the type MockBotPort
+func (_mock *MockBotPort) MentionReminder(g *guild.Guild, member1 *member.Member, r *reminder.Reminder) error {
+	ret := _mock.Called(g, member1, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MentionReminder")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*guild.Guild, *member.Member, *reminder.Reminder) error); ok {
+		r0 = returnFunc(g, member1, r)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBotPort_MentionReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MentionReminder'
+type MockBotPort_MentionReminder_Call struct {
+	*mock.Call
+}
+
+// MentionReminder is a helper method to define mock.On call
+//   - g *guild.Guild
+//   - member1 *member.Member
+//   - r *reminder.Reminder
+func (_e *MockBotPort_Expecter) MentionReminder(g interface{}, member1 interface{}, r interface{}) *MockBotPort_MentionReminder_Call {
+	return &MockBotPort_MentionReminder_Call{Call: _e.mock.On("MentionReminder", g, member1, r)}
+}
+
+func (_c *MockBotPort_MentionReminder_Call) Run(run func(g *guild.Guild, member1 *member.Member, r *reminder.Reminder)) *MockBotPort_MentionReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *guild.Guild
+		if args[0] != nil {
+			arg0 = args[0].(*guild.Guild)
+		}
+		var arg1 *member.Member
+		if args[1] != nil {
+			arg1 = args[1].(*member.Member)
+		}
+		var arg2 *reminder.Reminder
+		if args[2] != nil {
+			arg2 = args[2].(*reminder.Reminder)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBotPort_MentionReminder_Call) Return(err error) *MockBotPort_MentionReminder_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBotPort_MentionReminder_Call) RunAndReturn(run func(g *guild.Guild, member1 *member.Member, r *reminder.Reminder) error) *MockBotPort_MentionReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OpenDM provides a mock function for the type MockBotPort
 func (_mock *MockBotPort) OpenDM(m *member.Member) (*discord.Channel, error) {
 	ret := _mock.Called(m)
@@ -279,6 +412,63 @@ func (_c *MockBotPort_SendDMOverbookedNotification_Call) RunAndReturn(run func(m
 	return _c
 }
 
+// SendDMReminder provides a mock function for the type MockBotPort
+func (_mock *MockBotPort) SendDMReminder(member1 *member.Member, r *reminder.Reminder) error {
+	ret := _mock.Called(member1, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendDMReminder")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*member.Member, *reminder.Reminder) error); ok {
+		r0 = returnFunc(member1, r)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBotPort_SendDMReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDMReminder'
+type MockBotPort_SendDMReminder_Call struct {
+	*mock.Call
+}
+
+// SendDMReminder is a helper method to define mock.On call
+//   - member1 *member.Member
+//   - r *reminder.Reminder
+func (_e *MockBotPort_Expecter) SendDMReminder(member1 interface{}, r interface{}) *MockBotPort_SendDMReminder_Call {
+	return &MockBotPort_SendDMReminder_Call{Call: _e.mock.On("SendDMReminder", member1, r)}
+}
+
+func (_c *MockBotPort_SendDMReminder_Call) Run(run func(member1 *member.Member, r *reminder.Reminder)) *MockBotPort_SendDMReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *member.Member
+		if args[0] != nil {
+			arg0 = args[0].(*member.Member)
+		}
+		var arg1 *reminder.Reminder
+		if args[1] != nil {
+			arg1 = args[1].(*reminder.Reminder)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBotPort_SendDMReminder_Call) Return(err error) *MockBotPort_SendDMReminder_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBotPort_SendDMReminder_Call) RunAndReturn(run func(member1 *member.Member, r *reminder.Reminder) error) *MockBotPort_SendDMReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendLetterMessage provides a mock function for the type MockBotPort
 func (_mock *MockBotPort) SendLetterMessage(g *guild.Guild, ch *discord.Channel, sum *summary.Summary) error {
 	ret := _mock.Called(g, ch, sum)
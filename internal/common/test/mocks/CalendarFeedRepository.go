@@ -0,0 +1,179 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCalendarFeedRepository creates a new instance of MockCalendarFeedRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCalendarFeedRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCalendarFeedRepository {
+	mock := &MockCalendarFeedRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCalendarFeedRepository is an autogenerated mock type for the CalendarFeedRepository type
+type MockCalendarFeedRepository struct {
+	mock.Mock
+}
+
+type MockCalendarFeedRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCalendarFeedRepository) EXPECT() *MockCalendarFeedRepository_Expecter {
+	return &MockCalendarFeedRepository_Expecter{mock: &_m.Mock}
+}
+
+// SelectCalendarFeedNonce provides a mock function for the type MockCalendarFeedRepository
+func (_mock *MockCalendarFeedRepository) SelectCalendarFeedNonce(ctx context.Context, guildID string, memberID string) (string, error) {
+	ret := _mock.Called(ctx, guildID, memberID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectCalendarFeedNonce")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return returnFunc(ctx, guildID, memberID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = returnFunc(ctx, guildID, memberID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, guildID, memberID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarFeedRepository_SelectCalendarFeedNonce_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectCalendarFeedNonce'
+type MockCalendarFeedRepository_SelectCalendarFeedNonce_Call struct {
+	*mock.Call
+}
+
+// SelectCalendarFeedNonce is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - memberID string
+func (_e *MockCalendarFeedRepository_Expecter) SelectCalendarFeedNonce(ctx interface{}, guildID interface{}, memberID interface{}) *MockCalendarFeedRepository_SelectCalendarFeedNonce_Call {
+	return &MockCalendarFeedRepository_SelectCalendarFeedNonce_Call{Call: _e.mock.On("SelectCalendarFeedNonce", ctx, guildID, memberID)}
+}
+
+func (_c *MockCalendarFeedRepository_SelectCalendarFeedNonce_Call) Run(run func(ctx context.Context, guildID string, memberID string)) *MockCalendarFeedRepository_SelectCalendarFeedNonce_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarFeedRepository_SelectCalendarFeedNonce_Call) Return(s string, err error) *MockCalendarFeedRepository_SelectCalendarFeedNonce_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockCalendarFeedRepository_SelectCalendarFeedNonce_Call) RunAndReturn(run func(ctx context.Context, guildID string, memberID string) (string, error)) *MockCalendarFeedRepository_SelectCalendarFeedNonce_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertCalendarFeedNonce provides a mock function for the type MockCalendarFeedRepository
+func (_mock *MockCalendarFeedRepository) UpsertCalendarFeedNonce(ctx context.Context, guildID string, memberID string, nonce string) error {
+	ret := _mock.Called(ctx, guildID, memberID, nonce)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertCalendarFeedNonce")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, guildID, memberID, nonce)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCalendarFeedRepository_UpsertCalendarFeedNonce_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertCalendarFeedNonce'
+type MockCalendarFeedRepository_UpsertCalendarFeedNonce_Call struct {
+	*mock.Call
+}
+
+// UpsertCalendarFeedNonce is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - memberID string
+//   - nonce string
+func (_e *MockCalendarFeedRepository_Expecter) UpsertCalendarFeedNonce(ctx interface{}, guildID interface{}, memberID interface{}, nonce interface{}) *MockCalendarFeedRepository_UpsertCalendarFeedNonce_Call {
+	return &MockCalendarFeedRepository_UpsertCalendarFeedNonce_Call{Call: _e.mock.On("UpsertCalendarFeedNonce", ctx, guildID, memberID, nonce)}
+}
+
+func (_c *MockCalendarFeedRepository_UpsertCalendarFeedNonce_Call) Run(run func(ctx context.Context, guildID string, memberID string, nonce string)) *MockCalendarFeedRepository_UpsertCalendarFeedNonce_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarFeedRepository_UpsertCalendarFeedNonce_Call) Return(err error) *MockCalendarFeedRepository_UpsertCalendarFeedNonce_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCalendarFeedRepository_UpsertCalendarFeedNonce_Call) RunAndReturn(run func(ctx context.Context, guildID string, memberID string, nonce string) error) *MockCalendarFeedRepository_UpsertCalendarFeedNonce_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,274 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCalendarService creates a new instance of MockCalendarService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCalendarService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCalendarService {
+	mock := &MockCalendarService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCalendarService is an autogenerated mock type for the CalendarService type
+type MockCalendarService struct {
+	mock.Mock
+}
+
+type MockCalendarService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCalendarService) EXPECT() *MockCalendarService_Expecter {
+	return &MockCalendarService_Expecter{mock: &_m.Mock}
+}
+
+// Feed provides a mock function for the type MockCalendarService
+func (_mock *MockCalendarService) Feed(token string) ([]byte, error) {
+	ret := _mock.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Feed")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]byte, error)); ok {
+		return returnFunc(token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []byte); ok {
+		r0 = returnFunc(token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarService_Feed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Feed'
+type MockCalendarService_Feed_Call struct {
+	*mock.Call
+}
+
+// Feed is a helper method to define mock.On call
+//   - token string
+func (_e *MockCalendarService_Expecter) Feed(token interface{}) *MockCalendarService_Feed_Call {
+	return &MockCalendarService_Feed_Call{Call: _e.mock.On("Feed", token)}
+}
+
+func (_c *MockCalendarService_Feed_Call) Run(run func(token string)) *MockCalendarService_Feed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarService_Feed_Call) Return(bytes []byte, err error) *MockCalendarService_Feed_Call {
+	_c.Call.Return(bytes, err)
+	return _c
+}
+
+func (_c *MockCalendarService_Feed_Call) RunAndReturn(run func(token string) ([]byte, error)) *MockCalendarService_Feed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FeedURL provides a mock function for the type MockCalendarService
+func (_mock *MockCalendarService) FeedURL(guildID string, memberID string) (string, error) {
+	ret := _mock.Called(guildID, memberID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeedURL")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (string, error)); ok {
+		return returnFunc(guildID, memberID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = returnFunc(guildID, memberID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(guildID, memberID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarService_FeedURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FeedURL'
+type MockCalendarService_FeedURL_Call struct {
+	*mock.Call
+}
+
+// FeedURL is a helper method to define mock.On call
+//   - guildID string
+//   - memberID string
+func (_e *MockCalendarService_Expecter) FeedURL(guildID interface{}, memberID interface{}) *MockCalendarService_FeedURL_Call {
+	return &MockCalendarService_FeedURL_Call{Call: _e.mock.On("FeedURL", guildID, memberID)}
+}
+
+func (_c *MockCalendarService_FeedURL_Call) Run(run func(guildID string, memberID string)) *MockCalendarService_FeedURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarService_FeedURL_Call) Return(s string, err error) *MockCalendarService_FeedURL_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockCalendarService_FeedURL_Call) RunAndReturn(run func(guildID string, memberID string) (string, error)) *MockCalendarService_FeedURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsConfigured provides a mock function for the type MockCalendarService
+func (_mock *MockCalendarService) IsConfigured() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsConfigured")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockCalendarService_IsConfigured_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsConfigured'
+type MockCalendarService_IsConfigured_Call struct {
+	*mock.Call
+}
+
+// IsConfigured is a helper method to define mock.On call
+func (_e *MockCalendarService_Expecter) IsConfigured() *MockCalendarService_IsConfigured_Call {
+	return &MockCalendarService_IsConfigured_Call{Call: _e.mock.On("IsConfigured")}
+}
+
+func (_c *MockCalendarService_IsConfigured_Call) Run(run func()) *MockCalendarService_IsConfigured_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCalendarService_IsConfigured_Call) Return(b bool) *MockCalendarService_IsConfigured_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockCalendarService_IsConfigured_Call) RunAndReturn(run func() bool) *MockCalendarService_IsConfigured_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateFeedURL provides a mock function for the type MockCalendarService
+func (_mock *MockCalendarService) RotateFeedURL(guildID string, memberID string) (string, error) {
+	ret := _mock.Called(guildID, memberID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateFeedURL")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (string, error)); ok {
+		return returnFunc(guildID, memberID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = returnFunc(guildID, memberID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(guildID, memberID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarService_RotateFeedURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateFeedURL'
+type MockCalendarService_RotateFeedURL_Call struct {
+	*mock.Call
+}
+
+// RotateFeedURL is a helper method to define mock.On call
+//   - guildID string
+//   - memberID string
+func (_e *MockCalendarService_Expecter) RotateFeedURL(guildID interface{}, memberID interface{}) *MockCalendarService_RotateFeedURL_Call {
+	return &MockCalendarService_RotateFeedURL_Call{Call: _e.mock.On("RotateFeedURL", guildID, memberID)}
+}
+
+func (_c *MockCalendarService_RotateFeedURL_Call) Run(run func(guildID string, memberID string)) *MockCalendarService_RotateFeedURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarService_RotateFeedURL_Call) Return(s string, err error) *MockCalendarService_RotateFeedURL_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockCalendarService_RotateFeedURL_Call) RunAndReturn(run func(guildID string, memberID string) (string, error)) *MockCalendarService_RotateFeedURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,481 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"spot-assistant/internal/core/dto/character"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCharacterRepository creates a new instance of MockCharacterRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCharacterRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCharacterRepository {
+	mock := &MockCharacterRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCharacterRepository is an autogenerated mock type for the CharacterRepository type
+type MockCharacterRepository struct {
+	mock.Mock
+}
+
+type MockCharacterRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCharacterRepository) EXPECT() *MockCharacterRepository_Expecter {
+	return &MockCharacterRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClearCharacterWorld provides a mock function for the type MockCharacterRepository
+func (_mock *MockCharacterRepository) ClearCharacterWorld(ctx context.Context, guildID string, world string) error {
+	ret := _mock.Called(ctx, guildID, world)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearCharacterWorld")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, guildID, world)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCharacterRepository_ClearCharacterWorld_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearCharacterWorld'
+type MockCharacterRepository_ClearCharacterWorld_Call struct {
+	*mock.Call
+}
+
+// ClearCharacterWorld is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - world string
+func (_e *MockCharacterRepository_Expecter) ClearCharacterWorld(ctx interface{}, guildID interface{}, world interface{}) *MockCharacterRepository_ClearCharacterWorld_Call {
+	return &MockCharacterRepository_ClearCharacterWorld_Call{Call: _e.mock.On("ClearCharacterWorld", ctx, guildID, world)}
+}
+
+func (_c *MockCharacterRepository_ClearCharacterWorld_Call) Run(run func(ctx context.Context, guildID string, world string)) *MockCharacterRepository_ClearCharacterWorld_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCharacterRepository_ClearCharacterWorld_Call) Return(err error) *MockCharacterRepository_ClearCharacterWorld_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCharacterRepository_ClearCharacterWorld_Call) RunAndReturn(run func(ctx context.Context, guildID string, world string) error) *MockCharacterRepository_ClearCharacterWorld_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCharacter provides a mock function for the type MockCharacterRepository
+func (_mock *MockCharacterRepository) DeleteCharacter(ctx context.Context, guildID string, memberID string, name string) (bool, error) {
+	ret := _mock.Called(ctx, guildID, memberID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCharacter")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (bool, error)); ok {
+		return returnFunc(ctx, guildID, memberID, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) bool); ok {
+		r0 = returnFunc(ctx, guildID, memberID, name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, guildID, memberID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCharacterRepository_DeleteCharacter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCharacter'
+type MockCharacterRepository_DeleteCharacter_Call struct {
+	*mock.Call
+}
+
+// DeleteCharacter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - memberID string
+//   - name string
+func (_e *MockCharacterRepository_Expecter) DeleteCharacter(ctx interface{}, guildID interface{}, memberID interface{}, name interface{}) *MockCharacterRepository_DeleteCharacter_Call {
+	return &MockCharacterRepository_DeleteCharacter_Call{Call: _e.mock.On("DeleteCharacter", ctx, guildID, memberID, name)}
+}
+
+func (_c *MockCharacterRepository_DeleteCharacter_Call) Run(run func(ctx context.Context, guildID string, memberID string, name string)) *MockCharacterRepository_DeleteCharacter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCharacterRepository_DeleteCharacter_Call) Return(b bool, err error) *MockCharacterRepository_DeleteCharacter_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockCharacterRepository_DeleteCharacter_Call) RunAndReturn(run func(ctx context.Context, guildID string, memberID string, name string) (bool, error)) *MockCharacterRepository_DeleteCharacter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertCharacter provides a mock function for the type MockCharacterRepository
+func (_mock *MockCharacterRepository) InsertCharacter(ctx context.Context, c *character.Character) (*character.Character, error) {
+	ret := _mock.Called(ctx, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertCharacter")
+	}
+
+	var r0 *character.Character
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *character.Character) (*character.Character, error)); ok {
+		return returnFunc(ctx, c)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *character.Character) *character.Character); ok {
+		r0 = returnFunc(ctx, c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*character.Character)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *character.Character) error); ok {
+		r1 = returnFunc(ctx, c)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCharacterRepository_InsertCharacter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertCharacter'
+type MockCharacterRepository_InsertCharacter_Call struct {
+	*mock.Call
+}
+
+// InsertCharacter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - c *character.Character
+func (_e *MockCharacterRepository_Expecter) InsertCharacter(ctx interface{}, c interface{}) *MockCharacterRepository_InsertCharacter_Call {
+	return &MockCharacterRepository_InsertCharacter_Call{Call: _e.mock.On("InsertCharacter", ctx, c)}
+}
+
+func (_c *MockCharacterRepository_InsertCharacter_Call) Run(run func(ctx context.Context, c *character.Character)) *MockCharacterRepository_InsertCharacter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *character.Character
+		if args[1] != nil {
+			arg1 = args[1].(*character.Character)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCharacterRepository_InsertCharacter_Call) Return(character1 *character.Character, err error) *MockCharacterRepository_InsertCharacter_Call {
+	_c.Call.Return(character1, err)
+	return _c
+}
+
+func (_c *MockCharacterRepository_InsertCharacter_Call) RunAndReturn(run func(ctx context.Context, c *character.Character) (*character.Character, error)) *MockCharacterRepository_InsertCharacter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkCharacterVerified provides a mock function for the type MockCharacterRepository
+func (_mock *MockCharacterRepository) MarkCharacterVerified(ctx context.Context, guildID string, memberID string, name string, world string, at time.Time) (bool, error) {
+	ret := _mock.Called(ctx, guildID, memberID, name, world, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkCharacterVerified")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, time.Time) (bool, error)); ok {
+		return returnFunc(ctx, guildID, memberID, name, world, at)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, time.Time) bool); ok {
+		r0 = returnFunc(ctx, guildID, memberID, name, world, at)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, guildID, memberID, name, world, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCharacterRepository_MarkCharacterVerified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkCharacterVerified'
+type MockCharacterRepository_MarkCharacterVerified_Call struct {
+	*mock.Call
+}
+
+// MarkCharacterVerified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - memberID string
+//   - name string
+//   - world string
+//   - at time.Time
+func (_e *MockCharacterRepository_Expecter) MarkCharacterVerified(ctx interface{}, guildID interface{}, memberID interface{}, name interface{}, world interface{}, at interface{}) *MockCharacterRepository_MarkCharacterVerified_Call {
+	return &MockCharacterRepository_MarkCharacterVerified_Call{Call: _e.mock.On("MarkCharacterVerified", ctx, guildID, memberID, name, world, at)}
+}
+
+func (_c *MockCharacterRepository_MarkCharacterVerified_Call) Run(run func(ctx context.Context, guildID string, memberID string, name string, world string, at time.Time)) *MockCharacterRepository_MarkCharacterVerified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 time.Time
+		if args[5] != nil {
+			arg5 = args[5].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCharacterRepository_MarkCharacterVerified_Call) Return(b bool, err error) *MockCharacterRepository_MarkCharacterVerified_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockCharacterRepository_MarkCharacterVerified_Call) RunAndReturn(run func(ctx context.Context, guildID string, memberID string, name string, world string, at time.Time) (bool, error)) *MockCharacterRepository_MarkCharacterVerified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectGuildCharacters provides a mock function for the type MockCharacterRepository
+func (_mock *MockCharacterRepository) SelectGuildCharacters(ctx context.Context, guildID string) ([]*character.Character, error) {
+	ret := _mock.Called(ctx, guildID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectGuildCharacters")
+	}
+
+	var r0 []*character.Character
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*character.Character, error)); ok {
+		return returnFunc(ctx, guildID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*character.Character); ok {
+		r0 = returnFunc(ctx, guildID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*character.Character)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, guildID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCharacterRepository_SelectGuildCharacters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectGuildCharacters'
+type MockCharacterRepository_SelectGuildCharacters_Call struct {
+	*mock.Call
+}
+
+// SelectGuildCharacters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+func (_e *MockCharacterRepository_Expecter) SelectGuildCharacters(ctx interface{}, guildID interface{}) *MockCharacterRepository_SelectGuildCharacters_Call {
+	return &MockCharacterRepository_SelectGuildCharacters_Call{Call: _e.mock.On("SelectGuildCharacters", ctx, guildID)}
+}
+
+func (_c *MockCharacterRepository_SelectGuildCharacters_Call) Run(run func(ctx context.Context, guildID string)) *MockCharacterRepository_SelectGuildCharacters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCharacterRepository_SelectGuildCharacters_Call) Return(characters []*character.Character, err error) *MockCharacterRepository_SelectGuildCharacters_Call {
+	_c.Call.Return(characters, err)
+	return _c
+}
+
+func (_c *MockCharacterRepository_SelectGuildCharacters_Call) RunAndReturn(run func(ctx context.Context, guildID string) ([]*character.Character, error)) *MockCharacterRepository_SelectGuildCharacters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectMemberCharacters provides a mock function for the type MockCharacterRepository
+func (_mock *MockCharacterRepository) SelectMemberCharacters(ctx context.Context, guildID string, memberID string) ([]*character.Character, error) {
+	ret := _mock.Called(ctx, guildID, memberID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectMemberCharacters")
+	}
+
+	var r0 []*character.Character
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]*character.Character, error)); ok {
+		return returnFunc(ctx, guildID, memberID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []*character.Character); ok {
+		r0 = returnFunc(ctx, guildID, memberID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*character.Character)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, guildID, memberID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCharacterRepository_SelectMemberCharacters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectMemberCharacters'
+type MockCharacterRepository_SelectMemberCharacters_Call struct {
+	*mock.Call
+}
+
+// SelectMemberCharacters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - memberID string
+func (_e *MockCharacterRepository_Expecter) SelectMemberCharacters(ctx interface{}, guildID interface{}, memberID interface{}) *MockCharacterRepository_SelectMemberCharacters_Call {
+	return &MockCharacterRepository_SelectMemberCharacters_Call{Call: _e.mock.On("SelectMemberCharacters", ctx, guildID, memberID)}
+}
+
+func (_c *MockCharacterRepository_SelectMemberCharacters_Call) Run(run func(ctx context.Context, guildID string, memberID string)) *MockCharacterRepository_SelectMemberCharacters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCharacterRepository_SelectMemberCharacters_Call) Return(characters []*character.Character, err error) *MockCharacterRepository_SelectMemberCharacters_Call {
+	_c.Call.Return(characters, err)
+	return _c
+}
+
+func (_c *MockCharacterRepository_SelectMemberCharacters_Call) RunAndReturn(run func(ctx context.Context, guildID string, memberID string) ([]*character.Character, error)) *MockCharacterRepository_SelectMemberCharacters_Call {
+	_c.Call.Return(run)
+	return _c
+}
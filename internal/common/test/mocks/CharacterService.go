@@ -0,0 +1,367 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"spot-assistant/internal/core/dto/character"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCharacterService creates a new instance of MockCharacterService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCharacterService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCharacterService {
+	mock := &MockCharacterService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCharacterService is an autogenerated mock type for the CharacterService type
+type MockCharacterService struct {
+	mock.Mock
+}
+
+type MockCharacterService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCharacterService) EXPECT() *MockCharacterService_Expecter {
+	return &MockCharacterService_Expecter{mock: &_m.Mock}
+}
+
+// AddCharacter provides a mock function for the type MockCharacterService
+func (_mock *MockCharacterService) AddCharacter(guildID string, memberID string, name string, world string) (*character.Character, error) {
+	ret := _mock.Called(guildID, memberID, name, world)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCharacter")
+	}
+
+	var r0 *character.Character
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, string) (*character.Character, error)); ok {
+		return returnFunc(guildID, memberID, name, world)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, string) *character.Character); ok {
+		r0 = returnFunc(guildID, memberID, name, world)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*character.Character)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = returnFunc(guildID, memberID, name, world)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCharacterService_AddCharacter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCharacter'
+type MockCharacterService_AddCharacter_Call struct {
+	*mock.Call
+}
+
+// AddCharacter is a helper method to define mock.On call
+//   - guildID string
+//   - memberID string
+//   - name string
+//   - world string
+func (_e *MockCharacterService_Expecter) AddCharacter(guildID interface{}, memberID interface{}, name interface{}, world interface{}) *MockCharacterService_AddCharacter_Call {
+	return &MockCharacterService_AddCharacter_Call{Call: _e.mock.On("AddCharacter", guildID, memberID, name, world)}
+}
+
+func (_c *MockCharacterService_AddCharacter_Call) Run(run func(guildID string, memberID string, name string, world string)) *MockCharacterService_AddCharacter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCharacterService_AddCharacter_Call) Return(character1 *character.Character, err error) *MockCharacterService_AddCharacter_Call {
+	_c.Call.Return(character1, err)
+	return _c
+}
+
+func (_c *MockCharacterService_AddCharacter_Call) RunAndReturn(run func(guildID string, memberID string, name string, world string) (*character.Character, error)) *MockCharacterService_AddCharacter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CanVerify provides a mock function for the type MockCharacterService
+func (_mock *MockCharacterService) CanVerify() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CanVerify")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockCharacterService_CanVerify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CanVerify'
+type MockCharacterService_CanVerify_Call struct {
+	*mock.Call
+}
+
+// CanVerify is a helper method to define mock.On call
+func (_e *MockCharacterService_Expecter) CanVerify() *MockCharacterService_CanVerify_Call {
+	return &MockCharacterService_CanVerify_Call{Call: _e.mock.On("CanVerify")}
+}
+
+func (_c *MockCharacterService_CanVerify_Call) Run(run func()) *MockCharacterService_CanVerify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCharacterService_CanVerify_Call) Return(b bool) *MockCharacterService_CanVerify_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockCharacterService_CanVerify_Call) RunAndReturn(run func() bool) *MockCharacterService_CanVerify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCharacters provides a mock function for the type MockCharacterService
+func (_mock *MockCharacterService) ListCharacters(guildID string, memberID string) ([]*character.Character, error) {
+	ret := _mock.Called(guildID, memberID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCharacters")
+	}
+
+	var r0 []*character.Character
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) ([]*character.Character, error)); ok {
+		return returnFunc(guildID, memberID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) []*character.Character); ok {
+		r0 = returnFunc(guildID, memberID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*character.Character)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(guildID, memberID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCharacterService_ListCharacters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCharacters'
+type MockCharacterService_ListCharacters_Call struct {
+	*mock.Call
+}
+
+// ListCharacters is a helper method to define mock.On call
+//   - guildID string
+//   - memberID string
+func (_e *MockCharacterService_Expecter) ListCharacters(guildID interface{}, memberID interface{}) *MockCharacterService_ListCharacters_Call {
+	return &MockCharacterService_ListCharacters_Call{Call: _e.mock.On("ListCharacters", guildID, memberID)}
+}
+
+func (_c *MockCharacterService_ListCharacters_Call) Run(run func(guildID string, memberID string)) *MockCharacterService_ListCharacters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCharacterService_ListCharacters_Call) Return(characters []*character.Character, err error) *MockCharacterService_ListCharacters_Call {
+	_c.Call.Return(characters, err)
+	return _c
+}
+
+func (_c *MockCharacterService_ListCharacters_Call) RunAndReturn(run func(guildID string, memberID string) ([]*character.Character, error)) *MockCharacterService_ListCharacters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveCharacter provides a mock function for the type MockCharacterService
+func (_mock *MockCharacterService) RemoveCharacter(guildID string, memberID string, name string) error {
+	ret := _mock.Called(guildID, memberID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveCharacter")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = returnFunc(guildID, memberID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCharacterService_RemoveCharacter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCharacter'
+type MockCharacterService_RemoveCharacter_Call struct {
+	*mock.Call
+}
+
+// RemoveCharacter is a helper method to define mock.On call
+//   - guildID string
+//   - memberID string
+//   - name string
+func (_e *MockCharacterService_Expecter) RemoveCharacter(guildID interface{}, memberID interface{}, name interface{}) *MockCharacterService_RemoveCharacter_Call {
+	return &MockCharacterService_RemoveCharacter_Call{Call: _e.mock.On("RemoveCharacter", guildID, memberID, name)}
+}
+
+func (_c *MockCharacterService_RemoveCharacter_Call) Run(run func(guildID string, memberID string, name string)) *MockCharacterService_RemoveCharacter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCharacterService_RemoveCharacter_Call) Return(err error) *MockCharacterService_RemoveCharacter_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCharacterService_RemoveCharacter_Call) RunAndReturn(run func(guildID string, memberID string, name string) error) *MockCharacterService_RemoveCharacter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyCharacter provides a mock function for the type MockCharacterService
+func (_mock *MockCharacterService) VerifyCharacter(guildID string, memberID string, name string) (*character.Character, error) {
+	ret := _mock.Called(guildID, memberID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyCharacter")
+	}
+
+	var r0 *character.Character
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) (*character.Character, error)); ok {
+		return returnFunc(guildID, memberID, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) *character.Character); ok {
+		r0 = returnFunc(guildID, memberID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*character.Character)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = returnFunc(guildID, memberID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCharacterService_VerifyCharacter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyCharacter'
+type MockCharacterService_VerifyCharacter_Call struct {
+	*mock.Call
+}
+
+// VerifyCharacter is a helper method to define mock.On call
+//   - guildID string
+//   - memberID string
+//   - name string
+func (_e *MockCharacterService_Expecter) VerifyCharacter(guildID interface{}, memberID interface{}, name interface{}) *MockCharacterService_VerifyCharacter_Call {
+	return &MockCharacterService_VerifyCharacter_Call{Call: _e.mock.On("VerifyCharacter", guildID, memberID, name)}
+}
+
+func (_c *MockCharacterService_VerifyCharacter_Call) Run(run func(guildID string, memberID string, name string)) *MockCharacterService_VerifyCharacter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCharacterService_VerifyCharacter_Call) Return(character1 *character.Character, err error) *MockCharacterService_VerifyCharacter_Call {
+	_c.Call.Return(character1, err)
+	return _c
+}
+
+func (_c *MockCharacterService_VerifyCharacter_Call) RunAndReturn(run func(guildID string, memberID string, name string) (*character.Character, error)) *MockCharacterService_VerifyCharacter_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"spot-assistant/internal/core/dto/summary"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -102,3 +104,65 @@ func (_c *MockChartAdapter_NewChart_Call) RunAndReturn(run func(values []float64
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewTimeline provides a mock function for the type MockChartAdapter
+func (_mock *MockChartAdapter) NewTimeline(timeline summary.Timeline) ([]byte, error) {
+	ret := _mock.Called(timeline)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewTimeline")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(summary.Timeline) ([]byte, error)); ok {
+		return returnFunc(timeline)
+	}
+	if returnFunc, ok := ret.Get(0).(func(summary.Timeline) []byte); ok {
+		r0 = returnFunc(timeline)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(summary.Timeline) error); ok {
+		r1 = returnFunc(timeline)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockChartAdapter_NewTimeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewTimeline'
+type MockChartAdapter_NewTimeline_Call struct {
+	*mock.Call
+}
+
+// NewTimeline is a helper method to define mock.On call
+//   - timeline summary.Timeline
+func (_e *MockChartAdapter_Expecter) NewTimeline(timeline interface{}) *MockChartAdapter_NewTimeline_Call {
+	return &MockChartAdapter_NewTimeline_Call{Call: _e.mock.On("NewTimeline", timeline)}
+}
+
+func (_c *MockChartAdapter_NewTimeline_Call) Run(run func(timeline summary.Timeline)) *MockChartAdapter_NewTimeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 summary.Timeline
+		if args[0] != nil {
+			arg0 = args[0].(summary.Timeline)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChartAdapter_NewTimeline_Call) Return(bytes []byte, err error) *MockChartAdapter_NewTimeline_Call {
+	_c.Call.Return(bytes, err)
+	return _c
+}
+
+func (_c *MockChartAdapter_NewTimeline_Call) RunAndReturn(run func(timeline summary.Timeline) ([]byte, error)) *MockChartAdapter_NewTimeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
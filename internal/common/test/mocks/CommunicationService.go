@@ -7,6 +7,7 @@ package mocks
 import (
 	"spot-assistant/internal/core/dto/book"
 	"spot-assistant/internal/core/dto/guild"
+	"spot-assistant/internal/core/dto/reminder"
 	"spot-assistant/internal/core/dto/reservation"
 	"spot-assistant/internal/core/dto/summary"
 
@@ -41,9 +42,20 @@ func (_m *MockCommunicationService) EXPECT() *MockCommunicationService_Expecter
 }
 
 // NotifyOverbookedMember provides a mock function for the type MockCommunicationService
-func (_mock *MockCommunicationService) NotifyOverbookedMember(request book.BookRequest, res *reservation.ClippedOrRemovedReservation) {
-	_mock.Called(request, res)
-	return
+func (_mock *MockCommunicationService) NotifyOverbookedMember(request book.BookRequest, res *reservation.ClippedOrRemovedReservation) error {
+	ret := _mock.Called(request, res)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyOverbookedMember")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(book.BookRequest, *reservation.ClippedOrRemovedReservation) error); ok {
+		r0 = returnFunc(request, res)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
 // MockCommunicationService_NotifyOverbookedMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyOverbookedMember'
@@ -76,13 +88,64 @@ func (_c *MockCommunicationService_NotifyOverbookedMember_Call) Run(run func(req
 	return _c
 }
 
-func (_c *MockCommunicationService_NotifyOverbookedMember_Call) Return() *MockCommunicationService_NotifyOverbookedMember_Call {
-	_c.Call.Return()
+func (_c *MockCommunicationService_NotifyOverbookedMember_Call) Return(err error) *MockCommunicationService_NotifyOverbookedMember_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockCommunicationService_NotifyOverbookedMember_Call) RunAndReturn(run func(request book.BookRequest, res *reservation.ClippedOrRemovedReservation)) *MockCommunicationService_NotifyOverbookedMember_Call {
-	_c.Run(run)
+func (_c *MockCommunicationService_NotifyOverbookedMember_Call) RunAndReturn(run func(request book.BookRequest, res *reservation.ClippedOrRemovedReservation) error) *MockCommunicationService_NotifyOverbookedMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyReminder provides a mock function for the type MockCommunicationService
+func (_mock *MockCommunicationService) NotifyReminder(r *reminder.Reminder) error {
+	ret := _mock.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyReminder")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*reminder.Reminder) error); ok {
+		r0 = returnFunc(r)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCommunicationService_NotifyReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyReminder'
+type MockCommunicationService_NotifyReminder_Call struct {
+	*mock.Call
+}
+
+// NotifyReminder is a helper method to define mock.On call
+//   - r *reminder.Reminder
+func (_e *MockCommunicationService_Expecter) NotifyReminder(r interface{}) *MockCommunicationService_NotifyReminder_Call {
+	return &MockCommunicationService_NotifyReminder_Call{Call: _e.mock.On("NotifyReminder", r)}
+}
+
+func (_c *MockCommunicationService_NotifyReminder_Call) Run(run func(r *reminder.Reminder)) *MockCommunicationService_NotifyReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *reminder.Reminder
+		if args[0] != nil {
+			arg0 = args[0].(*reminder.Reminder)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommunicationService_NotifyReminder_Call) Return(err error) *MockCommunicationService_NotifyReminder_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCommunicationService_NotifyReminder_Call) RunAndReturn(run func(r *reminder.Reminder) error) *MockCommunicationService_NotifyReminder_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
@@ -0,0 +1,124 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockEvent creates a new instance of MockEvent. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEvent(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEvent {
+	mock := &MockEvent{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEvent is an autogenerated mock type for the Event type
+type MockEvent struct {
+	mock.Mock
+}
+
+type MockEvent_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEvent) EXPECT() *MockEvent_Expecter {
+	return &MockEvent_Expecter{mock: &_m.Mock}
+}
+
+// GuildID provides a mock function for the type MockEvent
+func (_mock *MockEvent) GuildID() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GuildID")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockEvent_GuildID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GuildID'
+type MockEvent_GuildID_Call struct {
+	*mock.Call
+}
+
+// GuildID is a helper method to define mock.On call
+func (_e *MockEvent_Expecter) GuildID() *MockEvent_GuildID_Call {
+	return &MockEvent_GuildID_Call{Call: _e.mock.On("GuildID")}
+}
+
+func (_c *MockEvent_GuildID_Call) Run(run func()) *MockEvent_GuildID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockEvent_GuildID_Call) Return(s string) *MockEvent_GuildID_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockEvent_GuildID_Call) RunAndReturn(run func() string) *MockEvent_GuildID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Name provides a mock function for the type MockEvent
+func (_mock *MockEvent) Name() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockEvent_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type MockEvent_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *MockEvent_Expecter) Name() *MockEvent_Name_Call {
+	return &MockEvent_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *MockEvent_Name_Call) Run(run func()) *MockEvent_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockEvent_Name_Call) Return(s string) *MockEvent_Name_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockEvent_Name_Call) RunAndReturn(run func() string) *MockEvent_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
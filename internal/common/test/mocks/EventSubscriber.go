@@ -0,0 +1,78 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"spot-assistant/internal/core/dto/event"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockEventSubscriber creates a new instance of MockEventSubscriber. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEventSubscriber(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEventSubscriber {
+	mock := &MockEventSubscriber{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEventSubscriber is an autogenerated mock type for the EventSubscriber type
+type MockEventSubscriber struct {
+	mock.Mock
+}
+
+type MockEventSubscriber_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEventSubscriber) EXPECT() *MockEventSubscriber_Expecter {
+	return &MockEventSubscriber_Expecter{mock: &_m.Mock}
+}
+
+// HandleEvent provides a mock function for the type MockEventSubscriber
+func (_mock *MockEventSubscriber) HandleEvent(e event.Event) {
+	_mock.Called(e)
+	return
+}
+
+// MockEventSubscriber_HandleEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleEvent'
+type MockEventSubscriber_HandleEvent_Call struct {
+	*mock.Call
+}
+
+// HandleEvent is a helper method to define mock.On call
+//   - e event.Event
+func (_e *MockEventSubscriber_Expecter) HandleEvent(e interface{}) *MockEventSubscriber_HandleEvent_Call {
+	return &MockEventSubscriber_HandleEvent_Call{Call: _e.mock.On("HandleEvent", e)}
+}
+
+func (_c *MockEventSubscriber_HandleEvent_Call) Run(run func(e event.Event)) *MockEventSubscriber_HandleEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 event.Event
+		if args[0] != nil {
+			arg0 = args[0].(event.Event)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSubscriber_HandleEvent_Call) Return() *MockEventSubscriber_HandleEvent_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockEventSubscriber_HandleEvent_Call) RunAndReturn(run func(e event.Event)) *MockEventSubscriber_HandleEvent_Call {
+	_c.Run(run)
+	return _c
+}
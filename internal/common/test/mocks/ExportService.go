@@ -0,0 +1,255 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"spot-assistant/internal/core/dto/export"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockExportService creates a new instance of MockExportService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExportService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExportService {
+	mock := &MockExportService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExportService is an autogenerated mock type for the ExportService type
+type MockExportService struct {
+	mock.Mock
+}
+
+type MockExportService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExportService) EXPECT() *MockExportService_Expecter {
+	return &MockExportService_Expecter{mock: &_m.Mock}
+}
+
+// ExportReservations provides a mock function for the type MockExportService
+func (_mock *MockExportService) ExportReservations(guildID string, from time.Time, to time.Time, format export.Format) ([]byte, error) {
+	ret := _mock.Called(guildID, from, to, format)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportReservations")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, time.Time, time.Time, export.Format) ([]byte, error)); ok {
+		return returnFunc(guildID, from, to, format)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, time.Time, time.Time, export.Format) []byte); ok {
+		r0 = returnFunc(guildID, from, to, format)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, time.Time, time.Time, export.Format) error); ok {
+		r1 = returnFunc(guildID, from, to, format)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExportService_ExportReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportReservations'
+type MockExportService_ExportReservations_Call struct {
+	*mock.Call
+}
+
+// ExportReservations is a helper method to define mock.On call
+//   - guildID string
+//   - from time.Time
+//   - to time.Time
+//   - format export.Format
+func (_e *MockExportService_Expecter) ExportReservations(guildID interface{}, from interface{}, to interface{}, format interface{}) *MockExportService_ExportReservations_Call {
+	return &MockExportService_ExportReservations_Call{Call: _e.mock.On("ExportReservations", guildID, from, to, format)}
+}
+
+func (_c *MockExportService_ExportReservations_Call) Run(run func(guildID string, from time.Time, to time.Time, format export.Format)) *MockExportService_ExportReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 export.Format
+		if args[3] != nil {
+			arg3 = args[3].(export.Format)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExportService_ExportReservations_Call) Return(bytes []byte, err error) *MockExportService_ExportReservations_Call {
+	_c.Call.Return(bytes, err)
+	return _c
+}
+
+func (_c *MockExportService_ExportReservations_Call) RunAndReturn(run func(guildID string, from time.Time, to time.Time, format export.Format) ([]byte, error)) *MockExportService_ExportReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportSpots provides a mock function for the type MockExportService
+func (_mock *MockExportService) ExportSpots(format export.Format) ([]byte, error) {
+	ret := _mock.Called(format)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportSpots")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(export.Format) ([]byte, error)); ok {
+		return returnFunc(format)
+	}
+	if returnFunc, ok := ret.Get(0).(func(export.Format) []byte); ok {
+		r0 = returnFunc(format)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(export.Format) error); ok {
+		r1 = returnFunc(format)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExportService_ExportSpots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportSpots'
+type MockExportService_ExportSpots_Call struct {
+	*mock.Call
+}
+
+// ExportSpots is a helper method to define mock.On call
+//   - format export.Format
+func (_e *MockExportService_Expecter) ExportSpots(format interface{}) *MockExportService_ExportSpots_Call {
+	return &MockExportService_ExportSpots_Call{Call: _e.mock.On("ExportSpots", format)}
+}
+
+func (_c *MockExportService_ExportSpots_Call) Run(run func(format export.Format)) *MockExportService_ExportSpots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 export.Format
+		if args[0] != nil {
+			arg0 = args[0].(export.Format)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExportService_ExportSpots_Call) Return(bytes []byte, err error) *MockExportService_ExportSpots_Call {
+	_c.Call.Return(bytes, err)
+	return _c
+}
+
+func (_c *MockExportService_ExportSpots_Call) RunAndReturn(run func(format export.Format) ([]byte, error)) *MockExportService_ExportSpots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportSpots provides a mock function for the type MockExportService
+func (_mock *MockExportService) ImportSpots(data []byte, format export.Format, dryRun bool) (*export.ImportReport, error) {
+	ret := _mock.Called(data, format, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportSpots")
+	}
+
+	var r0 *export.ImportReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]byte, export.Format, bool) (*export.ImportReport, error)); ok {
+		return returnFunc(data, format, dryRun)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]byte, export.Format, bool) *export.ImportReport); ok {
+		r0 = returnFunc(data, format, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*export.ImportReport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]byte, export.Format, bool) error); ok {
+		r1 = returnFunc(data, format, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExportService_ImportSpots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportSpots'
+type MockExportService_ImportSpots_Call struct {
+	*mock.Call
+}
+
+// ImportSpots is a helper method to define mock.On call
+//   - data []byte
+//   - format export.Format
+//   - dryRun bool
+func (_e *MockExportService_Expecter) ImportSpots(data interface{}, format interface{}, dryRun interface{}) *MockExportService_ImportSpots_Call {
+	return &MockExportService_ImportSpots_Call{Call: _e.mock.On("ImportSpots", data, format, dryRun)}
+}
+
+func (_c *MockExportService_ImportSpots_Call) Run(run func(data []byte, format export.Format, dryRun bool)) *MockExportService_ImportSpots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []byte
+		if args[0] != nil {
+			arg0 = args[0].([]byte)
+		}
+		var arg1 export.Format
+		if args[1] != nil {
+			arg1 = args[1].(export.Format)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExportService_ImportSpots_Call) Return(importReport *export.ImportReport, err error) *MockExportService_ImportSpots_Call {
+	_c.Call.Return(importReport, err)
+	return _c
+}
+
+func (_c *MockExportService_ImportSpots_Call) RunAndReturn(run func(data []byte, format export.Format, dryRun bool) (*export.ImportReport, error)) *MockExportService_ImportSpots_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,130 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"spot-assistant/internal/core/dto/book"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockGuildMembershipService creates a new instance of MockGuildMembershipService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGuildMembershipService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGuildMembershipService {
+	mock := &MockGuildMembershipService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGuildMembershipService is an autogenerated mock type for the GuildMembershipService type
+type MockGuildMembershipService struct {
+	mock.Mock
+}
+
+type MockGuildMembershipService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGuildMembershipService) EXPECT() *MockGuildMembershipService_Expecter {
+	return &MockGuildMembershipService_Expecter{mock: &_m.Mock}
+}
+
+// CheckBooking provides a mock function for the type MockGuildMembershipService
+func (_mock *MockGuildMembershipService) CheckBooking(request book.BookRequest) error {
+	ret := _mock.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBooking")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(book.BookRequest) error); ok {
+		r0 = returnFunc(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGuildMembershipService_CheckBooking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBooking'
+type MockGuildMembershipService_CheckBooking_Call struct {
+	*mock.Call
+}
+
+// CheckBooking is a helper method to define mock.On call
+//   - request book.BookRequest
+func (_e *MockGuildMembershipService_Expecter) CheckBooking(request interface{}) *MockGuildMembershipService_CheckBooking_Call {
+	return &MockGuildMembershipService_CheckBooking_Call{Call: _e.mock.On("CheckBooking", request)}
+}
+
+func (_c *MockGuildMembershipService_CheckBooking_Call) Run(run func(request book.BookRequest)) *MockGuildMembershipService_CheckBooking_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 book.BookRequest
+		if args[0] != nil {
+			arg0 = args[0].(book.BookRequest)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGuildMembershipService_CheckBooking_Call) Return(err error) *MockGuildMembershipService_CheckBooking_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGuildMembershipService_CheckBooking_Call) RunAndReturn(run func(request book.BookRequest) error) *MockGuildMembershipService_CheckBooking_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshDue provides a mock function for the type MockGuildMembershipService
+func (_mock *MockGuildMembershipService) RefreshDue(now time.Time) {
+	_mock.Called(now)
+	return
+}
+
+// MockGuildMembershipService_RefreshDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshDue'
+type MockGuildMembershipService_RefreshDue_Call struct {
+	*mock.Call
+}
+
+// RefreshDue is a helper method to define mock.On call
+//   - now time.Time
+func (_e *MockGuildMembershipService_Expecter) RefreshDue(now interface{}) *MockGuildMembershipService_RefreshDue_Call {
+	return &MockGuildMembershipService_RefreshDue_Call{Call: _e.mock.On("RefreshDue", now)}
+}
+
+func (_c *MockGuildMembershipService_RefreshDue_Call) Run(run func(now time.Time)) *MockGuildMembershipService_RefreshDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 time.Time
+		if args[0] != nil {
+			arg0 = args[0].(time.Time)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGuildMembershipService_RefreshDue_Call) Return() *MockGuildMembershipService_RefreshDue_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockGuildMembershipService_RefreshDue_Call) RunAndReturn(run func(now time.Time)) *MockGuildMembershipService_RefreshDue_Call {
+	_c.Run(run)
+	return _c
+}
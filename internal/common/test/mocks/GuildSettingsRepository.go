@@ -0,0 +1,243 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"spot-assistant/internal/core/dto/guildsettings"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockGuildSettingsRepository creates a new instance of MockGuildSettingsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGuildSettingsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGuildSettingsRepository {
+	mock := &MockGuildSettingsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGuildSettingsRepository is an autogenerated mock type for the GuildSettingsRepository type
+type MockGuildSettingsRepository struct {
+	mock.Mock
+}
+
+type MockGuildSettingsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGuildSettingsRepository) EXPECT() *MockGuildSettingsRepository_Expecter {
+	return &MockGuildSettingsRepository_Expecter{mock: &_m.Mock}
+}
+
+// SelectGuildSettings provides a mock function for the type MockGuildSettingsRepository
+func (_mock *MockGuildSettingsRepository) SelectGuildSettings(ctx context.Context, guildID string) (*guildsettings.GuildSettings, error) {
+	ret := _mock.Called(ctx, guildID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectGuildSettings")
+	}
+
+	var r0 *guildsettings.GuildSettings
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*guildsettings.GuildSettings, error)); ok {
+		return returnFunc(ctx, guildID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *guildsettings.GuildSettings); ok {
+		r0 = returnFunc(ctx, guildID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*guildsettings.GuildSettings)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, guildID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGuildSettingsRepository_SelectGuildSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectGuildSettings'
+type MockGuildSettingsRepository_SelectGuildSettings_Call struct {
+	*mock.Call
+}
+
+// SelectGuildSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+func (_e *MockGuildSettingsRepository_Expecter) SelectGuildSettings(ctx interface{}, guildID interface{}) *MockGuildSettingsRepository_SelectGuildSettings_Call {
+	return &MockGuildSettingsRepository_SelectGuildSettings_Call{Call: _e.mock.On("SelectGuildSettings", ctx, guildID)}
+}
+
+func (_c *MockGuildSettingsRepository_SelectGuildSettings_Call) Run(run func(ctx context.Context, guildID string)) *MockGuildSettingsRepository_SelectGuildSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGuildSettingsRepository_SelectGuildSettings_Call) Return(guildSettings *guildsettings.GuildSettings, err error) *MockGuildSettingsRepository_SelectGuildSettings_Call {
+	_c.Call.Return(guildSettings, err)
+	return _c
+}
+
+func (_c *MockGuildSettingsRepository_SelectGuildSettings_Call) RunAndReturn(run func(ctx context.Context, guildID string) (*guildsettings.GuildSettings, error)) *MockGuildSettingsRepository_SelectGuildSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectTibiaGuilds provides a mock function for the type MockGuildSettingsRepository
+func (_mock *MockGuildSettingsRepository) SelectTibiaGuilds(ctx context.Context) ([]string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectTibiaGuilds")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGuildSettingsRepository_SelectTibiaGuilds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectTibiaGuilds'
+type MockGuildSettingsRepository_SelectTibiaGuilds_Call struct {
+	*mock.Call
+}
+
+// SelectTibiaGuilds is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGuildSettingsRepository_Expecter) SelectTibiaGuilds(ctx interface{}) *MockGuildSettingsRepository_SelectTibiaGuilds_Call {
+	return &MockGuildSettingsRepository_SelectTibiaGuilds_Call{Call: _e.mock.On("SelectTibiaGuilds", ctx)}
+}
+
+func (_c *MockGuildSettingsRepository_SelectTibiaGuilds_Call) Run(run func(ctx context.Context)) *MockGuildSettingsRepository_SelectTibiaGuilds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGuildSettingsRepository_SelectTibiaGuilds_Call) Return(strings []string, err error) *MockGuildSettingsRepository_SelectTibiaGuilds_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockGuildSettingsRepository_SelectTibiaGuilds_Call) RunAndReturn(run func(ctx context.Context) ([]string, error)) *MockGuildSettingsRepository_SelectTibiaGuilds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateGuildSettings provides a mock function for the type MockGuildSettingsRepository
+func (_mock *MockGuildSettingsRepository) UpdateGuildSettings(ctx context.Context, guildID string, update func(*guildsettings.GuildSettings) error) (*guildsettings.GuildSettings, error) {
+	ret := _mock.Called(ctx, guildID, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGuildSettings")
+	}
+
+	var r0 *guildsettings.GuildSettings
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, func(*guildsettings.GuildSettings) error) (*guildsettings.GuildSettings, error)); ok {
+		return returnFunc(ctx, guildID, update)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, func(*guildsettings.GuildSettings) error) *guildsettings.GuildSettings); ok {
+		r0 = returnFunc(ctx, guildID, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*guildsettings.GuildSettings)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, func(*guildsettings.GuildSettings) error) error); ok {
+		r1 = returnFunc(ctx, guildID, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGuildSettingsRepository_UpdateGuildSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGuildSettings'
+type MockGuildSettingsRepository_UpdateGuildSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateGuildSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - update func(*guildsettings.GuildSettings) error
+func (_e *MockGuildSettingsRepository_Expecter) UpdateGuildSettings(ctx interface{}, guildID interface{}, update interface{}) *MockGuildSettingsRepository_UpdateGuildSettings_Call {
+	return &MockGuildSettingsRepository_UpdateGuildSettings_Call{Call: _e.mock.On("UpdateGuildSettings", ctx, guildID, update)}
+}
+
+func (_c *MockGuildSettingsRepository_UpdateGuildSettings_Call) Run(run func(ctx context.Context, guildID string, update func(*guildsettings.GuildSettings) error)) *MockGuildSettingsRepository_UpdateGuildSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 func(*guildsettings.GuildSettings) error
+		if args[2] != nil {
+			arg2 = args[2].(func(*guildsettings.GuildSettings) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGuildSettingsRepository_UpdateGuildSettings_Call) Return(guildSettings *guildsettings.GuildSettings, err error) *MockGuildSettingsRepository_UpdateGuildSettings_Call {
+	_c.Call.Return(guildSettings, err)
+	return _c
+}
+
+func (_c *MockGuildSettingsRepository_UpdateGuildSettings_Call) RunAndReturn(run func(ctx context.Context, guildID string, update func(*guildsettings.GuildSettings) error) (*guildsettings.GuildSettings, error)) *MockGuildSettingsRepository_UpdateGuildSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
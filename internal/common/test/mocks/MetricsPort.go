@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"time"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -191,6 +193,98 @@ func (_c *MockMetricsPort_IncCommandError_Call) RunAndReturn(run func(guildID st
 	return _c
 }
 
+// IncDomainEvent provides a mock function for the type MockMetricsPort
+func (_mock *MockMetricsPort) IncDomainEvent(guildID string, event string) {
+	_mock.Called(guildID, event)
+	return
+}
+
+// MockMetricsPort_IncDomainEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncDomainEvent'
+type MockMetricsPort_IncDomainEvent_Call struct {
+	*mock.Call
+}
+
+// IncDomainEvent is a helper method to define mock.On call
+//   - guildID string
+//   - event string
+func (_e *MockMetricsPort_Expecter) IncDomainEvent(guildID interface{}, event interface{}) *MockMetricsPort_IncDomainEvent_Call {
+	return &MockMetricsPort_IncDomainEvent_Call{Call: _e.mock.On("IncDomainEvent", guildID, event)}
+}
+
+func (_c *MockMetricsPort_IncDomainEvent_Call) Run(run func(guildID string, event string)) *MockMetricsPort_IncDomainEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMetricsPort_IncDomainEvent_Call) Return() *MockMetricsPort_IncDomainEvent_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMetricsPort_IncDomainEvent_Call) RunAndReturn(run func(guildID string, event string)) *MockMetricsPort_IncDomainEvent_Call {
+	_c.Run(run)
+	return _c
+}
+
+// IncMessagesEdited provides a mock function for the type MockMetricsPort
+func (_mock *MockMetricsPort) IncMessagesEdited(channelID string, channelName string) {
+	_mock.Called(channelID, channelName)
+	return
+}
+
+// MockMetricsPort_IncMessagesEdited_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncMessagesEdited'
+type MockMetricsPort_IncMessagesEdited_Call struct {
+	*mock.Call
+}
+
+// IncMessagesEdited is a helper method to define mock.On call
+//   - channelID string
+//   - channelName string
+func (_e *MockMetricsPort_Expecter) IncMessagesEdited(channelID interface{}, channelName interface{}) *MockMetricsPort_IncMessagesEdited_Call {
+	return &MockMetricsPort_IncMessagesEdited_Call{Call: _e.mock.On("IncMessagesEdited", channelID, channelName)}
+}
+
+func (_c *MockMetricsPort_IncMessagesEdited_Call) Run(run func(channelID string, channelName string)) *MockMetricsPort_IncMessagesEdited_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMetricsPort_IncMessagesEdited_Call) Return() *MockMetricsPort_IncMessagesEdited_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMetricsPort_IncMessagesEdited_Call) RunAndReturn(run func(channelID string, channelName string)) *MockMetricsPort_IncMessagesEdited_Call {
+	_c.Run(run)
+	return _c
+}
+
 // IncMessagesSent provides a mock function for the type MockMetricsPort
 func (_mock *MockMetricsPort) IncMessagesSent(channelID string, channelName string) {
 	_mock.Called(channelID, channelName)
@@ -335,6 +429,98 @@ func (_c *MockMetricsPort_IncSlashCommand_Call) RunAndReturn(run func(guildID st
 	return _c
 }
 
+// IncSummarySkips provides a mock function for the type MockMetricsPort
+func (_mock *MockMetricsPort) IncSummarySkips(guildID string, guildName string) {
+	_mock.Called(guildID, guildName)
+	return
+}
+
+// MockMetricsPort_IncSummarySkips_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncSummarySkips'
+type MockMetricsPort_IncSummarySkips_Call struct {
+	*mock.Call
+}
+
+// IncSummarySkips is a helper method to define mock.On call
+//   - guildID string
+//   - guildName string
+func (_e *MockMetricsPort_Expecter) IncSummarySkips(guildID interface{}, guildName interface{}) *MockMetricsPort_IncSummarySkips_Call {
+	return &MockMetricsPort_IncSummarySkips_Call{Call: _e.mock.On("IncSummarySkips", guildID, guildName)}
+}
+
+func (_c *MockMetricsPort_IncSummarySkips_Call) Run(run func(guildID string, guildName string)) *MockMetricsPort_IncSummarySkips_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMetricsPort_IncSummarySkips_Call) Return() *MockMetricsPort_IncSummarySkips_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMetricsPort_IncSummarySkips_Call) RunAndReturn(run func(guildID string, guildName string)) *MockMetricsPort_IncSummarySkips_Call {
+	_c.Run(run)
+	return _c
+}
+
+// IncSummaryUpdates provides a mock function for the type MockMetricsPort
+func (_mock *MockMetricsPort) IncSummaryUpdates(guildID string, guildName string) {
+	_mock.Called(guildID, guildName)
+	return
+}
+
+// MockMetricsPort_IncSummaryUpdates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncSummaryUpdates'
+type MockMetricsPort_IncSummaryUpdates_Call struct {
+	*mock.Call
+}
+
+// IncSummaryUpdates is a helper method to define mock.On call
+//   - guildID string
+//   - guildName string
+func (_e *MockMetricsPort_Expecter) IncSummaryUpdates(guildID interface{}, guildName interface{}) *MockMetricsPort_IncSummaryUpdates_Call {
+	return &MockMetricsPort_IncSummaryUpdates_Call{Call: _e.mock.On("IncSummaryUpdates", guildID, guildName)}
+}
+
+func (_c *MockMetricsPort_IncSummaryUpdates_Call) Run(run func(guildID string, guildName string)) *MockMetricsPort_IncSummaryUpdates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMetricsPort_IncSummaryUpdates_Call) Return() *MockMetricsPort_IncSummaryUpdates_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMetricsPort_IncSummaryUpdates_Call) RunAndReturn(run func(guildID string, guildName string)) *MockMetricsPort_IncSummaryUpdates_Call {
+	_c.Run(run)
+	return _c
+}
+
 // IncTicks provides a mock function for the type MockMetricsPort
 func (_mock *MockMetricsPort) IncTicks() {
 	_mock.Called()
@@ -368,6 +554,144 @@ func (_c *MockMetricsPort_IncTicks_Call) RunAndReturn(run func()) *MockMetricsPo
 	return _c
 }
 
+// IncWorldApiCache provides a mock function for the type MockMetricsPort
+func (_mock *MockMetricsPort) IncWorldApiCache(result string) {
+	_mock.Called(result)
+	return
+}
+
+// MockMetricsPort_IncWorldApiCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncWorldApiCache'
+type MockMetricsPort_IncWorldApiCache_Call struct {
+	*mock.Call
+}
+
+// IncWorldApiCache is a helper method to define mock.On call
+//   - result string
+func (_e *MockMetricsPort_Expecter) IncWorldApiCache(result interface{}) *MockMetricsPort_IncWorldApiCache_Call {
+	return &MockMetricsPort_IncWorldApiCache_Call{Call: _e.mock.On("IncWorldApiCache", result)}
+}
+
+func (_c *MockMetricsPort_IncWorldApiCache_Call) Run(run func(result string)) *MockMetricsPort_IncWorldApiCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMetricsPort_IncWorldApiCache_Call) Return() *MockMetricsPort_IncWorldApiCache_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMetricsPort_IncWorldApiCache_Call) RunAndReturn(run func(result string)) *MockMetricsPort_IncWorldApiCache_Call {
+	_c.Run(run)
+	return _c
+}
+
+// IncWorldApiError provides a mock function for the type MockMetricsPort
+func (_mock *MockMetricsPort) IncWorldApiError(operation string, reason string) {
+	_mock.Called(operation, reason)
+	return
+}
+
+// MockMetricsPort_IncWorldApiError_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncWorldApiError'
+type MockMetricsPort_IncWorldApiError_Call struct {
+	*mock.Call
+}
+
+// IncWorldApiError is a helper method to define mock.On call
+//   - operation string
+//   - reason string
+func (_e *MockMetricsPort_Expecter) IncWorldApiError(operation interface{}, reason interface{}) *MockMetricsPort_IncWorldApiError_Call {
+	return &MockMetricsPort_IncWorldApiError_Call{Call: _e.mock.On("IncWorldApiError", operation, reason)}
+}
+
+func (_c *MockMetricsPort_IncWorldApiError_Call) Run(run func(operation string, reason string)) *MockMetricsPort_IncWorldApiError_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMetricsPort_IncWorldApiError_Call) Return() *MockMetricsPort_IncWorldApiError_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMetricsPort_IncWorldApiError_Call) RunAndReturn(run func(operation string, reason string)) *MockMetricsPort_IncWorldApiError_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ObserveWorldApiRequest provides a mock function for the type MockMetricsPort
+func (_mock *MockMetricsPort) ObserveWorldApiRequest(operation string, outcome string, duration time.Duration) {
+	_mock.Called(operation, outcome, duration)
+	return
+}
+
+// MockMetricsPort_ObserveWorldApiRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ObserveWorldApiRequest'
+type MockMetricsPort_ObserveWorldApiRequest_Call struct {
+	*mock.Call
+}
+
+// ObserveWorldApiRequest is a helper method to define mock.On call
+//   - operation string
+//   - outcome string
+//   - duration time.Duration
+func (_e *MockMetricsPort_Expecter) ObserveWorldApiRequest(operation interface{}, outcome interface{}, duration interface{}) *MockMetricsPort_ObserveWorldApiRequest_Call {
+	return &MockMetricsPort_ObserveWorldApiRequest_Call{Call: _e.mock.On("ObserveWorldApiRequest", operation, outcome, duration)}
+}
+
+func (_c *MockMetricsPort_ObserveWorldApiRequest_Call) Run(run func(operation string, outcome string, duration time.Duration)) *MockMetricsPort_ObserveWorldApiRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMetricsPort_ObserveWorldApiRequest_Call) Return() *MockMetricsPort_ObserveWorldApiRequest_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMetricsPort_ObserveWorldApiRequest_Call) RunAndReturn(run func(operation string, outcome string, duration time.Duration)) *MockMetricsPort_ObserveWorldApiRequest_Call {
+	_c.Run(run)
+	return _c
+}
+
 // SetUpcomingReservations provides a mock function for the type MockMetricsPort
 func (_mock *MockMetricsPort) SetUpcomingReservations(guildID string, guildName string, count int) {
 	_mock.Called(guildID, guildName, count)
@@ -0,0 +1,309 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"spot-assistant/internal/core/dto/notification"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockNotificationOutboxRepository creates a new instance of MockNotificationOutboxRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationOutboxRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationOutboxRepository {
+	mock := &MockNotificationOutboxRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotificationOutboxRepository is an autogenerated mock type for the NotificationOutboxRepository type
+type MockNotificationOutboxRepository struct {
+	mock.Mock
+}
+
+type MockNotificationOutboxRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationOutboxRepository) EXPECT() *MockNotificationOutboxRepository_Expecter {
+	return &MockNotificationOutboxRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDueNotifications provides a mock function for the type MockNotificationOutboxRepository
+func (_mock *MockNotificationOutboxRepository) ClaimDueNotifications(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]*notification.Outbox, error) {
+	ret := _mock.Called(ctx, now, leaseUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDueNotifications")
+	}
+
+	var r0 []*notification.Outbox
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) ([]*notification.Outbox, error)); ok {
+		return returnFunc(ctx, now, leaseUntil, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) []*notification.Outbox); ok {
+		r0 = returnFunc(ctx, now, leaseUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*notification.Outbox)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, now, leaseUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationOutboxRepository_ClaimDueNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDueNotifications'
+type MockNotificationOutboxRepository_ClaimDueNotifications_Call struct {
+	*mock.Call
+}
+
+// ClaimDueNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - leaseUntil time.Time
+//   - limit int
+func (_e *MockNotificationOutboxRepository_Expecter) ClaimDueNotifications(ctx interface{}, now interface{}, leaseUntil interface{}, limit interface{}) *MockNotificationOutboxRepository_ClaimDueNotifications_Call {
+	return &MockNotificationOutboxRepository_ClaimDueNotifications_Call{Call: _e.mock.On("ClaimDueNotifications", ctx, now, leaseUntil, limit)}
+}
+
+func (_c *MockNotificationOutboxRepository_ClaimDueNotifications_Call) Run(run func(ctx context.Context, now time.Time, leaseUntil time.Time, limit int)) *MockNotificationOutboxRepository_ClaimDueNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationOutboxRepository_ClaimDueNotifications_Call) Return(outboxs []*notification.Outbox, err error) *MockNotificationOutboxRepository_ClaimDueNotifications_Call {
+	_c.Call.Return(outboxs, err)
+	return _c
+}
+
+func (_c *MockNotificationOutboxRepository_ClaimDueNotifications_Call) RunAndReturn(run func(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]*notification.Outbox, error)) *MockNotificationOutboxRepository_ClaimDueNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkNotificationFailed provides a mock function for the type MockNotificationOutboxRepository
+func (_mock *MockNotificationOutboxRepository) MarkNotificationFailed(ctx context.Context, id int64, lastError string) error {
+	ret := _mock.Called(ctx, id, lastError)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkNotificationFailed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = returnFunc(ctx, id, lastError)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationOutboxRepository_MarkNotificationFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkNotificationFailed'
+type MockNotificationOutboxRepository_MarkNotificationFailed_Call struct {
+	*mock.Call
+}
+
+// MarkNotificationFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - lastError string
+func (_e *MockNotificationOutboxRepository_Expecter) MarkNotificationFailed(ctx interface{}, id interface{}, lastError interface{}) *MockNotificationOutboxRepository_MarkNotificationFailed_Call {
+	return &MockNotificationOutboxRepository_MarkNotificationFailed_Call{Call: _e.mock.On("MarkNotificationFailed", ctx, id, lastError)}
+}
+
+func (_c *MockNotificationOutboxRepository_MarkNotificationFailed_Call) Run(run func(ctx context.Context, id int64, lastError string)) *MockNotificationOutboxRepository_MarkNotificationFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationOutboxRepository_MarkNotificationFailed_Call) Return(err error) *MockNotificationOutboxRepository_MarkNotificationFailed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationOutboxRepository_MarkNotificationFailed_Call) RunAndReturn(run func(ctx context.Context, id int64, lastError string) error) *MockNotificationOutboxRepository_MarkNotificationFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkNotificationSent provides a mock function for the type MockNotificationOutboxRepository
+func (_mock *MockNotificationOutboxRepository) MarkNotificationSent(ctx context.Context, id int64) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkNotificationSent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationOutboxRepository_MarkNotificationSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkNotificationSent'
+type MockNotificationOutboxRepository_MarkNotificationSent_Call struct {
+	*mock.Call
+}
+
+// MarkNotificationSent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockNotificationOutboxRepository_Expecter) MarkNotificationSent(ctx interface{}, id interface{}) *MockNotificationOutboxRepository_MarkNotificationSent_Call {
+	return &MockNotificationOutboxRepository_MarkNotificationSent_Call{Call: _e.mock.On("MarkNotificationSent", ctx, id)}
+}
+
+func (_c *MockNotificationOutboxRepository_MarkNotificationSent_Call) Run(run func(ctx context.Context, id int64)) *MockNotificationOutboxRepository_MarkNotificationSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationOutboxRepository_MarkNotificationSent_Call) Return(err error) *MockNotificationOutboxRepository_MarkNotificationSent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationOutboxRepository_MarkNotificationSent_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockNotificationOutboxRepository_MarkNotificationSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScheduleNotificationRetry provides a mock function for the type MockNotificationOutboxRepository
+func (_mock *MockNotificationOutboxRepository) ScheduleNotificationRetry(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error {
+	ret := _mock.Called(ctx, id, nextAttemptAt, lastError)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleNotificationRetry")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, string) error); ok {
+		r0 = returnFunc(ctx, id, nextAttemptAt, lastError)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationOutboxRepository_ScheduleNotificationRetry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScheduleNotificationRetry'
+type MockNotificationOutboxRepository_ScheduleNotificationRetry_Call struct {
+	*mock.Call
+}
+
+// ScheduleNotificationRetry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - nextAttemptAt time.Time
+//   - lastError string
+func (_e *MockNotificationOutboxRepository_Expecter) ScheduleNotificationRetry(ctx interface{}, id interface{}, nextAttemptAt interface{}, lastError interface{}) *MockNotificationOutboxRepository_ScheduleNotificationRetry_Call {
+	return &MockNotificationOutboxRepository_ScheduleNotificationRetry_Call{Call: _e.mock.On("ScheduleNotificationRetry", ctx, id, nextAttemptAt, lastError)}
+}
+
+func (_c *MockNotificationOutboxRepository_ScheduleNotificationRetry_Call) Run(run func(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string)) *MockNotificationOutboxRepository_ScheduleNotificationRetry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationOutboxRepository_ScheduleNotificationRetry_Call) Return(err error) *MockNotificationOutboxRepository_ScheduleNotificationRetry_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationOutboxRepository_ScheduleNotificationRetry_Call) RunAndReturn(run func(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error) *MockNotificationOutboxRepository_ScheduleNotificationRetry_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,266 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"spot-assistant/internal/core/dto/notification"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockNotificationPreferenceRepository creates a new instance of MockNotificationPreferenceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationPreferenceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationPreferenceRepository {
+	mock := &MockNotificationPreferenceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotificationPreferenceRepository is an autogenerated mock type for the NotificationPreferenceRepository type
+type MockNotificationPreferenceRepository struct {
+	mock.Mock
+}
+
+type MockNotificationPreferenceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationPreferenceRepository) EXPECT() *MockNotificationPreferenceRepository_Expecter {
+	return &MockNotificationPreferenceRepository_Expecter{mock: &_m.Mock}
+}
+
+// SelectNotificationChannel provides a mock function for the type MockNotificationPreferenceRepository
+func (_mock *MockNotificationPreferenceRepository) SelectNotificationChannel(ctx context.Context, guildID string, memberID string, kind notification.Kind) (notification.Channel, error) {
+	ret := _mock.Called(ctx, guildID, memberID, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectNotificationChannel")
+	}
+
+	var r0 notification.Channel
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, notification.Kind) (notification.Channel, error)); ok {
+		return returnFunc(ctx, guildID, memberID, kind)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, notification.Kind) notification.Channel); ok {
+		r0 = returnFunc(ctx, guildID, memberID, kind)
+	} else {
+		r0 = ret.Get(0).(notification.Channel)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, notification.Kind) error); ok {
+		r1 = returnFunc(ctx, guildID, memberID, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationPreferenceRepository_SelectNotificationChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectNotificationChannel'
+type MockNotificationPreferenceRepository_SelectNotificationChannel_Call struct {
+	*mock.Call
+}
+
+// SelectNotificationChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - memberID string
+//   - kind notification.Kind
+func (_e *MockNotificationPreferenceRepository_Expecter) SelectNotificationChannel(ctx interface{}, guildID interface{}, memberID interface{}, kind interface{}) *MockNotificationPreferenceRepository_SelectNotificationChannel_Call {
+	return &MockNotificationPreferenceRepository_SelectNotificationChannel_Call{Call: _e.mock.On("SelectNotificationChannel", ctx, guildID, memberID, kind)}
+}
+
+func (_c *MockNotificationPreferenceRepository_SelectNotificationChannel_Call) Run(run func(ctx context.Context, guildID string, memberID string, kind notification.Kind)) *MockNotificationPreferenceRepository_SelectNotificationChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 notification.Kind
+		if args[3] != nil {
+			arg3 = args[3].(notification.Kind)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationPreferenceRepository_SelectNotificationChannel_Call) Return(channel notification.Channel, err error) *MockNotificationPreferenceRepository_SelectNotificationChannel_Call {
+	_c.Call.Return(channel, err)
+	return _c
+}
+
+func (_c *MockNotificationPreferenceRepository_SelectNotificationChannel_Call) RunAndReturn(run func(ctx context.Context, guildID string, memberID string, kind notification.Kind) (notification.Channel, error)) *MockNotificationPreferenceRepository_SelectNotificationChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectNotificationChannels provides a mock function for the type MockNotificationPreferenceRepository
+func (_mock *MockNotificationPreferenceRepository) SelectNotificationChannels(ctx context.Context, guildID string, memberID string) (map[notification.Kind]notification.Channel, error) {
+	ret := _mock.Called(ctx, guildID, memberID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectNotificationChannels")
+	}
+
+	var r0 map[notification.Kind]notification.Channel
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (map[notification.Kind]notification.Channel, error)); ok {
+		return returnFunc(ctx, guildID, memberID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) map[notification.Kind]notification.Channel); ok {
+		r0 = returnFunc(ctx, guildID, memberID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[notification.Kind]notification.Channel)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, guildID, memberID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationPreferenceRepository_SelectNotificationChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectNotificationChannels'
+type MockNotificationPreferenceRepository_SelectNotificationChannels_Call struct {
+	*mock.Call
+}
+
+// SelectNotificationChannels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - memberID string
+func (_e *MockNotificationPreferenceRepository_Expecter) SelectNotificationChannels(ctx interface{}, guildID interface{}, memberID interface{}) *MockNotificationPreferenceRepository_SelectNotificationChannels_Call {
+	return &MockNotificationPreferenceRepository_SelectNotificationChannels_Call{Call: _e.mock.On("SelectNotificationChannels", ctx, guildID, memberID)}
+}
+
+func (_c *MockNotificationPreferenceRepository_SelectNotificationChannels_Call) Run(run func(ctx context.Context, guildID string, memberID string)) *MockNotificationPreferenceRepository_SelectNotificationChannels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationPreferenceRepository_SelectNotificationChannels_Call) Return(kindToChannel map[notification.Kind]notification.Channel, err error) *MockNotificationPreferenceRepository_SelectNotificationChannels_Call {
+	_c.Call.Return(kindToChannel, err)
+	return _c
+}
+
+func (_c *MockNotificationPreferenceRepository_SelectNotificationChannels_Call) RunAndReturn(run func(ctx context.Context, guildID string, memberID string) (map[notification.Kind]notification.Channel, error)) *MockNotificationPreferenceRepository_SelectNotificationChannels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertNotificationChannel provides a mock function for the type MockNotificationPreferenceRepository
+func (_mock *MockNotificationPreferenceRepository) UpsertNotificationChannel(ctx context.Context, guildID string, memberID string, kind notification.Kind, channel notification.Channel) error {
+	ret := _mock.Called(ctx, guildID, memberID, kind, channel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertNotificationChannel")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, notification.Kind, notification.Channel) error); ok {
+		r0 = returnFunc(ctx, guildID, memberID, kind, channel)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationPreferenceRepository_UpsertNotificationChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertNotificationChannel'
+type MockNotificationPreferenceRepository_UpsertNotificationChannel_Call struct {
+	*mock.Call
+}
+
+// UpsertNotificationChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - memberID string
+//   - kind notification.Kind
+//   - channel notification.Channel
+func (_e *MockNotificationPreferenceRepository_Expecter) UpsertNotificationChannel(ctx interface{}, guildID interface{}, memberID interface{}, kind interface{}, channel interface{}) *MockNotificationPreferenceRepository_UpsertNotificationChannel_Call {
+	return &MockNotificationPreferenceRepository_UpsertNotificationChannel_Call{Call: _e.mock.On("UpsertNotificationChannel", ctx, guildID, memberID, kind, channel)}
+}
+
+func (_c *MockNotificationPreferenceRepository_UpsertNotificationChannel_Call) Run(run func(ctx context.Context, guildID string, memberID string, kind notification.Kind, channel notification.Channel)) *MockNotificationPreferenceRepository_UpsertNotificationChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 notification.Kind
+		if args[3] != nil {
+			arg3 = args[3].(notification.Kind)
+		}
+		var arg4 notification.Channel
+		if args[4] != nil {
+			arg4 = args[4].(notification.Channel)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationPreferenceRepository_UpsertNotificationChannel_Call) Return(err error) *MockNotificationPreferenceRepository_UpsertNotificationChannel_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationPreferenceRepository_UpsertNotificationChannel_Call) RunAndReturn(run func(ctx context.Context, guildID string, memberID string, kind notification.Kind, channel notification.Channel) error) *MockNotificationPreferenceRepository_UpsertNotificationChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
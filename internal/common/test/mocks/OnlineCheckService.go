@@ -37,25 +37,36 @@ func (_m *MockOnlineCheckService) EXPECT() *MockOnlineCheckService_Expecter {
 	return &MockOnlineCheckService_Expecter{mock: &_m.Mock}
 }
 
-// ConfigureWorldName provides a mock function for the type MockOnlineCheckService
-func (_mock *MockOnlineCheckService) ConfigureWorldName(guildID string, world string) {
-	_mock.Called(guildID, world)
-	return
+// AddGuildWorld provides a mock function for the type MockOnlineCheckService
+func (_mock *MockOnlineCheckService) AddGuildWorld(guildID string, world string) error {
+	ret := _mock.Called(guildID, world)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddGuildWorld")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(guildID, world)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
-// MockOnlineCheckService_ConfigureWorldName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfigureWorldName'
-type MockOnlineCheckService_ConfigureWorldName_Call struct {
+// MockOnlineCheckService_AddGuildWorld_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGuildWorld'
+type MockOnlineCheckService_AddGuildWorld_Call struct {
 	*mock.Call
 }
 
-// ConfigureWorldName is a helper method to define mock.On call
+// AddGuildWorld is a helper method to define mock.On call
 //   - guildID string
 //   - world string
-func (_e *MockOnlineCheckService_Expecter) ConfigureWorldName(guildID interface{}, world interface{}) *MockOnlineCheckService_ConfigureWorldName_Call {
-	return &MockOnlineCheckService_ConfigureWorldName_Call{Call: _e.mock.On("ConfigureWorldName", guildID, world)}
+func (_e *MockOnlineCheckService_Expecter) AddGuildWorld(guildID interface{}, world interface{}) *MockOnlineCheckService_AddGuildWorld_Call {
+	return &MockOnlineCheckService_AddGuildWorld_Call{Call: _e.mock.On("AddGuildWorld", guildID, world)}
 }
 
-func (_c *MockOnlineCheckService_ConfigureWorldName_Call) Run(run func(guildID string, world string)) *MockOnlineCheckService_ConfigureWorldName_Call {
+func (_c *MockOnlineCheckService_AddGuildWorld_Call) Run(run func(guildID string, world string)) *MockOnlineCheckService_AddGuildWorld_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -73,22 +84,22 @@ func (_c *MockOnlineCheckService_ConfigureWorldName_Call) Run(run func(guildID s
 	return _c
 }
 
-func (_c *MockOnlineCheckService_ConfigureWorldName_Call) Return() *MockOnlineCheckService_ConfigureWorldName_Call {
-	_c.Call.Return()
+func (_c *MockOnlineCheckService_AddGuildWorld_Call) Return(err error) *MockOnlineCheckService_AddGuildWorld_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockOnlineCheckService_ConfigureWorldName_Call) RunAndReturn(run func(guildID string, world string)) *MockOnlineCheckService_ConfigureWorldName_Call {
-	_c.Run(run)
+func (_c *MockOnlineCheckService_AddGuildWorld_Call) RunAndReturn(run func(guildID string, world string) error) *MockOnlineCheckService_AddGuildWorld_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// ConfigureWorldNameForGuild provides a mock function for the type MockOnlineCheckService
-func (_mock *MockOnlineCheckService) ConfigureWorldNameForGuild(guildID string) error {
+// ConfigureGuildWorlds provides a mock function for the type MockOnlineCheckService
+func (_mock *MockOnlineCheckService) ConfigureGuildWorlds(guildID string) error {
 	ret := _mock.Called(guildID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ConfigureWorldNameForGuild")
+		panic("no return value specified for ConfigureGuildWorlds")
 	}
 
 	var r0 error
@@ -100,18 +111,18 @@ func (_mock *MockOnlineCheckService) ConfigureWorldNameForGuild(guildID string)
 	return r0
 }
 
-// MockOnlineCheckService_ConfigureWorldNameForGuild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfigureWorldNameForGuild'
-type MockOnlineCheckService_ConfigureWorldNameForGuild_Call struct {
+// MockOnlineCheckService_ConfigureGuildWorlds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfigureGuildWorlds'
+type MockOnlineCheckService_ConfigureGuildWorlds_Call struct {
 	*mock.Call
 }
 
-// ConfigureWorldNameForGuild is a helper method to define mock.On call
+// ConfigureGuildWorlds is a helper method to define mock.On call
 //   - guildID string
-func (_e *MockOnlineCheckService_Expecter) ConfigureWorldNameForGuild(guildID interface{}) *MockOnlineCheckService_ConfigureWorldNameForGuild_Call {
-	return &MockOnlineCheckService_ConfigureWorldNameForGuild_Call{Call: _e.mock.On("ConfigureWorldNameForGuild", guildID)}
+func (_e *MockOnlineCheckService_Expecter) ConfigureGuildWorlds(guildID interface{}) *MockOnlineCheckService_ConfigureGuildWorlds_Call {
+	return &MockOnlineCheckService_ConfigureGuildWorlds_Call{Call: _e.mock.On("ConfigureGuildWorlds", guildID)}
 }
 
-func (_c *MockOnlineCheckService_ConfigureWorldNameForGuild_Call) Run(run func(guildID string)) *MockOnlineCheckService_ConfigureWorldNameForGuild_Call {
+func (_c *MockOnlineCheckService_ConfigureGuildWorlds_Call) Run(run func(guildID string)) *MockOnlineCheckService_ConfigureGuildWorlds_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -124,12 +135,65 @@ func (_c *MockOnlineCheckService_ConfigureWorldNameForGuild_Call) Run(run func(g
 	return _c
 }
 
-func (_c *MockOnlineCheckService_ConfigureWorldNameForGuild_Call) Return(err error) *MockOnlineCheckService_ConfigureWorldNameForGuild_Call {
+func (_c *MockOnlineCheckService_ConfigureGuildWorlds_Call) Return(err error) *MockOnlineCheckService_ConfigureGuildWorlds_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockOnlineCheckService_ConfigureWorldNameForGuild_Call) RunAndReturn(run func(guildID string) error) *MockOnlineCheckService_ConfigureWorldNameForGuild_Call {
+func (_c *MockOnlineCheckService_ConfigureGuildWorlds_Call) RunAndReturn(run func(guildID string) error) *MockOnlineCheckService_ConfigureGuildWorlds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GuildWorlds provides a mock function for the type MockOnlineCheckService
+func (_mock *MockOnlineCheckService) GuildWorlds(guildID string) []string {
+	ret := _mock.Called(guildID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GuildWorlds")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = returnFunc(guildID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockOnlineCheckService_GuildWorlds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GuildWorlds'
+type MockOnlineCheckService_GuildWorlds_Call struct {
+	*mock.Call
+}
+
+// GuildWorlds is a helper method to define mock.On call
+//   - guildID string
+func (_e *MockOnlineCheckService_Expecter) GuildWorlds(guildID interface{}) *MockOnlineCheckService_GuildWorlds_Call {
+	return &MockOnlineCheckService_GuildWorlds_Call{Call: _e.mock.On("GuildWorlds", guildID)}
+}
+
+func (_c *MockOnlineCheckService_GuildWorlds_Call) Run(run func(guildID string)) *MockOnlineCheckService_GuildWorlds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOnlineCheckService_GuildWorlds_Call) Return(strings []string) *MockOnlineCheckService_GuildWorlds_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockOnlineCheckService_GuildWorlds_Call) RunAndReturn(run func(guildID string) []string) *MockOnlineCheckService_GuildWorlds_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -179,16 +243,16 @@ func (_c *MockOnlineCheckService_IsConfigured_Call) RunAndReturn(run func() bool
 }
 
 // IsOnline provides a mock function for the type MockOnlineCheckService
-func (_mock *MockOnlineCheckService) IsOnline(guildID string, characterName string) bool {
-	ret := _mock.Called(guildID, characterName)
+func (_mock *MockOnlineCheckService) IsOnline(guildID string, memberID string, characterName string) bool {
+	ret := _mock.Called(guildID, memberID, characterName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for IsOnline")
 	}
 
 	var r0 bool
-	if returnFunc, ok := ret.Get(0).(func(string, string) bool); ok {
-		r0 = returnFunc(guildID, characterName)
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) bool); ok {
+		r0 = returnFunc(guildID, memberID, characterName)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
@@ -202,12 +266,13 @@ type MockOnlineCheckService_IsOnline_Call struct {
 
 // IsOnline is a helper method to define mock.On call
 //   - guildID string
+//   - memberID string
 //   - characterName string
-func (_e *MockOnlineCheckService_Expecter) IsOnline(guildID interface{}, characterName interface{}) *MockOnlineCheckService_IsOnline_Call {
-	return &MockOnlineCheckService_IsOnline_Call{Call: _e.mock.On("IsOnline", guildID, characterName)}
+func (_e *MockOnlineCheckService_Expecter) IsOnline(guildID interface{}, memberID interface{}, characterName interface{}) *MockOnlineCheckService_IsOnline_Call {
+	return &MockOnlineCheckService_IsOnline_Call{Call: _e.mock.On("IsOnline", guildID, memberID, characterName)}
 }
 
-func (_c *MockOnlineCheckService_IsOnline_Call) Run(run func(guildID string, characterName string)) *MockOnlineCheckService_IsOnline_Call {
+func (_c *MockOnlineCheckService_IsOnline_Call) Run(run func(guildID string, memberID string, characterName string)) *MockOnlineCheckService_IsOnline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -217,9 +282,14 @@ func (_c *MockOnlineCheckService_IsOnline_Call) Run(run func(guildID string, cha
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -230,22 +300,22 @@ func (_c *MockOnlineCheckService_IsOnline_Call) Return(b bool) *MockOnlineCheckS
 	return _c
 }
 
-func (_c *MockOnlineCheckService_IsOnline_Call) RunAndReturn(run func(guildID string, characterName string) bool) *MockOnlineCheckService_IsOnline_Call {
+func (_c *MockOnlineCheckService_IsOnline_Call) RunAndReturn(run func(guildID string, memberID string, characterName string) bool) *MockOnlineCheckService_IsOnline_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // PlayerStatus provides a mock function for the type MockOnlineCheckService
-func (_mock *MockOnlineCheckService) PlayerStatus(guildID string, characterName string) summary.OnlineStatus {
-	ret := _mock.Called(guildID, characterName)
+func (_mock *MockOnlineCheckService) PlayerStatus(guildID string, memberID string, characterName string) summary.OnlineStatus {
+	ret := _mock.Called(guildID, memberID, characterName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PlayerStatus")
 	}
 
 	var r0 summary.OnlineStatus
-	if returnFunc, ok := ret.Get(0).(func(string, string) summary.OnlineStatus); ok {
-		r0 = returnFunc(guildID, characterName)
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) summary.OnlineStatus); ok {
+		r0 = returnFunc(guildID, memberID, characterName)
 	} else {
 		r0 = ret.Get(0).(summary.OnlineStatus)
 	}
@@ -259,12 +329,13 @@ type MockOnlineCheckService_PlayerStatus_Call struct {
 
 // PlayerStatus is a helper method to define mock.On call
 //   - guildID string
+//   - memberID string
 //   - characterName string
-func (_e *MockOnlineCheckService_Expecter) PlayerStatus(guildID interface{}, characterName interface{}) *MockOnlineCheckService_PlayerStatus_Call {
-	return &MockOnlineCheckService_PlayerStatus_Call{Call: _e.mock.On("PlayerStatus", guildID, characterName)}
+func (_e *MockOnlineCheckService_Expecter) PlayerStatus(guildID interface{}, memberID interface{}, characterName interface{}) *MockOnlineCheckService_PlayerStatus_Call {
+	return &MockOnlineCheckService_PlayerStatus_Call{Call: _e.mock.On("PlayerStatus", guildID, memberID, characterName)}
 }
 
-func (_c *MockOnlineCheckService_PlayerStatus_Call) Run(run func(guildID string, characterName string)) *MockOnlineCheckService_PlayerStatus_Call {
+func (_c *MockOnlineCheckService_PlayerStatus_Call) Run(run func(guildID string, memberID string, characterName string)) *MockOnlineCheckService_PlayerStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -274,9 +345,14 @@ func (_c *MockOnlineCheckService_PlayerStatus_Call) Run(run func(guildID string,
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -287,7 +363,58 @@ func (_c *MockOnlineCheckService_PlayerStatus_Call) Return(onlineStatus summary.
 	return _c
 }
 
-func (_c *MockOnlineCheckService_PlayerStatus_Call) RunAndReturn(run func(guildID string, characterName string) summary.OnlineStatus) *MockOnlineCheckService_PlayerStatus_Call {
+func (_c *MockOnlineCheckService_PlayerStatus_Call) RunAndReturn(run func(guildID string, memberID string, characterName string) summary.OnlineStatus) *MockOnlineCheckService_PlayerStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshCharacters provides a mock function for the type MockOnlineCheckService
+func (_mock *MockOnlineCheckService) RefreshCharacters(guildID string) error {
+	ret := _mock.Called(guildID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshCharacters")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(guildID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOnlineCheckService_RefreshCharacters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshCharacters'
+type MockOnlineCheckService_RefreshCharacters_Call struct {
+	*mock.Call
+}
+
+// RefreshCharacters is a helper method to define mock.On call
+//   - guildID string
+func (_e *MockOnlineCheckService_Expecter) RefreshCharacters(guildID interface{}) *MockOnlineCheckService_RefreshCharacters_Call {
+	return &MockOnlineCheckService_RefreshCharacters_Call{Call: _e.mock.On("RefreshCharacters", guildID)}
+}
+
+func (_c *MockOnlineCheckService_RefreshCharacters_Call) Run(run func(guildID string)) *MockOnlineCheckService_RefreshCharacters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOnlineCheckService_RefreshCharacters_Call) Return(err error) *MockOnlineCheckService_RefreshCharacters_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOnlineCheckService_RefreshCharacters_Call) RunAndReturn(run func(guildID string) error) *MockOnlineCheckService_RefreshCharacters_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -343,12 +470,12 @@ func (_c *MockOnlineCheckService_RefreshOnlinePlayers_Call) RunAndReturn(run fun
 	return _c
 }
 
-// SetGuildWorld provides a mock function for the type MockOnlineCheckService
-func (_mock *MockOnlineCheckService) SetGuildWorld(guildID string, world string) error {
+// RemoveGuildWorld provides a mock function for the type MockOnlineCheckService
+func (_mock *MockOnlineCheckService) RemoveGuildWorld(guildID string, world string) error {
 	ret := _mock.Called(guildID, world)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetGuildWorld")
+		panic("no return value specified for RemoveGuildWorld")
 	}
 
 	var r0 error
@@ -360,19 +487,19 @@ func (_mock *MockOnlineCheckService) SetGuildWorld(guildID string, world string)
 	return r0
 }
 
-// MockOnlineCheckService_SetGuildWorld_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetGuildWorld'
-type MockOnlineCheckService_SetGuildWorld_Call struct {
+// MockOnlineCheckService_RemoveGuildWorld_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveGuildWorld'
+type MockOnlineCheckService_RemoveGuildWorld_Call struct {
 	*mock.Call
 }
 
-// SetGuildWorld is a helper method to define mock.On call
+// RemoveGuildWorld is a helper method to define mock.On call
 //   - guildID string
 //   - world string
-func (_e *MockOnlineCheckService_Expecter) SetGuildWorld(guildID interface{}, world interface{}) *MockOnlineCheckService_SetGuildWorld_Call {
-	return &MockOnlineCheckService_SetGuildWorld_Call{Call: _e.mock.On("SetGuildWorld", guildID, world)}
+func (_e *MockOnlineCheckService_Expecter) RemoveGuildWorld(guildID interface{}, world interface{}) *MockOnlineCheckService_RemoveGuildWorld_Call {
+	return &MockOnlineCheckService_RemoveGuildWorld_Call{Call: _e.mock.On("RemoveGuildWorld", guildID, world)}
 }
 
-func (_c *MockOnlineCheckService_SetGuildWorld_Call) Run(run func(guildID string, world string)) *MockOnlineCheckService_SetGuildWorld_Call {
+func (_c *MockOnlineCheckService_RemoveGuildWorld_Call) Run(run func(guildID string, world string)) *MockOnlineCheckService_RemoveGuildWorld_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -390,12 +517,12 @@ func (_c *MockOnlineCheckService_SetGuildWorld_Call) Run(run func(guildID string
 	return _c
 }
 
-func (_c *MockOnlineCheckService_SetGuildWorld_Call) Return(err error) *MockOnlineCheckService_SetGuildWorld_Call {
+func (_c *MockOnlineCheckService_RemoveGuildWorld_Call) Return(err error) *MockOnlineCheckService_RemoveGuildWorld_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockOnlineCheckService_SetGuildWorld_Call) RunAndReturn(run func(guildID string, world string) error) *MockOnlineCheckService_SetGuildWorld_Call {
+func (_c *MockOnlineCheckService_RemoveGuildWorld_Call) RunAndReturn(run func(guildID string, world string) error) *MockOnlineCheckService_RemoveGuildWorld_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,78 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOutboxService creates a new instance of MockOutboxService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOutboxService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOutboxService {
+	mock := &MockOutboxService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOutboxService is an autogenerated mock type for the OutboxService type
+type MockOutboxService struct {
+	mock.Mock
+}
+
+type MockOutboxService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOutboxService) EXPECT() *MockOutboxService_Expecter {
+	return &MockOutboxService_Expecter{mock: &_m.Mock}
+}
+
+// DispatchDue provides a mock function for the type MockOutboxService
+func (_mock *MockOutboxService) DispatchDue(now time.Time) {
+	_mock.Called(now)
+	return
+}
+
+// MockOutboxService_DispatchDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DispatchDue'
+type MockOutboxService_DispatchDue_Call struct {
+	*mock.Call
+}
+
+// DispatchDue is a helper method to define mock.On call
+//   - now time.Time
+func (_e *MockOutboxService_Expecter) DispatchDue(now interface{}) *MockOutboxService_DispatchDue_Call {
+	return &MockOutboxService_DispatchDue_Call{Call: _e.mock.On("DispatchDue", now)}
+}
+
+func (_c *MockOutboxService_DispatchDue_Call) Run(run func(now time.Time)) *MockOutboxService_DispatchDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 time.Time
+		if args[0] != nil {
+			arg0 = args[0].(time.Time)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxService_DispatchDue_Call) Return() *MockOutboxService_DispatchDue_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockOutboxService_DispatchDue_Call) RunAndReturn(run func(now time.Time)) *MockOutboxService_DispatchDue_Call {
+	_c.Run(run)
+	return _c
+}
@@ -0,0 +1,181 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"spot-assistant/internal/core/dto/reliability"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockReliabilityRepository creates a new instance of MockReliabilityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReliabilityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReliabilityRepository {
+	mock := &MockReliabilityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReliabilityRepository is an autogenerated mock type for the ReliabilityRepository type
+type MockReliabilityRepository struct {
+	mock.Mock
+}
+
+type MockReliabilityRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReliabilityRepository) EXPECT() *MockReliabilityRepository_Expecter {
+	return &MockReliabilityRepository_Expecter{mock: &_m.Mock}
+}
+
+// InsertIncident provides a mock function for the type MockReliabilityRepository
+func (_mock *MockReliabilityRepository) InsertIncident(ctx context.Context, incident *reliability.Incident) error {
+	ret := _mock.Called(ctx, incident)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertIncident")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *reliability.Incident) error); ok {
+		r0 = returnFunc(ctx, incident)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReliabilityRepository_InsertIncident_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertIncident'
+type MockReliabilityRepository_InsertIncident_Call struct {
+	*mock.Call
+}
+
+// InsertIncident is a helper method to define mock.On call
+//   - ctx context.Context
+//   - incident *reliability.Incident
+func (_e *MockReliabilityRepository_Expecter) InsertIncident(ctx interface{}, incident interface{}) *MockReliabilityRepository_InsertIncident_Call {
+	return &MockReliabilityRepository_InsertIncident_Call{Call: _e.mock.On("InsertIncident", ctx, incident)}
+}
+
+func (_c *MockReliabilityRepository_InsertIncident_Call) Run(run func(ctx context.Context, incident *reliability.Incident)) *MockReliabilityRepository_InsertIncident_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *reliability.Incident
+		if args[1] != nil {
+			arg1 = args[1].(*reliability.Incident)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReliabilityRepository_InsertIncident_Call) Return(err error) *MockReliabilityRepository_InsertIncident_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReliabilityRepository_InsertIncident_Call) RunAndReturn(run func(ctx context.Context, incident *reliability.Incident) error) *MockReliabilityRepository_InsertIncident_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectMemberHistory provides a mock function for the type MockReliabilityRepository
+func (_mock *MockReliabilityRepository) SelectMemberHistory(ctx context.Context, guildID string, memberID string, since time.Time, now time.Time) (reliability.History, error) {
+	ret := _mock.Called(ctx, guildID, memberID, since, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectMemberHistory")
+	}
+
+	var r0 reliability.History
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time, time.Time) (reliability.History, error)); ok {
+		return returnFunc(ctx, guildID, memberID, since, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time, time.Time) reliability.History); ok {
+		r0 = returnFunc(ctx, guildID, memberID, since, now)
+	} else {
+		r0 = ret.Get(0).(reliability.History)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, guildID, memberID, since, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReliabilityRepository_SelectMemberHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectMemberHistory'
+type MockReliabilityRepository_SelectMemberHistory_Call struct {
+	*mock.Call
+}
+
+// SelectMemberHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - memberID string
+//   - since time.Time
+//   - now time.Time
+func (_e *MockReliabilityRepository_Expecter) SelectMemberHistory(ctx interface{}, guildID interface{}, memberID interface{}, since interface{}, now interface{}) *MockReliabilityRepository_SelectMemberHistory_Call {
+	return &MockReliabilityRepository_SelectMemberHistory_Call{Call: _e.mock.On("SelectMemberHistory", ctx, guildID, memberID, since, now)}
+}
+
+func (_c *MockReliabilityRepository_SelectMemberHistory_Call) Run(run func(ctx context.Context, guildID string, memberID string, since time.Time, now time.Time)) *MockReliabilityRepository_SelectMemberHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReliabilityRepository_SelectMemberHistory_Call) Return(history reliability.History, err error) *MockReliabilityRepository_SelectMemberHistory_Call {
+	_c.Call.Return(history, err)
+	return _c
+}
+
+func (_c *MockReliabilityRepository_SelectMemberHistory_Call) RunAndReturn(run func(ctx context.Context, guildID string, memberID string, since time.Time, now time.Time) (reliability.History, error)) *MockReliabilityRepository_SelectMemberHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
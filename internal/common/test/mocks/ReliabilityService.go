@@ -0,0 +1,111 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"spot-assistant/internal/core/dto/reliability"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockReliabilityService creates a new instance of MockReliabilityService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReliabilityService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReliabilityService {
+	mock := &MockReliabilityService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReliabilityService is an autogenerated mock type for the ReliabilityService type
+type MockReliabilityService struct {
+	mock.Mock
+}
+
+type MockReliabilityService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReliabilityService) EXPECT() *MockReliabilityService_Expecter {
+	return &MockReliabilityService_Expecter{mock: &_m.Mock}
+}
+
+// Score provides a mock function for the type MockReliabilityService
+func (_mock *MockReliabilityService) Score(guildID string, memberID string, now time.Time) (reliability.Score, error) {
+	ret := _mock.Called(guildID, memberID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Score")
+	}
+
+	var r0 reliability.Score
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, time.Time) (reliability.Score, error)); ok {
+		return returnFunc(guildID, memberID, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, time.Time) reliability.Score); ok {
+		r0 = returnFunc(guildID, memberID, now)
+	} else {
+		r0 = ret.Get(0).(reliability.Score)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, time.Time) error); ok {
+		r1 = returnFunc(guildID, memberID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReliabilityService_Score_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Score'
+type MockReliabilityService_Score_Call struct {
+	*mock.Call
+}
+
+// Score is a helper method to define mock.On call
+//   - guildID string
+//   - memberID string
+//   - now time.Time
+func (_e *MockReliabilityService_Expecter) Score(guildID interface{}, memberID interface{}, now interface{}) *MockReliabilityService_Score_Call {
+	return &MockReliabilityService_Score_Call{Call: _e.mock.On("Score", guildID, memberID, now)}
+}
+
+func (_c *MockReliabilityService_Score_Call) Run(run func(guildID string, memberID string, now time.Time)) *MockReliabilityService_Score_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReliabilityService_Score_Call) Return(score reliability.Score, err error) *MockReliabilityService_Score_Call {
+	_c.Call.Return(score, err)
+	return _c
+}
+
+func (_c *MockReliabilityService_Score_Call) RunAndReturn(run func(guildID string, memberID string, now time.Time) (reliability.Score, error)) *MockReliabilityService_Score_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,426 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"spot-assistant/internal/core/dto/reminder"
+	"spot-assistant/internal/core/dto/reservation"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockReminderRepository creates a new instance of MockReminderRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReminderRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReminderRepository {
+	mock := &MockReminderRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReminderRepository is an autogenerated mock type for the ReminderRepository type
+type MockReminderRepository struct {
+	mock.Mock
+}
+
+type MockReminderRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReminderRepository) EXPECT() *MockReminderRepository_Expecter {
+	return &MockReminderRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimReminder provides a mock function for the type MockReminderRepository
+func (_mock *MockReminderRepository) ClaimReminder(ctx context.Context, res reservation.Reservation) (bool, error) {
+	ret := _mock.Called(ctx, res)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimReminder")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, reservation.Reservation) (bool, error)); ok {
+		return returnFunc(ctx, res)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, reservation.Reservation) bool); ok {
+		r0 = returnFunc(ctx, res)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, reservation.Reservation) error); ok {
+		r1 = returnFunc(ctx, res)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReminderRepository_ClaimReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimReminder'
+type MockReminderRepository_ClaimReminder_Call struct {
+	*mock.Call
+}
+
+// ClaimReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - res reservation.Reservation
+func (_e *MockReminderRepository_Expecter) ClaimReminder(ctx interface{}, res interface{}) *MockReminderRepository_ClaimReminder_Call {
+	return &MockReminderRepository_ClaimReminder_Call{Call: _e.mock.On("ClaimReminder", ctx, res)}
+}
+
+func (_c *MockReminderRepository_ClaimReminder_Call) Run(run func(ctx context.Context, res reservation.Reservation)) *MockReminderRepository_ClaimReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 reservation.Reservation
+		if args[1] != nil {
+			arg1 = args[1].(reservation.Reservation)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReminderRepository_ClaimReminder_Call) Return(b bool, err error) *MockReminderRepository_ClaimReminder_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockReminderRepository_ClaimReminder_Call) RunAndReturn(run func(ctx context.Context, res reservation.Reservation) (bool, error)) *MockReminderRepository_ClaimReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMemberReminder provides a mock function for the type MockReminderRepository
+func (_mock *MockReminderRepository) DeleteMemberReminder(ctx context.Context, guildID string, memberID string) error {
+	ret := _mock.Called(ctx, guildID, memberID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMemberReminder")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, guildID, memberID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReminderRepository_DeleteMemberReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMemberReminder'
+type MockReminderRepository_DeleteMemberReminder_Call struct {
+	*mock.Call
+}
+
+// DeleteMemberReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - memberID string
+func (_e *MockReminderRepository_Expecter) DeleteMemberReminder(ctx interface{}, guildID interface{}, memberID interface{}) *MockReminderRepository_DeleteMemberReminder_Call {
+	return &MockReminderRepository_DeleteMemberReminder_Call{Call: _e.mock.On("DeleteMemberReminder", ctx, guildID, memberID)}
+}
+
+func (_c *MockReminderRepository_DeleteMemberReminder_Call) Run(run func(ctx context.Context, guildID string, memberID string)) *MockReminderRepository_DeleteMemberReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReminderRepository_DeleteMemberReminder_Call) Return(err error) *MockReminderRepository_DeleteMemberReminder_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReminderRepository_DeleteMemberReminder_Call) RunAndReturn(run func(ctx context.Context, guildID string, memberID string) error) *MockReminderRepository_DeleteMemberReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReminderClaimsBefore provides a mock function for the type MockReminderRepository
+func (_mock *MockReminderRepository) DeleteReminderClaimsBefore(ctx context.Context, before time.Time) error {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReminderClaimsBefore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReminderRepository_DeleteReminderClaimsBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReminderClaimsBefore'
+type MockReminderRepository_DeleteReminderClaimsBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteReminderClaimsBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockReminderRepository_Expecter) DeleteReminderClaimsBefore(ctx interface{}, before interface{}) *MockReminderRepository_DeleteReminderClaimsBefore_Call {
+	return &MockReminderRepository_DeleteReminderClaimsBefore_Call{Call: _e.mock.On("DeleteReminderClaimsBefore", ctx, before)}
+}
+
+func (_c *MockReminderRepository_DeleteReminderClaimsBefore_Call) Run(run func(ctx context.Context, before time.Time)) *MockReminderRepository_DeleteReminderClaimsBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReminderRepository_DeleteReminderClaimsBefore_Call) Return(err error) *MockReminderRepository_DeleteReminderClaimsBefore_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReminderRepository_DeleteReminderClaimsBefore_Call) RunAndReturn(run func(ctx context.Context, before time.Time) error) *MockReminderRepository_DeleteReminderClaimsBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectDueReminders provides a mock function for the type MockReminderRepository
+func (_mock *MockReminderRepository) SelectDueReminders(ctx context.Context, now time.Time) ([]*reminder.Reminder, error) {
+	ret := _mock.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectDueReminders")
+	}
+
+	var r0 []*reminder.Reminder
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]*reminder.Reminder, error)); ok {
+		return returnFunc(ctx, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []*reminder.Reminder); ok {
+		r0 = returnFunc(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*reminder.Reminder)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReminderRepository_SelectDueReminders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectDueReminders'
+type MockReminderRepository_SelectDueReminders_Call struct {
+	*mock.Call
+}
+
+// SelectDueReminders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockReminderRepository_Expecter) SelectDueReminders(ctx interface{}, now interface{}) *MockReminderRepository_SelectDueReminders_Call {
+	return &MockReminderRepository_SelectDueReminders_Call{Call: _e.mock.On("SelectDueReminders", ctx, now)}
+}
+
+func (_c *MockReminderRepository_SelectDueReminders_Call) Run(run func(ctx context.Context, now time.Time)) *MockReminderRepository_SelectDueReminders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReminderRepository_SelectDueReminders_Call) Return(reminders []*reminder.Reminder, err error) *MockReminderRepository_SelectDueReminders_Call {
+	_c.Call.Return(reminders, err)
+	return _c
+}
+
+func (_c *MockReminderRepository_SelectDueReminders_Call) RunAndReturn(run func(ctx context.Context, now time.Time) ([]*reminder.Reminder, error)) *MockReminderRepository_SelectDueReminders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectMemberReminder provides a mock function for the type MockReminderRepository
+func (_mock *MockReminderRepository) SelectMemberReminder(ctx context.Context, guildID string, memberID string) (*reminder.MemberReminder, error) {
+	ret := _mock.Called(ctx, guildID, memberID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectMemberReminder")
+	}
+
+	var r0 *reminder.MemberReminder
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*reminder.MemberReminder, error)); ok {
+		return returnFunc(ctx, guildID, memberID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *reminder.MemberReminder); ok {
+		r0 = returnFunc(ctx, guildID, memberID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reminder.MemberReminder)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, guildID, memberID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReminderRepository_SelectMemberReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectMemberReminder'
+type MockReminderRepository_SelectMemberReminder_Call struct {
+	*mock.Call
+}
+
+// SelectMemberReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - memberID string
+func (_e *MockReminderRepository_Expecter) SelectMemberReminder(ctx interface{}, guildID interface{}, memberID interface{}) *MockReminderRepository_SelectMemberReminder_Call {
+	return &MockReminderRepository_SelectMemberReminder_Call{Call: _e.mock.On("SelectMemberReminder", ctx, guildID, memberID)}
+}
+
+func (_c *MockReminderRepository_SelectMemberReminder_Call) Run(run func(ctx context.Context, guildID string, memberID string)) *MockReminderRepository_SelectMemberReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReminderRepository_SelectMemberReminder_Call) Return(memberReminder *reminder.MemberReminder, err error) *MockReminderRepository_SelectMemberReminder_Call {
+	_c.Call.Return(memberReminder, err)
+	return _c
+}
+
+func (_c *MockReminderRepository_SelectMemberReminder_Call) RunAndReturn(run func(ctx context.Context, guildID string, memberID string) (*reminder.MemberReminder, error)) *MockReminderRepository_SelectMemberReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertMemberReminder provides a mock function for the type MockReminderRepository
+func (_mock *MockReminderRepository) UpsertMemberReminder(ctx context.Context, memberReminder *reminder.MemberReminder) error {
+	ret := _mock.Called(ctx, memberReminder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertMemberReminder")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *reminder.MemberReminder) error); ok {
+		r0 = returnFunc(ctx, memberReminder)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReminderRepository_UpsertMemberReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertMemberReminder'
+type MockReminderRepository_UpsertMemberReminder_Call struct {
+	*mock.Call
+}
+
+// UpsertMemberReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - memberReminder *reminder.MemberReminder
+func (_e *MockReminderRepository_Expecter) UpsertMemberReminder(ctx interface{}, memberReminder interface{}) *MockReminderRepository_UpsertMemberReminder_Call {
+	return &MockReminderRepository_UpsertMemberReminder_Call{Call: _e.mock.On("UpsertMemberReminder", ctx, memberReminder)}
+}
+
+func (_c *MockReminderRepository_UpsertMemberReminder_Call) Run(run func(ctx context.Context, memberReminder *reminder.MemberReminder)) *MockReminderRepository_UpsertMemberReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *reminder.MemberReminder
+		if args[1] != nil {
+			arg1 = args[1].(*reminder.MemberReminder)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReminderRepository_UpsertMemberReminder_Call) Return(err error) *MockReminderRepository_UpsertMemberReminder_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReminderRepository_UpsertMemberReminder_Call) RunAndReturn(run func(ctx context.Context, memberReminder *reminder.MemberReminder) error) *MockReminderRepository_UpsertMemberReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
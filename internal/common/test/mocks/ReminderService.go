@@ -0,0 +1,78 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockReminderService creates a new instance of MockReminderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReminderService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReminderService {
+	mock := &MockReminderService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReminderService is an autogenerated mock type for the ReminderService type
+type MockReminderService struct {
+	mock.Mock
+}
+
+type MockReminderService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReminderService) EXPECT() *MockReminderService_Expecter {
+	return &MockReminderService_Expecter{mock: &_m.Mock}
+}
+
+// SendDueReminders provides a mock function for the type MockReminderService
+func (_mock *MockReminderService) SendDueReminders(now time.Time) {
+	_mock.Called(now)
+	return
+}
+
+// MockReminderService_SendDueReminders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDueReminders'
+type MockReminderService_SendDueReminders_Call struct {
+	*mock.Call
+}
+
+// SendDueReminders is a helper method to define mock.On call
+//   - now time.Time
+func (_e *MockReminderService_Expecter) SendDueReminders(now interface{}) *MockReminderService_SendDueReminders_Call {
+	return &MockReminderService_SendDueReminders_Call{Call: _e.mock.On("SendDueReminders", now)}
+}
+
+func (_c *MockReminderService_SendDueReminders_Call) Run(run func(now time.Time)) *MockReminderService_SendDueReminders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 time.Time
+		if args[0] != nil {
+			arg0 = args[0].(time.Time)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReminderService_SendDueReminders_Call) Return() *MockReminderService_SendDueReminders_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockReminderService_SendDueReminders_Call) RunAndReturn(run func(now time.Time)) *MockReminderService_SendDueReminders_Call {
+	_c.Run(run)
+	return _c
+}
@@ -9,6 +9,7 @@ import (
 	"spot-assistant/internal/core/dto/guild"
 	"spot-assistant/internal/core/dto/member"
 	"spot-assistant/internal/core/dto/reservation"
+	"spot-assistant/internal/core/dto/spot"
 	"time"
 
 	mock "github.com/stretchr/testify/mock"
@@ -42,31 +43,39 @@ func (_m *MockReservationRepository) EXPECT() *MockReservationRepository_Expecte
 }
 
 // CreateAndDeleteConflicting provides a mock function for the type MockReservationRepository
-func (_mock *MockReservationRepository) CreateAndDeleteConflicting(ctx context.Context, member1 *member.Member, guild1 *guild.Guild, conflicts []*reservation.Reservation, spotId int64, startAt time.Time, endAt time.Time) ([]*reservation.ClippedOrRemovedReservation, error) {
-	ret := _mock.Called(ctx, member1, guild1, conflicts, spotId, startAt, endAt)
+func (_mock *MockReservationRepository) CreateAndDeleteConflicting(ctx context.Context, member1 *member.Member, guild1 *guild.Guild, conflicts []*reservation.Reservation, spot1 *spot.Spot, startAt time.Time, endAt time.Time) (*reservation.Reservation, []*reservation.ClippedOrRemovedReservation, error) {
+	ret := _mock.Called(ctx, member1, guild1, conflicts, spot1, startAt, endAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateAndDeleteConflicting")
 	}
 
-	var r0 []*reservation.ClippedOrRemovedReservation
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *member.Member, *guild.Guild, []*reservation.Reservation, int64, time.Time, time.Time) ([]*reservation.ClippedOrRemovedReservation, error)); ok {
-		return returnFunc(ctx, member1, guild1, conflicts, spotId, startAt, endAt)
+	var r0 *reservation.Reservation
+	var r1 []*reservation.ClippedOrRemovedReservation
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *member.Member, *guild.Guild, []*reservation.Reservation, *spot.Spot, time.Time, time.Time) (*reservation.Reservation, []*reservation.ClippedOrRemovedReservation, error)); ok {
+		return returnFunc(ctx, member1, guild1, conflicts, spot1, startAt, endAt)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *member.Member, *guild.Guild, []*reservation.Reservation, int64, time.Time, time.Time) []*reservation.ClippedOrRemovedReservation); ok {
-		r0 = returnFunc(ctx, member1, guild1, conflicts, spotId, startAt, endAt)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *member.Member, *guild.Guild, []*reservation.Reservation, *spot.Spot, time.Time, time.Time) *reservation.Reservation); ok {
+		r0 = returnFunc(ctx, member1, guild1, conflicts, spot1, startAt, endAt)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*reservation.ClippedOrRemovedReservation)
+			r0 = ret.Get(0).(*reservation.Reservation)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *member.Member, *guild.Guild, []*reservation.Reservation, int64, time.Time, time.Time) error); ok {
-		r1 = returnFunc(ctx, member1, guild1, conflicts, spotId, startAt, endAt)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *member.Member, *guild.Guild, []*reservation.Reservation, *spot.Spot, time.Time, time.Time) []*reservation.ClippedOrRemovedReservation); ok {
+		r1 = returnFunc(ctx, member1, guild1, conflicts, spot1, startAt, endAt)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*reservation.ClippedOrRemovedReservation)
+		}
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, *member.Member, *guild.Guild, []*reservation.Reservation, *spot.Spot, time.Time, time.Time) error); ok {
+		r2 = returnFunc(ctx, member1, guild1, conflicts, spot1, startAt, endAt)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
 // MockReservationRepository_CreateAndDeleteConflicting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAndDeleteConflicting'
@@ -79,14 +88,14 @@ type MockReservationRepository_CreateAndDeleteConflicting_Call struct {
 //   - member1 *member.Member
 //   - guild1 *guild.Guild
 //   - conflicts []*reservation.Reservation
-//   - spotId int64
+//   - spot1 *spot.Spot
 //   - startAt time.Time
 //   - endAt time.Time
-func (_e *MockReservationRepository_Expecter) CreateAndDeleteConflicting(ctx interface{}, member1 interface{}, guild1 interface{}, conflicts interface{}, spotId interface{}, startAt interface{}, endAt interface{}) *MockReservationRepository_CreateAndDeleteConflicting_Call {
-	return &MockReservationRepository_CreateAndDeleteConflicting_Call{Call: _e.mock.On("CreateAndDeleteConflicting", ctx, member1, guild1, conflicts, spotId, startAt, endAt)}
+func (_e *MockReservationRepository_Expecter) CreateAndDeleteConflicting(ctx interface{}, member1 interface{}, guild1 interface{}, conflicts interface{}, spot1 interface{}, startAt interface{}, endAt interface{}) *MockReservationRepository_CreateAndDeleteConflicting_Call {
+	return &MockReservationRepository_CreateAndDeleteConflicting_Call{Call: _e.mock.On("CreateAndDeleteConflicting", ctx, member1, guild1, conflicts, spot1, startAt, endAt)}
 }
 
-func (_c *MockReservationRepository_CreateAndDeleteConflicting_Call) Run(run func(ctx context.Context, member1 *member.Member, guild1 *guild.Guild, conflicts []*reservation.Reservation, spotId int64, startAt time.Time, endAt time.Time)) *MockReservationRepository_CreateAndDeleteConflicting_Call {
+func (_c *MockReservationRepository_CreateAndDeleteConflicting_Call) Run(run func(ctx context.Context, member1 *member.Member, guild1 *guild.Guild, conflicts []*reservation.Reservation, spot1 *spot.Spot, startAt time.Time, endAt time.Time)) *MockReservationRepository_CreateAndDeleteConflicting_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -104,9 +113,9 @@ func (_c *MockReservationRepository_CreateAndDeleteConflicting_Call) Run(run fun
 		if args[3] != nil {
 			arg3 = args[3].([]*reservation.Reservation)
 		}
-		var arg4 int64
+		var arg4 *spot.Spot
 		if args[4] != nil {
-			arg4 = args[4].(int64)
+			arg4 = args[4].(*spot.Spot)
 		}
 		var arg5 time.Time
 		if args[5] != nil {
@@ -129,12 +138,12 @@ func (_c *MockReservationRepository_CreateAndDeleteConflicting_Call) Run(run fun
 	return _c
 }
 
-func (_c *MockReservationRepository_CreateAndDeleteConflicting_Call) Return(clippedOrRemovedReservations []*reservation.ClippedOrRemovedReservation, err error) *MockReservationRepository_CreateAndDeleteConflicting_Call {
-	_c.Call.Return(clippedOrRemovedReservations, err)
+func (_c *MockReservationRepository_CreateAndDeleteConflicting_Call) Return(reservation1 *reservation.Reservation, clippedOrRemovedReservations []*reservation.ClippedOrRemovedReservation, err error) *MockReservationRepository_CreateAndDeleteConflicting_Call {
+	_c.Call.Return(reservation1, clippedOrRemovedReservations, err)
 	return _c
 }
 
-func (_c *MockReservationRepository_CreateAndDeleteConflicting_Call) RunAndReturn(run func(ctx context.Context, member1 *member.Member, guild1 *guild.Guild, conflicts []*reservation.Reservation, spotId int64, startAt time.Time, endAt time.Time) ([]*reservation.ClippedOrRemovedReservation, error)) *MockReservationRepository_CreateAndDeleteConflicting_Call {
+func (_c *MockReservationRepository_CreateAndDeleteConflicting_Call) RunAndReturn(run func(ctx context.Context, member1 *member.Member, guild1 *guild.Guild, conflicts []*reservation.Reservation, spot1 *spot.Spot, startAt time.Time, endAt time.Time) (*reservation.Reservation, []*reservation.ClippedOrRemovedReservation, error)) *MockReservationRepository_CreateAndDeleteConflicting_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -356,9 +365,151 @@ func (_c *MockReservationRepository_FindReservationWithSpot_Call) RunAndReturn(r
 	return _c
 }
 
+// SelectFilteredReservationsWithSpot provides a mock function for the type MockReservationRepository
+func (_mock *MockReservationRepository) SelectFilteredReservationsWithSpot(ctx context.Context, filter reservation.Filter) ([]*reservation.ReservationWithSpot, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectFilteredReservationsWithSpot")
+	}
+
+	var r0 []*reservation.ReservationWithSpot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, reservation.Filter) ([]*reservation.ReservationWithSpot, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, reservation.Filter) []*reservation.ReservationWithSpot); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*reservation.ReservationWithSpot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, reservation.Filter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReservationRepository_SelectFilteredReservationsWithSpot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectFilteredReservationsWithSpot'
+type MockReservationRepository_SelectFilteredReservationsWithSpot_Call struct {
+	*mock.Call
+}
+
+// SelectFilteredReservationsWithSpot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter reservation.Filter
+func (_e *MockReservationRepository_Expecter) SelectFilteredReservationsWithSpot(ctx interface{}, filter interface{}) *MockReservationRepository_SelectFilteredReservationsWithSpot_Call {
+	return &MockReservationRepository_SelectFilteredReservationsWithSpot_Call{Call: _e.mock.On("SelectFilteredReservationsWithSpot", ctx, filter)}
+}
+
+func (_c *MockReservationRepository_SelectFilteredReservationsWithSpot_Call) Run(run func(ctx context.Context, filter reservation.Filter)) *MockReservationRepository_SelectFilteredReservationsWithSpot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 reservation.Filter
+		if args[1] != nil {
+			arg1 = args[1].(reservation.Filter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_SelectFilteredReservationsWithSpot_Call) Return(reservationWithSpots []*reservation.ReservationWithSpot, err error) *MockReservationRepository_SelectFilteredReservationsWithSpot_Call {
+	_c.Call.Return(reservationWithSpots, err)
+	return _c
+}
+
+func (_c *MockReservationRepository_SelectFilteredReservationsWithSpot_Call) RunAndReturn(run func(ctx context.Context, filter reservation.Filter) ([]*reservation.ReservationWithSpot, error)) *MockReservationRepository_SelectFilteredReservationsWithSpot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectFreeSpots provides a mock function for the type MockReservationRepository
+func (_mock *MockReservationRepository) SelectFreeSpots(ctx context.Context, guildID string, spotName string) ([]*reservation.FreeSpot, error) {
+	ret := _mock.Called(ctx, guildID, spotName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectFreeSpots")
+	}
+
+	var r0 []*reservation.FreeSpot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]*reservation.FreeSpot, error)); ok {
+		return returnFunc(ctx, guildID, spotName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []*reservation.FreeSpot); ok {
+		r0 = returnFunc(ctx, guildID, spotName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*reservation.FreeSpot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, guildID, spotName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReservationRepository_SelectFreeSpots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectFreeSpots'
+type MockReservationRepository_SelectFreeSpots_Call struct {
+	*mock.Call
+}
+
+// SelectFreeSpots is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - spotName string
+func (_e *MockReservationRepository_Expecter) SelectFreeSpots(ctx interface{}, guildID interface{}, spotName interface{}) *MockReservationRepository_SelectFreeSpots_Call {
+	return &MockReservationRepository_SelectFreeSpots_Call{Call: _e.mock.On("SelectFreeSpots", ctx, guildID, spotName)}
+}
+
+func (_c *MockReservationRepository_SelectFreeSpots_Call) Run(run func(ctx context.Context, guildID string, spotName string)) *MockReservationRepository_SelectFreeSpots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_SelectFreeSpots_Call) Return(freeSpots []*reservation.FreeSpot, err error) *MockReservationRepository_SelectFreeSpots_Call {
+	_c.Call.Return(freeSpots, err)
+	return _c
+}
+
+func (_c *MockReservationRepository_SelectFreeSpots_Call) RunAndReturn(run func(ctx context.Context, guildID string, spotName string) ([]*reservation.FreeSpot, error)) *MockReservationRepository_SelectFreeSpots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectOverlappingReservations provides a mock function for the type MockReservationRepository
-func (_mock *MockReservationRepository) SelectOverlappingReservations(ctx context.Context, spot string, startAt time.Time, endAt time.Time, guildId string) ([]*reservation.Reservation, error) {
-	ret := _mock.Called(ctx, spot, startAt, endAt, guildId)
+func (_mock *MockReservationRepository) SelectOverlappingReservations(ctx context.Context, spot1 string, startAt time.Time, endAt time.Time, guildId string) ([]*reservation.Reservation, error) {
+	ret := _mock.Called(ctx, spot1, startAt, endAt, guildId)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SelectOverlappingReservations")
@@ -367,17 +518,17 @@ func (_mock *MockReservationRepository) SelectOverlappingReservations(ctx contex
 	var r0 []*reservation.Reservation
 	var r1 error
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time, string) ([]*reservation.Reservation, error)); ok {
-		return returnFunc(ctx, spot, startAt, endAt, guildId)
+		return returnFunc(ctx, spot1, startAt, endAt, guildId)
 	}
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time, string) []*reservation.Reservation); ok {
-		r0 = returnFunc(ctx, spot, startAt, endAt, guildId)
+		r0 = returnFunc(ctx, spot1, startAt, endAt, guildId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*reservation.Reservation)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time, string) error); ok {
-		r1 = returnFunc(ctx, spot, startAt, endAt, guildId)
+		r1 = returnFunc(ctx, spot1, startAt, endAt, guildId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -391,15 +542,15 @@ type MockReservationRepository_SelectOverlappingReservations_Call struct {
 
 // SelectOverlappingReservations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - spot string
+//   - spot1 string
 //   - startAt time.Time
 //   - endAt time.Time
 //   - guildId string
-func (_e *MockReservationRepository_Expecter) SelectOverlappingReservations(ctx interface{}, spot interface{}, startAt interface{}, endAt interface{}, guildId interface{}) *MockReservationRepository_SelectOverlappingReservations_Call {
-	return &MockReservationRepository_SelectOverlappingReservations_Call{Call: _e.mock.On("SelectOverlappingReservations", ctx, spot, startAt, endAt, guildId)}
+func (_e *MockReservationRepository_Expecter) SelectOverlappingReservations(ctx interface{}, spot1 interface{}, startAt interface{}, endAt interface{}, guildId interface{}) *MockReservationRepository_SelectOverlappingReservations_Call {
+	return &MockReservationRepository_SelectOverlappingReservations_Call{Call: _e.mock.On("SelectOverlappingReservations", ctx, spot1, startAt, endAt, guildId)}
 }
 
-func (_c *MockReservationRepository_SelectOverlappingReservations_Call) Run(run func(ctx context.Context, spot string, startAt time.Time, endAt time.Time, guildId string)) *MockReservationRepository_SelectOverlappingReservations_Call {
+func (_c *MockReservationRepository_SelectOverlappingReservations_Call) Run(run func(ctx context.Context, spot1 string, startAt time.Time, endAt time.Time, guildId string)) *MockReservationRepository_SelectOverlappingReservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -437,7 +588,87 @@ func (_c *MockReservationRepository_SelectOverlappingReservations_Call) Return(r
 	return _c
 }
 
-func (_c *MockReservationRepository_SelectOverlappingReservations_Call) RunAndReturn(run func(ctx context.Context, spot string, startAt time.Time, endAt time.Time, guildId string) ([]*reservation.Reservation, error)) *MockReservationRepository_SelectOverlappingReservations_Call {
+func (_c *MockReservationRepository_SelectOverlappingReservations_Call) RunAndReturn(run func(ctx context.Context, spot1 string, startAt time.Time, endAt time.Time, guildId string) ([]*reservation.Reservation, error)) *MockReservationRepository_SelectOverlappingReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectReservationsWithSpotInRange provides a mock function for the type MockReservationRepository
+func (_mock *MockReservationRepository) SelectReservationsWithSpotInRange(ctx context.Context, guildID string, from time.Time, to time.Time) ([]*reservation.ReservationWithSpot, error) {
+	ret := _mock.Called(ctx, guildID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectReservationsWithSpotInRange")
+	}
+
+	var r0 []*reservation.ReservationWithSpot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) ([]*reservation.ReservationWithSpot, error)); ok {
+		return returnFunc(ctx, guildID, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) []*reservation.ReservationWithSpot); ok {
+		r0 = returnFunc(ctx, guildID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*reservation.ReservationWithSpot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, guildID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReservationRepository_SelectReservationsWithSpotInRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectReservationsWithSpotInRange'
+type MockReservationRepository_SelectReservationsWithSpotInRange_Call struct {
+	*mock.Call
+}
+
+// SelectReservationsWithSpotInRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - from time.Time
+//   - to time.Time
+func (_e *MockReservationRepository_Expecter) SelectReservationsWithSpotInRange(ctx interface{}, guildID interface{}, from interface{}, to interface{}) *MockReservationRepository_SelectReservationsWithSpotInRange_Call {
+	return &MockReservationRepository_SelectReservationsWithSpotInRange_Call{Call: _e.mock.On("SelectReservationsWithSpotInRange", ctx, guildID, from, to)}
+}
+
+func (_c *MockReservationRepository_SelectReservationsWithSpotInRange_Call) Run(run func(ctx context.Context, guildID string, from time.Time, to time.Time)) *MockReservationRepository_SelectReservationsWithSpotInRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_SelectReservationsWithSpotInRange_Call) Return(reservationWithSpots []*reservation.ReservationWithSpot, err error) *MockReservationRepository_SelectReservationsWithSpotInRange_Call {
+	_c.Call.Return(reservationWithSpots, err)
+	return _c
+}
+
+func (_c *MockReservationRepository_SelectReservationsWithSpotInRange_Call) RunAndReturn(run func(ctx context.Context, guildID string, from time.Time, to time.Time) ([]*reservation.ReservationWithSpot, error)) *MockReservationRepository_SelectReservationsWithSpotInRange_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -38,6 +38,63 @@ func (_m *MockSpotRepository) EXPECT() *MockSpotRepository_Expecter {
 	return &MockSpotRepository_Expecter{mock: &_m.Mock}
 }
 
+// CreateSpots provides a mock function for the type MockSpotRepository
+func (_mock *MockSpotRepository) CreateSpots(ctx context.Context, names []string) error {
+	ret := _mock.Called(ctx, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSpots")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = returnFunc(ctx, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSpotRepository_CreateSpots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSpots'
+type MockSpotRepository_CreateSpots_Call struct {
+	*mock.Call
+}
+
+// CreateSpots is a helper method to define mock.On call
+//   - ctx context.Context
+//   - names []string
+func (_e *MockSpotRepository_Expecter) CreateSpots(ctx interface{}, names interface{}) *MockSpotRepository_CreateSpots_Call {
+	return &MockSpotRepository_CreateSpots_Call{Call: _e.mock.On("CreateSpots", ctx, names)}
+}
+
+func (_c *MockSpotRepository_CreateSpots_Call) Run(run func(ctx context.Context, names []string)) *MockSpotRepository_CreateSpots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSpotRepository_CreateSpots_Call) Return(err error) *MockSpotRepository_CreateSpots_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSpotRepository_CreateSpots_Call) RunAndReturn(run func(ctx context.Context, names []string) error) *MockSpotRepository_CreateSpots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectAllSpots provides a mock function for the type MockSpotRepository
 func (_mock *MockSpotRepository) SelectAllSpots(ctx context.Context) ([]*spot.Spot, error) {
 	ret := _mock.Called(ctx)
@@ -235,3 +292,75 @@ func (_c *MockSpotRepository_SelectSpotsByNameCaseInsensitiveLike_Call) RunAndRe
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateSpotRequirements provides a mock function for the type MockSpotRepository
+func (_mock *MockSpotRepository) UpdateSpotRequirements(ctx context.Context, name string, requirements spot.Requirements) (bool, error) {
+	ret := _mock.Called(ctx, name, requirements)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSpotRequirements")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, spot.Requirements) (bool, error)); ok {
+		return returnFunc(ctx, name, requirements)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, spot.Requirements) bool); ok {
+		r0 = returnFunc(ctx, name, requirements)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, spot.Requirements) error); ok {
+		r1 = returnFunc(ctx, name, requirements)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSpotRepository_UpdateSpotRequirements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSpotRequirements'
+type MockSpotRepository_UpdateSpotRequirements_Call struct {
+	*mock.Call
+}
+
+// UpdateSpotRequirements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - requirements spot.Requirements
+func (_e *MockSpotRepository_Expecter) UpdateSpotRequirements(ctx interface{}, name interface{}, requirements interface{}) *MockSpotRepository_UpdateSpotRequirements_Call {
+	return &MockSpotRepository_UpdateSpotRequirements_Call{Call: _e.mock.On("UpdateSpotRequirements", ctx, name, requirements)}
+}
+
+func (_c *MockSpotRepository_UpdateSpotRequirements_Call) Run(run func(ctx context.Context, name string, requirements spot.Requirements)) *MockSpotRepository_UpdateSpotRequirements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 spot.Requirements
+		if args[2] != nil {
+			arg2 = args[2].(spot.Requirements)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSpotRepository_UpdateSpotRequirements_Call) Return(b bool, err error) *MockSpotRepository_UpdateSpotRequirements_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockSpotRepository_UpdateSpotRequirements_Call) RunAndReturn(run func(ctx context.Context, name string, requirements spot.Requirements) (bool, error)) *MockSpotRepository_UpdateSpotRequirements_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,106 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"spot-assistant/internal/core/dto/spot"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSpotRequirementsService creates a new instance of MockSpotRequirementsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSpotRequirementsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSpotRequirementsService {
+	mock := &MockSpotRequirementsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSpotRequirementsService is an autogenerated mock type for the SpotRequirementsService type
+type MockSpotRequirementsService struct {
+	mock.Mock
+}
+
+type MockSpotRequirementsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSpotRequirementsService) EXPECT() *MockSpotRequirementsService_Expecter {
+	return &MockSpotRequirementsService_Expecter{mock: &_m.Mock}
+}
+
+// SetRequirements provides a mock function for the type MockSpotRequirementsService
+func (_mock *MockSpotRequirementsService) SetRequirements(spotName string, requirements spot.Requirements) (*spot.Spot, error) {
+	ret := _mock.Called(spotName, requirements)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRequirements")
+	}
+
+	var r0 *spot.Spot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, spot.Requirements) (*spot.Spot, error)); ok {
+		return returnFunc(spotName, requirements)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, spot.Requirements) *spot.Spot); ok {
+		r0 = returnFunc(spotName, requirements)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*spot.Spot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, spot.Requirements) error); ok {
+		r1 = returnFunc(spotName, requirements)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSpotRequirementsService_SetRequirements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRequirements'
+type MockSpotRequirementsService_SetRequirements_Call struct {
+	*mock.Call
+}
+
+// SetRequirements is a helper method to define mock.On call
+//   - spotName string
+//   - requirements spot.Requirements
+func (_e *MockSpotRequirementsService_Expecter) SetRequirements(spotName interface{}, requirements interface{}) *MockSpotRequirementsService_SetRequirements_Call {
+	return &MockSpotRequirementsService_SetRequirements_Call{Call: _e.mock.On("SetRequirements", spotName, requirements)}
+}
+
+func (_c *MockSpotRequirementsService_SetRequirements_Call) Run(run func(spotName string, requirements spot.Requirements)) *MockSpotRequirementsService_SetRequirements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 spot.Requirements
+		if args[1] != nil {
+			arg1 = args[1].(spot.Requirements)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSpotRequirementsService_SetRequirements_Call) Return(spot1 *spot.Spot, err error) *MockSpotRequirementsService_SetRequirements_Call {
+	_c.Call.Return(spot1, err)
+	return _c
+}
+
+func (_c *MockSpotRequirementsService_SetRequirements_Call) RunAndReturn(run func(spotName string, requirements spot.Requirements) (*spot.Spot, error)) *MockSpotRequirementsService_SetRequirements_Call {
+	_c.Call.Return(run)
+	return _c
+}
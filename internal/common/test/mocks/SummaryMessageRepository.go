@@ -0,0 +1,181 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSummaryMessageRepository creates a new instance of MockSummaryMessageRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSummaryMessageRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSummaryMessageRepository {
+	mock := &MockSummaryMessageRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSummaryMessageRepository is an autogenerated mock type for the SummaryMessageRepository type
+type MockSummaryMessageRepository struct {
+	mock.Mock
+}
+
+type MockSummaryMessageRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSummaryMessageRepository) EXPECT() *MockSummaryMessageRepository_Expecter {
+	return &MockSummaryMessageRepository_Expecter{mock: &_m.Mock}
+}
+
+// ReplaceSummaryMessageIDs provides a mock function for the type MockSummaryMessageRepository
+func (_mock *MockSummaryMessageRepository) ReplaceSummaryMessageIDs(ctx context.Context, guildID string, channelID string, messageIDs []string) error {
+	ret := _mock.Called(ctx, guildID, channelID, messageIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceSummaryMessageIDs")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string) error); ok {
+		r0 = returnFunc(ctx, guildID, channelID, messageIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSummaryMessageRepository_ReplaceSummaryMessageIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceSummaryMessageIDs'
+type MockSummaryMessageRepository_ReplaceSummaryMessageIDs_Call struct {
+	*mock.Call
+}
+
+// ReplaceSummaryMessageIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - channelID string
+//   - messageIDs []string
+func (_e *MockSummaryMessageRepository_Expecter) ReplaceSummaryMessageIDs(ctx interface{}, guildID interface{}, channelID interface{}, messageIDs interface{}) *MockSummaryMessageRepository_ReplaceSummaryMessageIDs_Call {
+	return &MockSummaryMessageRepository_ReplaceSummaryMessageIDs_Call{Call: _e.mock.On("ReplaceSummaryMessageIDs", ctx, guildID, channelID, messageIDs)}
+}
+
+func (_c *MockSummaryMessageRepository_ReplaceSummaryMessageIDs_Call) Run(run func(ctx context.Context, guildID string, channelID string, messageIDs []string)) *MockSummaryMessageRepository_ReplaceSummaryMessageIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSummaryMessageRepository_ReplaceSummaryMessageIDs_Call) Return(err error) *MockSummaryMessageRepository_ReplaceSummaryMessageIDs_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSummaryMessageRepository_ReplaceSummaryMessageIDs_Call) RunAndReturn(run func(ctx context.Context, guildID string, channelID string, messageIDs []string) error) *MockSummaryMessageRepository_ReplaceSummaryMessageIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectSummaryMessageIDs provides a mock function for the type MockSummaryMessageRepository
+func (_mock *MockSummaryMessageRepository) SelectSummaryMessageIDs(ctx context.Context, guildID string, channelID string) ([]string, error) {
+	ret := _mock.Called(ctx, guildID, channelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectSummaryMessageIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]string, error)); ok {
+		return returnFunc(ctx, guildID, channelID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
+		r0 = returnFunc(ctx, guildID, channelID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, guildID, channelID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSummaryMessageRepository_SelectSummaryMessageIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectSummaryMessageIDs'
+type MockSummaryMessageRepository_SelectSummaryMessageIDs_Call struct {
+	*mock.Call
+}
+
+// SelectSummaryMessageIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - channelID string
+func (_e *MockSummaryMessageRepository_Expecter) SelectSummaryMessageIDs(ctx interface{}, guildID interface{}, channelID interface{}) *MockSummaryMessageRepository_SelectSummaryMessageIDs_Call {
+	return &MockSummaryMessageRepository_SelectSummaryMessageIDs_Call{Call: _e.mock.On("SelectSummaryMessageIDs", ctx, guildID, channelID)}
+}
+
+func (_c *MockSummaryMessageRepository_SelectSummaryMessageIDs_Call) Run(run func(ctx context.Context, guildID string, channelID string)) *MockSummaryMessageRepository_SelectSummaryMessageIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSummaryMessageRepository_SelectSummaryMessageIDs_Call) Return(strings []string, err error) *MockSummaryMessageRepository_SelectSummaryMessageIDs_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockSummaryMessageRepository_SelectSummaryMessageIDs_Call) RunAndReturn(run func(ctx context.Context, guildID string, channelID string) ([]string, error)) *MockSummaryMessageRepository_SelectSummaryMessageIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -38,9 +38,77 @@ func (_m *MockSummaryService) EXPECT() *MockSummaryService_Expecter {
 	return &MockSummaryService_Expecter{mock: &_m.Mock}
 }
 
+// PrepareLedger provides a mock function for the type MockSummaryService
+func (_mock *MockSummaryService) PrepareLedger(guildID string, reservations []*reservation.ReservationWithSpot) (*summary.Summary, error) {
+	ret := _mock.Called(guildID, reservations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrepareLedger")
+	}
+
+	var r0 *summary.Summary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, []*reservation.ReservationWithSpot) (*summary.Summary, error)); ok {
+		return returnFunc(guildID, reservations)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, []*reservation.ReservationWithSpot) *summary.Summary); ok {
+		r0 = returnFunc(guildID, reservations)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*summary.Summary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, []*reservation.ReservationWithSpot) error); ok {
+		r1 = returnFunc(guildID, reservations)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSummaryService_PrepareLedger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PrepareLedger'
+type MockSummaryService_PrepareLedger_Call struct {
+	*mock.Call
+}
+
+// PrepareLedger is a helper method to define mock.On call
+//   - guildID string
+//   - reservations []*reservation.ReservationWithSpot
+func (_e *MockSummaryService_Expecter) PrepareLedger(guildID interface{}, reservations interface{}) *MockSummaryService_PrepareLedger_Call {
+	return &MockSummaryService_PrepareLedger_Call{Call: _e.mock.On("PrepareLedger", guildID, reservations)}
+}
+
+func (_c *MockSummaryService_PrepareLedger_Call) Run(run func(guildID string, reservations []*reservation.ReservationWithSpot)) *MockSummaryService_PrepareLedger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 []*reservation.ReservationWithSpot
+		if args[1] != nil {
+			arg1 = args[1].([]*reservation.ReservationWithSpot)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSummaryService_PrepareLedger_Call) Return(summary1 *summary.Summary, err error) *MockSummaryService_PrepareLedger_Call {
+	_c.Call.Return(summary1, err)
+	return _c
+}
+
+func (_c *MockSummaryService_PrepareLedger_Call) RunAndReturn(run func(guildID string, reservations []*reservation.ReservationWithSpot) (*summary.Summary, error)) *MockSummaryService_PrepareLedger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PrepareSummary provides a mock function for the type MockSummaryService
-func (_mock *MockSummaryService) PrepareSummary(reservations []*reservation.ReservationWithSpot) (*summary.Summary, error) {
-	ret := _mock.Called(reservations)
+func (_mock *MockSummaryService) PrepareSummary(guildID string, reservations []*reservation.ReservationWithSpot) (*summary.Summary, error) {
+	ret := _mock.Called(guildID, reservations)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PrepareSummary")
@@ -48,18 +116,18 @@ func (_mock *MockSummaryService) PrepareSummary(reservations []*reservation.Rese
 
 	var r0 *summary.Summary
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func([]*reservation.ReservationWithSpot) (*summary.Summary, error)); ok {
-		return returnFunc(reservations)
+	if returnFunc, ok := ret.Get(0).(func(string, []*reservation.ReservationWithSpot) (*summary.Summary, error)); ok {
+		return returnFunc(guildID, reservations)
 	}
-	if returnFunc, ok := ret.Get(0).(func([]*reservation.ReservationWithSpot) *summary.Summary); ok {
-		r0 = returnFunc(reservations)
+	if returnFunc, ok := ret.Get(0).(func(string, []*reservation.ReservationWithSpot) *summary.Summary); ok {
+		r0 = returnFunc(guildID, reservations)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*summary.Summary)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func([]*reservation.ReservationWithSpot) error); ok {
-		r1 = returnFunc(reservations)
+	if returnFunc, ok := ret.Get(1).(func(string, []*reservation.ReservationWithSpot) error); ok {
+		r1 = returnFunc(guildID, reservations)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -72,19 +140,25 @@ type MockSummaryService_PrepareSummary_Call struct {
 }
 
 // PrepareSummary is a helper method to define mock.On call
+//   - guildID string
 //   - reservations []*reservation.ReservationWithSpot
-func (_e *MockSummaryService_Expecter) PrepareSummary(reservations interface{}) *MockSummaryService_PrepareSummary_Call {
-	return &MockSummaryService_PrepareSummary_Call{Call: _e.mock.On("PrepareSummary", reservations)}
+func (_e *MockSummaryService_Expecter) PrepareSummary(guildID interface{}, reservations interface{}) *MockSummaryService_PrepareSummary_Call {
+	return &MockSummaryService_PrepareSummary_Call{Call: _e.mock.On("PrepareSummary", guildID, reservations)}
 }
 
-func (_c *MockSummaryService_PrepareSummary_Call) Run(run func(reservations []*reservation.ReservationWithSpot)) *MockSummaryService_PrepareSummary_Call {
+func (_c *MockSummaryService_PrepareSummary_Call) Run(run func(guildID string, reservations []*reservation.ReservationWithSpot)) *MockSummaryService_PrepareSummary_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 []*reservation.ReservationWithSpot
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].([]*reservation.ReservationWithSpot)
+			arg0 = args[0].(string)
+		}
+		var arg1 []*reservation.ReservationWithSpot
+		if args[1] != nil {
+			arg1 = args[1].([]*reservation.ReservationWithSpot)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -95,7 +169,64 @@ func (_c *MockSummaryService_PrepareSummary_Call) Return(summary1 *summary.Summa
 	return _c
 }
 
-func (_c *MockSummaryService_PrepareSummary_Call) RunAndReturn(run func(reservations []*reservation.ReservationWithSpot) (*summary.Summary, error)) *MockSummaryService_PrepareSummary_Call {
+func (_c *MockSummaryService_PrepareSummary_Call) RunAndReturn(run func(guildID string, reservations []*reservation.ReservationWithSpot) (*summary.Summary, error)) *MockSummaryService_PrepareSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenderCharts provides a mock function for the type MockSummaryService
+func (_mock *MockSummaryService) RenderCharts(guildID string, sum *summary.Summary) error {
+	ret := _mock.Called(guildID, sum)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenderCharts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, *summary.Summary) error); ok {
+		r0 = returnFunc(guildID, sum)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSummaryService_RenderCharts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderCharts'
+type MockSummaryService_RenderCharts_Call struct {
+	*mock.Call
+}
+
+// RenderCharts is a helper method to define mock.On call
+//   - guildID string
+//   - sum *summary.Summary
+func (_e *MockSummaryService_Expecter) RenderCharts(guildID interface{}, sum interface{}) *MockSummaryService_RenderCharts_Call {
+	return &MockSummaryService_RenderCharts_Call{Call: _e.mock.On("RenderCharts", guildID, sum)}
+}
+
+func (_c *MockSummaryService_RenderCharts_Call) Run(run func(guildID string, sum *summary.Summary)) *MockSummaryService_RenderCharts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 *summary.Summary
+		if args[1] != nil {
+			arg1 = args[1].(*summary.Summary)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSummaryService_RenderCharts_Call) Return(err error) *MockSummaryService_RenderCharts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSummaryService_RenderCharts_Call) RunAndReturn(run func(guildID string, sum *summary.Summary) error) *MockSummaryService_RenderCharts_Call {
 	_c.Call.Return(run)
 	return _c
 }
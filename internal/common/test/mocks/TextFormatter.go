@@ -7,6 +7,7 @@ package mocks
 import (
 	"spot-assistant/internal/core/dto/book"
 	"spot-assistant/internal/core/dto/member"
+	"spot-assistant/internal/core/dto/reminder"
 	"spot-assistant/internal/core/dto/reservation"
 
 	mock "github.com/stretchr/testify/mock"
@@ -260,3 +261,54 @@ func (_c *MockTextFormatter_FormatOverbookedMemberNotification_Call) RunAndRetur
 	_c.Call.Return(run)
 	return _c
 }
+
+// FormatReminder provides a mock function for the type MockTextFormatter
+func (_mock *MockTextFormatter) FormatReminder(r *reminder.Reminder) string {
+	ret := _mock.Called(r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FormatReminder")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(*reminder.Reminder) string); ok {
+		r0 = returnFunc(r)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockTextFormatter_FormatReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FormatReminder'
+type MockTextFormatter_FormatReminder_Call struct {
+	*mock.Call
+}
+
+// FormatReminder is a helper method to define mock.On call
+//   - r *reminder.Reminder
+func (_e *MockTextFormatter_Expecter) FormatReminder(r interface{}) *MockTextFormatter_FormatReminder_Call {
+	return &MockTextFormatter_FormatReminder_Call{Call: _e.mock.On("FormatReminder", r)}
+}
+
+func (_c *MockTextFormatter_FormatReminder_Call) Run(run func(r *reminder.Reminder)) *MockTextFormatter_FormatReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *reminder.Reminder
+		if args[0] != nil {
+			arg0 = args[0].(*reminder.Reminder)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTextFormatter_FormatReminder_Call) Return(s string) *MockTextFormatter_FormatReminder_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockTextFormatter_FormatReminder_Call) RunAndReturn(run func(r *reminder.Reminder) string) *MockTextFormatter_FormatReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,687 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"spot-assistant/internal/core/dto/webhook"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockWebhookRepository creates a new instance of MockWebhookRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookRepository {
+	mock := &MockWebhookRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookRepository is an autogenerated mock type for the WebhookRepository type
+type MockWebhookRepository struct {
+	mock.Mock
+}
+
+type MockWebhookRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookRepository) EXPECT() *MockWebhookRepository_Expecter {
+	return &MockWebhookRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDueDeliveries provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) ClaimDueDeliveries(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]*webhook.Delivery, error) {
+	ret := _mock.Called(ctx, now, leaseUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDueDeliveries")
+	}
+
+	var r0 []*webhook.Delivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) ([]*webhook.Delivery, error)); ok {
+		return returnFunc(ctx, now, leaseUntil, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) []*webhook.Delivery); ok {
+		r0 = returnFunc(ctx, now, leaseUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*webhook.Delivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, now, leaseUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepository_ClaimDueDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDueDeliveries'
+type MockWebhookRepository_ClaimDueDeliveries_Call struct {
+	*mock.Call
+}
+
+// ClaimDueDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - leaseUntil time.Time
+//   - limit int
+func (_e *MockWebhookRepository_Expecter) ClaimDueDeliveries(ctx interface{}, now interface{}, leaseUntil interface{}, limit interface{}) *MockWebhookRepository_ClaimDueDeliveries_Call {
+	return &MockWebhookRepository_ClaimDueDeliveries_Call{Call: _e.mock.On("ClaimDueDeliveries", ctx, now, leaseUntil, limit)}
+}
+
+func (_c *MockWebhookRepository_ClaimDueDeliveries_Call) Run(run func(ctx context.Context, now time.Time, leaseUntil time.Time, limit int)) *MockWebhookRepository_ClaimDueDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_ClaimDueDeliveries_Call) Return(deliverys []*webhook.Delivery, err error) *MockWebhookRepository_ClaimDueDeliveries_Call {
+	_c.Call.Return(deliverys, err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_ClaimDueDeliveries_Call) RunAndReturn(run func(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]*webhook.Delivery, error)) *MockWebhookRepository_ClaimDueDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeadLetterDelivery provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) DeadLetterDelivery(ctx context.Context, id int64, attempt webhook.Attempt) error {
+	ret := _mock.Called(ctx, id, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeadLetterDelivery")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, webhook.Attempt) error); ok {
+		r0 = returnFunc(ctx, id, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookRepository_DeadLetterDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeadLetterDelivery'
+type MockWebhookRepository_DeadLetterDelivery_Call struct {
+	*mock.Call
+}
+
+// DeadLetterDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - attempt webhook.Attempt
+func (_e *MockWebhookRepository_Expecter) DeadLetterDelivery(ctx interface{}, id interface{}, attempt interface{}) *MockWebhookRepository_DeadLetterDelivery_Call {
+	return &MockWebhookRepository_DeadLetterDelivery_Call{Call: _e.mock.On("DeadLetterDelivery", ctx, id, attempt)}
+}
+
+func (_c *MockWebhookRepository_DeadLetterDelivery_Call) Run(run func(ctx context.Context, id int64, attempt webhook.Attempt)) *MockWebhookRepository_DeadLetterDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 webhook.Attempt
+		if args[2] != nil {
+			arg2 = args[2].(webhook.Attempt)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_DeadLetterDelivery_Call) Return(err error) *MockWebhookRepository_DeadLetterDelivery_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_DeadLetterDelivery_Call) RunAndReturn(run func(ctx context.Context, id int64, attempt webhook.Attempt) error) *MockWebhookRepository_DeadLetterDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) DeleteWebhook(ctx context.Context, guildID string, id int64) (bool, error) {
+	ret := _mock.Called(ctx, guildID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64) (bool, error)); ok {
+		return returnFunc(ctx, guildID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64) bool); ok {
+		r0 = returnFunc(ctx, guildID, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = returnFunc(ctx, guildID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepository_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type MockWebhookRepository_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - id int64
+func (_e *MockWebhookRepository_Expecter) DeleteWebhook(ctx interface{}, guildID interface{}, id interface{}) *MockWebhookRepository_DeleteWebhook_Call {
+	return &MockWebhookRepository_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, guildID, id)}
+}
+
+func (_c *MockWebhookRepository_DeleteWebhook_Call) Run(run func(ctx context.Context, guildID string, id int64)) *MockWebhookRepository_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_DeleteWebhook_Call) Return(b bool, err error) *MockWebhookRepository_DeleteWebhook_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_DeleteWebhook_Call) RunAndReturn(run func(ctx context.Context, guildID string, id int64) (bool, error)) *MockWebhookRepository_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueDeliveries provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) EnqueueDeliveries(ctx context.Context, guildID string, event webhook.EventType, payload []byte) (int, error) {
+	ret := _mock.Called(ctx, guildID, event, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueDeliveries")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, webhook.EventType, []byte) (int, error)); ok {
+		return returnFunc(ctx, guildID, event, payload)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, webhook.EventType, []byte) int); ok {
+		r0 = returnFunc(ctx, guildID, event, payload)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, webhook.EventType, []byte) error); ok {
+		r1 = returnFunc(ctx, guildID, event, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepository_EnqueueDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueDeliveries'
+type MockWebhookRepository_EnqueueDeliveries_Call struct {
+	*mock.Call
+}
+
+// EnqueueDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - event webhook.EventType
+//   - payload []byte
+func (_e *MockWebhookRepository_Expecter) EnqueueDeliveries(ctx interface{}, guildID interface{}, event interface{}, payload interface{}) *MockWebhookRepository_EnqueueDeliveries_Call {
+	return &MockWebhookRepository_EnqueueDeliveries_Call{Call: _e.mock.On("EnqueueDeliveries", ctx, guildID, event, payload)}
+}
+
+func (_c *MockWebhookRepository_EnqueueDeliveries_Call) Run(run func(ctx context.Context, guildID string, event webhook.EventType, payload []byte)) *MockWebhookRepository_EnqueueDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 webhook.EventType
+		if args[2] != nil {
+			arg2 = args[2].(webhook.EventType)
+		}
+		var arg3 []byte
+		if args[3] != nil {
+			arg3 = args[3].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_EnqueueDeliveries_Call) Return(n int, err error) *MockWebhookRepository_EnqueueDeliveries_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_EnqueueDeliveries_Call) RunAndReturn(run func(ctx context.Context, guildID string, event webhook.EventType, payload []byte) (int, error)) *MockWebhookRepository_EnqueueDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertWebhook provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) InsertWebhook(ctx context.Context, guildID string, url string, secret string) (*webhook.Webhook, error) {
+	ret := _mock.Called(ctx, guildID, url, secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertWebhook")
+	}
+
+	var r0 *webhook.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*webhook.Webhook, error)); ok {
+		return returnFunc(ctx, guildID, url, secret)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *webhook.Webhook); ok {
+		r0 = returnFunc(ctx, guildID, url, secret)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, guildID, url, secret)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepository_InsertWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertWebhook'
+type MockWebhookRepository_InsertWebhook_Call struct {
+	*mock.Call
+}
+
+// InsertWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - url string
+//   - secret string
+func (_e *MockWebhookRepository_Expecter) InsertWebhook(ctx interface{}, guildID interface{}, url interface{}, secret interface{}) *MockWebhookRepository_InsertWebhook_Call {
+	return &MockWebhookRepository_InsertWebhook_Call{Call: _e.mock.On("InsertWebhook", ctx, guildID, url, secret)}
+}
+
+func (_c *MockWebhookRepository_InsertWebhook_Call) Run(run func(ctx context.Context, guildID string, url string, secret string)) *MockWebhookRepository_InsertWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_InsertWebhook_Call) Return(webhook1 *webhook.Webhook, err error) *MockWebhookRepository_InsertWebhook_Call {
+	_c.Call.Return(webhook1, err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_InsertWebhook_Call) RunAndReturn(run func(ctx context.Context, guildID string, url string, secret string) (*webhook.Webhook, error)) *MockWebhookRepository_InsertWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkDelivered provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) MarkDelivered(ctx context.Context, id int64, attempt webhook.Attempt) error {
+	ret := _mock.Called(ctx, id, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDelivered")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, webhook.Attempt) error); ok {
+		r0 = returnFunc(ctx, id, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookRepository_MarkDelivered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDelivered'
+type MockWebhookRepository_MarkDelivered_Call struct {
+	*mock.Call
+}
+
+// MarkDelivered is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - attempt webhook.Attempt
+func (_e *MockWebhookRepository_Expecter) MarkDelivered(ctx interface{}, id interface{}, attempt interface{}) *MockWebhookRepository_MarkDelivered_Call {
+	return &MockWebhookRepository_MarkDelivered_Call{Call: _e.mock.On("MarkDelivered", ctx, id, attempt)}
+}
+
+func (_c *MockWebhookRepository_MarkDelivered_Call) Run(run func(ctx context.Context, id int64, attempt webhook.Attempt)) *MockWebhookRepository_MarkDelivered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 webhook.Attempt
+		if args[2] != nil {
+			arg2 = args[2].(webhook.Attempt)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_MarkDelivered_Call) Return(err error) *MockWebhookRepository_MarkDelivered_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_MarkDelivered_Call) RunAndReturn(run func(ctx context.Context, id int64, attempt webhook.Attempt) error) *MockWebhookRepository_MarkDelivered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScheduleRetry provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) ScheduleRetry(ctx context.Context, id int64, nextAttemptAt time.Time, attempt webhook.Attempt) error {
+	ret := _mock.Called(ctx, id, nextAttemptAt, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleRetry")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time, webhook.Attempt) error); ok {
+		r0 = returnFunc(ctx, id, nextAttemptAt, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookRepository_ScheduleRetry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScheduleRetry'
+type MockWebhookRepository_ScheduleRetry_Call struct {
+	*mock.Call
+}
+
+// ScheduleRetry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - nextAttemptAt time.Time
+//   - attempt webhook.Attempt
+func (_e *MockWebhookRepository_Expecter) ScheduleRetry(ctx interface{}, id interface{}, nextAttemptAt interface{}, attempt interface{}) *MockWebhookRepository_ScheduleRetry_Call {
+	return &MockWebhookRepository_ScheduleRetry_Call{Call: _e.mock.On("ScheduleRetry", ctx, id, nextAttemptAt, attempt)}
+}
+
+func (_c *MockWebhookRepository_ScheduleRetry_Call) Run(run func(ctx context.Context, id int64, nextAttemptAt time.Time, attempt webhook.Attempt)) *MockWebhookRepository_ScheduleRetry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 webhook.Attempt
+		if args[3] != nil {
+			arg3 = args[3].(webhook.Attempt)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_ScheduleRetry_Call) Return(err error) *MockWebhookRepository_ScheduleRetry_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_ScheduleRetry_Call) RunAndReturn(run func(ctx context.Context, id int64, nextAttemptAt time.Time, attempt webhook.Attempt) error) *MockWebhookRepository_ScheduleRetry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectRecentDeliveries provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) SelectRecentDeliveries(ctx context.Context, guildID string, limit int) ([]*webhook.Delivery, error) {
+	ret := _mock.Called(ctx, guildID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectRecentDeliveries")
+	}
+
+	var r0 []*webhook.Delivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]*webhook.Delivery, error)); ok {
+		return returnFunc(ctx, guildID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []*webhook.Delivery); ok {
+		r0 = returnFunc(ctx, guildID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*webhook.Delivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, guildID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepository_SelectRecentDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectRecentDeliveries'
+type MockWebhookRepository_SelectRecentDeliveries_Call struct {
+	*mock.Call
+}
+
+// SelectRecentDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+//   - limit int
+func (_e *MockWebhookRepository_Expecter) SelectRecentDeliveries(ctx interface{}, guildID interface{}, limit interface{}) *MockWebhookRepository_SelectRecentDeliveries_Call {
+	return &MockWebhookRepository_SelectRecentDeliveries_Call{Call: _e.mock.On("SelectRecentDeliveries", ctx, guildID, limit)}
+}
+
+func (_c *MockWebhookRepository_SelectRecentDeliveries_Call) Run(run func(ctx context.Context, guildID string, limit int)) *MockWebhookRepository_SelectRecentDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_SelectRecentDeliveries_Call) Return(deliverys []*webhook.Delivery, err error) *MockWebhookRepository_SelectRecentDeliveries_Call {
+	_c.Call.Return(deliverys, err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_SelectRecentDeliveries_Call) RunAndReturn(run func(ctx context.Context, guildID string, limit int) ([]*webhook.Delivery, error)) *MockWebhookRepository_SelectRecentDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectWebhooks provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) SelectWebhooks(ctx context.Context, guildID string) ([]*webhook.Webhook, error) {
+	ret := _mock.Called(ctx, guildID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectWebhooks")
+	}
+
+	var r0 []*webhook.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*webhook.Webhook, error)); ok {
+		return returnFunc(ctx, guildID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*webhook.Webhook); ok {
+		r0 = returnFunc(ctx, guildID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*webhook.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, guildID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepository_SelectWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectWebhooks'
+type MockWebhookRepository_SelectWebhooks_Call struct {
+	*mock.Call
+}
+
+// SelectWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+func (_e *MockWebhookRepository_Expecter) SelectWebhooks(ctx interface{}, guildID interface{}) *MockWebhookRepository_SelectWebhooks_Call {
+	return &MockWebhookRepository_SelectWebhooks_Call{Call: _e.mock.On("SelectWebhooks", ctx, guildID)}
+}
+
+func (_c *MockWebhookRepository_SelectWebhooks_Call) Run(run func(ctx context.Context, guildID string)) *MockWebhookRepository_SelectWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_SelectWebhooks_Call) Return(webhooks []*webhook.Webhook, err error) *MockWebhookRepository_SelectWebhooks_Call {
+	_c.Call.Return(webhooks, err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_SelectWebhooks_Call) RunAndReturn(run func(ctx context.Context, guildID string) ([]*webhook.Webhook, error)) *MockWebhookRepository_SelectWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
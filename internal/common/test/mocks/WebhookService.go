@@ -0,0 +1,334 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"spot-assistant/internal/core/dto/webhook"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockWebhookService creates a new instance of MockWebhookService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookService {
+	mock := &MockWebhookService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookService is an autogenerated mock type for the WebhookService type
+type MockWebhookService struct {
+	mock.Mock
+}
+
+type MockWebhookService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookService) EXPECT() *MockWebhookService_Expecter {
+	return &MockWebhookService_Expecter{mock: &_m.Mock}
+}
+
+// AddWebhook provides a mock function for the type MockWebhookService
+func (_mock *MockWebhookService) AddWebhook(guildID string, url string) (*webhook.Webhook, error) {
+	ret := _mock.Called(guildID, url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddWebhook")
+	}
+
+	var r0 *webhook.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (*webhook.Webhook, error)); ok {
+		return returnFunc(guildID, url)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) *webhook.Webhook); ok {
+		r0 = returnFunc(guildID, url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(guildID, url)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookService_AddWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddWebhook'
+type MockWebhookService_AddWebhook_Call struct {
+	*mock.Call
+}
+
+// AddWebhook is a helper method to define mock.On call
+//   - guildID string
+//   - url string
+func (_e *MockWebhookService_Expecter) AddWebhook(guildID interface{}, url interface{}) *MockWebhookService_AddWebhook_Call {
+	return &MockWebhookService_AddWebhook_Call{Call: _e.mock.On("AddWebhook", guildID, url)}
+}
+
+func (_c *MockWebhookService_AddWebhook_Call) Run(run func(guildID string, url string)) *MockWebhookService_AddWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookService_AddWebhook_Call) Return(webhook1 *webhook.Webhook, err error) *MockWebhookService_AddWebhook_Call {
+	_c.Call.Return(webhook1, err)
+	return _c
+}
+
+func (_c *MockWebhookService_AddWebhook_Call) RunAndReturn(run func(guildID string, url string) (*webhook.Webhook, error)) *MockWebhookService_AddWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeliverDue provides a mock function for the type MockWebhookService
+func (_mock *MockWebhookService) DeliverDue(now time.Time) {
+	_mock.Called(now)
+	return
+}
+
+// MockWebhookService_DeliverDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeliverDue'
+type MockWebhookService_DeliverDue_Call struct {
+	*mock.Call
+}
+
+// DeliverDue is a helper method to define mock.On call
+//   - now time.Time
+func (_e *MockWebhookService_Expecter) DeliverDue(now interface{}) *MockWebhookService_DeliverDue_Call {
+	return &MockWebhookService_DeliverDue_Call{Call: _e.mock.On("DeliverDue", now)}
+}
+
+func (_c *MockWebhookService_DeliverDue_Call) Run(run func(now time.Time)) *MockWebhookService_DeliverDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 time.Time
+		if args[0] != nil {
+			arg0 = args[0].(time.Time)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookService_DeliverDue_Call) Return() *MockWebhookService_DeliverDue_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockWebhookService_DeliverDue_Call) RunAndReturn(run func(now time.Time)) *MockWebhookService_DeliverDue_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ListWebhooks provides a mock function for the type MockWebhookService
+func (_mock *MockWebhookService) ListWebhooks(guildID string) ([]*webhook.Webhook, error) {
+	ret := _mock.Called(guildID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhooks")
+	}
+
+	var r0 []*webhook.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]*webhook.Webhook, error)); ok {
+		return returnFunc(guildID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []*webhook.Webhook); ok {
+		r0 = returnFunc(guildID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*webhook.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(guildID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookService_ListWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhooks'
+type MockWebhookService_ListWebhooks_Call struct {
+	*mock.Call
+}
+
+// ListWebhooks is a helper method to define mock.On call
+//   - guildID string
+func (_e *MockWebhookService_Expecter) ListWebhooks(guildID interface{}) *MockWebhookService_ListWebhooks_Call {
+	return &MockWebhookService_ListWebhooks_Call{Call: _e.mock.On("ListWebhooks", guildID)}
+}
+
+func (_c *MockWebhookService_ListWebhooks_Call) Run(run func(guildID string)) *MockWebhookService_ListWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookService_ListWebhooks_Call) Return(webhooks []*webhook.Webhook, err error) *MockWebhookService_ListWebhooks_Call {
+	_c.Call.Return(webhooks, err)
+	return _c
+}
+
+func (_c *MockWebhookService_ListWebhooks_Call) RunAndReturn(run func(guildID string) ([]*webhook.Webhook, error)) *MockWebhookService_ListWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecentDeliveries provides a mock function for the type MockWebhookService
+func (_mock *MockWebhookService) RecentDeliveries(guildID string, limit int) ([]*webhook.Delivery, error) {
+	ret := _mock.Called(guildID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecentDeliveries")
+	}
+
+	var r0 []*webhook.Delivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, int) ([]*webhook.Delivery, error)); ok {
+		return returnFunc(guildID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, int) []*webhook.Delivery); ok {
+		r0 = returnFunc(guildID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*webhook.Delivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = returnFunc(guildID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookService_RecentDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecentDeliveries'
+type MockWebhookService_RecentDeliveries_Call struct {
+	*mock.Call
+}
+
+// RecentDeliveries is a helper method to define mock.On call
+//   - guildID string
+//   - limit int
+func (_e *MockWebhookService_Expecter) RecentDeliveries(guildID interface{}, limit interface{}) *MockWebhookService_RecentDeliveries_Call {
+	return &MockWebhookService_RecentDeliveries_Call{Call: _e.mock.On("RecentDeliveries", guildID, limit)}
+}
+
+func (_c *MockWebhookService_RecentDeliveries_Call) Run(run func(guildID string, limit int)) *MockWebhookService_RecentDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookService_RecentDeliveries_Call) Return(deliverys []*webhook.Delivery, err error) *MockWebhookService_RecentDeliveries_Call {
+	_c.Call.Return(deliverys, err)
+	return _c
+}
+
+func (_c *MockWebhookService_RecentDeliveries_Call) RunAndReturn(run func(guildID string, limit int) ([]*webhook.Delivery, error)) *MockWebhookService_RecentDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveWebhook provides a mock function for the type MockWebhookService
+func (_mock *MockWebhookService) RemoveWebhook(guildID string, id int64) error {
+	ret := _mock.Called(guildID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveWebhook")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = returnFunc(guildID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookService_RemoveWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveWebhook'
+type MockWebhookService_RemoveWebhook_Call struct {
+	*mock.Call
+}
+
+// RemoveWebhook is a helper method to define mock.On call
+//   - guildID string
+//   - id int64
+func (_e *MockWebhookService_Expecter) RemoveWebhook(guildID interface{}, id interface{}) *MockWebhookService_RemoveWebhook_Call {
+	return &MockWebhookService_RemoveWebhook_Call{Call: _e.mock.On("RemoveWebhook", guildID, id)}
+}
+
+func (_c *MockWebhookService_RemoveWebhook_Call) Run(run func(guildID string, id int64)) *MockWebhookService_RemoveWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookService_RemoveWebhook_Call) Return(err error) *MockWebhookService_RemoveWebhook_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookService_RemoveWebhook_Call) RunAndReturn(run func(guildID string, id int64) error) *MockWebhookService_RemoveWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
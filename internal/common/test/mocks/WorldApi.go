@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"spot-assistant/internal/core/dto/world"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -79,6 +81,130 @@ func (_c *MockWorldApi_GetBaseURL_Call) RunAndReturn(run func() string) *MockWor
 	return _c
 }
 
+// GetCharacter provides a mock function for the type MockWorldApi
+func (_mock *MockWorldApi) GetCharacter(name string) (*world.Character, error) {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCharacter")
+	}
+
+	var r0 *world.Character
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*world.Character, error)); ok {
+		return returnFunc(name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *world.Character); ok {
+		r0 = returnFunc(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*world.Character)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWorldApi_GetCharacter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCharacter'
+type MockWorldApi_GetCharacter_Call struct {
+	*mock.Call
+}
+
+// GetCharacter is a helper method to define mock.On call
+//   - name string
+func (_e *MockWorldApi_Expecter) GetCharacter(name interface{}) *MockWorldApi_GetCharacter_Call {
+	return &MockWorldApi_GetCharacter_Call{Call: _e.mock.On("GetCharacter", name)}
+}
+
+func (_c *MockWorldApi_GetCharacter_Call) Run(run func(name string)) *MockWorldApi_GetCharacter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWorldApi_GetCharacter_Call) Return(character *world.Character, err error) *MockWorldApi_GetCharacter_Call {
+	_c.Call.Return(character, err)
+	return _c
+}
+
+func (_c *MockWorldApi_GetCharacter_Call) RunAndReturn(run func(name string) (*world.Character, error)) *MockWorldApi_GetCharacter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGuildMembers provides a mock function for the type MockWorldApi
+func (_mock *MockWorldApi) GetGuildMembers(guildName string) ([]string, error) {
+	ret := _mock.Called(guildName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGuildMembers")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return returnFunc(guildName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = returnFunc(guildName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(guildName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWorldApi_GetGuildMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGuildMembers'
+type MockWorldApi_GetGuildMembers_Call struct {
+	*mock.Call
+}
+
+// GetGuildMembers is a helper method to define mock.On call
+//   - guildName string
+func (_e *MockWorldApi_Expecter) GetGuildMembers(guildName interface{}) *MockWorldApi_GetGuildMembers_Call {
+	return &MockWorldApi_GetGuildMembers_Call{Call: _e.mock.On("GetGuildMembers", guildName)}
+}
+
+func (_c *MockWorldApi_GetGuildMembers_Call) Run(run func(guildName string)) *MockWorldApi_GetGuildMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWorldApi_GetGuildMembers_Call) Return(strings []string, err error) *MockWorldApi_GetGuildMembers_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockWorldApi_GetGuildMembers_Call) RunAndReturn(run func(guildName string) ([]string, error)) *MockWorldApi_GetGuildMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOnlinePlayerNames provides a mock function for the type MockWorldApi
 func (_mock *MockWorldApi) GetOnlinePlayerNames(worldName string) ([]string, error) {
 	ret := _mock.Called(worldName)
@@ -140,3 +266,58 @@ func (_c *MockWorldApi_GetOnlinePlayerNames_Call) RunAndReturn(run func(worldNam
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetWorldNames provides a mock function for the type MockWorldApi
+func (_mock *MockWorldApi) GetWorldNames() ([]string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorldNames")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWorldApi_GetWorldNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorldNames'
+type MockWorldApi_GetWorldNames_Call struct {
+	*mock.Call
+}
+
+// GetWorldNames is a helper method to define mock.On call
+func (_e *MockWorldApi_Expecter) GetWorldNames() *MockWorldApi_GetWorldNames_Call {
+	return &MockWorldApi_GetWorldNames_Call{Call: _e.mock.On("GetWorldNames")}
+}
+
+func (_c *MockWorldApi_GetWorldNames_Call) Run(run func()) *MockWorldApi_GetWorldNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWorldApi_GetWorldNames_Call) Return(strings []string, err error) *MockWorldApi_GetWorldNames_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockWorldApi_GetWorldNames_Call) RunAndReturn(run func() ([]string, error)) *MockWorldApi_GetWorldNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
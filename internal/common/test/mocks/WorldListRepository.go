@@ -0,0 +1,165 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"spot-assistant/internal/core/dto/world"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockWorldListRepository creates a new instance of MockWorldListRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWorldListRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWorldListRepository {
+	mock := &MockWorldListRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWorldListRepository is an autogenerated mock type for the WorldListRepository type
+type MockWorldListRepository struct {
+	mock.Mock
+}
+
+type MockWorldListRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWorldListRepository) EXPECT() *MockWorldListRepository_Expecter {
+	return &MockWorldListRepository_Expecter{mock: &_m.Mock}
+}
+
+// ReplaceWorlds provides a mock function for the type MockWorldListRepository
+func (_mock *MockWorldListRepository) ReplaceWorlds(ctx context.Context, names []string, refreshedAt time.Time) error {
+	ret := _mock.Called(ctx, names, refreshedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceWorlds")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string, time.Time) error); ok {
+		r0 = returnFunc(ctx, names, refreshedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWorldListRepository_ReplaceWorlds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceWorlds'
+type MockWorldListRepository_ReplaceWorlds_Call struct {
+	*mock.Call
+}
+
+// ReplaceWorlds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - names []string
+//   - refreshedAt time.Time
+func (_e *MockWorldListRepository_Expecter) ReplaceWorlds(ctx interface{}, names interface{}, refreshedAt interface{}) *MockWorldListRepository_ReplaceWorlds_Call {
+	return &MockWorldListRepository_ReplaceWorlds_Call{Call: _e.mock.On("ReplaceWorlds", ctx, names, refreshedAt)}
+}
+
+func (_c *MockWorldListRepository_ReplaceWorlds_Call) Run(run func(ctx context.Context, names []string, refreshedAt time.Time)) *MockWorldListRepository_ReplaceWorlds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWorldListRepository_ReplaceWorlds_Call) Return(err error) *MockWorldListRepository_ReplaceWorlds_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWorldListRepository_ReplaceWorlds_Call) RunAndReturn(run func(ctx context.Context, names []string, refreshedAt time.Time) error) *MockWorldListRepository_ReplaceWorlds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectWorlds provides a mock function for the type MockWorldListRepository
+func (_mock *MockWorldListRepository) SelectWorlds(ctx context.Context) (*world.List, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectWorlds")
+	}
+
+	var r0 *world.List
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*world.List, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *world.List); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*world.List)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWorldListRepository_SelectWorlds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectWorlds'
+type MockWorldListRepository_SelectWorlds_Call struct {
+	*mock.Call
+}
+
+// SelectWorlds is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockWorldListRepository_Expecter) SelectWorlds(ctx interface{}) *MockWorldListRepository_SelectWorlds_Call {
+	return &MockWorldListRepository_SelectWorlds_Call{Call: _e.mock.On("SelectWorlds", ctx)}
+}
+
+func (_c *MockWorldListRepository_SelectWorlds_Call) Run(run func(ctx context.Context)) *MockWorldListRepository_SelectWorlds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWorldListRepository_SelectWorlds_Call) Return(list *world.List, err error) *MockWorldListRepository_SelectWorlds_Call {
+	_c.Call.Return(list, err)
+	return _c
+}
+
+func (_c *MockWorldListRepository_SelectWorlds_Call) RunAndReturn(run func(ctx context.Context) (*world.List, error)) *MockWorldListRepository_SelectWorlds_Call {
+	_c.Call.Return(run)
+	return _c
+}
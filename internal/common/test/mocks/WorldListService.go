@@ -0,0 +1,124 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockWorldListService creates a new instance of MockWorldListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWorldListService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWorldListService {
+	mock := &MockWorldListService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWorldListService is an autogenerated mock type for the WorldListService type
+type MockWorldListService struct {
+	mock.Mock
+}
+
+type MockWorldListService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWorldListService) EXPECT() *MockWorldListService_Expecter {
+	return &MockWorldListService_Expecter{mock: &_m.Mock}
+}
+
+// RefreshDue provides a mock function for the type MockWorldListService
+func (_mock *MockWorldListService) RefreshDue(now time.Time) {
+	_mock.Called(now)
+	return
+}
+
+// MockWorldListService_RefreshDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshDue'
+type MockWorldListService_RefreshDue_Call struct {
+	*mock.Call
+}
+
+// RefreshDue is a helper method to define mock.On call
+//   - now time.Time
+func (_e *MockWorldListService_Expecter) RefreshDue(now interface{}) *MockWorldListService_RefreshDue_Call {
+	return &MockWorldListService_RefreshDue_Call{Call: _e.mock.On("RefreshDue", now)}
+}
+
+func (_c *MockWorldListService_RefreshDue_Call) Run(run func(now time.Time)) *MockWorldListService_RefreshDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 time.Time
+		if args[0] != nil {
+			arg0 = args[0].(time.Time)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWorldListService_RefreshDue_Call) Return() *MockWorldListService_RefreshDue_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockWorldListService_RefreshDue_Call) RunAndReturn(run func(now time.Time)) *MockWorldListService_RefreshDue_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Worlds provides a mock function for the type MockWorldListService
+func (_mock *MockWorldListService) Worlds() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Worlds")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockWorldListService_Worlds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Worlds'
+type MockWorldListService_Worlds_Call struct {
+	*mock.Call
+}
+
+// Worlds is a helper method to define mock.On call
+func (_e *MockWorldListService_Expecter) Worlds() *MockWorldListService_Worlds_Call {
+	return &MockWorldListService_Worlds_Call{Call: _e.mock.On("Worlds")}
+}
+
+func (_c *MockWorldListService_Worlds_Call) Run(run func()) *MockWorldListService_Worlds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWorldListService_Worlds_Call) Return(strings []string) *MockWorldListService_Worlds_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockWorldListService_Worlds_Call) RunAndReturn(run func() []string) *MockWorldListService_Worlds_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -38,47 +38,46 @@ func (_m *MockWorldNameRepository) EXPECT() *MockWorldNameRepository_Expecter {
 	return &MockWorldNameRepository_Expecter{mock: &_m.Mock}
 }
 
-// SelectGuildWorld provides a mock function for the type MockWorldNameRepository
-func (_mock *MockWorldNameRepository) SelectGuildWorld(ctx context.Context, guildID string) (*guildsworld.GuildsWorld, error) {
-	ret := _mock.Called(ctx, guildID)
+// DeleteGuildWorld provides a mock function for the type MockWorldNameRepository
+func (_mock *MockWorldNameRepository) DeleteGuildWorld(ctx context.Context, guildID string, worldName string) (bool, error) {
+	ret := _mock.Called(ctx, guildID, worldName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SelectGuildWorld")
+		panic("no return value specified for DeleteGuildWorld")
 	}
 
-	var r0 *guildsworld.GuildsWorld
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*guildsworld.GuildsWorld, error)); ok {
-		return returnFunc(ctx, guildID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, guildID, worldName)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *guildsworld.GuildsWorld); ok {
-		r0 = returnFunc(ctx, guildID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, guildID, worldName)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*guildsworld.GuildsWorld)
-		}
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, guildID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, guildID, worldName)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockWorldNameRepository_SelectGuildWorld_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectGuildWorld'
-type MockWorldNameRepository_SelectGuildWorld_Call struct {
+// MockWorldNameRepository_DeleteGuildWorld_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGuildWorld'
+type MockWorldNameRepository_DeleteGuildWorld_Call struct {
 	*mock.Call
 }
 
-// SelectGuildWorld is a helper method to define mock.On call
+// DeleteGuildWorld is a helper method to define mock.On call
 //   - ctx context.Context
 //   - guildID string
-func (_e *MockWorldNameRepository_Expecter) SelectGuildWorld(ctx interface{}, guildID interface{}) *MockWorldNameRepository_SelectGuildWorld_Call {
-	return &MockWorldNameRepository_SelectGuildWorld_Call{Call: _e.mock.On("SelectGuildWorld", ctx, guildID)}
+//   - worldName string
+func (_e *MockWorldNameRepository_Expecter) DeleteGuildWorld(ctx interface{}, guildID interface{}, worldName interface{}) *MockWorldNameRepository_DeleteGuildWorld_Call {
+	return &MockWorldNameRepository_DeleteGuildWorld_Call{Call: _e.mock.On("DeleteGuildWorld", ctx, guildID, worldName)}
 }
 
-func (_c *MockWorldNameRepository_SelectGuildWorld_Call) Run(run func(ctx context.Context, guildID string)) *MockWorldNameRepository_SelectGuildWorld_Call {
+func (_c *MockWorldNameRepository_DeleteGuildWorld_Call) Run(run func(ctx context.Context, guildID string, worldName string)) *MockWorldNameRepository_DeleteGuildWorld_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -88,55 +87,69 @@ func (_c *MockWorldNameRepository_SelectGuildWorld_Call) Run(run func(ctx contex
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockWorldNameRepository_SelectGuildWorld_Call) Return(guildsWorld *guildsworld.GuildsWorld, err error) *MockWorldNameRepository_SelectGuildWorld_Call {
-	_c.Call.Return(guildsWorld, err)
+func (_c *MockWorldNameRepository_DeleteGuildWorld_Call) Return(b bool, err error) *MockWorldNameRepository_DeleteGuildWorld_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockWorldNameRepository_SelectGuildWorld_Call) RunAndReturn(run func(ctx context.Context, guildID string) (*guildsworld.GuildsWorld, error)) *MockWorldNameRepository_SelectGuildWorld_Call {
+func (_c *MockWorldNameRepository_DeleteGuildWorld_Call) RunAndReturn(run func(ctx context.Context, guildID string, worldName string) (bool, error)) *MockWorldNameRepository_DeleteGuildWorld_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpsertGuildWorld provides a mock function for the type MockWorldNameRepository
-func (_mock *MockWorldNameRepository) UpsertGuildWorld(ctx context.Context, guildID string, worldName string) error {
+// InsertGuildWorld provides a mock function for the type MockWorldNameRepository
+func (_mock *MockWorldNameRepository) InsertGuildWorld(ctx context.Context, guildID string, worldName string) (bool, error) {
 	ret := _mock.Called(ctx, guildID, worldName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpsertGuildWorld")
+		panic("no return value specified for InsertGuildWorld")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, guildID, worldName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
 		r0 = returnFunc(ctx, guildID, worldName)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, guildID, worldName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockWorldNameRepository_UpsertGuildWorld_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertGuildWorld'
-type MockWorldNameRepository_UpsertGuildWorld_Call struct {
+// MockWorldNameRepository_InsertGuildWorld_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertGuildWorld'
+type MockWorldNameRepository_InsertGuildWorld_Call struct {
 	*mock.Call
 }
 
-// UpsertGuildWorld is a helper method to define mock.On call
+// InsertGuildWorld is a helper method to define mock.On call
 //   - ctx context.Context
 //   - guildID string
 //   - worldName string
-func (_e *MockWorldNameRepository_Expecter) UpsertGuildWorld(ctx interface{}, guildID interface{}, worldName interface{}) *MockWorldNameRepository_UpsertGuildWorld_Call {
-	return &MockWorldNameRepository_UpsertGuildWorld_Call{Call: _e.mock.On("UpsertGuildWorld", ctx, guildID, worldName)}
+func (_e *MockWorldNameRepository_Expecter) InsertGuildWorld(ctx interface{}, guildID interface{}, worldName interface{}) *MockWorldNameRepository_InsertGuildWorld_Call {
+	return &MockWorldNameRepository_InsertGuildWorld_Call{Call: _e.mock.On("InsertGuildWorld", ctx, guildID, worldName)}
 }
 
-func (_c *MockWorldNameRepository_UpsertGuildWorld_Call) Run(run func(ctx context.Context, guildID string, worldName string)) *MockWorldNameRepository_UpsertGuildWorld_Call {
+func (_c *MockWorldNameRepository_InsertGuildWorld_Call) Run(run func(ctx context.Context, guildID string, worldName string)) *MockWorldNameRepository_InsertGuildWorld_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -159,12 +172,80 @@ func (_c *MockWorldNameRepository_UpsertGuildWorld_Call) Run(run func(ctx contex
 	return _c
 }
 
-func (_c *MockWorldNameRepository_UpsertGuildWorld_Call) Return(err error) *MockWorldNameRepository_UpsertGuildWorld_Call {
-	_c.Call.Return(err)
+func (_c *MockWorldNameRepository_InsertGuildWorld_Call) Return(b bool, err error) *MockWorldNameRepository_InsertGuildWorld_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockWorldNameRepository_InsertGuildWorld_Call) RunAndReturn(run func(ctx context.Context, guildID string, worldName string) (bool, error)) *MockWorldNameRepository_InsertGuildWorld_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectGuildWorlds provides a mock function for the type MockWorldNameRepository
+func (_mock *MockWorldNameRepository) SelectGuildWorlds(ctx context.Context, guildID string) ([]*guildsworld.GuildsWorld, error) {
+	ret := _mock.Called(ctx, guildID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectGuildWorlds")
+	}
+
+	var r0 []*guildsworld.GuildsWorld
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*guildsworld.GuildsWorld, error)); ok {
+		return returnFunc(ctx, guildID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*guildsworld.GuildsWorld); ok {
+		r0 = returnFunc(ctx, guildID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*guildsworld.GuildsWorld)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, guildID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWorldNameRepository_SelectGuildWorlds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectGuildWorlds'
+type MockWorldNameRepository_SelectGuildWorlds_Call struct {
+	*mock.Call
+}
+
+// SelectGuildWorlds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID string
+func (_e *MockWorldNameRepository_Expecter) SelectGuildWorlds(ctx interface{}, guildID interface{}) *MockWorldNameRepository_SelectGuildWorlds_Call {
+	return &MockWorldNameRepository_SelectGuildWorlds_Call{Call: _e.mock.On("SelectGuildWorlds", ctx, guildID)}
+}
+
+func (_c *MockWorldNameRepository_SelectGuildWorlds_Call) Run(run func(ctx context.Context, guildID string)) *MockWorldNameRepository_SelectGuildWorlds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWorldNameRepository_SelectGuildWorlds_Call) Return(guildsWorlds []*guildsworld.GuildsWorld, err error) *MockWorldNameRepository_SelectGuildWorlds_Call {
+	_c.Call.Return(guildsWorlds, err)
 	return _c
 }
 
-func (_c *MockWorldNameRepository_UpsertGuildWorld_Call) RunAndReturn(run func(ctx context.Context, guildID string, worldName string) error) *MockWorldNameRepository_UpsertGuildWorld_Call {
+func (_c *MockWorldNameRepository_SelectGuildWorlds_Call) RunAndReturn(run func(ctx context.Context, guildID string) ([]*guildsworld.GuildsWorld, error)) *MockWorldNameRepository_SelectGuildWorlds_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,143 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"spot-assistant/internal/core/dto/summary"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// newMocksummaryLayout creates a new instance of mocksummaryLayout. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMocksummaryLayout(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mocksummaryLayout {
+	mock := &mocksummaryLayout{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mocksummaryLayout is an autogenerated mock type for the summaryLayout type
+type mocksummaryLayout struct {
+	mock.Mock
+}
+
+type mocksummaryLayout_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mocksummaryLayout) EXPECT() *mocksummaryLayout_Expecter {
+	return &mocksummaryLayout_Expecter{mock: &_m.Mock}
+}
+
+// fields provides a mock function for the type mocksummaryLayout
+func (_mock *mocksummaryLayout) fields(ledger summary.Ledger, now time.Time) []*discordgo.MessageEmbedField {
+	ret := _mock.Called(ledger, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for fields")
+	}
+
+	var r0 []*discordgo.MessageEmbedField
+	if returnFunc, ok := ret.Get(0).(func(summary.Ledger, time.Time) []*discordgo.MessageEmbedField); ok {
+		r0 = returnFunc(ledger, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*discordgo.MessageEmbedField)
+		}
+	}
+	return r0
+}
+
+// mocksummaryLayout_fields_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'fields'
+type mocksummaryLayout_fields_Call struct {
+	*mock.Call
+}
+
+// fields is a helper method to define mock.On call
+//   - ledger summary.Ledger
+//   - now time.Time
+func (_e *mocksummaryLayout_Expecter) fields(ledger interface{}, now interface{}) *mocksummaryLayout_fields_Call {
+	return &mocksummaryLayout_fields_Call{Call: _e.mock.On("fields", ledger, now)}
+}
+
+func (_c *mocksummaryLayout_fields_Call) Run(run func(ledger summary.Ledger, now time.Time)) *mocksummaryLayout_fields_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 summary.Ledger
+		if args[0] != nil {
+			arg0 = args[0].(summary.Ledger)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksummaryLayout_fields_Call) Return(messageEmbedFields []*discordgo.MessageEmbedField) *mocksummaryLayout_fields_Call {
+	_c.Call.Return(messageEmbedFields)
+	return _c
+}
+
+func (_c *mocksummaryLayout_fields_Call) RunAndReturn(run func(ledger summary.Ledger, now time.Time) []*discordgo.MessageEmbedField) *mocksummaryLayout_fields_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// fieldsPerEmbed provides a mock function for the type mocksummaryLayout
+func (_mock *mocksummaryLayout) fieldsPerEmbed() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for fieldsPerEmbed")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// mocksummaryLayout_fieldsPerEmbed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'fieldsPerEmbed'
+type mocksummaryLayout_fieldsPerEmbed_Call struct {
+	*mock.Call
+}
+
+// fieldsPerEmbed is a helper method to define mock.On call
+func (_e *mocksummaryLayout_Expecter) fieldsPerEmbed() *mocksummaryLayout_fieldsPerEmbed_Call {
+	return &mocksummaryLayout_fieldsPerEmbed_Call{Call: _e.mock.On("fieldsPerEmbed")}
+}
+
+func (_c *mocksummaryLayout_fieldsPerEmbed_Call) Run(run func()) *mocksummaryLayout_fieldsPerEmbed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mocksummaryLayout_fieldsPerEmbed_Call) Return(n int) *mocksummaryLayout_fieldsPerEmbed_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *mocksummaryLayout_fieldsPerEmbed_Call) RunAndReturn(run func() int) *mocksummaryLayout_fieldsPerEmbed_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,26 @@
+package attendance
+
+import (
+	"go.uber.org/zap"
+
+	"spot-assistant/internal/ports"
+)
+
+type Adapter struct {
+	attendanceRepo ports.AttendanceRepository
+	onlineCheckSrv ports.OnlineCheckService
+	log            *zap.SugaredLogger
+}
+
+func NewAdapter(attendanceRepo ports.AttendanceRepository, onlineCheckSrv ports.OnlineCheckService) *Adapter {
+	return &Adapter{
+		attendanceRepo: attendanceRepo,
+		onlineCheckSrv: onlineCheckSrv,
+		log:            zap.NewNop().Sugar(),
+	}
+}
+
+func (a *Adapter) WithLogger(log *zap.SugaredLogger) *Adapter {
+	a.log = log.With("layer", "core", "name", "attendanceService")
+	return a
+}
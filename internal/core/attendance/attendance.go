@@ -0,0 +1,41 @@
+package attendance
+
+import (
+	"context"
+	"time"
+
+	"spot-assistant/internal/core/dto/summary"
+)
+
+// SampleInterval is the least time between two samples of a reservation. Online players are refreshed
+// every tick, so a reservation sampled by several instances, or by a refresh outside of the tick,
+// is counted once per tick.
+const SampleInterval = time.Minute
+
+// SampleAttendance records whether owners of ongoing reservations of the guild are online.
+// Nothing is recorded for owners, whose status is unknown, e.g. as players of their world failed to refresh.
+func (a *Adapter) SampleAttendance(guildID string, now time.Time) {
+	if !a.onlineCheckSrv.IsConfigured() {
+		return
+	}
+
+	ctx := context.Background()
+	reservations, err := a.attendanceRepo.SelectOngoingReservations(ctx, guildID, now)
+	if err != nil {
+		a.log.Errorf("could not select ongoing reservations of guild %s: %s", guildID, err)
+
+		return
+	}
+
+	for _, r := range reservations {
+		status := a.onlineCheckSrv.PlayerStatus(guildID, r.AuthorDiscordID, r.Author)
+		if status == summary.Unknown {
+			continue
+		}
+
+		online := status == summary.Online
+		if _, err := a.attendanceRepo.RecordAttendanceSample(ctx, r.ID, online, now, now.Add(-SampleInterval)); err != nil {
+			a.log.Errorf("could not record attendance of reservation %d: %s", r.ID, err)
+		}
+	}
+}
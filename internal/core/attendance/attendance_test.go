@@ -0,0 +1,76 @@
+package attendance
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/reservation"
+	"spot-assistant/internal/core/dto/summary"
+)
+
+func TestSampleAttendance(t *testing.T) {
+	// given
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	attendanceRepo := mocks.NewMockAttendanceRepository(t)
+	attendanceRepo.On("SelectOngoingReservations", mock.Anything, "guild-id", now).Return([]*reservation.Reservation{
+		{ID: 1, AuthorDiscordID: "online-id", Author: "Asar Cham"},
+		{ID: 2, AuthorDiscordID: "offline-id", Author: "Irnas"},
+		{ID: 3, AuthorDiscordID: "failing-id", Author: "Mariysz"},
+	}, nil)
+	attendanceRepo.On("RecordAttendanceSample", mock.Anything, int64(1), true, now, now.Add(-SampleInterval)).Return(true, nil).Once()
+	attendanceRepo.On("RecordAttendanceSample", mock.Anything, int64(2), false, now, now.Add(-SampleInterval)).Return(false, nil).Once()
+	attendanceRepo.On("RecordAttendanceSample", mock.Anything, int64(3), false, now, now.Add(-SampleInterval)).Return(false, errors.New("connection reset")).Once()
+	onlineCheckSrv := mocks.NewMockOnlineCheckService(t)
+	onlineCheckSrv.On("IsConfigured").Return(true)
+	onlineCheckSrv.On("PlayerStatus", "guild-id", "online-id", "Asar Cham").Return(summary.Online)
+	onlineCheckSrv.On("PlayerStatus", "guild-id", "offline-id", "Irnas").Return(summary.Offline)
+	onlineCheckSrv.On("PlayerStatus", "guild-id", "failing-id", "Mariysz").Return(summary.Offline)
+	adapter := NewAdapter(attendanceRepo, onlineCheckSrv)
+
+	// when
+	adapter.SampleAttendance("guild-id", now)
+
+	// then
+	attendanceRepo.AssertNumberOfCalls(t, "RecordAttendanceSample", 3)
+}
+
+func TestSampleAttendanceWhenWorldIsUnknown(t *testing.T) {
+	// given
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	attendanceRepo := mocks.NewMockAttendanceRepository(t)
+	attendanceRepo.On("SelectOngoingReservations", mock.Anything, "guild-id", now).Return([]*reservation.Reservation{
+		{ID: 1, AuthorDiscordID: "author-id", Author: "Asar Cham"},
+		{ID: 2, AuthorDiscordID: "other-id", Author: "Irnas"},
+	}, nil)
+	attendanceRepo.On("RecordAttendanceSample", mock.Anything, int64(2), true, now, now.Add(-SampleInterval)).Return(true, nil).Once()
+	onlineCheckSrv := mocks.NewMockOnlineCheckService(t)
+	onlineCheckSrv.On("IsConfigured").Return(true)
+	onlineCheckSrv.On("PlayerStatus", "guild-id", "author-id", "Asar Cham").Return(summary.Unknown)
+	onlineCheckSrv.On("PlayerStatus", "guild-id", "other-id", "Irnas").Return(summary.Online)
+	adapter := NewAdapter(attendanceRepo, onlineCheckSrv)
+
+	// when
+	adapter.SampleAttendance("guild-id", now)
+
+	// then
+	attendanceRepo.AssertNotCalled(t, "RecordAttendanceSample", mock.Anything, int64(1), mock.Anything, mock.Anything, mock.Anything)
+	attendanceRepo.AssertNumberOfCalls(t, "RecordAttendanceSample", 1)
+}
+
+func TestSampleAttendanceWhenOnlineCheckIsDisabled(t *testing.T) {
+	// given
+	attendanceRepo := mocks.NewMockAttendanceRepository(t)
+	onlineCheckSrv := mocks.NewMockOnlineCheckService(t)
+	onlineCheckSrv.On("IsConfigured").Return(false)
+	adapter := NewAdapter(attendanceRepo, onlineCheckSrv)
+
+	// when
+	adapter.SampleAttendance("guild-id", time.Now())
+
+	// then
+	attendanceRepo.AssertNotCalled(t, "SelectOngoingReservations", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -8,15 +8,20 @@ import (
 type Adapter struct {
 	reservationRepo ports.ReservationRepository
 	spotRepo        ports.SpotRepository
-	commSrv         ports.CommunicationService
+	characterRepo   ports.CharacterRepository
+	events          ports.EventPublisher
+	rules           []ports.BookingRule
 	log             *zap.SugaredLogger
 }
 
-func NewAdapter(spotRepo ports.SpotRepository, reservationRepo ports.ReservationRepository, commSrv ports.CommunicationService) *Adapter {
+// NewAdapter creates the booking service. Every booking has to pass the rules, in the given order.
+func NewAdapter(spotRepo ports.SpotRepository, reservationRepo ports.ReservationRepository, characterRepo ports.CharacterRepository, events ports.EventPublisher, rules ...ports.BookingRule) *Adapter {
 	return &Adapter{
 		spotRepo:        spotRepo,
 		reservationRepo: reservationRepo,
-		commSrv:         commSrv,
+		characterRepo:   characterRepo,
+		events:          events,
+		rules:           rules,
 		log:             zap.NewNop().Sugar(),
 	}
 }
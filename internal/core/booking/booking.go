@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/character"
+	"spot-assistant/internal/core/dto/event"
 	"spot-assistant/internal/core/dto/guild"
 	"spot-assistant/internal/core/dto/member"
 
@@ -100,6 +102,10 @@ func (a *Adapter) Book(request book.BookRequest) ([]*reservation.ClippedOrRemove
 		return nil, err
 	}
 
+	if err = a.checkRules(request); err != nil {
+		return nil, err
+	}
+
 	upcomingAuthorReservations, err := a.reservationRepo.SelectUpcomingMemberReservationsWithSpots(context.Background(), guild, member)
 	if err != nil {
 		return nil, fmt.Errorf("could not select upcoming member reservations: %w", err)
@@ -133,19 +139,51 @@ func (a *Adapter) Book(request book.BookRequest) ([]*reservation.ClippedOrRemove
 		}
 	}
 
-	res, err := a.reservationRepo.CreateAndDeleteConflicting(context.Background(), member, guild, conflictingReservations, spot.ID, startAt, endAt)
+	created, res, err := a.reservationRepo.CreateAndDeleteConflicting(context.Background(), a.author(guild, member), guild, conflictingReservations, spot, startAt, endAt)
 	if err != nil {
 		return nil, fmt.Errorf("could not create the reservation: %w", err)
 	}
 
-	for _, res := range res {
-		res := res
-		go a.commSrv.NotifyOverbookedMember(request, res)
+	a.events.Publish(event.ReservationCreated{Request: request, Reservation: created})
+	for _, clipped := range res {
+		a.events.Publish(event.ReservationClipped{Request: request, By: created, Clipped: clipped})
 	}
 
 	return res, nil
 }
 
+// checkRules returns the error of the first booking rule the request breaks.
+func (a *Adapter) checkRules(request book.BookRequest) error {
+	for _, rule := range a.rules {
+		if err := rule.CheckBooking(request); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// author returns the member as reservations are signed with. Members, who verified characters,
+// sign them with the character names instead of their nick, so the summary shows the characters.
+// Unverified characters are not used, as anyone can register them.
+func (a *Adapter) author(g *guild.Guild, m *member.Member) *member.Member {
+	characters, err := a.characterRepo.SelectMemberCharacters(context.Background(), g.ID, m.ID)
+	if err != nil {
+		a.log.Warnf("could not load characters of member %s, signing the reservation with their nick: %s", m.ID, err)
+		return m
+	}
+	characters = collections.PoorMansFilter(characters, (*character.Character).IsVerified)
+	if len(characters) == 0 {
+		return m
+	}
+
+	author := *m
+	author.Nick = strings.Join(collections.PoorMansMap(characters, func(c *character.Character) string {
+		return c.Name
+	}), " / ")
+	return &author
+}
+
 func (a *Adapter) UnbookAutocomplete(g *guild.Guild, m *member.Member, filter string) ([]*reservation.ReservationWithSpot, error) {
 	// Get reservations with end_date >= time.Now()
 	// a.reservationRepo.SelectUpcomingReservationsWithSpot(context.Background(), g.ID)
@@ -184,5 +222,10 @@ func (a *Adapter) Unbook(g *guild.Guild, m *member.Member, reservationId int64)
 		return res, err
 	}
 
+	a.events.Publish(event.ReservationCancelled{
+		Request:     book.UnbookRequest{Guild: g, Member: m, ReservationID: reservationId},
+		Reservation: res,
+	})
+
 	return res, nil
 }
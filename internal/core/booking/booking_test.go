@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/character"
+	"spot-assistant/internal/core/dto/event"
 	guild2 "spot-assistant/internal/core/dto/guild"
 	member2 "spot-assistant/internal/core/dto/member"
 
@@ -24,7 +26,7 @@ func TestFindAvailableSpotsWithNoFilter(t *testing.T) {
 	// given
 	assert := assert.New(t)
 	mockSpotRepo := mocks.NewMockSpotRepository(t)
-	adapter := NewAdapter(mockSpotRepo, mocks.NewMockReservationRepository(t), mocks.NewMockCommunicationService(t))
+	adapter := NewAdapter(mockSpotRepo, mocks.NewMockReservationRepository(t), mocks.NewMockCharacterRepository(t), mocks.NewMockEventPublisher(t))
 	spots := []*spot.Spot{
 		{
 			Name: "test-1",
@@ -51,7 +53,7 @@ func TestFindAvailableSpotsWithFilter(t *testing.T) {
 	// given
 	assert := assert.New(t)
 	mockSpotRepo := mocks.NewMockSpotRepository(t)
-	adapter := NewAdapter(mockSpotRepo, mocks.NewMockReservationRepository(t), mocks.NewMockCommunicationService(t))
+	adapter := NewAdapter(mockSpotRepo, mocks.NewMockReservationRepository(t), mocks.NewMockCharacterRepository(t), mocks.NewMockEventPublisher(t))
 	spots := []*spot.Spot{
 		{
 			Name: "test-2",
@@ -74,7 +76,7 @@ func TestFindAvailableSpots_ReturnsEmpty_WhenNoMatch(t *testing.T) {
 	// given
 	assert := assert.New(t)
 	mockSpotRepo := mocks.NewMockSpotRepository(t)
-	adapter := NewAdapter(mockSpotRepo, mocks.NewMockReservationRepository(t), mocks.NewMockCommunicationService(t))
+	adapter := NewAdapter(mockSpotRepo, mocks.NewMockReservationRepository(t), mocks.NewMockCharacterRepository(t), mocks.NewMockEventPublisher(t))
 	mockSpotRepo.On("SelectSpotsByNameCaseInsensitiveLike", context.Background(), "nonexistent").Return([]*spot.Spot{}, nil)
 
 	// when
@@ -90,7 +92,7 @@ func TestFindAvailableSpots_PropagatesError_WhenRepoFails(t *testing.T) {
 	// given
 	assert := assert.New(t)
 	mockSpotRepo := mocks.NewMockSpotRepository(t)
-	adapter := NewAdapter(mockSpotRepo, mocks.NewMockReservationRepository(t), mocks.NewMockCommunicationService(t))
+	adapter := NewAdapter(mockSpotRepo, mocks.NewMockReservationRepository(t), mocks.NewMockCharacterRepository(t), mocks.NewMockEventPublisher(t))
 	mockSpotRepo.On("SelectSpotsByNameCaseInsensitiveLike", context.Background(), "error").Return(nil, errors.New("db error"))
 
 	// when
@@ -106,7 +108,7 @@ func TestGetSuggestedHoursWithNoFilter(t *testing.T) {
 	// given
 	tBase := time.Date(2023, 8, 19, 15, 0, 0, 0, time.Now().Location())
 	assert := assert.New(t)
-	adapter := NewAdapter(mocks.NewMockSpotRepository(t), mocks.NewMockReservationRepository(t), mocks.NewMockCommunicationService(t))
+	adapter := NewAdapter(mocks.NewMockSpotRepository(t), mocks.NewMockReservationRepository(t), mocks.NewMockCharacterRepository(t), mocks.NewMockEventPublisher(t))
 
 	// when
 	res := adapter.GetSuggestedHours(tBase, "")
@@ -123,7 +125,7 @@ func TestGetSuggestedHoursWithFilter(t *testing.T) {
 	// given
 	tBase := time.Date(2023, 8, 19, 15, 0, 0, 0, time.Now().Location())
 	assert := assert.New(t)
-	adapter := NewAdapter(mocks.NewMockSpotRepository(t), mocks.NewMockReservationRepository(t), mocks.NewMockCommunicationService(t))
+	adapter := NewAdapter(mocks.NewMockSpotRepository(t), mocks.NewMockReservationRepository(t), mocks.NewMockCharacterRepository(t), mocks.NewMockEventPublisher(t))
 
 	// when
 	res := adapter.GetSuggestedHours(tBase, "30")
@@ -140,7 +142,7 @@ func TestGetSuggestedHoursWithFilterWithSpecificHour(t *testing.T) {
 	// given
 	tBase := time.Date(2023, 8, 19, 15, 0, 0, 0, time.Now().Location())
 	assert := assert.New(t)
-	adapter := NewAdapter(mocks.NewMockSpotRepository(t), mocks.NewMockReservationRepository(t), mocks.NewMockCommunicationService(t))
+	adapter := NewAdapter(mocks.NewMockSpotRepository(t), mocks.NewMockReservationRepository(t), mocks.NewMockCharacterRepository(t), mocks.NewMockEventPublisher(t))
 
 	// when
 	res := adapter.GetSuggestedHours(tBase, "15:20")
@@ -180,8 +182,12 @@ func TestUnbook(t *testing.T) {
 		mocks.ContextMock,
 		reservation.Reservation.ID, guild.ID, member.ID).Return(reservation, nil)
 	reservationService.On("DeletePresentMemberReservation", mocks.ContextMock, guild, member, reservation.Reservation.ID).Return(nil)
-	communicationOperations := mocks.NewMockCommunicationService(t)
-	adapter := NewAdapter(mocks.NewMockSpotRepository(t), reservationService, communicationOperations)
+	events := mocks.NewMockEventPublisher(t)
+	events.On("Publish", event.ReservationCancelled{
+		Request:     book.UnbookRequest{Guild: guild, Member: member, ReservationID: reservation.Reservation.ID},
+		Reservation: reservation,
+	}).Once()
+	adapter := NewAdapter(mocks.NewMockSpotRepository(t), reservationService, mocks.NewMockCharacterRepository(t), events)
 
 	// when
 	res, err := adapter.Unbook(guild, member, reservation.Reservation.ID)
@@ -220,7 +226,7 @@ func TestUnbookAutocomplete(t *testing.T) {
 		"SelectUpcomingMemberReservationsWithSpots",
 		mocks.ContextMock,
 		guild, member).Return(reservations, nil)
-	adapter := NewAdapter(mocks.NewMockSpotRepository(t), reservationService, mocks.NewMockCommunicationService(t))
+	adapter := NewAdapter(mocks.NewMockSpotRepository(t), reservationService, mocks.NewMockCharacterRepository(t), mocks.NewMockEventPublisher(t))
 
 	// when
 	res, err := adapter.UnbookAutocomplete(guild, member, "")
@@ -273,7 +279,7 @@ func TestUnbookAutocompleteWithFilterMatching(t *testing.T) {
 		"SelectUpcomingMemberReservationsWithSpots",
 		mocks.ContextMock,
 		guild, member).Return(reservations, nil)
-	adapter := NewAdapter(mocks.NewMockSpotRepository(t), reservationService, mocks.NewMockCommunicationService(t))
+	adapter := NewAdapter(mocks.NewMockSpotRepository(t), reservationService, mocks.NewMockCharacterRepository(t), mocks.NewMockEventPublisher(t))
 
 	// when
 	res, err := adapter.UnbookAutocomplete(guild, member, "Library")
@@ -307,11 +313,9 @@ func TestBook(t *testing.T) {
 	reservationService := mocks.NewMockReservationRepository(t)
 	reservationService.On("SelectOverlappingReservations", mocks.ContextMock, spotInput.Name, startAt, endAt, guild.ID).Return([]*reservation.Reservation{}, nil)
 	reservationService.On("SelectUpcomingMemberReservationsWithSpots", mocks.ContextMock, guild, member).Return([]*reservation.ReservationWithSpot{}, nil)
-	reservationService.On("CreateAndDeleteConflicting", mocks.ContextMock, member, guild, []*reservation.Reservation{}, spotInput.ID, startAt, endAt).Return([]*reservation.ClippedOrRemovedReservation{}, nil)
-	adapter := NewAdapter(spotService, reservationService, mocks.NewMockCommunicationService(t))
-
-	// when
-	res, err := adapter.Book(book.BookRequest{
+	created := &reservation.Reservation{ID: 42, SpotID: spotInput.ID, GuildID: guild.ID, AuthorDiscordID: member.ID, StartAt: startAt, EndAt: endAt}
+	reservationService.On("CreateAndDeleteConflicting", mocks.ContextMock, member, guild, []*reservation.Reservation{}, spotInput, startAt, endAt).Return(created, []*reservation.ClippedOrRemovedReservation{}, nil)
+	request := book.BookRequest{
 		Member:         member,
 		Guild:          guild,
 		Spot:           spotInput.Name,
@@ -319,13 +323,97 @@ func TestBook(t *testing.T) {
 		EndAt:          endAt,
 		Overbook:       false,
 		HasPermissions: false,
-	})
+	}
+	events := mocks.NewMockEventPublisher(t)
+	events.On("Publish", event.ReservationCreated{Request: request, Reservation: created}).Once()
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("SelectMemberCharacters", mocks.ContextMock, guild.ID, member.ID).Return([]*character.Character{}, nil)
+	adapter := NewAdapter(spotService, reservationService, characterRepo, events)
+
+	// when
+	res, err := adapter.Book(request)
 
 	// assert
 	assert.Nil(err)
 	assert.NotNil(res)
 }
 
+func TestBookSignsReservationWithCharacters(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	guild := &guild2.Guild{ID: "test-id"}
+	member := &member2.Member{ID: "test-member", Nick: "test-nick"}
+	author := &member2.Member{ID: "test-member", Nick: "Mariysz / Asar Cham"}
+	startAt := time.Now().Add(1 * time.Minute)
+	endAt := startAt.Add(2 * time.Hour)
+	spotInput := &spot.Spot{Name: "test-spot", ID: 1}
+	spotService := mocks.NewMockSpotRepository(t)
+	spotService.On("SelectSpotByName", mocks.ContextMock, spotInput.Name).Return(spotInput, nil)
+	reservationService := mocks.NewMockReservationRepository(t)
+	reservationService.On("SelectOverlappingReservations", mocks.ContextMock, spotInput.Name, startAt, endAt, guild.ID).Return([]*reservation.Reservation{}, nil)
+	reservationService.On("SelectUpcomingMemberReservationsWithSpots", mocks.ContextMock, guild, member).Return([]*reservation.ReservationWithSpot{}, nil)
+	created := &reservation.Reservation{ID: 42, SpotID: spotInput.ID, GuildID: guild.ID, AuthorDiscordID: member.ID, StartAt: startAt, EndAt: endAt}
+	reservationService.On("CreateAndDeleteConflicting", mocks.ContextMock, author, guild, []*reservation.Reservation{}, spotInput, startAt, endAt).
+		Return(created, []*reservation.ClippedOrRemovedReservation{}, nil).Once()
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("SelectMemberCharacters", mocks.ContextMock, guild.ID, member.ID).Return([]*character.Character{
+		{Name: "Mariysz", VerifiedAt: startAt},
+		{Name: "Someone Else's Main"},
+		{Name: "Asar Cham", VerifiedAt: startAt},
+	}, nil)
+	request := book.BookRequest{Member: member, Guild: guild, Spot: spotInput.Name, StartAt: startAt, EndAt: endAt}
+	events := mocks.NewMockEventPublisher(t)
+	events.On("Publish", event.ReservationCreated{Request: request, Reservation: created}).Once()
+	adapter := NewAdapter(spotService, reservationService, characterRepo, events)
+
+	// when
+	_, err := adapter.Book(request)
+
+	// assert
+	assert.Nil(err)
+	assert.Equal("test-nick", member.Nick)
+}
+
+func TestBookPublishesClippedReservations(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	guild := &guild2.Guild{ID: "test-id"}
+	member := &member2.Member{ID: "test-member"}
+	startAt := time.Now().Add(1 * time.Minute)
+	endAt := startAt.Add(2 * time.Hour)
+	spotInput := &spot.Spot{Name: "test-spot", ID: 1}
+	conflicting := []*reservation.Reservation{
+		{ID: 2, AuthorDiscordID: "other-member", StartAt: startAt, EndAt: endAt},
+		{ID: 3, AuthorDiscordID: "other-member", StartAt: endAt.Add(-time.Hour), EndAt: endAt.Add(time.Hour)},
+	}
+	clipped := []*reservation.ClippedOrRemovedReservation{
+		{Original: conflicting[0]},
+		{Original: conflicting[1], New: []*reservation.Reservation{{ID: 4, StartAt: endAt.Add(time.Minute), EndAt: endAt.Add(time.Hour)}}},
+	}
+	spotService := mocks.NewMockSpotRepository(t)
+	spotService.On("SelectSpotByName", mocks.ContextMock, spotInput.Name).Return(spotInput, nil)
+	reservationService := mocks.NewMockReservationRepository(t)
+	reservationService.On("SelectOverlappingReservations", mocks.ContextMock, spotInput.Name, startAt, endAt, guild.ID).Return(conflicting, nil)
+	reservationService.On("SelectUpcomingMemberReservationsWithSpots", mocks.ContextMock, guild, member).Return([]*reservation.ReservationWithSpot{}, nil)
+	created := &reservation.Reservation{ID: 42, SpotID: spotInput.ID, GuildID: guild.ID, AuthorDiscordID: member.ID, StartAt: startAt, EndAt: endAt}
+	reservationService.On("CreateAndDeleteConflicting", mocks.ContextMock, member, guild, conflicting, spotInput, startAt, endAt).Return(created, clipped, nil)
+	request := book.BookRequest{Member: member, Guild: guild, Spot: spotInput.Name, StartAt: startAt, EndAt: endAt, Overbook: true, HasPermissions: true}
+	events := mocks.NewMockEventPublisher(t)
+	events.On("Publish", event.ReservationCreated{Request: request, Reservation: created}).Once()
+	events.On("Publish", event.ReservationClipped{Request: request, By: created, Clipped: clipped[0]}).Once()
+	events.On("Publish", event.ReservationClipped{Request: request, By: created, Clipped: clipped[1]}).Once()
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("SelectMemberCharacters", mocks.ContextMock, guild.ID, member.ID).Return([]*character.Character{}, nil)
+	adapter := NewAdapter(spotService, reservationService, characterRepo, events)
+
+	// when
+	res, err := adapter.Book(request)
+
+	// assert
+	assert.Nil(err)
+	assert.Equal(clipped, res)
+}
+
 func TestBookFailOnSpotRepo(t *testing.T) {
 	// given
 	assert := assert.New(t)
@@ -348,7 +436,7 @@ func TestBookFailOnSpotRepo(t *testing.T) {
 	spotService.On("SelectSpotByName", mocks.ContextMock, spotInput.Name).Return(nil, errors.New("test-error"))
 	reservationService := mocks.NewMockReservationRepository(t)
 
-	adapter := NewAdapter(spotService, reservationService, mocks.NewMockCommunicationService(t))
+	adapter := NewAdapter(spotService, reservationService, mocks.NewMockCharacterRepository(t), mocks.NewMockEventPublisher(t))
 
 	// when
 	_, err := adapter.Book(book.BookRequest{
@@ -365,6 +453,38 @@ func TestBookFailOnSpotRepo(t *testing.T) {
 	assert.NotNil(err)
 }
 
+func TestBookFailOnBrokenRule(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	guild := &guild2.Guild{ID: "test-id"}
+	member := &member2.Member{ID: "test-member", Nick: "test-nick"}
+	startAt := time.Now().Add(1 * time.Minute)
+	spotInput := &spot.Spot{Name: "test-spot", ID: 1}
+	request := book.BookRequest{
+		Member:  member,
+		Guild:   guild,
+		Spot:    spotInput.Name,
+		StartAt: startAt,
+		EndAt:   startAt.Add(2 * time.Hour),
+	}
+	spotService := mocks.NewMockSpotRepository(t)
+	spotService.On("SelectSpotByName", mocks.ContextMock, spotInput.Name).Return(spotInput, nil)
+	passing, broken, unchecked := mocks.NewMockBookingRule(t), mocks.NewMockBookingRule(t), mocks.NewMockBookingRule(t)
+	passing.On("CheckBooking", request).Return(nil).Once()
+	brokenErr := errors.New("your reliability score is too low")
+	broken.On("CheckBooking", request).Return(brokenErr).Once()
+	adapter := NewAdapter(spotService, mocks.NewMockReservationRepository(t), mocks.NewMockCharacterRepository(t), mocks.NewMockEventPublisher(t),
+		passing, broken, unchecked)
+
+	// when
+	res, err := adapter.Book(request)
+
+	// assert
+	assert.ErrorIs(err, brokenErr)
+	assert.Nil(res)
+	unchecked.AssertNotCalled(t, "CheckBooking", request)
+}
+
 func TestBookFailOnUnknownSpot(t *testing.T) {
 	// given
 	assert := assert.New(t)
@@ -381,7 +501,7 @@ func TestBookFailOnUnknownSpot(t *testing.T) {
 	spotService := mocks.NewMockSpotRepository(t)
 	spotService.On("SelectSpotByName", mocks.ContextMock, "Library").Return(nil, errors.New("not found"))
 	reservationService := mocks.NewMockReservationRepository(t)
-	adapter := NewAdapter(spotService, reservationService, mocks.NewMockCommunicationService(t))
+	adapter := NewAdapter(spotService, reservationService, mocks.NewMockCharacterRepository(t), mocks.NewMockEventPublisher(t))
 
 	// when
 	res, err := adapter.Book(book.BookRequest{
@@ -460,11 +580,17 @@ func TestBookOnMultizoneCase(t *testing.T) {
 	reservationService := mocks.NewMockReservationRepository(t)
 	reservationService.On("SelectOverlappingReservations", mocks.ContextMock, spotInput.Name, startAt, endAt, guild.ID).Return([]*reservation.Reservation{}, nil)
 	reservationService.On("SelectUpcomingMemberReservationsWithSpots", mocks.ContextMock, guild, member).Return(existingReservations, nil)
-	reservationService.On("CreateAndDeleteConflicting", mocks.ContextMock, member, guild, []*reservation.Reservation{}, spotInput.ID, startAt, endAt).Return([]*reservation.ClippedOrRemovedReservation{}, nil)
-	adapter := NewAdapter(spotService, reservationService, mocks.NewMockCommunicationService(t))
+	created := &reservation.Reservation{ID: 42, SpotID: spotInput.ID, GuildID: guild.ID, AuthorDiscordID: member.ID, StartAt: startAt, EndAt: endAt}
+	reservationService.On("CreateAndDeleteConflicting", mocks.ContextMock, member, guild, []*reservation.Reservation{}, spotInput, startAt, endAt).Return(created, []*reservation.ClippedOrRemovedReservation{}, nil)
+	request := book.BookRequest{Member: member, Guild: guild, Spot: spotInput.Name, StartAt: startAt, EndAt: endAt}
+	events := mocks.NewMockEventPublisher(t)
+	events.On("Publish", event.ReservationCreated{Request: request, Reservation: created}).Once()
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("SelectMemberCharacters", mocks.ContextMock, guild.ID, member.ID).Return([]*character.Character{}, nil)
+	adapter := NewAdapter(spotService, reservationService, characterRepo, events)
 
 	// when
-	res, err := adapter.Book(book.BookRequest{Member: member, Guild: guild, Spot: spotInput.Name, StartAt: startAt, EndAt: endAt})
+	res, err := adapter.Book(request)
 
 	// assert
 	assert.Nil(err)
@@ -505,7 +631,7 @@ func TestBookFailOnOverbookAuthorsReservation(t *testing.T) {
 	reservationService := mocks.NewMockReservationRepository(t)
 	reservationService.On("SelectOverlappingReservations", mocks.ContextMock, spotInput.Name, startAt, endAt, guild.ID).Return(conflictingReservations, nil)
 	reservationService.On("SelectUpcomingMemberReservationsWithSpots", mocks.ContextMock, guild, member).Return([]*reservation.ReservationWithSpot{}, nil)
-	adapter := NewAdapter(spotService, reservationService, mocks.NewMockCommunicationService(t))
+	adapter := NewAdapter(spotService, reservationService, mocks.NewMockCharacterRepository(t), mocks.NewMockEventPublisher(t))
 
 	// when
 	res, err := adapter.Book(book.BookRequest{Member: member, Guild: guild, Spot: spotInput.Name, StartAt: startAt, EndAt: endAt, Overbook: true, HasPermissions: true})
@@ -0,0 +1,38 @@
+package calendar
+
+import (
+	"strings"
+
+	"go.uber.org/zap"
+
+	"spot-assistant/internal/ports"
+)
+
+type Adapter struct {
+	reservationRepo ports.ReservationRepository
+	feedRepo        ports.CalendarFeedRepository
+	secret          []byte
+	baseURL         string
+	log             *zap.SugaredLogger
+}
+
+// NewAdapter creates a calendar service signing feed links with the secret.
+// Links point to the baseURL, where the HTTP server is publicly reachable.
+func NewAdapter(reservationRepo ports.ReservationRepository, feedRepo ports.CalendarFeedRepository, secret, baseURL string) *Adapter {
+	return &Adapter{
+		reservationRepo: reservationRepo,
+		feedRepo:        feedRepo,
+		secret:          []byte(secret),
+		baseURL:         strings.TrimRight(baseURL, "/"),
+		log:             zap.NewNop().Sugar(),
+	}
+}
+
+func (a *Adapter) WithLogger(log *zap.SugaredLogger) *Adapter {
+	a.log = log.With("layer", "core", "name", "calendarService")
+	return a
+}
+
+func (a *Adapter) IsConfigured() bool {
+	return len(a.secret) > 0 && a.baseURL != ""
+}
@@ -0,0 +1,91 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"spot-assistant/internal/core/dto/reservation"
+)
+
+// ErrNotConfigured is returned when there is no secret or base URL to issue links with.
+var ErrNotConfigured = errors.New("calendar feeds are not configured")
+
+// FeedURL returns the link to a calendar feed, issuing it if needed.
+// An empty member ID stands for the feed of the whole guild.
+func (a *Adapter) FeedURL(guildID, memberID string) (string, error) {
+	if !a.IsConfigured() {
+		return "", ErrNotConfigured
+	}
+
+	nonce, err := a.feedRepo.SelectCalendarFeedNonce(context.Background(), guildID, memberID)
+	if err != nil {
+		return "", fmt.Errorf("could not load the calendar feed: %w", err)
+	}
+	if nonce == "" {
+		return a.RotateFeedURL(guildID, memberID)
+	}
+
+	return a.feedURL(feedClaims{GuildID: guildID, MemberID: memberID, Nonce: nonce}), nil
+}
+
+// RotateFeedURL issues a new link to a calendar feed, so the previous one stops working.
+func (a *Adapter) RotateFeedURL(guildID, memberID string) (string, error) {
+	if !a.IsConfigured() {
+		return "", ErrNotConfigured
+	}
+
+	nonce, err := newNonce()
+	if err != nil {
+		return "", fmt.Errorf("could not generate a calendar token: %w", err)
+	}
+
+	err = a.feedRepo.UpsertCalendarFeedNonce(context.Background(), guildID, memberID, nonce)
+	if err != nil {
+		return "", fmt.Errorf("could not save the calendar feed: %w", err)
+	}
+
+	return a.feedURL(feedClaims{GuildID: guildID, MemberID: memberID, Nonce: nonce}), nil
+}
+
+// Feed renders the iCalendar feed a signed token grants access to.
+// Returns ErrInvalidToken for forged tokens and tokens which were rotated since.
+func (a *Adapter) Feed(token string) ([]byte, error) {
+	if !a.IsConfigured() {
+		return nil, ErrNotConfigured
+	}
+
+	claims, err := a.verify(token)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	nonce, err := a.feedRepo.SelectCalendarFeedNonce(ctx, claims.GuildID, claims.MemberID)
+	if err != nil {
+		return nil, fmt.Errorf("could not load the calendar feed: %w", err)
+	}
+	if nonce != claims.Nonce {
+		return nil, ErrInvalidToken
+	}
+
+	reservations, err := a.reservationRepo.SelectFilteredReservationsWithSpot(ctx, reservation.Filter{
+		GuildID:         claims.GuildID,
+		AuthorDiscordID: claims.MemberID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not load reservations: %w", err)
+	}
+
+	name := "Hunts"
+	if claims.MemberID != "" {
+		name = "My hunts"
+	}
+
+	return renderCalendar(name, claims.MemberID == "", reservations, time.Now()), nil
+}
+
+func (a *Adapter) feedURL(claims feedClaims) string {
+	return fmt.Sprintf("%s/calendar/%s.ics", a.baseURL, a.sign(claims))
+}
@@ -0,0 +1,96 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/reservation"
+)
+
+func tokenFromURL(url string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(url, "https://bot.example.com/calendar/"), ".ics")
+}
+
+func TestFeedURLIssuesFeedOnce(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	feedRepo := new(mocks.MockCalendarFeedRepository)
+	adapter := NewAdapter(new(mocks.MockReservationRepository), feedRepo, "secret", "https://bot.example.com/")
+	feedRepo.On("SelectCalendarFeedNonce", mock.Anything, "guild-id", "member-id").Return("", nil).Once()
+	feedRepo.On("UpsertCalendarFeedNonce", mock.Anything, "guild-id", "member-id", mock.Anything).Return(nil).Once()
+
+	// when
+	url, err := adapter.FeedURL("guild-id", "member-id")
+
+	// then
+	assert.Nil(err)
+	assert.True(strings.HasPrefix(url, "https://bot.example.com/calendar/"))
+	assert.True(strings.HasSuffix(url, ".ics"))
+	claims, err := adapter.verify(tokenFromURL(url))
+	assert.Nil(err)
+	assert.Equal("guild-id", claims.GuildID)
+	assert.Equal("member-id", claims.MemberID)
+	feedRepo.AssertExpectations(t)
+}
+
+func TestFeedRejectsForgedAndRotatedTokens(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	feedRepo := new(mocks.MockCalendarFeedRepository)
+	adapter := NewAdapter(new(mocks.MockReservationRepository), feedRepo, "secret", "https://bot.example.com")
+	forger := NewAdapter(nil, nil, "another secret", "https://bot.example.com")
+	claims := feedClaims{GuildID: "guild-id", MemberID: "member-id", Nonce: "old"}
+	feedRepo.On("SelectCalendarFeedNonce", mock.Anything, "guild-id", "member-id").Return("new", nil)
+
+	// when
+	_, forgedErr := adapter.Feed(forger.sign(claims))
+	_, rotatedErr := adapter.Feed(adapter.sign(claims))
+	_, malformedErr := adapter.Feed("not-a-token")
+
+	// then
+	assert.ErrorIs(forgedErr, ErrInvalidToken)
+	assert.ErrorIs(rotatedErr, ErrInvalidToken)
+	assert.ErrorIs(malformedErr, ErrInvalidToken)
+}
+
+func TestFeedRendersMemberReservations(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	feedRepo := new(mocks.MockCalendarFeedRepository)
+	reservationRepo := new(mocks.MockReservationRepository)
+	adapter := NewAdapter(reservationRepo, feedRepo, "secret", "https://bot.example.com")
+	feedRepo.On("SelectCalendarFeedNonce", mock.Anything, "guild-id", "member-id").Return("nonce", nil)
+	reservationRepo.On("SelectFilteredReservationsWithSpot", mock.Anything, reservation.Filter{
+		GuildID:         "guild-id",
+		AuthorDiscordID: "member-id",
+	}).Return([]*reservation.ReservationWithSpot{{
+		Reservation: reservation.Reservation{ID: 7, Author: "Mariysz"},
+		Spot:        reservation.Spot{Name: "Flimsy"},
+	}}, nil)
+
+	// when
+	feed, err := adapter.Feed(adapter.sign(feedClaims{GuildID: "guild-id", MemberID: "member-id", Nonce: "nonce"}))
+
+	// then
+	assert.Nil(err)
+	assert.Contains(string(feed), "X-WR-CALNAME:My hunts\r\n")
+	assert.Contains(string(feed), "UID:reservation-7@spot-assistant\r\n")
+	assert.Contains(string(feed), "SUMMARY:Flimsy\r\n")
+}
+
+func TestFeedURLNotConfigured(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	adapter := NewAdapter(nil, nil, "", "https://bot.example.com")
+
+	// when
+	_, err := adapter.FeedURL("guild-id", "")
+
+	// then
+	assert.False(adapter.IsConfigured())
+	assert.ErrorIs(err, ErrNotConfigured)
+}
@@ -0,0 +1,75 @@
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"spot-assistant/internal/core/dto/reservation"
+)
+
+// iCalendar (RFC 5545) constants.
+const (
+	icalTimeFormat = "20060102T150405Z"
+	icalLineLength = 75
+	icalProductID  = "-//TibiaLoot.com//Spot Assistant//EN"
+)
+
+var icalEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+
+// renderCalendar renders reservations as an iCalendar feed. Events of a guild feed
+// mention their authors, as they are not necessarily the subscriber's own.
+func renderCalendar(name string, withAuthors bool, reservations []*reservation.ReservationWithSpot, now time.Time) []byte {
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:" + icalProductID,
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:" + icalEscaper.Replace(name),
+	}
+
+	for _, res := range reservations {
+		summary := res.Spot.Name
+		if withAuthors {
+			summary = fmt.Sprintf("%s (%s)", res.Spot.Name, res.Author)
+		}
+
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			fmt.Sprintf("UID:reservation-%d@spot-assistant", res.Reservation.ID),
+			"DTSTAMP:"+now.UTC().Format(icalTimeFormat),
+			"DTSTART:"+res.StartAt.UTC().Format(icalTimeFormat),
+			"DTEND:"+res.EndAt.UTC().Format(icalTimeFormat),
+			"SUMMARY:"+icalEscaper.Replace(summary),
+			"END:VEVENT",
+		)
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	feed := strings.Builder{}
+	for _, line := range lines {
+		feed.WriteString(foldLine(line))
+	}
+
+	return []byte(feed.String())
+}
+
+// foldLine terminates a content line with CRLF, splitting it into lines
+// of at most 75 octets, continued with a leading space. Runes are never split.
+func foldLine(line string) string {
+	folded := strings.Builder{}
+	length := 0
+	for _, r := range line {
+		size := len(string(r))
+		if length+size > icalLineLength {
+			folded.WriteString("\r\n ")
+			length = 1
+		}
+		folded.WriteRune(r)
+		length += size
+	}
+	folded.WriteString("\r\n")
+
+	return folded.String()
+}
@@ -0,0 +1,65 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/reservation"
+)
+
+func TestRenderCalendar(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	berlin := time.FixedZone("CEST", 2*60*60)
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	reservations := []*reservation.ReservationWithSpot{{
+		Reservation: reservation.Reservation{
+			ID:      42,
+			Author:  "Mariysz",
+			StartAt: time.Date(2026, 10, 19, 20, 0, 0, 0, berlin),
+			EndAt:   time.Date(2026, 10, 19, 22, 30, 0, 0, berlin),
+		},
+		Spot: reservation.Spot{Name: "Flimsy, Lost Souls; south"},
+	}}
+
+	// when
+	feed := string(renderCalendar("Hunts", true, reservations, now))
+
+	// then
+	assert.Equal(strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//TibiaLoot.com//Spot Assistant//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:Hunts",
+		"BEGIN:VEVENT",
+		"UID:reservation-42@spot-assistant",
+		"DTSTAMP:20261019T120000Z",
+		"DTSTART:20261019T180000Z",
+		"DTEND:20261019T203000Z",
+		`SUMMARY:Flimsy\, Lost Souls\; south (Mariysz)`,
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n"), feed)
+}
+
+func TestFoldLine(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	line := "SUMMARY:" + strings.Repeat("ż", 40)
+
+	// when
+	folded := foldLine(line)
+
+	// then
+	lines := strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n")
+	assert.Len(lines, 2)
+	assert.LessOrEqual(len(lines[0]), icalLineLength)
+	assert.True(strings.HasPrefix(lines[1], " "))
+	assert.Equal(line, lines[0]+strings.TrimPrefix(lines[1], " "))
+}
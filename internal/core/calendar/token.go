@@ -0,0 +1,71 @@
+package calendar
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidToken is returned for tokens which were not signed by us, or were rotated since.
+var ErrInvalidToken = errors.New("invalid calendar token")
+
+// feedClaims identify a feed. Nonce changes whenever the feed is rotated.
+type feedClaims struct {
+	GuildID  string
+	MemberID string
+	Nonce    string
+}
+
+// sign encodes the claims as "<payload>.<signature>", both base64url encoded.
+func (a *Adapter) sign(claims feedClaims) string {
+	payload := base64.RawURLEncoding.EncodeToString(
+		[]byte(strings.Join([]string{claims.GuildID, claims.MemberID, claims.Nonce}, ":")),
+	)
+
+	return payload + "." + base64.RawURLEncoding.EncodeToString(a.signature(payload))
+}
+
+// verify decodes the claims of a token, if its signature matches.
+func (a *Adapter) verify(token string) (feedClaims, error) {
+	payload, signature, found := strings.Cut(token, ".")
+	if !found {
+		return feedClaims{}, ErrInvalidToken
+	}
+
+	decodedSignature, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(decodedSignature, a.signature(payload)) {
+		return feedClaims{}, ErrInvalidToken
+	}
+
+	decodedPayload, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return feedClaims{}, ErrInvalidToken
+	}
+
+	parts := strings.Split(string(decodedPayload), ":")
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		return feedClaims{}, ErrInvalidToken
+	}
+
+	return feedClaims{GuildID: parts[0], MemberID: parts[1], Nonce: parts[2]}, nil
+}
+
+func (a *Adapter) signature(payload string) []byte {
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write([]byte(payload))
+
+	return mac.Sum(nil)
+}
+
+func newNonce() (string, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(nonce), nil
+}
@@ -0,0 +1,30 @@
+package character
+
+import (
+	"go.uber.org/zap"
+
+	"spot-assistant/internal/ports"
+)
+
+type Adapter struct {
+	characterRepo ports.CharacterRepository
+	worldNameRepo ports.WorldNameRepository
+	api           ports.WorldApi
+	events        ports.EventPublisher
+	log           *zap.SugaredLogger
+}
+
+func NewAdapter(characterRepo ports.CharacterRepository, worldNameRepo ports.WorldNameRepository, api ports.WorldApi, events ports.EventPublisher) *Adapter {
+	return &Adapter{
+		characterRepo: characterRepo,
+		worldNameRepo: worldNameRepo,
+		api:           api,
+		events:        events,
+		log:           zap.NewNop().Sugar(),
+	}
+}
+
+func (a *Adapter) WithLogger(log *zap.SugaredLogger) *Adapter {
+	a.log = log.With("layer", "core", "name", "characterService")
+	return a
+}
@@ -0,0 +1,192 @@
+package character
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"spot-assistant/internal/core/dto/character"
+	"spot-assistant/internal/core/dto/event"
+	"spot-assistant/internal/core/dto/guild"
+	"spot-assistant/internal/core/dto/guildsworld"
+	"spot-assistant/internal/core/dto/member"
+)
+
+// VerificationCodePrefix starts every verification code, so it is easy to spot in a character comment.
+const VerificationCodePrefix = "SA-"
+
+var (
+	ErrInvalidName          = errors.New("character names consist of 2 to 29 letters, spaces, apostrophes and hyphens")
+	ErrCharacterNotFound    = errors.New("you have not registered such a character")
+	ErrVerificationDisabled = errors.New("characters cannot be verified, as the world API is not configured")
+	ErrCodeNotFound         = errors.New("the verification code was not found in the character comment")
+)
+
+var nameRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z' -]{1,28}$`)
+
+// AddCharacter registers a Tibia character of the member, returning it with its verification code.
+// Until verified, a character can be registered by several members of the guild, and the one verifying
+// it takes it over. The world is optional, and has to be one of the worlds of the guild.
+func (a *Adapter) AddCharacter(guildID, memberID, name, world string) (*character.Character, error) {
+	name = normalizeName(name)
+	if !nameRegexp.MatchString(name) {
+		return nil, ErrInvalidName
+	}
+
+	ctx := context.Background()
+	world, err := a.guildWorld(ctx, guildID, world)
+	if err != nil {
+		return nil, err
+	}
+	characters, err := a.characterRepo.SelectMemberCharacters(ctx, guildID, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("could not load characters: %w", err)
+	}
+	if len(characters) >= character.MaxCharactersPerMember {
+		return nil, character.ErrTooManyCharacters
+	}
+
+	code, err := newVerificationCode()
+	if err != nil {
+		return nil, fmt.Errorf("could not generate a verification code: %w", err)
+	}
+
+	c, err := a.characterRepo.InsertCharacter(ctx, &character.Character{
+		GuildID:          guildID,
+		MemberID:         memberID,
+		Name:             name,
+		World:            world,
+		VerificationCode: code,
+	})
+	if err != nil {
+		return nil, err
+	}
+	a.publishChanged(guildID, memberID)
+
+	return c, nil
+}
+
+// RemoveCharacter removes a character registered by the member.
+func (a *Adapter) RemoveCharacter(guildID, memberID, name string) error {
+	deleted, err := a.characterRepo.DeleteCharacter(context.Background(), guildID, memberID, normalizeName(name))
+	if err != nil {
+		return fmt.Errorf("could not remove the character: %w", err)
+	}
+	if !deleted {
+		return ErrCharacterNotFound
+	}
+	a.publishChanged(guildID, memberID)
+
+	return nil
+}
+
+// ListCharacters returns characters registered by the member.
+func (a *Adapter) ListCharacters(guildID, memberID string) ([]*character.Character, error) {
+	return a.characterRepo.SelectMemberCharacters(context.Background(), guildID, memberID)
+}
+
+// CanVerify tells whether characters can be verified through the world API.
+func (a *Adapter) CanVerify() bool {
+	return a.api != nil && a.api.GetBaseURL() != ""
+}
+
+// VerifyCharacter checks whether the comment of the character holds its verification code,
+// which proves the member owns the character.
+func (a *Adapter) VerifyCharacter(guildID, memberID, name string) (*character.Character, error) {
+	if !a.CanVerify() {
+		return nil, ErrVerificationDisabled
+	}
+
+	ctx := context.Background()
+	characters, err := a.characterRepo.SelectMemberCharacters(ctx, guildID, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("could not load characters: %w", err)
+	}
+	c := findCharacter(characters, normalizeName(name))
+	if c == nil {
+		return nil, ErrCharacterNotFound
+	}
+	if c.IsVerified() {
+		return c, nil
+	}
+
+	profile, err := a.api.GetCharacter(c.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not look up the character: %w", err)
+	}
+	if !strings.Contains(profile.Comment, c.VerificationCode) {
+		return nil, ErrCodeNotFound
+	}
+
+	// A character playing on a world the guild does not play on is looked up on the worlds of the guild.
+	world, err := a.guildWorld(ctx, guildID, profile.World)
+	if err != nil && !errors.Is(err, guildsworld.ErrWorldNotFound) {
+		return nil, err
+	}
+
+	now := time.Now()
+	if _, err = a.characterRepo.MarkCharacterVerified(ctx, guildID, memberID, c.Name, world, now); err != nil {
+		return nil, fmt.Errorf("could not verify the character: %w", err)
+	}
+	c.VerifiedAt = now
+	c.World = world
+	a.publishChanged(guildID, memberID)
+
+	return c, nil
+}
+
+func (a *Adapter) publishChanged(guildID, memberID string) {
+	a.events.Publish(event.MemberCharactersChanged{
+		Guild:  &guild.Guild{ID: guildID},
+		Member: &member.Member{ID: memberID},
+	})
+}
+
+// guildWorld returns the world of the guild named like the given one, or an empty world if none is given.
+func (a *Adapter) guildWorld(ctx context.Context, guildID, world string) (string, error) {
+	world = strings.TrimSpace(world)
+	if world == "" {
+		return "", nil
+	}
+
+	worlds, err := a.worldNameRepo.SelectGuildWorlds(ctx, guildID)
+	if err != nil {
+		return "", fmt.Errorf("could not load worlds: %w", err)
+	}
+	for _, w := range worlds {
+		if strings.EqualFold(w.WorldName, world) {
+			return w.WorldName, nil
+		}
+	}
+
+	return "", guildsworld.ErrWorldNotFound
+}
+
+func findCharacter(characters []*character.Character, name string) *character.Character {
+	for _, c := range characters {
+		if strings.EqualFold(c.Name, name) {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// normalizeName trims the name and collapses whitespace, as Tibia names have single spaces between words.
+func normalizeName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+func newVerificationCode() (string, error) {
+	code := make([]byte, 4)
+	if _, err := rand.Read(code); err != nil {
+		return "", err
+	}
+
+	return VerificationCodePrefix + strings.ToUpper(hex.EncodeToString(code)), nil
+}
@@ -0,0 +1,225 @@
+package character
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/character"
+	"spot-assistant/internal/core/dto/event"
+	"spot-assistant/internal/core/dto/guildsworld"
+	"spot-assistant/internal/core/dto/world"
+)
+
+func TestAddCharacter(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("SelectMemberCharacters", mock.Anything, "guild-id", "member-id").Return([]*character.Character{}, nil)
+	characterRepo.On("InsertCharacter", mock.Anything, mock.MatchedBy(func(c *character.Character) bool {
+		return c.Name == "Asar Cham" && strings.HasPrefix(c.VerificationCode, VerificationCodePrefix)
+	})).Return(&character.Character{ID: 1, Name: "Asar Cham"}, nil).Once()
+	events := mocks.NewMockEventPublisher(t)
+	events.On("Publish", mock.AnythingOfType("event.MemberCharactersChanged")).Once()
+	adapter := NewAdapter(characterRepo, mocks.NewMockWorldNameRepository(t), mocks.NewMockWorldApi(t), events)
+
+	// when
+	c, err := adapter.AddCharacter("guild-id", "member-id", "  Asar   Cham ", "")
+
+	// then
+	assert.NoError(err)
+	assert.Equal(int64(1), c.ID)
+}
+
+func TestAddCharacterOnGuildWorld(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	worldNameRepo := mocks.NewMockWorldNameRepository(t)
+	worldNameRepo.On("SelectGuildWorlds", mock.Anything, "guild-id").
+		Return([]*guildsworld.GuildsWorld{{WorldName: "Celesta"}, {WorldName: "Secura"}}, nil)
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("SelectMemberCharacters", mock.Anything, "guild-id", "member-id").Return([]*character.Character{}, nil)
+	characterRepo.On("InsertCharacter", mock.Anything, mock.MatchedBy(func(c *character.Character) bool {
+		return c.Name == "Mariysz" && c.World == "Secura"
+	})).Return(&character.Character{ID: 1, Name: "Mariysz", World: "Secura"}, nil).Once()
+	events := mocks.NewMockEventPublisher(t)
+	events.On("Publish", mock.AnythingOfType("event.MemberCharactersChanged")).Once()
+	adapter := NewAdapter(characterRepo, worldNameRepo, mocks.NewMockWorldApi(t), events)
+
+	// when
+	c, err := adapter.AddCharacter("guild-id", "member-id", "Mariysz", "secura")
+
+	// then
+	assert.NoError(err)
+	assert.Equal("Secura", c.World)
+}
+
+func TestAddCharacterOnUnknownWorld(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	worldNameRepo := mocks.NewMockWorldNameRepository(t)
+	worldNameRepo.On("SelectGuildWorlds", mock.Anything, "guild-id").
+		Return([]*guildsworld.GuildsWorld{{WorldName: "Celesta"}}, nil)
+	adapter := NewAdapter(mocks.NewMockCharacterRepository(t), worldNameRepo, mocks.NewMockWorldApi(t), mocks.NewMockEventPublisher(t))
+
+	// when
+	_, err := adapter.AddCharacter("guild-id", "member-id", "Mariysz", "Secura")
+
+	// then
+	assert.ErrorIs(err, guildsworld.ErrWorldNotFound)
+}
+
+func TestAddCharacterRejectsInvalidNames(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	adapter := NewAdapter(mocks.NewMockCharacterRepository(t), mocks.NewMockWorldNameRepository(t), mocks.NewMockWorldApi(t), mocks.NewMockEventPublisher(t))
+
+	for _, name := range []string{"", "A", "Mariysz/Asar Cham", "<@123>", strings.Repeat("a", 30)} {
+		// when
+		_, err := adapter.AddCharacter("guild-id", "member-id", name, "")
+
+		// then
+		assert.ErrorIs(err, ErrInvalidName, name)
+	}
+}
+
+func TestAddCharacterOverLimit(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("SelectMemberCharacters", mock.Anything, "guild-id", "member-id").
+		Return(make([]*character.Character, character.MaxCharactersPerMember), nil)
+	adapter := NewAdapter(characterRepo, mocks.NewMockWorldNameRepository(t), mocks.NewMockWorldApi(t), mocks.NewMockEventPublisher(t))
+
+	// when
+	_, err := adapter.AddCharacter("guild-id", "member-id", "Mariysz", "")
+
+	// then
+	assert.ErrorIs(err, character.ErrTooManyCharacters)
+}
+
+func TestRemoveCharacterNotFound(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("DeleteCharacter", mock.Anything, "guild-id", "member-id", "Mariysz").Return(false, nil)
+	adapter := NewAdapter(characterRepo, mocks.NewMockWorldNameRepository(t), mocks.NewMockWorldApi(t), mocks.NewMockEventPublisher(t))
+
+	// when
+	err := adapter.RemoveCharacter("guild-id", "member-id", "Mariysz")
+
+	// then
+	assert.ErrorIs(err, ErrCharacterNotFound)
+}
+
+func TestVerifyCharacter(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	registered := &character.Character{Name: "Mariysz", VerificationCode: "SA-CAFE0123"}
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("SelectMemberCharacters", mock.Anything, "guild-id", "member-id").Return([]*character.Character{registered}, nil)
+	characterRepo.On("MarkCharacterVerified", mock.Anything, "guild-id", "member-id", "Mariysz", "Celesta", mock.AnythingOfType("time.Time")).
+		Return(true, nil).Once()
+	api := mocks.NewMockWorldApi(t)
+	api.On("GetBaseURL").Return("https://api.tibiadata.com/v4")
+	api.On("GetCharacter", "Mariysz").Return(&world.Character{Name: "Mariysz", World: "Celesta", Comment: "Letter: SA-CAFE0123"}, nil)
+	worldNameRepo := mocks.NewMockWorldNameRepository(t)
+	worldNameRepo.On("SelectGuildWorlds", mock.Anything, "guild-id").
+		Return([]*guildsworld.GuildsWorld{{WorldName: "Celesta"}}, nil)
+	events := mocks.NewMockEventPublisher(t)
+	events.On("Publish", mock.MatchedBy(func(e event.MemberCharactersChanged) bool {
+		return e.Guild.ID == "guild-id" && e.Member.ID == "member-id"
+	})).Once()
+	adapter := NewAdapter(characterRepo, worldNameRepo, api, events)
+
+	// when
+	c, err := adapter.VerifyCharacter("guild-id", "member-id", "mariysz")
+
+	// then
+	assert.NoError(err)
+	assert.True(c.IsVerified())
+	assert.Equal("Celesta", c.World)
+}
+
+func TestVerifyCharacterOnWorldOutsideGuild(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	registered := &character.Character{Name: "Mariysz", World: "Celesta", VerificationCode: "SA-CAFE0123"}
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("SelectMemberCharacters", mock.Anything, "guild-id", "member-id").Return([]*character.Character{registered}, nil)
+	characterRepo.On("MarkCharacterVerified", mock.Anything, "guild-id", "member-id", "Mariysz", "", mock.AnythingOfType("time.Time")).
+		Return(true, nil).Once()
+	api := mocks.NewMockWorldApi(t)
+	api.On("GetBaseURL").Return("https://api.tibiadata.com/v4")
+	api.On("GetCharacter", "Mariysz").Return(&world.Character{Name: "Mariysz", World: "Antica", Comment: "Letter: SA-CAFE0123"}, nil)
+	worldNameRepo := mocks.NewMockWorldNameRepository(t)
+	worldNameRepo.On("SelectGuildWorlds", mock.Anything, "guild-id").
+		Return([]*guildsworld.GuildsWorld{{WorldName: "Celesta"}}, nil)
+	events := mocks.NewMockEventPublisher(t)
+	events.On("Publish", mock.Anything).Once()
+	adapter := NewAdapter(characterRepo, worldNameRepo, api, events)
+
+	// when
+	c, err := adapter.VerifyCharacter("guild-id", "member-id", "Mariysz")
+
+	// then
+	assert.NoError(err)
+	assert.True(c.IsVerified())
+	assert.Empty(c.World)
+}
+
+func TestVerifyCharacterWithoutCode(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("SelectMemberCharacters", mock.Anything, "guild-id", "member-id").
+		Return([]*character.Character{{Name: "Mariysz", VerificationCode: "SA-CAFE0123"}}, nil)
+	api := mocks.NewMockWorldApi(t)
+	api.On("GetBaseURL").Return("https://api.tibiadata.com/v4")
+	api.On("GetCharacter", "Mariysz").Return(&world.Character{Name: "Mariysz", Comment: "Elite Knight"}, nil)
+	adapter := NewAdapter(characterRepo, mocks.NewMockWorldNameRepository(t), api, mocks.NewMockEventPublisher(t))
+
+	// when
+	_, err := adapter.VerifyCharacter("guild-id", "member-id", "Mariysz")
+
+	// then
+	assert.ErrorIs(err, ErrCodeNotFound)
+	characterRepo.AssertNotCalled(t, "MarkCharacterVerified", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestVerifyAlreadyVerifiedCharacter(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	verified := &character.Character{Name: "Mariysz", VerifiedAt: time.Now()}
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("SelectMemberCharacters", mock.Anything, "guild-id", "member-id").Return([]*character.Character{verified}, nil)
+	api := mocks.NewMockWorldApi(t)
+	api.On("GetBaseURL").Return("https://api.tibiadata.com/v4")
+	adapter := NewAdapter(characterRepo, mocks.NewMockWorldNameRepository(t), api, mocks.NewMockEventPublisher(t))
+
+	// when
+	c, err := adapter.VerifyCharacter("guild-id", "member-id", "Mariysz")
+
+	// then
+	assert.NoError(err)
+	assert.Equal(verified, c)
+	api.AssertNotCalled(t, "GetCharacter", mock.Anything)
+}
+
+func TestVerifyCharacterWithoutApi(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	api := mocks.NewMockWorldApi(t)
+	api.On("GetBaseURL").Return("")
+	adapter := NewAdapter(mocks.NewMockCharacterRepository(t), mocks.NewMockWorldNameRepository(t), api, mocks.NewMockEventPublisher(t))
+
+	// when
+	_, err := adapter.VerifyCharacter("guild-id", "member-id", "Mariysz")
+
+	// then
+	assert.ErrorIs(err, ErrVerificationDisabled)
+}
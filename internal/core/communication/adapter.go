@@ -10,12 +10,15 @@ type Adapter struct {
 	log        *zap.SugaredLogger
 	bot        ports.BotPort
 	memberRepo ports.MemberRepository
+	prefRepo   ports.NotificationPreferenceRepository
 }
 
-func NewAdapter(bot ports.BotPort, memberRepo ports.MemberRepository) *Adapter {
+func NewAdapter(bot ports.BotPort, memberRepo ports.MemberRepository, prefRepo ports.NotificationPreferenceRepository) *Adapter {
 	return &Adapter{
+		log:        zap.NewNop().Sugar(),
 		bot:        bot,
 		memberRepo: memberRepo,
+		prefRepo:   prefRepo,
 	}
 }
 
@@ -1,24 +1,27 @@
 package communication
 
 import (
+	"fmt"
+
 	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/notification"
 	"spot-assistant/internal/core/dto/reservation"
 )
 
 // NotifyOverbookedMember gets a member from the repository,
-// and sends a DM to the member about overbook.
+// and notifies the member about overbook through the channel they have chosen.
 func (a *Adapter) NotifyOverbookedMember(
 	request book.BookRequest,
 	res *reservation.ClippedOrRemovedReservation,
-) {
+) error {
 	member, err := a.memberRepo.GetMemberByGuildAndId(request.Guild, res.Original.AuthorDiscordID)
 	if err != nil {
-		a.log.Error("something went wrong when fetching member to notify about overbooking: ", err)
-		return
+		return fmt.Errorf("could not fetch member to notify about overbooking: %w", err)
 	}
 
-	err = a.bot.SendDMOverbookedNotification(member, request, res)
-	if err != nil {
-		a.log.Errorf("error sending DM: %s", err)
-	}
+	return a.notify(request.Guild, member, notification.KindOverbook, func() error {
+		return a.bot.SendDMOverbookedNotification(member, request, res)
+	}, func() error {
+		return a.bot.MentionOverbookedNotification(request.Guild, member, request, res)
+	})
 }
@@ -3,10 +3,14 @@ package communication
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
 	"spot-assistant/internal/common/test/mocks"
 	"spot-assistant/internal/core/dto/book"
 	"spot-assistant/internal/core/dto/guild"
 	"spot-assistant/internal/core/dto/member"
+	"spot-assistant/internal/core/dto/notification"
 	"spot-assistant/internal/core/dto/reservation"
 )
 
@@ -34,11 +38,41 @@ func TestAdapter_NotifyOverbookedMember(t *testing.T) {
 	memberOperations.On("GetMemberByGuildAndId", guild, res.Original.AuthorDiscordID).Return(member, nil).Once()
 	botOperations := mocks.NewMockBotPort(t)
 	botOperations.On("SendDMOverbookedNotification", member, request, res).Return(nil).Once()
-	adapter := NewAdapter(botOperations, memberOperations)
+	prefRepo := mocks.NewMockNotificationPreferenceRepository(t)
+	prefRepo.On("SelectNotificationChannel", mock.Anything, "123", "conflicting-author-id", notification.KindOverbook).
+		Return(notification.ChannelDM, nil).Once()
+	adapter := NewAdapter(botOperations, memberOperations, prefRepo)
+
+	// when
+	err := adapter.NotifyOverbookedMember(request, res)
+
+	// assert
+	assert.NoError(t, err)
+	botOperations.AssertExpectations(t)
+}
+
+func TestAdapter_NotifyOverbookedMemberFallsBackToMention(t *testing.T) {
+	// given
+	member := &member.Member{ID: "conflicting-author-id"}
+	guild := &guild.Guild{ID: "123"}
+	request := book.BookRequest{Guild: guild, Member: member}
+	res := &reservation.ClippedOrRemovedReservation{
+		Original: &reservation.Reservation{AuthorDiscordID: "conflicting-author-id"},
+	}
+	memberOperations := mocks.NewMockMemberRepository(t)
+	memberOperations.On("GetMemberByGuildAndId", guild, "conflicting-author-id").Return(member, nil).Once()
+	prefRepo := mocks.NewMockNotificationPreferenceRepository(t)
+	prefRepo.On("SelectNotificationChannel", mock.Anything, "123", "conflicting-author-id", notification.KindOverbook).
+		Return(notification.ChannelDM, nil).Once()
+	botOperations := mocks.NewMockBotPort(t)
+	botOperations.On("SendDMOverbookedNotification", member, request, res).Return(notification.ErrCannotDM).Once()
+	botOperations.On("MentionOverbookedNotification", guild, member, request, res).Return(nil).Once()
+	adapter := NewAdapter(botOperations, memberOperations, prefRepo)
 
 	// when
-	adapter.NotifyOverbookedMember(request, res)
+	err := adapter.NotifyOverbookedMember(request, res)
 
 	// assert
+	assert.NoError(t, err)
 	botOperations.AssertExpectations(t)
 }
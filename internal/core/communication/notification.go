@@ -0,0 +1,37 @@
+package communication
+
+import (
+	"context"
+	"errors"
+
+	"spot-assistant/internal/core/dto/guild"
+	"spot-assistant/internal/core/dto/member"
+	"spot-assistant/internal/core/dto/notification"
+)
+
+// notify delivers a notification through the channel chosen by the member. A DM, which
+// cannot be delivered because the member does not accept them, falls back to a mention.
+// If the preference cannot be loaded, the notification is sent as a DM.
+func (a *Adapter) notify(g *guild.Guild, m *member.Member, kind notification.Kind, dm, mention func() error) error {
+	channel, err := a.prefRepo.SelectNotificationChannel(context.Background(), g.ID, m.ID, kind)
+	if err != nil {
+		a.log.Errorf("could not load notification preference of member %s: %s", m.ID, err)
+		channel = notification.DefaultChannel
+	}
+
+	switch channel {
+	case notification.ChannelNone:
+		return nil
+	case notification.ChannelMention:
+		return mention()
+	default:
+		err := dm()
+		if errors.Is(err, notification.ErrCannotDM) {
+			a.log.Infof("member %s does not accept DMs, mentioning them instead", m.ID)
+
+			return mention()
+		}
+
+		return err
+	}
+}
@@ -0,0 +1,25 @@
+package communication
+
+import (
+	"fmt"
+
+	"spot-assistant/internal/core/dto/guild"
+	"spot-assistant/internal/core/dto/notification"
+	"spot-assistant/internal/core/dto/reminder"
+)
+
+// NotifyReminder gets the owner of the reservation from the repository,
+// and notifies them about the reservation starting soon through the channel they have chosen.
+func (a *Adapter) NotifyReminder(r *reminder.Reminder) error {
+	g := &guild.Guild{ID: r.Reservation.GuildID}
+	member, err := a.memberRepo.GetMemberByGuildAndId(g, r.Reservation.AuthorDiscordID)
+	if err != nil {
+		return fmt.Errorf("could not fetch member to remind: %w", err)
+	}
+
+	return a.notify(g, member, notification.KindReminder, func() error {
+		return a.bot.SendDMReminder(member, r)
+	}, func() error {
+		return a.bot.MentionReminder(g, member, r)
+	})
+}
@@ -0,0 +1,67 @@
+package communication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/guild"
+	"spot-assistant/internal/core/dto/member"
+	"spot-assistant/internal/core/dto/notification"
+	"spot-assistant/internal/core/dto/reminder"
+	"spot-assistant/internal/core/dto/reservation"
+)
+
+func TestAdapter_NotifyReminder(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	member := &member.Member{ID: "author-id", Username: "sample-member"}
+	r := &reminder.Reminder{
+		Reservation: reservation.ReservationWithSpot{
+			Reservation: reservation.Reservation{GuildID: "123", AuthorDiscordID: "author-id"},
+		},
+	}
+	memberOperations := mocks.NewMockMemberRepository(t)
+	memberOperations.On("GetMemberByGuildAndId", &guild.Guild{ID: "123"}, "author-id").Return(member, nil).Once()
+	botOperations := mocks.NewMockBotPort(t)
+	botOperations.On("SendDMReminder", member, r).Return(nil).Once()
+	prefRepo := mocks.NewMockNotificationPreferenceRepository(t)
+	prefRepo.On("SelectNotificationChannel", mock.Anything, "123", "author-id", notification.KindReminder).
+		Return(notification.DefaultChannel, nil).Once()
+	adapter := NewAdapter(botOperations, memberOperations, prefRepo)
+
+	// when
+	err := adapter.NotifyReminder(r)
+
+	// assert
+	assert.Nil(err)
+	botOperations.AssertExpectations(t)
+}
+
+func TestAdapter_NotifyReminderWhenMemberChoseNone(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	member := &member.Member{ID: "author-id"}
+	r := &reminder.Reminder{
+		Reservation: reservation.ReservationWithSpot{
+			Reservation: reservation.Reservation{GuildID: "123", AuthorDiscordID: "author-id"},
+		},
+	}
+	memberOperations := mocks.NewMockMemberRepository(t)
+	memberOperations.On("GetMemberByGuildAndId", &guild.Guild{ID: "123"}, "author-id").Return(member, nil).Once()
+	prefRepo := mocks.NewMockNotificationPreferenceRepository(t)
+	prefRepo.On("SelectNotificationChannel", mock.Anything, "123", "author-id", notification.KindReminder).
+		Return(notification.ChannelNone, nil).Once()
+	botOperations := mocks.NewMockBotPort(t)
+	adapter := NewAdapter(botOperations, memberOperations, prefRepo)
+
+	// when
+	err := adapter.NotifyReminder(r)
+
+	// assert
+	assert.Nil(err)
+	botOperations.AssertNotCalled(t, "SendDMReminder", mock.Anything, mock.Anything)
+	botOperations.AssertNotCalled(t, "MentionReminder", mock.Anything, mock.Anything, mock.Anything)
+}
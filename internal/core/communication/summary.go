@@ -1,11 +1,13 @@
 package communication
 
 import (
+	"errors"
 	"strconv"
 
 	"spot-assistant/internal/core/dto/discord"
 	"spot-assistant/internal/core/dto/guild"
 	"spot-assistant/internal/core/dto/member"
+	"spot-assistant/internal/core/dto/notification"
 
 	"spot-assistant/internal/core/dto/summary"
 )
@@ -29,5 +31,10 @@ func (a *Adapter) SendPrivateSummary(request summary.PrivateSummaryRequest, summ
 		return err
 	}
 
-	return a.bot.SendLetterMessage(nil, dmChannel, summary)
+	err = a.bot.SendLetterMessage(nil, dmChannel, summary)
+	if errors.Is(err, notification.ErrCannotDM) {
+		return errors.New("could not send you the summary, please allow direct messages from server members")
+	}
+
+	return err
 }
@@ -5,11 +5,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"spot-assistant/internal/common/test/mocks"
 	"spot-assistant/internal/core/dto/discord"
 	"spot-assistant/internal/core/dto/guild"
 	"spot-assistant/internal/core/dto/member"
+	"spot-assistant/internal/core/dto/notification"
 	"spot-assistant/internal/core/dto/summary"
 )
 
@@ -23,7 +25,7 @@ func TestAdapter_SendGuildSummary(t *testing.T) {
 	botOperations := mocks.NewMockBotPort(t)
 	botOperations.On("FindChannelByName", guild, discord.SummaryChannel).Return(summaryCh, nil).Once()
 	botOperations.On("SendLetterMessage", guild, summaryCh, summary).Return(nil).Once()
-	adapter := NewAdapter(botOperations, memberOperations)
+	adapter := NewAdapter(botOperations, memberOperations, mocks.NewMockNotificationPreferenceRepository(t))
 
 	// when
 	err := adapter.SendGuildSummary(guild, summary)
@@ -45,7 +47,7 @@ func TestAdapter_SendPrivateSummary(t *testing.T) {
 	botOperations := mocks.NewMockBotPort(t)
 	botOperations.On("OpenDM", &member.Member{ID: strconv.FormatInt(request.UserID, 10)}).Return(dmChannel, nil).Once()
 	botOperations.On("SendLetterMessage", nilptrGuild, dmChannel, summary).Return(nil).Once()
-	adapter := NewAdapter(botOperations, nil)
+	adapter := NewAdapter(botOperations, nil, nil)
 
 	// when
 	err := adapter.SendPrivateSummary(request, summary)
@@ -55,3 +57,20 @@ func TestAdapter_SendPrivateSummary(t *testing.T) {
 	botOperations.AssertExpectations(t)
 
 }
+
+func TestAdapter_SendPrivateSummaryWhenMemberDoesNotAcceptDMs(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	dmChannel := &discord.Channel{}
+	request := summary.PrivateSummaryRequest{UserID: 123}
+	botOperations := mocks.NewMockBotPort(t)
+	botOperations.On("OpenDM", &member.Member{ID: "123"}).Return(dmChannel, nil).Once()
+	botOperations.On("SendLetterMessage", mock.Anything, dmChannel, mock.Anything).Return(notification.ErrCannotDM).Once()
+	adapter := NewAdapter(botOperations, nil, nil)
+
+	// when
+	err := adapter.SendPrivateSummary(request, &summary.Summary{})
+
+	// assert
+	assert.EqualError(err, "could not send you the summary, please allow direct messages from server members")
+}
@@ -44,3 +44,22 @@ type BookResponse struct {
 
 	ConflictingReservations []*reservation.ClippedOrRemovedReservation
 }
+
+// Rejection is returned by booking rules, which do not let the member book. Unlike other errors,
+// it is the member to act on it, so it is shown to them as is.
+type Rejection struct {
+	err error
+}
+
+// Reject wraps the reason the member cannot book.
+func Reject(reason error) error {
+	return &Rejection{err: reason}
+}
+
+func (r *Rejection) Error() string {
+	return r.err.Error()
+}
+
+func (r *Rejection) Unwrap() error {
+	return r.err
+}
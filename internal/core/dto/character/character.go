@@ -0,0 +1,38 @@
+package character
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MaxCharactersPerMember limits characters a member registers in a guild,
+// so reservations signed with all of them still fit in the summary.
+const MaxCharactersPerMember = 5
+
+// ErrCharacterTaken is returned when the member already registered the character, or another member verified it.
+var ErrCharacterTaken = errors.New("this character is already registered on this server")
+
+// ErrTooManyCharacters is returned when a member tries to register more characters than allowed.
+var ErrTooManyCharacters = fmt.Errorf("a member can register at most %d characters", MaxCharactersPerMember)
+
+// Character is a Tibia character registered by a member of a guild.
+type Character struct {
+	ID       int64
+	GuildID  string
+	MemberID string
+	Name     string
+	// World the character plays on. Empty until known, and then the character is looked for on all worlds of the guild.
+	World string
+
+	// VerificationCode proves the member owns the character, once it is put in the character comment.
+	VerificationCode string
+	// VerifiedAt is zero until the character is verified.
+	VerifiedAt time.Time
+	CreatedAt  time.Time
+}
+
+// IsVerified reports whether the member proved they own the character.
+func (c *Character) IsVerified() bool {
+	return !c.VerifiedAt.IsZero()
+}
@@ -0,0 +1,80 @@
+package event
+
+import (
+	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/guild"
+	"spot-assistant/internal/core/dto/member"
+	"spot-assistant/internal/core/dto/reservation"
+)
+
+// Names of domain events.
+const (
+	NameReservationCreated      = "reservation.created"
+	NameReservationCancelled    = "reservation.cancelled"
+	NameReservationClipped      = "reservation.clipped"
+	NameGuildWorldChanged       = "guild.world_changed"
+	NameMemberCharactersChanged = "member.characters_changed"
+)
+
+// Event is something that happened in the domain. Services publish events,
+// instead of triggering their side effects, and subscribers react to the ones they need.
+type Event interface {
+	// Name identifies the type of the event.
+	Name() string
+
+	// GuildID returns ID of the guild the event happened in.
+	GuildID() string
+}
+
+// ReservationCreated is published when a member books a spot.
+type ReservationCreated struct {
+	Request     book.BookRequest
+	Reservation *reservation.Reservation
+}
+
+func (e ReservationCreated) Name() string    { return NameReservationCreated }
+func (e ReservationCreated) GuildID() string { return e.Request.Guild.ID }
+
+// ReservationClipped is published for every reservation shortened or removed by an overbooking one.
+type ReservationClipped struct {
+	// Request is the booking, which overbooked the reservation, and By is the reservation it created.
+	Request book.BookRequest
+	By      *reservation.Reservation
+	Clipped *reservation.ClippedOrRemovedReservation
+}
+
+func (e ReservationClipped) Name() string    { return NameReservationClipped }
+func (e ReservationClipped) GuildID() string { return e.Request.Guild.ID }
+
+// Removed reports whether nothing is left of the reservation.
+func (e ReservationClipped) Removed() bool {
+	return len(e.Clipped.New) == 0
+}
+
+// ReservationCancelled is published when a member cancels their reservation.
+type ReservationCancelled struct {
+	Request     book.UnbookRequest
+	Reservation *reservation.ReservationWithSpot
+}
+
+func (e ReservationCancelled) Name() string    { return NameReservationCancelled }
+func (e ReservationCancelled) GuildID() string { return e.Request.Guild.ID }
+
+// GuildWorldChanged is published when a guild adds or removes a Tibia world its members play on.
+type GuildWorldChanged struct {
+	Guild   *guild.Guild
+	World   string
+	Removed bool
+}
+
+func (e GuildWorldChanged) Name() string    { return NameGuildWorldChanged }
+func (e GuildWorldChanged) GuildID() string { return e.Guild.ID }
+
+// MemberCharactersChanged is published when a member registers, removes or verifies a character.
+type MemberCharactersChanged struct {
+	Guild  *guild.Guild
+	Member *member.Member
+}
+
+func (e MemberCharactersChanged) Name() string    { return NameMemberCharactersChanged }
+func (e MemberCharactersChanged) GuildID() string { return e.Guild.ID }
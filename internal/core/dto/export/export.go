@@ -0,0 +1,98 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Format is an encoding of exported and imported records.
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatJSON Format = "json"
+)
+
+var Formats = []Format{FormatCSV, FormatJSON}
+
+func (f Format) IsValid() bool {
+	for _, format := range Formats {
+		if f == format {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReservationRecord is a single exported reservation.
+type ReservationRecord struct {
+	ID              int64     `json:"id"`
+	Spot            string    `json:"spot"`
+	Author          string    `json:"author"`
+	AuthorDiscordID string    `json:"author_discord_id"`
+	StartAt         time.Time `json:"start_at"`
+	EndAt           time.Time `json:"end_at"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// SpotRecord is a single exported or imported spot.
+type SpotRecord struct {
+	Name string `json:"name"`
+}
+
+// SkippedSpot is a spot which was not imported, along with the reason.
+type SkippedSpot struct {
+	Line   int    `json:"line"`
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// ImportReport describes the outcome of a spot import. Nothing is saved during a dry run.
+type ImportReport struct {
+	DryRun  bool          `json:"dry_run"`
+	Added   []string      `json:"added"`
+	Skipped []SkippedSpot `json:"skipped"`
+}
+
+// DateFormat is the format of range boundaries given by members.
+const DateFormat = "2006-01-02"
+
+// Default and maximum range of exported reservations.
+const (
+	DefaultRangeDays = 7
+	MaxRangeDays     = 92
+)
+
+// ParseRange parses an inclusive range of dates. Missing boundaries default
+// to DefaultRangeDays before and after today.
+func ParseRange(from, to string, now time.Time) (time.Time, time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	start := today.AddDate(0, 0, -DefaultRangeDays)
+	end := today.AddDate(0, 0, DefaultRangeDays+1)
+
+	if from != "" {
+		parsed, err := time.ParseInLocation(DateFormat, from, now.Location())
+		if err != nil {
+			return start, end, fmt.Errorf("invalid start date %s, expected format YYYY-MM-DD", from)
+		}
+		start = parsed
+	}
+	if to != "" {
+		parsed, err := time.ParseInLocation(DateFormat, to, now.Location())
+		if err != nil {
+			return start, end, fmt.Errorf("invalid end date %s, expected format YYYY-MM-DD", to)
+		}
+		end = parsed.AddDate(0, 0, 1)
+	}
+
+	if !start.Before(end) {
+		return start, end, errors.New("the start date must not be after the end date")
+	}
+	if end.After(start.AddDate(0, 0, MaxRangeDays)) {
+		return start, end, fmt.Errorf("the range must not be longer than %d days", MaxRangeDays)
+	}
+
+	return start, end, nil
+}
@@ -0,0 +1,32 @@
+package export
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRange(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	now := time.Date(2026, 10, 19, 15, 30, 0, 0, time.UTC)
+
+	// when
+	defaultFrom, defaultTo, defaultErr := ParseRange("", "", now)
+	from, to, err := ParseRange("2026-10-01", "2026-10-07", now)
+	_, _, reversedErr := ParseRange("2026-10-07", "2026-10-01", now)
+	_, _, tooLongErr := ParseRange("2026-01-01", "2026-10-07", now)
+	_, _, malformedErr := ParseRange("yesterday", "", now)
+
+	// then
+	assert.Nil(defaultErr)
+	assert.Equal(time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), defaultFrom)
+	assert.Equal(time.Date(2026, 10, 27, 0, 0, 0, 0, time.UTC), defaultTo)
+	assert.Nil(err)
+	assert.Equal(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), from)
+	assert.Equal(time.Date(2026, 10, 8, 0, 0, 0, 0, time.UTC), to)
+	assert.NotNil(reversedErr)
+	assert.NotNil(tooLongErr)
+	assert.NotNil(malformedErr)
+}
@@ -0,0 +1,220 @@
+package guildsettings
+
+import (
+	"slices"
+
+	"spot-assistant/internal/core/dto/discord"
+)
+
+// SummaryChart decides which charts are attached to the summary.
+type SummaryChart string
+
+const (
+	SummaryChartPie      SummaryChart = "pie"
+	SummaryChartTimeline SummaryChart = "timeline"
+	SummaryChartBoth     SummaryChart = "both"
+)
+
+// SummaryCharts lists all supported summary chart settings.
+var SummaryCharts = []SummaryChart{SummaryChartPie, SummaryChartTimeline, SummaryChartBoth}
+
+// IsValid reports whether the chart setting is supported.
+func (c SummaryChart) IsValid() bool {
+	for _, chart := range SummaryCharts {
+		if c == chart {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasPie reports whether the pie chart should be attached.
+func (c SummaryChart) HasPie() bool {
+	return c == SummaryChartPie || c == SummaryChartBoth
+}
+
+// HasTimeline reports whether the timeline chart should be attached.
+func (c SummaryChart) HasTimeline() bool {
+	return c == SummaryChartTimeline || c == SummaryChartBoth
+}
+
+// SummaryLayout decides how the ledger of the summary is laid out.
+type SummaryLayout string
+
+const (
+	// SummaryLayoutBySpot groups bookings by spot.
+	SummaryLayoutBySpot SummaryLayout = "by-spot"
+	// SummaryLayoutByHour lists bookings chronologically, grouped by the hour they start at.
+	SummaryLayoutByHour SummaryLayout = "by-hour"
+	// SummaryLayoutCompact shows the current and the next booking of every spot in a table.
+	SummaryLayoutCompact SummaryLayout = "compact"
+	// SummaryLayoutCurrent shows only the bookings in progress.
+	SummaryLayoutCurrent SummaryLayout = "current"
+)
+
+// SummaryLayouts lists all supported summary layouts.
+var SummaryLayouts = []SummaryLayout{SummaryLayoutBySpot, SummaryLayoutByHour, SummaryLayoutCompact, SummaryLayoutCurrent}
+
+// IsValid reports whether the layout is supported.
+func (l SummaryLayout) IsValid() bool {
+	for _, layout := range SummaryLayouts {
+		if l == layout {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Limits of the branding texts, below the limits of Discord embeds and messages.
+const (
+	MaxBrandingTitleLength       = 256
+	MaxBrandingDescriptionLength = 1024
+	MaxBrandingPreMessageLength  = 2000
+)
+
+// SummaryBranding customises texts and looks of the summary.
+// Empty values fall back to the defaults of the bot.
+type SummaryBranding struct {
+	Title        string
+	URL          string
+	Description  string
+	ThumbnailURL string
+	// Color of the embeds as 0xRRGGBB, no colour if zero.
+	Color int
+
+	// PreMessage replaces the message posted above the summary.
+	PreMessage string
+	// HidePreMessage leaves the summary without any pre-message.
+	HidePreMessage bool
+}
+
+// DefaultReliabilityMaxHoursAhead is how far ahead members with a low reliability score can book,
+// unless the guild chooses otherwise.
+const DefaultReliabilityMaxHoursAhead = 24
+
+// MaxReliabilityHoursAhead limits how far ahead the guild can let members with a low reliability score book.
+const MaxReliabilityHoursAhead = 7 * 24
+
+// ReliabilitySettings restrict booking of members, who often cancel late or do not show up.
+type ReliabilitySettings struct {
+	// MinScore is the reliability score members below are restricted at. Restrictions are off if zero.
+	MinScore int
+	// MaxHoursAhead is how far ahead restricted members can book.
+	MaxHoursAhead int
+	// OverbookDM tells members with the privileged role, who overbook others, how reliable they are.
+	OverbookDM bool
+}
+
+// MaxTibiaGuildLength is the longest name of an in-game guild.
+const MaxTibiaGuildLength = 29
+
+// MaxFavouriteSpots limits favourite spots of a guild, so the summary stays readable.
+const MaxFavouriteSpots = 20
+
+// GuildSettings holds per-guild preferences of the bot.
+type GuildSettings struct {
+	GuildID       string
+	SummaryChart  SummaryChart
+	SummaryLayout SummaryLayout
+
+	// IDs of the channels and the role the bot uses in the guild,
+	// empty until bound. Binding by ID lets the guild rename them freely.
+	SummaryChannelID string
+	CommandChannelID string
+	PrivilegedRoleID string
+
+	// BookingChannelIDs restricts booking commands to the channels, if not empty.
+	BookingChannelIDs []string
+	// MirrorBookings posts bookings made outside the command channel to the command channel.
+	MirrorBookings bool
+
+	// FavouriteSpots are listed in the free now and free soon sections of the summary.
+	FavouriteSpots []string
+
+	Branding SummaryBranding
+
+	// ReminderMinutesBefore is how early members are reminded of their reservations,
+	// unless they choose otherwise. Reminders are off if zero.
+	ReminderMinutesBefore int
+
+	Reliability ReliabilitySettings
+
+	// TibiaGuild restricts booking to members with a character in the in-game guild, if not empty.
+	TibiaGuild string
+	// SpotRequirements lets members book only spots, whose level and vocation requirements one of their characters meets.
+	SpotRequirements bool
+}
+
+// Default returns settings used by guilds that have not changed anything yet.
+func Default(guildID string) *GuildSettings {
+	return &GuildSettings{
+		GuildID:           guildID,
+		SummaryChart:      SummaryChartPie,
+		SummaryLayout:     SummaryLayoutBySpot,
+		BookingChannelIDs: []string{},
+		FavouriteSpots:    []string{},
+		Reliability: ReliabilitySettings{
+			MaxHoursAhead: DefaultReliabilityMaxHoursAhead,
+		},
+	}
+}
+
+// ChannelID returns ID of the channel bound in place of the default channel name,
+// or an empty string if it is not bound.
+func (s *GuildSettings) ChannelID(channelName string) string {
+	if s == nil {
+		return ""
+	}
+
+	switch channelName {
+	case discord.SummaryChannel:
+		return s.SummaryChannelID
+	case discord.CommandChannel:
+		return s.CommandChannelID
+	default:
+		return ""
+	}
+}
+
+// BindChannel binds the channel in place of the default channel name.
+func (s *GuildSettings) BindChannel(channelName, channelID string) {
+	switch channelName {
+	case discord.SummaryChannel:
+		s.SummaryChannelID = channelID
+	case discord.CommandChannel:
+		s.CommandChannelID = channelID
+	}
+}
+
+// RoleID returns ID of the role bound in place of the default role name,
+// or an empty string if it is not bound.
+func (s *GuildSettings) RoleID(roleName string) string {
+	if s == nil || roleName != discord.PrivilegedRole {
+		return ""
+	}
+
+	return s.PrivilegedRoleID
+}
+
+// BindRole binds the role in place of the default role name.
+func (s *GuildSettings) BindRole(roleName, roleID string) {
+	if roleName == discord.PrivilegedRole {
+		s.PrivilegedRoleID = roleID
+	}
+}
+
+// AllowsBookingIn reports whether booking commands can be used in the channel.
+func (s *GuildSettings) AllowsBookingIn(channelID string) bool {
+	if s == nil || len(s.BookingChannelIDs) == 0 {
+		return true
+	}
+
+	return slices.Contains(s.BookingChannelIDs, channelID)
+}
+
+// MirrorsBookings reports whether bookings made outside of the command channel are mirrored to it.
+func (s *GuildSettings) MirrorsBookings() bool {
+	return s != nil && s.MirrorBookings
+}
@@ -1,9 +1,25 @@
 package guildsworld
 
 import (
+	"errors"
+	"fmt"
 	"time"
 )
 
+// MaxWorldsPerGuild limits worlds of a guild, as players online in every one of them are fetched on each tick.
+const MaxWorldsPerGuild = 10
+
+var (
+	// ErrWorldTaken is returned when the world is already registered in the guild.
+	ErrWorldTaken = errors.New("this world is already registered on this server")
+
+	// ErrWorldNotFound is returned when the world is not registered in the guild.
+	ErrWorldNotFound = errors.New("this world is not registered on this server, see /world list")
+
+	// ErrTooManyWorlds is returned when a guild tries to register more worlds than allowed.
+	ErrTooManyWorlds = fmt.Errorf("a server can register at most %d worlds", MaxWorldsPerGuild)
+)
+
 type GuildsWorld struct {
 	ID        int64
 	GuildID   string
@@ -0,0 +1,59 @@
+package notification
+
+import "errors"
+
+// ErrCannotDM is returned when a member does not accept direct messages from the bot.
+var ErrCannotDM = errors.New("cannot send messages to this user")
+
+// Kind of notifications, which members choose the channel of.
+type Kind string
+
+const (
+	// KindOverbook notifies a member their reservation was overbooked.
+	KindOverbook Kind = "overbook"
+	// KindReminder reminds a member of their reservation about to start.
+	KindReminder Kind = "reminder"
+)
+
+// Kinds lists all kinds of notifications.
+var Kinds = []Kind{KindOverbook, KindReminder}
+
+// IsValid reports whether the kind of notifications is supported.
+func (k Kind) IsValid() bool {
+	for _, kind := range Kinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Channel decides how a notification is delivered.
+type Channel string
+
+const (
+	// ChannelDM sends a direct message, mentioning the member in the command channel if it cannot be delivered.
+	ChannelDM Channel = "dm"
+	// ChannelMention mentions the member in the command channel.
+	ChannelMention Channel = "mention"
+	// ChannelNone does not deliver notifications at all.
+	ChannelNone Channel = "none"
+)
+
+// DefaultChannel is used for notifications a member has not chosen the channel of.
+const DefaultChannel = ChannelDM
+
+// Channels lists all supported channels.
+var Channels = []Channel{ChannelDM, ChannelMention, ChannelNone}
+
+// IsValid reports whether the channel is supported.
+func (c Channel) IsValid() bool {
+	for _, channel := range Channels {
+		if c == channel {
+			return true
+		}
+	}
+
+	return false
+}
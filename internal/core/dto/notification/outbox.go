@@ -0,0 +1,72 @@
+package notification
+
+import (
+	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/guild"
+	"spot-assistant/internal/core/dto/member"
+	"spot-assistant/internal/core/dto/reservation"
+)
+
+// MaxAttempts of delivering a notification from the outbox, before it is marked as failed.
+const MaxAttempts = 8
+
+// OutboxStatus tells whether a notification from the outbox has been delivered.
+type OutboxStatus string
+
+const (
+	// OutboxPending notifications are waiting for their next attempt.
+	OutboxPending OutboxStatus = "pending"
+	// OutboxSent notifications have been delivered, or the member does not want them.
+	OutboxSent OutboxStatus = "sent"
+	// OutboxFailed notifications have run out of attempts.
+	OutboxFailed OutboxStatus = "failed"
+)
+
+// Outbox is a notification saved in the same transaction as the change it is about,
+// so it is delivered even if the bot restarts right after the change.
+type Outbox struct {
+	ID          int64
+	GuildID     string
+	RecipientID string
+	Kind        Kind
+	Payload     []byte
+	Attempts    int
+}
+
+// Overbook is the payload of an overbook notification. It holds everything
+// needed to tell a member about their reservation being overbooked.
+type Overbook struct {
+	Spot     string                     `json:"spot"`
+	ByID     string                     `json:"by_id"`
+	ByNick   string                     `json:"by_nick"`
+	Original *reservation.Reservation   `json:"original"`
+	New      []*reservation.Reservation `json:"new"`
+}
+
+// NewOverbook prepares the payload of a notification about the reservation overbooked by the member.
+func NewOverbook(by *member.Member, spot string, res *reservation.ClippedOrRemovedReservation) Overbook {
+	return Overbook{
+		Spot:     spot,
+		ByID:     by.ID,
+		ByNick:   by.Nick,
+		Original: res.Original,
+		New:      res.New,
+	}
+}
+
+// Request restores the overbooking request of the guild.
+func (o Overbook) Request(guildID string) book.BookRequest {
+	return book.BookRequest{
+		Guild:  &guild.Guild{ID: guildID},
+		Member: &member.Member{ID: o.ByID, Nick: o.ByNick},
+		Spot:   o.Spot,
+	}
+}
+
+// Clipped restores the overbooked reservation together with what is left of it.
+func (o Overbook) Clipped() *reservation.ClippedOrRemovedReservation {
+	return &reservation.ClippedOrRemovedReservation{
+		Original: o.Original,
+		New:      o.New,
+	}
+}
@@ -0,0 +1,84 @@
+package reliability
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// Window is how far back reservations count towards the reliability score.
+	Window = 30 * 24 * time.Hour
+
+	// LateCancellationWindow is how close to its start a cancelled reservation counts as a late cancellation.
+	LateCancellationWindow = 2 * time.Hour
+
+	// MinReservations is how many reservations a member needs in the window before they are scored.
+	MinReservations = 3
+
+	// MaxScore of a member, who attended all of their reservations.
+	MaxScore = 100
+)
+
+// IncidentKind tells what went wrong with a reservation.
+type IncidentKind string
+
+const (
+	// IncidentLateCancellation is a reservation cancelled shortly before, or after, it started.
+	IncidentLateCancellation IncidentKind = "late_cancellation"
+	// IncidentAbandoned is a reservation overbooked after it started, as its owner did not hunt.
+	IncidentAbandoned IncidentKind = "abandoned"
+)
+
+// Incident is something a member did with their reservation, which makes them less reliable.
+type Incident struct {
+	GuildID       string
+	MemberID      string
+	ReservationID int64
+	Kind          IncidentKind
+	StartAt       time.Time
+	OccurredAt    time.Time
+}
+
+// History sums up reservations of a member within the window.
+type History struct {
+	// Reservations which have ended.
+	Reservations int
+	// Attended sums attendance ratios of the reservations. Reservations without attendance
+	// samples count as attended, as online status of their owner is unknown.
+	Attended float64
+	// NoShows are reservations, whose owner was never seen online.
+	NoShows int
+
+	LateCancellations int
+	Abandoned         int
+}
+
+// Score of a member, from 0 for members who never hunt to MaxScore for members who always do.
+type Score struct {
+	History History
+	Value   int
+
+	// Known reports whether the member has made enough reservations to be scored.
+	Known bool
+}
+
+// NewScore scores a member by the share of their reservations they have hunted on. Late cancellations
+// count as reservations not hunted on, and every abandoned reservation takes one more off.
+func NewScore(h History) Score {
+	total := h.Reservations + h.LateCancellations
+	if total == 0 {
+		return Score{History: h, Value: MaxScore}
+	}
+
+	attended := math.Max(0, h.Attended-float64(h.Abandoned))
+	return Score{
+		History: h,
+		Value:   int(math.Round(MaxScore * math.Min(1, attended/float64(total)))),
+		Known:   total >= MinReservations,
+	}
+}
+
+// IsBelow reports whether the member is known to score below the minimum.
+func (s Score) IsBelow(minScore int) bool {
+	return s.Known && s.Value < minScore
+}
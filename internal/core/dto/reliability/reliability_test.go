@@ -0,0 +1,48 @@
+package reliability
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewScore(t *testing.T) {
+	tests := []struct {
+		name    string
+		history History
+		value   int
+		known   bool
+	}{
+		{"without history", History{}, MaxScore, false},
+		{"attending every reservation", History{Reservations: 4, Attended: 4}, 100, true},
+		{"attending half of reservations", History{Reservations: 4, Attended: 2, NoShows: 2}, 50, true},
+		{"cancelling late", History{Reservations: 3, Attended: 3, LateCancellations: 1}, 75, true},
+		{"abandoning reservations", History{Reservations: 4, Attended: 4, Abandoned: 1}, 75, true},
+		{"abandoning more than attending", History{Reservations: 1, Attended: 0.5, Abandoned: 2}, 0, false},
+		{"with too little history", History{Reservations: 1, Attended: 0, LateCancellations: 1}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			score := NewScore(tt.history)
+
+			// then
+			assert.Equal(t, tt.value, score.Value)
+			assert.Equal(t, tt.known, score.Known)
+			assert.Equal(t, tt.history, score.History)
+		})
+	}
+}
+
+func TestScoreIsBelow(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	known := Score{Value: 40, Known: true}
+	unknown := Score{Value: 0, Known: false}
+
+	// then
+	assert.True(known.IsBelow(50))
+	assert.False(known.IsBelow(40))
+	assert.False(unknown.IsBelow(50))
+}
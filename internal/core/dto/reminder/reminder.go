@@ -0,0 +1,37 @@
+package reminder
+
+import (
+	"time"
+
+	"spot-assistant/internal/core/dto/reservation"
+)
+
+// MaxMinutesBefore limits how early members can be reminded of their reservations.
+const MaxMinutesBefore = 180
+
+// Reminder of a reservation about to start, or of the previous hunt on its spot about to end.
+type Reminder struct {
+	Reservation reservation.ReservationWithSpot
+
+	// MinutesBefore is how early the owner of the reservation wants to be reminded.
+	MinutesBefore int
+
+	// Previous is the hunt on the same spot ending before the reservation starts, nil if there is none.
+	Previous *PreviousHunt
+}
+
+// PreviousHunt is a hunt the owner of a reminded reservation takes the spot over from.
+type PreviousHunt struct {
+	Author          string
+	AuthorDiscordID string
+	EndAt           time.Time
+}
+
+// MemberReminder is a reminder preference of a member, overriding the default of the guild.
+type MemberReminder struct {
+	GuildID  string
+	MemberID string
+
+	// MinutesBefore is how early the member is reminded, reminders are off if zero.
+	MinutesBefore int
+}
@@ -30,3 +30,22 @@ type ReservationWithSpot struct {
 	Reservation
 	Spot
 }
+
+// Filter narrows down upcoming reservations of a guild. Zero values do not filter.
+type Filter struct {
+	GuildID         string
+	SpotName        string
+	AuthorDiscordID string
+
+	// From and To select reservations overlapping the window.
+	From time.Time
+	To   time.Time
+}
+
+// FreeSpot is a spot of a guild, which nobody is hunting on right now.
+type FreeSpot struct {
+	Name string
+
+	// NextStartAt is when the next reservation of the spot starts, zero if there is none.
+	NextStartAt time.Time
+}
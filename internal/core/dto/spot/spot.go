@@ -1,9 +1,111 @@
 package spot
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
 
 type Spot struct {
 	Name      string
 	ID        int64
 	CreatedAt time.Time
+
+	Requirements Requirements
+}
+
+// MaxLevel limits levels of spot requirements.
+const MaxLevel = 5000
+
+// Vocations of Tibia characters, other than none. Promoted vocations count as the ones they are promoted from.
+var Vocations = []string{"Knight", "Paladin", "Sorcerer", "Druid", "Monk"}
+
+// BaseVocation returns the vocation a promoted one is promoted from, e.g. Knight for Elite Knight.
+// Other vocations are returned as they are.
+func BaseVocation(vocation string) string {
+	for _, base := range Vocations {
+		if strings.HasSuffix(strings.ToLower(vocation), strings.ToLower(base)) {
+			return base
+		}
+	}
+
+	return vocation
+}
+
+// ParseVocations parses a comma separated list of vocations, spelling them as Vocations do.
+func ParseVocations(list string) ([]string, error) {
+	vocations := make([]string, 0)
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		idx := slices.IndexFunc(Vocations, func(v string) bool {
+			return strings.EqualFold(v, BaseVocation(name))
+		})
+		if idx == -1 {
+			return nil, fmt.Errorf("unknown vocation %s, expected one of: %s", name, strings.Join(Vocations, ", "))
+		}
+		if !slices.Contains(vocations, Vocations[idx]) {
+			vocations = append(vocations, Vocations[idx])
+		}
+	}
+
+	return vocations, nil
+}
+
+// Requirements characters have to meet to hunt on a spot. Zero values do not restrict.
+type Requirements struct {
+	MinLevel  int
+	MaxLevel  int
+	Vocations []string
+}
+
+// IsZero reports whether the requirements do not restrict anyone.
+func (r Requirements) IsZero() bool {
+	return r.MinLevel == 0 && r.MaxLevel == 0 && len(r.Vocations) == 0
+}
+
+// Validate returns an error, unless levels are within MaxLevel and in order.
+func (r Requirements) Validate() error {
+	if r.MinLevel < 0 || r.MinLevel > MaxLevel || r.MaxLevel < 0 || r.MaxLevel > MaxLevel {
+		return fmt.Errorf("levels have to be between 0 and %d", MaxLevel)
+	}
+	if r.MaxLevel != 0 && r.MaxLevel < r.MinLevel {
+		return errors.New("the maximum level must not be below the minimum level")
+	}
+
+	return nil
+}
+
+// Allows reports whether a character of the level and vocation meets the requirements.
+func (r Requirements) Allows(level int, vocation string) bool {
+	if level < r.MinLevel || (r.MaxLevel != 0 && level > r.MaxLevel) {
+		return false
+	}
+
+	return len(r.Vocations) == 0 || slices.Contains(r.Vocations, BaseVocation(vocation))
+}
+
+// String describes the requirements, e.g. "level 100 to 200, Knight or Paladin".
+func (r Requirements) String() string {
+	var level string
+	switch {
+	case r.MinLevel != 0 && r.MaxLevel != 0:
+		level = fmt.Sprintf("level %d to %d", r.MinLevel, r.MaxLevel)
+	case r.MinLevel != 0:
+		level = fmt.Sprintf("level %d or higher", r.MinLevel)
+	case r.MaxLevel != 0:
+		level = fmt.Sprintf("level %d or lower", r.MaxLevel)
+	default:
+		level = "any level"
+	}
+	if len(r.Vocations) == 0 {
+		return level
+	}
+
+	return fmt.Sprintf("%s, %s", level, strings.Join(r.Vocations, " or "))
 }
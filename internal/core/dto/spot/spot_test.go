@@ -0,0 +1,57 @@
+package spot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVocations(t *testing.T) {
+	// when
+	vocations, err := ParseVocations("elite knight, Paladin,knight,, ")
+	_, unknownErr := ParseVocations("Knight, Necromancer")
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Knight", "Paladin"}, vocations)
+	assert.Error(t, unknownErr)
+}
+
+func TestRequirementsAllows(t *testing.T) {
+	requirements := Requirements{MinLevel: 100, MaxLevel: 200, Vocations: []string{"Knight", "Paladin"}}
+
+	tests := []struct {
+		name     string
+		level    int
+		vocation string
+		expected bool
+	}{
+		{"promoted vocation within levels", 150, "Elite Knight", true},
+		{"vocation within levels", 100, "Paladin", true},
+		{"below the minimum level", 99, "Knight", false},
+		{"above the maximum level", 201, "Royal Paladin", false},
+		{"other vocation", 150, "Elder Druid", false},
+		{"no vocation", 150, "None", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, requirements.Allows(tt.level, tt.vocation))
+		})
+	}
+}
+
+func TestRequirementsString(t *testing.T) {
+	assert.Equal(t, "level 100 to 200, Knight or Paladin", Requirements{MinLevel: 100, MaxLevel: 200, Vocations: []string{"Knight", "Paladin"}}.String())
+	assert.Equal(t, "level 100 or higher", Requirements{MinLevel: 100}.String())
+	assert.Equal(t, "level 50 or lower", Requirements{MaxLevel: 50}.String())
+	assert.Equal(t, "any level, Druid", Requirements{Vocations: []string{"Druid"}}.String())
+}
+
+func TestRequirementsValidate(t *testing.T) {
+	assert.NoError(t, Requirements{MinLevel: 100}.Validate())
+	assert.NoError(t, Requirements{MinLevel: 100, MaxLevel: 100}.Validate())
+	assert.Error(t, Requirements{MinLevel: 200, MaxLevel: 100}.Validate())
+	assert.Error(t, Requirements{MinLevel: -1}.Validate())
+	assert.Error(t, Requirements{MaxLevel: MaxLevel + 1}.Validate())
+}
@@ -2,6 +2,8 @@ package summary
 
 import (
 	"time"
+
+	"spot-assistant/internal/core/dto/guildsettings"
 )
 
 type OnlineStatus int
@@ -13,14 +15,33 @@ const (
 )
 
 type Summary struct {
-	PreMessage   string
-	Chart        []byte
-	URL          string
-	Title        string
-	Footer       string
-	Description  string
-	Ledger       Ledger
-	LegendValues []LegendValue
+	PreMessage    string
+	Chart         []byte
+	TimelineChart []byte
+	URL           string
+	Title         string
+	Footer        string
+	Description   string
+	ThumbnailURL  string
+	Color         int
+	Ledger        Ledger
+	LegendValues  []LegendValue
+	Layout        guildsettings.SummaryLayout
+
+	// FreeNow and FreeSoon list favourite spots of the guild, which can be hunted on now or soon.
+	FreeNow  []FreeSpot
+	FreeSoon []FreeSpot
+}
+
+// FreeSpot is a spot, which nobody hunts on from the given moment until the next booking.
+type FreeSpot struct {
+	Spot string
+
+	// From is when the spot becomes free, zero if it is free now.
+	From time.Time
+
+	// Until is the start of the next booking, zero if there is none.
+	Until time.Time
 }
 
 type Ledger []LedgerEntry
@@ -39,6 +60,15 @@ type Booking struct {
 	EndAt           time.Time
 }
 
+// Timeline describes a chart of bookings per spot over time.
+type Timeline struct {
+	Ledger     Ledger
+	From       time.Time
+	To         time.Time
+	Now        time.Time
+	ServerSave time.Time
+}
+
 // LegendValue is a container for label (Legend) and float64 value (Value)
 type LegendValue struct {
 	Legend string
@@ -49,4 +79,18 @@ type PrivateSummaryRequest struct {
 	UserID   int64
 	GuildID  int64
 	SpotName string
+
+	// AuthorDiscordID limits the summary to reservations of a single member.
+	AuthorDiscordID string
+
+	// Window limits the summary to reservations overlapping the time window.
+	Window TimeWindow
+
+	// FreeNow lists spots nobody is hunting on right now, instead of reservations.
+	FreeNow bool
+}
+
+// IsFiltered tells whether the request narrows down the reservations in any way.
+func (r PrivateSummaryRequest) IsFiltered() bool {
+	return r.SpotName != "" || r.AuthorDiscordID != "" || r.Window != "" || r.FreeNow
 }
@@ -0,0 +1,70 @@
+package summary
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeWindow is a named period of time, relative to the moment a summary is requested.
+type TimeWindow string
+
+const (
+	WindowNextHour       TimeWindow = "next-1h"
+	WindowNextThreeHours TimeWindow = "next-3h"
+	WindowNextSixHours   TimeWindow = "next-6h"
+	WindowToday          TimeWindow = "today"
+	WindowTonight        TimeWindow = "tonight"
+	WindowTomorrow       TimeWindow = "tomorrow"
+)
+
+var TimeWindows = []TimeWindow{
+	WindowNextHour, WindowNextThreeHours, WindowNextSixHours, WindowToday, WindowTonight, WindowTomorrow,
+}
+
+// Evening hunts start at TonightStartHour and last until TonightEndHour of the next day.
+const (
+	TonightStartHour = 18
+	TonightEndHour   = 2
+)
+
+func (w TimeWindow) IsValid() bool {
+	for _, window := range TimeWindows {
+		if w == window {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Range returns the beginning and the end of the window, as seen at the given moment.
+func (w TimeWindow) Range(now time.Time) (time.Time, time.Time, error) {
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch w {
+	case WindowNextHour:
+		return now, now.Add(time.Hour), nil
+	case WindowNextThreeHours:
+		return now, now.Add(3 * time.Hour), nil
+	case WindowNextSixHours:
+		return now, now.Add(6 * time.Hour), nil
+	case WindowToday:
+		return now, midnight.AddDate(0, 0, 1), nil
+	case WindowTonight:
+		// Past midnight, it is still the night that started yesterday
+		if now.Hour() < TonightEndHour {
+			return now, midnight.Add(TonightEndHour * time.Hour), nil
+		}
+
+		from := midnight.Add(TonightStartHour * time.Hour)
+		if now.After(from) {
+			from = now
+		}
+
+		return from, midnight.AddDate(0, 0, 1).Add(TonightEndHour * time.Hour), nil
+	case WindowTomorrow:
+		return midnight.AddDate(0, 0, 1), midnight.AddDate(0, 0, 2), nil
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid time window: %s", w)
+	}
+}
@@ -0,0 +1,52 @@
+package summary
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeWindowRange(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 10, day, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		window TimeWindow
+		now    time.Time
+		from   time.Time
+		to     time.Time
+	}{
+		{WindowNextThreeHours, at(19, 12, 30), at(19, 12, 30), at(19, 15, 30)},
+		{WindowToday, at(19, 12, 30), at(19, 12, 30), at(20, 0, 0)},
+		{WindowTonight, at(19, 12, 30), at(19, 18, 0), at(20, 2, 0)},
+		{WindowTonight, at(19, 21, 15), at(19, 21, 15), at(20, 2, 0)},
+		{WindowTonight, at(20, 1, 0), at(20, 1, 0), at(20, 2, 0)},
+		{WindowTomorrow, at(19, 12, 30), at(20, 0, 0), at(21, 0, 0)},
+	}
+
+	for _, test := range tests {
+		// when
+		from, to, err := test.window.Range(test.now)
+
+		// then
+		assert.Nil(err)
+		assert.Equal(test.from, from, "%s at %s", test.window, test.now)
+		assert.Equal(test.to, to, "%s at %s", test.window, test.now)
+	}
+}
+
+func TestTimeWindowRangeInvalid(t *testing.T) {
+	// given
+	assert := assert.New(t)
+
+	// when
+	_, _, err := TimeWindow("someday").Range(time.Now())
+
+	// then
+	assert.NotNil(err)
+	assert.False(TimeWindow("someday").IsValid())
+	assert.True(WindowTonight.IsValid())
+}
@@ -0,0 +1,152 @@
+package webhook
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"time"
+)
+
+// Headers of webhook requests. The timestamp is the Unix time of the attempt in seconds. The signature
+// is a hex encoded HMAC-SHA256 of the timestamp, a dot and the request body, keyed with the secret
+// of the webhook and prefixed with "sha256=".
+const (
+	HeaderEvent     = "X-Spot-Assistant-Event"
+	HeaderDelivery  = "X-Spot-Assistant-Delivery"
+	HeaderTimestamp = "X-Spot-Assistant-Timestamp"
+	HeaderSignature = "X-Spot-Assistant-Signature"
+)
+
+// SignatureTolerance is how old a timestamp receivers should accept, rejecting older deliveries as replayed.
+const SignatureTolerance = 5 * time.Minute
+
+// MaxWebhooksPerGuild limits webhooks of a guild, so a single booking does not fan out too far.
+const MaxWebhooksPerGuild = 5
+
+// MaxAttempts of a delivery, before it is moved to the dead letters.
+const MaxAttempts = 8
+
+// EventType tells what happened to a reservation.
+type EventType string
+
+const (
+	// EventReservationCreated is sent when a member books a spot.
+	EventReservationCreated EventType = "reservation.created"
+	// EventReservationCancelled is sent when a member cancels their reservation.
+	EventReservationCancelled EventType = "reservation.cancelled"
+	// EventReservationOverbooked is sent when a reservation is removed by an overbooking one.
+	EventReservationOverbooked EventType = "reservation.overbooked"
+	// EventReservationClipped is sent when a reservation is shortened by an overbooking one.
+	EventReservationClipped EventType = "reservation.clipped"
+)
+
+// EventTypes lists all types of events sent to webhooks.
+var EventTypes = []EventType{
+	EventReservationCreated,
+	EventReservationCancelled,
+	EventReservationOverbooked,
+	EventReservationClipped,
+}
+
+// Reservation as sent in events.
+type Reservation struct {
+	ID              int64     `json:"id,omitempty"`
+	Spot            string    `json:"spot"`
+	Author          string    `json:"author"`
+	AuthorDiscordID string    `json:"author_discord_id"`
+	StartAt         time.Time `json:"start_at"`
+	EndAt           time.Time `json:"end_at"`
+}
+
+// Event is the JSON body POSTed to webhooks.
+type Event struct {
+	Type       EventType `json:"event"`
+	GuildID    string    `json:"guild_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+
+	Reservation Reservation `json:"reservation"`
+
+	// Remaining parts of a clipped reservation.
+	Remaining []Reservation `json:"remaining,omitempty"`
+
+	// By is the reservation, which overbooked or clipped the one of the event.
+	By *Reservation `json:"by,omitempty"`
+}
+
+// Webhook of a guild, receiving all its events.
+type Webhook struct {
+	ID        int64
+	GuildID   string
+	URL       string
+	Secret    string
+	CreatedAt time.Time
+}
+
+// DeliveryStatus tells whether a delivery is still being attempted.
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	// DeliveryDead deliveries ran out of attempts and were moved to the dead letters.
+	DeliveryDead DeliveryStatus = "dead"
+)
+
+// Delivery of an event to a webhook.
+type Delivery struct {
+	ID        int64
+	WebhookID int64
+	GuildID   string
+	URL       string
+	Secret    string
+	Event     EventType
+	Payload   []byte
+	Status    DeliveryStatus
+
+	// Attempts made so far, including the one in progress.
+	Attempts       int
+	NextAttemptAt  time.Time
+	ResponseStatus int
+	LastError      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Attempt is an outcome of POSTing a delivery.
+type Attempt struct {
+	// ResponseStatus is the HTTP status of the response, zero if there was none.
+	ResponseStatus int
+	Error          string
+}
+
+// ErrNonPublicAddress is returned for webhooks pointing at loopback, private or link-local addresses,
+// which would let guilds reach services of the network the bot runs in.
+var ErrNonPublicAddress = errors.New("webhook URL must point at a public address")
+
+// nonPublicPrefixes are ranges not covered by the checks of net.IP, which are not reachable publicly either.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+}
+
+// IsPublicIP reports whether webhook requests may be sent to the address.
+func IsPublicIP(ip net.IP) bool {
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
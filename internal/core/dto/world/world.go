@@ -1,5 +1,16 @@
 package world
 
+import (
+	"errors"
+	"time"
+)
+
+// ErrCharacterNotFound is returned when there is no character of the given name.
+var ErrCharacterNotFound = errors.New("there is no such character")
+
+// ErrGuildNotFound is returned when there is no in-game guild of the given name.
+var ErrGuildNotFound = errors.New("there is no such guild")
+
 type Player struct {
 	Name string `json:"name"`
 }
@@ -11,3 +22,44 @@ type World struct {
 type Response struct {
 	World World `json:"world"`
 }
+
+// Character is a public profile of a Tibia character.
+type Character struct {
+	Name     string `json:"name"`
+	World    string `json:"world"`
+	Level    int    `json:"level"`
+	Vocation string `json:"vocation"`
+	Comment  string `json:"comment"`
+}
+
+type CharacterResponse struct {
+	Character struct {
+		Character Character `json:"character"`
+	} `json:"character"`
+}
+
+// GuildResponse lists members of an in-game guild.
+type GuildResponse struct {
+	Guild struct {
+		Name    string   `json:"name"`
+		Members []Player `json:"members"`
+	} `json:"guild"`
+}
+
+// WorldsResponse lists Tibia worlds.
+type WorldsResponse struct {
+	Worlds struct {
+		RegularWorlds []Overview `json:"regular_worlds"`
+	} `json:"worlds"`
+}
+
+// Overview of a Tibia world.
+type Overview struct {
+	Name string `json:"name"`
+}
+
+// List holds names of Tibia worlds, as they were when last fetched from the world API.
+type List struct {
+	Names       []string
+	RefreshedAt time.Time
+}
@@ -0,0 +1,26 @@
+package export
+
+import (
+	"go.uber.org/zap"
+
+	"spot-assistant/internal/ports"
+)
+
+type Adapter struct {
+	reservationRepo ports.ReservationRepository
+	spotRepo        ports.SpotRepository
+	log             *zap.SugaredLogger
+}
+
+func NewAdapter(reservationRepo ports.ReservationRepository, spotRepo ports.SpotRepository) *Adapter {
+	return &Adapter{
+		reservationRepo: reservationRepo,
+		spotRepo:        spotRepo,
+		log:             zap.NewNop().Sugar(),
+	}
+}
+
+func (a *Adapter) WithLogger(log *zap.SugaredLogger) *Adapter {
+	a.log = log.With("layer", "core", "name", "exportService")
+	return a
+}
@@ -0,0 +1,119 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	dto "spot-assistant/internal/core/dto/export"
+	"spot-assistant/internal/core/dto/reservation"
+	"spot-assistant/internal/core/dto/spot"
+)
+
+var (
+	reservationsHeader = []string{"id", "spot", "author", "author_discord_id", "start_at", "end_at", "created_at"}
+	spotsHeader        = []string{"name"}
+)
+
+// ExportReservations encodes reservations of a guild overlapping the range.
+func (a *Adapter) ExportReservations(guildID string, from, to time.Time, format dto.Format) ([]byte, error) {
+	if !format.IsValid() {
+		return nil, fmt.Errorf("invalid format: %s", format)
+	}
+
+	reservations, err := a.reservationRepo.SelectReservationsWithSpotInRange(context.Background(), guildID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("could not load reservations: %w", err)
+	}
+
+	records := make([]dto.ReservationRecord, len(reservations))
+	for i, res := range reservations {
+		records[i] = mapReservationRecord(res)
+	}
+
+	if format == dto.FormatJSON {
+		return json.MarshalIndent(records, "", "  ")
+	}
+
+	rows := make([][]string, len(records))
+	for i, record := range records {
+		rows[i] = []string{
+			strconv.FormatInt(record.ID, 10),
+			record.Spot,
+			record.Author,
+			record.AuthorDiscordID,
+			record.StartAt.Format(time.RFC3339),
+			record.EndAt.Format(time.RFC3339),
+			record.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return encodeCSV(reservationsHeader, rows)
+}
+
+// ExportSpots encodes all spots, sorted as they are stored.
+func (a *Adapter) ExportSpots(format dto.Format) ([]byte, error) {
+	if !format.IsValid() {
+		return nil, fmt.Errorf("invalid format: %s", format)
+	}
+
+	spots, err := a.spotRepo.SelectAllSpots(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("could not load spots: %w", err)
+	}
+
+	if format == dto.FormatJSON {
+		records := make([]dto.SpotRecord, len(spots))
+		for i, s := range spots {
+			records[i] = dto.SpotRecord{Name: s.Name}
+		}
+
+		return json.MarshalIndent(records, "", "  ")
+	}
+
+	rows := make([][]string, len(spots))
+	for i, s := range spots {
+		rows[i] = []string{s.Name}
+	}
+
+	return encodeCSV(spotsHeader, rows)
+}
+
+func mapReservationRecord(res *reservation.ReservationWithSpot) dto.ReservationRecord {
+	return dto.ReservationRecord{
+		ID:              res.Reservation.ID,
+		Spot:            res.Spot.Name,
+		Author:          res.Author,
+		AuthorDiscordID: res.AuthorDiscordID,
+		StartAt:         res.StartAt.Local(),
+		EndAt:           res.EndAt.Local(),
+		CreatedAt:       res.Reservation.CreatedAt.Local(),
+	}
+}
+
+func encodeCSV(header []string, rows [][]string) ([]byte, error) {
+	buf := bytes.Buffer{}
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// spotNames returns a set of lowercase names of spots.
+func spotNames(spots []*spot.Spot) map[string]bool {
+	names := make(map[string]bool, len(spots))
+	for _, s := range spots {
+		names[normalizeSpotName(s.Name)] = true
+	}
+
+	return names
+}
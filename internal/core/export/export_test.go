@@ -0,0 +1,101 @@
+package export
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/mocks"
+	dto "spot-assistant/internal/core/dto/export"
+	"spot-assistant/internal/core/dto/reservation"
+	"spot-assistant/internal/core/dto/spot"
+)
+
+func exportedReservations() []*reservation.ReservationWithSpot {
+	startAt := time.Date(2026, 10, 19, 20, 0, 0, 0, time.Local)
+
+	return []*reservation.ReservationWithSpot{{
+		Reservation: reservation.Reservation{
+			ID:              7,
+			Author:          "Mariysz, the Knight",
+			AuthorDiscordID: "123",
+			StartAt:         startAt,
+			EndAt:           startAt.Add(2 * time.Hour),
+			CreatedAt:       startAt.Add(-time.Hour),
+		},
+		Spot: reservation.Spot{Name: "Flimsy"},
+	}}
+}
+
+func TestExportReservationsCSV(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	reservationRepo := new(mocks.MockReservationRepository)
+	adapter := NewAdapter(reservationRepo, new(mocks.MockSpotRepository))
+	from := time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local)
+	to := from.AddDate(0, 0, 8)
+	reservationRepo.On("SelectReservationsWithSpotInRange", mock.Anything, "guild-id", from, to).Return(exportedReservations(), nil)
+
+	// when
+	data, err := adapter.ExportReservations("guild-id", from, to, dto.FormatCSV)
+
+	// then
+	assert.Nil(err)
+	start := exportedReservations()[0].StartAt
+	assert.Equal(
+		"id,spot,author,author_discord_id,start_at,end_at,created_at\n"+
+			"7,Flimsy,\"Mariysz, the Knight\",123,"+start.Format(time.RFC3339)+","+
+			start.Add(2*time.Hour).Format(time.RFC3339)+","+start.Add(-time.Hour).Format(time.RFC3339)+"\n",
+		string(data),
+	)
+}
+
+func TestExportReservationsJSON(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	reservationRepo := new(mocks.MockReservationRepository)
+	adapter := NewAdapter(reservationRepo, new(mocks.MockSpotRepository))
+	reservationRepo.On("SelectReservationsWithSpotInRange", mock.Anything, "guild-id", mock.Anything, mock.Anything).Return(exportedReservations(), nil)
+
+	// when
+	data, err := adapter.ExportReservations("guild-id", time.Now(), time.Now(), dto.FormatJSON)
+
+	// then
+	assert.Nil(err)
+	var records []dto.ReservationRecord
+	assert.Nil(json.Unmarshal(data, &records))
+	assert.Len(records, 1)
+	assert.Equal("Flimsy", records[0].Spot)
+	assert.Equal("123", records[0].AuthorDiscordID)
+	assert.True(exportedReservations()[0].StartAt.Equal(records[0].StartAt))
+}
+
+func TestExportSpotsInvalidFormat(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	adapter := NewAdapter(new(mocks.MockReservationRepository), new(mocks.MockSpotRepository))
+
+	// when
+	_, err := adapter.ExportSpots("xlsx")
+
+	// then
+	assert.EqualError(err, "invalid format: xlsx")
+}
+
+func TestExportSpotsCSV(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	spotRepo := new(mocks.MockSpotRepository)
+	adapter := NewAdapter(new(mocks.MockReservationRepository), spotRepo)
+	spotRepo.On("SelectAllSpots", mock.Anything).Return([]*spot.Spot{{Name: "Flimsy"}, {Name: "Lost Souls"}}, nil)
+
+	// when
+	data, err := adapter.ExportSpots(dto.FormatCSV)
+
+	// then
+	assert.Nil(err)
+	assert.Equal("name\nFlimsy\nLost Souls\n", string(data))
+}
@@ -0,0 +1,146 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	dto "spot-assistant/internal/core/dto/export"
+)
+
+// Limits of imported spots.
+const (
+	MaxSpotNameLength = 120
+	MaxImportedSpots  = 5000
+)
+
+// importedSpot is a spot read from an import, along with the line it was read from.
+type importedSpot struct {
+	line int
+	name string
+}
+
+// ImportSpots adds spots, which are valid and do not exist yet. Spots are added all or none,
+// and nothing is saved during a dry run; the report describes what was (or would be) done.
+func (a *Adapter) ImportSpots(data []byte, format dto.Format, dryRun bool) (*dto.ImportReport, error) {
+	imported, err := decodeSpots(data, format)
+	if err != nil {
+		return nil, err
+	}
+	if len(imported) > MaxImportedSpots {
+		return nil, fmt.Errorf("at most %d spots can be imported at once", MaxImportedSpots)
+	}
+
+	ctx := context.Background()
+	existing, err := a.spotRepo.SelectAllSpots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not load spots: %w", err)
+	}
+
+	report := validateSpots(imported, spotNames(existing))
+	report.DryRun = dryRun
+	if dryRun || len(report.Added) == 0 {
+		return report, nil
+	}
+
+	if err = a.spotRepo.CreateSpots(ctx, report.Added); err != nil {
+		return nil, fmt.Errorf("could not save spots: %w", err)
+	}
+	a.log.With("added", len(report.Added), "skipped", len(report.Skipped)).Info("spots imported")
+
+	return report, nil
+}
+
+// validateSpots splits imported spots into the ones to add and the skipped ones.
+func validateSpots(imported []importedSpot, existing map[string]bool) *dto.ImportReport {
+	report := &dto.ImportReport{Added: []string{}, Skipped: []dto.SkippedSpot{}}
+	seen := make(map[string]int, len(imported))
+	for _, spot := range imported {
+		name := strings.TrimSpace(spot.name)
+		reason := ""
+		switch {
+		case name == "":
+			reason = "empty name"
+		case !utf8.ValidString(name) || strings.IndexFunc(name, unicode.IsControl) != -1:
+			reason = "name contains invalid characters"
+		case utf8.RuneCountInString(name) > MaxSpotNameLength:
+			reason = fmt.Sprintf("name longer than %d characters", MaxSpotNameLength)
+		case existing[normalizeSpotName(name)]:
+			reason = "already exists"
+		case seen[normalizeSpotName(name)] != 0:
+			reason = fmt.Sprintf("duplicate of line %d", seen[normalizeSpotName(name)])
+		}
+
+		if reason != "" {
+			report.Skipped = append(report.Skipped, dto.SkippedSpot{Line: spot.line, Name: name, Reason: reason})
+			continue
+		}
+
+		seen[normalizeSpotName(name)] = spot.line
+		report.Added = append(report.Added, name)
+	}
+
+	return report
+}
+
+func decodeSpots(data []byte, format dto.Format) ([]importedSpot, error) {
+	switch format {
+	case dto.FormatCSV:
+		return decodeSpotsCSV(data)
+	case dto.FormatJSON:
+		return decodeSpotsJSON(data)
+	default:
+		return nil, fmt.Errorf("invalid format: %s", format)
+	}
+}
+
+// decodeSpotsCSV reads names from the first column. The header is optional.
+func decodeSpotsCSV(data []byte) ([]importedSpot, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+
+	spots := make([]importedSpot, 0)
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read CSV: %w", err)
+		}
+
+		line, _ := r.FieldPos(0)
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), spotsHeader[0]) {
+			continue
+		}
+		spots = append(spots, importedSpot{line: line, name: record[0]})
+	}
+
+	return spots, nil
+}
+
+// decodeSpotsJSON reads an array of spot records, numbering them from 1 instead of lines.
+func decodeSpotsJSON(data []byte) ([]importedSpot, error) {
+	var records []dto.SpotRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("could not read JSON: %w", err)
+	}
+
+	spots := make([]importedSpot, len(records))
+	for i, record := range records {
+		spots[i] = importedSpot{line: i + 1, name: record.Name}
+	}
+
+	return spots, nil
+}
+
+func normalizeSpotName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -0,0 +1,75 @@
+package export
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/mocks"
+	dto "spot-assistant/internal/core/dto/export"
+	"spot-assistant/internal/core/dto/spot"
+)
+
+func TestImportSpotsDryRun(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	spotRepo := new(mocks.MockSpotRepository)
+	adapter := NewAdapter(new(mocks.MockReservationRepository), spotRepo)
+	spotRepo.On("SelectAllSpots", mock.Anything).Return([]*spot.Spot{{Name: "Flimsy"}}, nil)
+	data := "name\n" +
+		"Lost Souls\n" +
+		"flimsy\n" +
+		"\"  \"\n" +
+		"lost souls \n" +
+		strings.Repeat("x", MaxSpotNameLength+1) + "\n" +
+		"Banuta\n"
+
+	// when
+	report, err := adapter.ImportSpots([]byte(data), dto.FormatCSV, true)
+
+	// then
+	assert.Nil(err)
+	assert.True(report.DryRun)
+	assert.Equal([]string{"Lost Souls", "Banuta"}, report.Added)
+	assert.Equal([]dto.SkippedSpot{
+		{Line: 3, Name: "flimsy", Reason: "already exists"},
+		{Line: 4, Name: "", Reason: "empty name"},
+		{Line: 5, Name: "lost souls", Reason: "duplicate of line 2"},
+		{Line: 6, Name: strings.Repeat("x", MaxSpotNameLength+1), Reason: "name longer than 120 characters"},
+	}, report.Skipped)
+	spotRepo.AssertNotCalled(t, "CreateSpots", mock.Anything, mock.Anything)
+}
+
+func TestImportSpotsSavesValidSpots(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	spotRepo := new(mocks.MockSpotRepository)
+	adapter := NewAdapter(new(mocks.MockReservationRepository), spotRepo)
+	spotRepo.On("SelectAllSpots", mock.Anything).Return([]*spot.Spot{}, nil)
+	spotRepo.On("CreateSpots", mock.Anything, []string{"Lost Souls", "Banuta"}).Return(nil)
+
+	// when
+	report, err := adapter.ImportSpots([]byte(`[{"name": "Lost Souls"}, {"name": "Banuta"}, {"name": "banuta"}]`), dto.FormatJSON, false)
+
+	// then
+	assert.Nil(err)
+	assert.False(report.DryRun)
+	assert.Equal([]dto.SkippedSpot{{Line: 3, Name: "banuta", Reason: "duplicate of line 2"}}, report.Skipped)
+	spotRepo.AssertExpectations(t)
+}
+
+func TestImportSpotsMalformed(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	adapter := NewAdapter(new(mocks.MockReservationRepository), new(mocks.MockSpotRepository))
+
+	// when
+	_, jsonErr := adapter.ImportSpots([]byte(`{"name": "Banuta"}`), dto.FormatJSON, true)
+	_, csvErr := adapter.ImportSpots([]byte("\"Banuta\n"), dto.FormatCSV, true)
+
+	// then
+	assert.ErrorContains(jsonErr, "could not read JSON")
+	assert.ErrorContains(csvErr, "could not read CSV")
+}
@@ -0,0 +1,40 @@
+package guildmembership
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"spot-assistant/internal/ports"
+)
+
+type Adapter struct {
+	guildSettingsRepo ports.GuildSettingsRepository
+	characterRepo     ports.CharacterRepository
+	api               ports.WorldApi
+
+	mu sync.Mutex
+	// members of in-game guilds, by lowercase guild name.
+	members   map[string]*members
+	refreshes singleflight.Group
+	now       func() time.Time
+	log       *zap.SugaredLogger
+}
+
+func NewAdapter(guildSettingsRepo ports.GuildSettingsRepository, characterRepo ports.CharacterRepository, api ports.WorldApi) *Adapter {
+	return &Adapter{
+		guildSettingsRepo: guildSettingsRepo,
+		characterRepo:     characterRepo,
+		api:               api,
+		members:           make(map[string]*members),
+		now:               time.Now,
+		log:               zap.NewNop().Sugar(),
+	}
+}
+
+func (a *Adapter) WithLogger(log *zap.SugaredLogger) *Adapter {
+	a.log = log.With("layer", "core", "name", "guildMembershipService")
+	return a
+}
@@ -0,0 +1,150 @@
+package guildmembership
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/world"
+)
+
+// MembersTTL is how long members of an in-game guild are trusted. Members are fetched again on the tick
+// once they get older, and older members are still used meanwhile, so booking never waits for the world API twice.
+const MembersTTL = 15 * time.Minute
+
+var (
+	ErrNotGuildMember    = errors.New("none of your verified characters is a member of")
+	ErrUnknownTibiaGuild = errors.New("there is no in-game guild named")
+)
+
+// members of an in-game guild, as they were when fetched from the world API.
+type members struct {
+	names       map[string]struct{}
+	found       bool
+	refreshedAt time.Time
+}
+
+// CheckBooking lets only members with a verified character in the in-game guild of the server book,
+// if the server chose one. Members with the privileged role are not restricted. Members are let book
+// while the world API is unavailable, rather than nobody being able to book.
+func (a *Adapter) CheckBooking(request book.BookRequest) error {
+	if request.HasPermissions {
+		return nil
+	}
+
+	ctx := context.Background()
+	settings, err := a.guildSettingsRepo.SelectGuildSettings(ctx, request.Guild.ID)
+	if err != nil {
+		return fmt.Errorf("could not load guild settings: %w", err)
+	}
+	if settings.TibiaGuild == "" {
+		return nil
+	}
+
+	guildMembers, err := a.guildMembers(settings.TibiaGuild)
+	if err != nil {
+		a.log.With("guild.ID", request.Guild.ID).Warnf("could not check members of %s, booking is not restricted: %s", settings.TibiaGuild, err)
+		return nil
+	}
+	if !guildMembers.found {
+		return book.Reject(fmt.Errorf("%w %s, ask the server owner to change it with `/settings tibia-guild`", ErrUnknownTibiaGuild, settings.TibiaGuild))
+	}
+
+	characters, err := a.characterRepo.SelectMemberCharacters(ctx, request.Guild.ID, request.Member.ID)
+	if err != nil {
+		return fmt.Errorf("could not load your characters: %w", err)
+	}
+	for _, c := range characters {
+		if _, ok := guildMembers.names[strings.ToLower(c.Name)]; ok && c.IsVerified() {
+			return nil
+		}
+	}
+
+	return book.Reject(fmt.Errorf("%w %s, register one with `/character add` and verify it with `/character verify`", ErrNotGuildMember, settings.TibiaGuild))
+}
+
+// guildMembers returns cached members of an in-game guild, refreshing them in the background once
+// they get older than MembersTTL. Members not cached yet are fetched right away.
+func (a *Adapter) guildMembers(guildName string) (*members, error) {
+	a.mu.Lock()
+	cached, ok := a.members[strings.ToLower(guildName)]
+	a.mu.Unlock()
+
+	if !ok {
+		return a.refresh(guildName)
+	}
+	if a.now().Sub(cached.refreshedAt) >= MembersTTL {
+		go func() {
+			if _, err := a.refresh(guildName); err != nil {
+				a.log.Warnf("could not refresh members of %s: %s", guildName, err)
+			}
+		}()
+	}
+
+	return cached, nil
+}
+
+// refresh fetches members of an in-game guild. Concurrent refreshes of the guild share a single call.
+func (a *Adapter) refresh(guildName string) (*members, error) {
+	key := strings.ToLower(guildName)
+	fetched, err, _ := a.refreshes.Do(key, func() (any, error) {
+		names, err := a.api.GetGuildMembers(guildName)
+		if err != nil && !errors.Is(err, world.ErrGuildNotFound) {
+			return nil, err
+		}
+
+		m := &members{names: make(map[string]struct{}, len(names)), found: err == nil, refreshedAt: a.now()}
+		for _, name := range names {
+			m.names[strings.ToLower(name)] = struct{}{}
+		}
+
+		a.mu.Lock()
+		a.members[key] = m
+		a.mu.Unlock()
+
+		return m, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fetched.(*members), nil
+}
+
+// RefreshDue fetches members of in-game guilds chosen by any server, once they get older than MembersTTL,
+// so members are kept fresh even if nobody books. Members of guilds no server chooses anymore are forgotten.
+func (a *Adapter) RefreshDue(now time.Time) {
+	guildNames, err := a.guildSettingsRepo.SelectTibiaGuilds(context.Background())
+	if err != nil {
+		a.log.Errorf("could not load in-game guilds: %s", err)
+
+		return
+	}
+
+	chosen := make(map[string]struct{}, len(guildNames))
+	for _, guildName := range guildNames {
+		key := strings.ToLower(guildName)
+		chosen[key] = struct{}{}
+
+		a.mu.Lock()
+		cached, ok := a.members[key]
+		a.mu.Unlock()
+		if ok && now.Sub(cached.refreshedAt) < MembersTTL {
+			continue
+		}
+		if _, err := a.refresh(guildName); err != nil {
+			a.log.Warnf("could not refresh members of %s: %s", guildName, err)
+		}
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for key := range a.members {
+		if _, ok := chosen[key]; !ok {
+			delete(a.members, key)
+		}
+	}
+}
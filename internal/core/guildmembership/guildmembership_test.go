@@ -0,0 +1,135 @@
+package guildmembership
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/factories"
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/character"
+	"spot-assistant/internal/core/dto/guildsettings"
+	"spot-assistant/internal/core/dto/world"
+)
+
+func TestCheckBooking(t *testing.T) {
+	guild, member := factories.CreateGuild(), factories.CreateMember()
+	settings := guildsettings.Default(guild.ID)
+	settings.TibiaGuild = "Red Rose"
+	verified := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		hasPermissions bool
+		settings       *guildsettings.GuildSettings
+		guildMembers   []string
+		apiErr         error
+		characters     []*character.Character
+		err            error
+	}{
+		{"without an in-game guild", false, guildsettings.Default(guild.ID), nil, nil, nil, nil},
+		{"with the privileged role", true, nil, nil, nil, nil, nil},
+		{"with a verified member", false, settings, []string{"Mariysz", "Asar Cham"}, nil, []*character.Character{{Name: "Zed"}, {Name: "asar cham", VerifiedAt: verified}}, nil},
+		{"with an unverified member", false, settings, []string{"Asar Cham"}, nil, []*character.Character{{Name: "Asar Cham"}}, ErrNotGuildMember},
+		{"without a member", false, settings, []string{"Mariysz"}, nil, []*character.Character{{Name: "Asar Cham", VerifiedAt: verified}}, ErrNotGuildMember},
+		{"with an unknown in-game guild", false, settings, nil, world.ErrGuildNotFound, nil, ErrUnknownTibiaGuild},
+		{"with the world API unavailable", false, settings, nil, errors.New("timeout"), nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			guildSettingsRepo := mocks.NewMockGuildSettingsRepository(t)
+			if tt.settings != nil {
+				guildSettingsRepo.On("SelectGuildSettings", mock.Anything, guild.ID).Return(tt.settings, nil)
+			}
+			api := mocks.NewMockWorldApi(t)
+			if tt.settings != nil && tt.settings.TibiaGuild != "" {
+				api.On("GetGuildMembers", "Red Rose").Return(tt.guildMembers, tt.apiErr)
+			}
+			characterRepo := mocks.NewMockCharacterRepository(t)
+			if tt.characters != nil {
+				characterRepo.On("SelectMemberCharacters", mock.Anything, guild.ID, member.ID).Return(tt.characters, nil)
+			}
+			adapter := NewAdapter(guildSettingsRepo, characterRepo, api)
+
+			// when
+			err := adapter.CheckBooking(book.BookRequest{Guild: guild, Member: member, Spot: "Flimsy", HasPermissions: tt.hasPermissions})
+
+			// then
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var rejection *book.Rejection
+			assert.ErrorAs(t, err, &rejection)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestCheckBookingCachesGuildMembers(t *testing.T) {
+	// given
+	guild, member := factories.CreateGuild(), factories.CreateMember()
+	now := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	settings := guildsettings.Default(guild.ID)
+	settings.TibiaGuild = "Red Rose"
+	guildSettingsRepo := mocks.NewMockGuildSettingsRepository(t)
+	guildSettingsRepo.On("SelectGuildSettings", mock.Anything, guild.ID).Return(settings, nil)
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("SelectMemberCharacters", mock.Anything, guild.ID, member.ID).Return([]*character.Character{{Name: "Asar Cham", VerifiedAt: now}}, nil)
+	api := mocks.NewMockWorldApi(t)
+	api.On("GetGuildMembers", "Red Rose").Return([]string{"Asar Cham"}, nil).Once()
+	refreshed := make(chan struct{})
+	api.On("GetGuildMembers", "Red Rose").Return([]string{"Mariysz"}, nil).Once().Run(func(mock.Arguments) { close(refreshed) })
+	adapter := NewAdapter(guildSettingsRepo, characterRepo, api)
+	adapter.now = func() time.Time { return now }
+	request := book.BookRequest{Guild: guild, Member: member, Spot: "Flimsy"}
+
+	// when
+	first := adapter.CheckBooking(request)
+	cached := adapter.CheckBooking(request)
+	now = now.Add(MembersTTL)
+	stale := adapter.CheckBooking(request)
+	<-refreshed
+	assert.Eventually(t, func() bool {
+		adapter.mu.Lock()
+		defer adapter.mu.Unlock()
+		return adapter.members["red rose"].refreshedAt.Equal(now)
+	}, time.Second, 10*time.Millisecond)
+	refreshedErr := adapter.CheckBooking(request)
+
+	// then
+	assert.NoError(t, first)
+	assert.NoError(t, cached)
+	assert.NoError(t, stale)
+	assert.ErrorIs(t, refreshedErr, ErrNotGuildMember)
+}
+
+func TestRefreshDue(t *testing.T) {
+	// given
+	now := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	guildSettingsRepo := mocks.NewMockGuildSettingsRepository(t)
+	guildSettingsRepo.On("SelectTibiaGuilds", mock.Anything).Return([]string{"Red Rose", "White Rose"}, nil)
+	api := mocks.NewMockWorldApi(t)
+	api.On("GetGuildMembers", "Red Rose").Return([]string{"Asar Cham"}, nil).Twice()
+	api.On("GetGuildMembers", "White Rose").Return(nil, errors.New("timeout")).Times(3)
+	adapter := NewAdapter(guildSettingsRepo, mocks.NewMockCharacterRepository(t), api)
+	adapter.now = func() time.Time { return now }
+	adapter.members["black rose"] = &members{refreshedAt: now}
+
+	// when
+	adapter.RefreshDue(now)
+	adapter.RefreshDue(now.Add(MembersTTL - time.Second))
+	now = now.Add(MembersTTL)
+	adapter.RefreshDue(now)
+
+	// then
+	assert.Equal(t, now, adapter.members["red rose"].refreshedAt)
+	assert.NotContains(t, adapter.members, "white rose")
+	assert.NotContains(t, adapter.members, "black rose")
+}
@@ -9,19 +9,35 @@ import (
 )
 
 type Adapter struct {
-	log            *zap.SugaredLogger
-	api            ports.WorldApi
-	worldNameRepo  ports.WorldNameRepository
-	guildIdToWorld cmap.ConcurrentMap[string, string]
-	players        cmap.ConcurrentMap[string, map[string]struct{}]
+	log           *zap.SugaredLogger
+	api           ports.WorldApi
+	worldNameRepo ports.WorldNameRepository
+	characterRepo ports.CharacterRepository
+	events        ports.EventPublisher
+	// guildWorlds holds worlds of guilds, by guild ID.
+	guildWorlds cmap.ConcurrentMap[string, []string]
+	// players holds lowercase names of players online, by world. A world is missing until its players are
+	// fetched, and after fetching them fails.
+	players cmap.ConcurrentMap[string, map[string]struct{}]
+	// characters holds characters registered by members, by guild and member ID.
+	characters cmap.ConcurrentMap[string, map[string][]trackedCharacter]
 }
 
-func NewAdapter(api ports.WorldApi, worldNameRepo ports.WorldNameRepository) *Adapter {
+// trackedCharacter is a character registered by a member, with a lowercase name.
+type trackedCharacter struct {
+	name  string
+	world string
+}
+
+func NewAdapter(api ports.WorldApi, worldNameRepo ports.WorldNameRepository, characterRepo ports.CharacterRepository, events ports.EventPublisher) *Adapter {
 	return &Adapter{
-		api:            api,
-		worldNameRepo:  worldNameRepo,
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
+		api:           api,
+		worldNameRepo: worldNameRepo,
+		characterRepo: characterRepo,
+		events:        events,
+		guildWorlds:   cmap.New[[]string](),
+		players:       cmap.New[map[string]struct{}](),
+		characters:    cmap.New[map[string][]trackedCharacter](),
 	}
 }
 
@@ -2,7 +2,12 @@ package onlinecheck
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"spot-assistant/internal/core/dto/event"
+	"spot-assistant/internal/core/dto/guild"
+	"spot-assistant/internal/core/dto/guildsworld"
 	"spot-assistant/internal/core/dto/summary"
 	"strings"
 )
@@ -11,15 +16,34 @@ func (a *Adapter) RefreshOnlinePlayers(guildID string) error {
 	if !a.IsConfigured() {
 		return nil
 	}
-	world, ok := a.guildIdToWorld.Get(guildID)
-	if !ok || world == "" {
+	worlds := a.GuildWorlds(guildID)
+	if len(worlds) == 0 {
 		return nil
 	}
+	if !a.characters.Has(guildID) {
+		if err := a.RefreshCharacters(guildID); err != nil {
+			a.log.Errorf("could not load characters of guild %s: %v", guildID, err)
+		}
+	}
+
+	var errs []error
+	for _, world := range worlds {
+		if err := a.refreshWorld(world); err != nil {
+			errs = append(errs, fmt.Errorf("could not refresh players of %s: %w", world, err))
+		}
+	}
+	a.log.Infof("API call for %v (guild %s)", worlds, guildID)
+
+	return errors.Join(errs...)
+}
+
+func (a *Adapter) refreshWorld(world string) error {
 	players, err := a.api.GetOnlinePlayerNames(world)
 	if err != nil {
+		// Stale players would show members online, who already left, so their status becomes unknown.
+		a.players.Remove(world)
 		return err
 	}
-	a.log.Infof("API call for '%s' (guild %s)", world, guildID)
 
 	playersMap := make(map[string]struct{}, len(players))
 	for _, p := range players {
@@ -29,36 +53,79 @@ func (a *Adapter) RefreshOnlinePlayers(guildID string) error {
 	return nil
 }
 
-func (a *Adapter) IsOnline(guildID, characterName string) bool {
-	world, worldOk := a.guildIdToWorld.Get(guildID)
-	players, playersOk := a.players.Get(world)
+// RefreshCharacters reloads characters registered by members of the guild.
+func (a *Adapter) RefreshCharacters(guildID string) error {
+	if a.characterRepo == nil {
+		return nil
+	}
+	characters, err := a.characterRepo.SelectGuildCharacters(context.Background(), guildID)
+	if err != nil {
+		return err
+	}
 
-	if !worldOk || world == "" || !playersOk {
-		return false
+	byMember := make(map[string][]trackedCharacter)
+	for _, c := range characters {
+		byMember[c.MemberID] = append(byMember[c.MemberID], trackedCharacter{
+			name:  strings.ToLower(c.Name),
+			world: c.World,
+		})
 	}
+	a.characters.Set(guildID, byMember)
+	return nil
+}
 
-	names := strings.Split(characterName, "/")
-	for i := range names {
-		names[i] = strings.TrimSpace(names[i])
+// IsOnline checks characters registered by the member. Members, who have not registered any,
+// are guessed to play characters named like their nick, split on "/".
+func (a *Adapter) IsOnline(guildID, memberID, characterName string) bool {
+	return a.status(guildID, memberID, characterName) == summary.Online
+}
+
+func (a *Adapter) PlayerStatus(guildID, memberID, characterName string) summary.OnlineStatus {
+	if !a.IsConfigured() {
+		return summary.Unknown
+	}
+	return a.status(guildID, memberID, characterName)
+}
+
+// status is online, if any candidate is online on its world. Otherwise, it is unknown,
+// if players of any of the worlds are missing.
+func (a *Adapter) status(guildID, memberID, characterName string) summary.OnlineStatus {
+	guildWorlds := a.GuildWorlds(guildID)
+	if len(guildWorlds) == 0 {
+		return summary.Unknown
 	}
 
-	for _, candidate := range names {
-		if _, ok := players[strings.ToLower(candidate)]; ok {
-			return true
+	status := summary.Offline
+	for _, candidate := range a.candidates(guildID, memberID, characterName) {
+		worlds := guildWorlds
+		if candidate.world != "" {
+			worlds = []string{candidate.world}
+		}
+		for _, world := range worlds {
+			players, ok := a.players.Get(world)
+			if !ok {
+				status = summary.Unknown
+				continue
+			}
+			if _, ok := players[candidate.name]; ok {
+				return summary.Online
+			}
 		}
 	}
-	return false
+	return status
 }
 
-func (a *Adapter) PlayerStatus(guildID, characterName string) summary.OnlineStatus {
-	world, worldOk := a.guildIdToWorld.Get(guildID)
-	if !a.IsConfigured() || !worldOk || world == "" {
-		return summary.Unknown
+func (a *Adapter) candidates(guildID, memberID, characterName string) []trackedCharacter {
+	if characters, ok := a.characters.Get(guildID); ok && len(characters[memberID]) > 0 {
+		return characters[memberID]
 	}
-	if a.IsOnline(guildID, characterName) {
-		return summary.Online
+
+	names := strings.Split(characterName, "/")
+	candidates := make([]trackedCharacter, len(names))
+	for i := range names {
+		candidates[i] = trackedCharacter{name: strings.ToLower(strings.TrimSpace(names[i]))}
 	}
-	return summary.Offline
+	return candidates
 }
 
 func (a *Adapter) TryRefresh(guildID string) {
@@ -67,31 +134,87 @@ func (a *Adapter) TryRefresh(guildID string) {
 	}
 }
 
-func (a *Adapter) ConfigureWorldName(guildID, world string) {
-	a.guildIdToWorld.Set(guildID, world)
+func (a *Adapter) GuildWorlds(guildID string) []string {
+	worlds, _ := a.guildWorlds.Get(guildID)
+	return worlds
 }
 
-func (a *Adapter) SetGuildWorld(guildID, world string) error {
+func (a *Adapter) AddGuildWorld(guildID, world string) error {
 	if a.worldNameRepo == nil {
 		return fmt.Errorf("worldNameRepo is not configured")
 	}
-	if err := a.worldNameRepo.UpsertGuildWorld(context.Background(), guildID, world); err != nil {
+	worlds := a.GuildWorlds(guildID)
+	if slices.Contains(worlds, world) {
+		return guildsworld.ErrWorldTaken
+	}
+	if len(worlds) >= guildsworld.MaxWorldsPerGuild {
+		return guildsworld.ErrTooManyWorlds
+	}
+
+	inserted, err := a.worldNameRepo.InsertGuildWorld(context.Background(), guildID, world)
+	if err != nil {
 		return err
 	}
-	a.ConfigureWorldName(guildID, world)
+	if !inserted {
+		return guildsworld.ErrWorldTaken
+	}
+	return a.guildWorldChanged(guildID, world, false)
+}
+
+func (a *Adapter) RemoveGuildWorld(guildID, world string) error {
+	if a.worldNameRepo == nil {
+		return fmt.Errorf("worldNameRepo is not configured")
+	}
+	deleted, err := a.worldNameRepo.DeleteGuildWorld(context.Background(), guildID, world)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return guildsworld.ErrWorldNotFound
+	}
+	if err = a.unbindCharacters(guildID, world); err != nil {
+		return err
+	}
+	return a.guildWorldChanged(guildID, world, true)
+}
+
+// unbindCharacters clears the world of characters bound to a removed world, so they are looked up
+// on the remaining worlds of the guild, instead of staying unknown.
+func (a *Adapter) unbindCharacters(guildID, world string) error {
+	if a.characterRepo == nil {
+		return nil
+	}
+	if err := a.characterRepo.ClearCharacterWorld(context.Background(), guildID, world); err != nil {
+		return fmt.Errorf("could not unbind characters from the world: %w", err)
+	}
+	return a.RefreshCharacters(guildID)
+}
+
+func (a *Adapter) guildWorldChanged(guildID, world string, removed bool) error {
+	if err := a.ConfigureGuildWorlds(guildID); err != nil {
+		return err
+	}
+	if a.events != nil {
+		a.events.Publish(event.GuildWorldChanged{Guild: &guild.Guild{ID: guildID}, World: world, Removed: removed})
+	}
 	return nil
 }
 
-func (a *Adapter) ConfigureWorldNameForGuild(guildID string) error {
+func (a *Adapter) ConfigureGuildWorlds(guildID string) error {
 	if a.worldNameRepo == nil {
 		return fmt.Errorf("worldNameRepo is not configured")
 	}
-	guildWorld, err := a.worldNameRepo.SelectGuildWorld(context.Background(), guildID)
+	guildWorlds, err := a.worldNameRepo.SelectGuildWorlds(context.Background(), guildID)
 	if err != nil {
 		return err
 	}
-	if guildWorld != nil && guildWorld.WorldName != "" {
-		a.ConfigureWorldName(guildID, guildWorld.WorldName)
+
+	worlds := make([]string, 0, len(guildWorlds))
+	for _, w := range guildWorlds {
+		if w.WorldName != "" {
+			worlds = append(worlds, w.WorldName)
+		}
 	}
+	a.guildWorlds.Set(guildID, worlds)
 	return nil
 }
@@ -11,11 +11,12 @@ import (
 func BenchmarkIsOnline(b *testing.B) {
 	log := zap.NewNop().Sugar()
 	adapter := &Adapter{
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
+		guildWorlds: cmap.New[[]string](),
+		players:     cmap.New[map[string]struct{}](),
+		characters:  cmap.New[map[string][]trackedCharacter](),
+		log:         log,
 	}
-	adapter.guildIdToWorld.Set("guild1", "Celesta")
+	adapter.guildWorlds.Set("guild1", []string{"Celesta"})
 
 	// Populate with 1000 players
 	players := make(map[string]struct{})
@@ -29,20 +30,21 @@ func BenchmarkIsOnline(b *testing.B) {
 	b.ReportAllocs()
 
 	for i := 0; i < b.N; i++ {
-		adapter.IsOnline("guild1", "Mariysz")
-		adapter.IsOnline("guild1", "Mariysz / Another")
-		adapter.IsOnline("guild1", "OfflinePlayer")
+		adapter.IsOnline("guild1", "member1", "Mariysz")
+		adapter.IsOnline("guild1", "member1", "Mariysz / Another")
+		adapter.IsOnline("guild1", "member1", "OfflinePlayer")
 	}
 }
 
 func BenchmarkIsOnline_MultiName(b *testing.B) {
 	log := zap.NewNop().Sugar()
 	adapter := &Adapter{
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
+		guildWorlds: cmap.New[[]string](),
+		players:     cmap.New[map[string]struct{}](),
+		characters:  cmap.New[map[string][]trackedCharacter](),
+		log:         log,
 	}
-	adapter.guildIdToWorld.Set("guild1", "Celesta")
+	adapter.guildWorlds.Set("guild1", []string{"Celesta"})
 	players := map[string]struct{}{
 		"Mariysz": {},
 	}
@@ -53,6 +55,6 @@ func BenchmarkIsOnline_MultiName(b *testing.B) {
 
 	name := "One / Two / Three / Four / Five / Six"
 	for i := 0; i < b.N; i++ {
-		adapter.IsOnline("guild1", name)
+		adapter.IsOnline("guild1", "member1", name)
 	}
 }
@@ -5,16 +5,22 @@ import (
 	"testing"
 
 	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/character"
+	"spot-assistant/internal/core/dto/event"
+	"spot-assistant/internal/core/dto/guild"
 	"spot-assistant/internal/core/dto/guildsworld"
 	"spot-assistant/internal/core/dto/summary"
+	"spot-assistant/internal/core/dto/world"
 
 	cmap "github.com/orcaman/concurrent-map/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap/zaptest"
 )
 
 type MockAPI struct {
 	players []string
+	byWorld map[string][]string
 	err     error
 }
 
@@ -22,9 +28,24 @@ func (m *MockAPI) GetOnlinePlayerNames(world string) ([]string, error) {
 	if m.err != nil {
 		return nil, m.err
 	}
+	if m.byWorld != nil {
+		return m.byWorld[world], nil
+	}
 	return m.players, nil
 }
 
+func (m *MockAPI) GetCharacter(name string) (*world.Character, error) {
+	return nil, world.ErrCharacterNotFound
+}
+
+func (m *MockAPI) GetWorldNames() ([]string, error) {
+	return nil, nil
+}
+
+func (m *MockAPI) GetGuildMembers(guildName string) ([]string, error) {
+	return nil, world.ErrGuildNotFound
+}
+
 func (m *MockAPI) GetBaseURL() string {
 	return "mock://baseurl"
 }
@@ -37,12 +58,13 @@ func TestRefreshOnlinePlayers_Success(t *testing.T) {
 	log := zaptest.NewLogger(t).Sugar()
 
 	a := &Adapter{
-		api:            mockAPI,
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
+		api:         mockAPI,
+		guildWorlds: cmap.New[[]string](),
+		players:     cmap.New[map[string]struct{}](),
+		characters:  cmap.New[map[string][]trackedCharacter](),
+		log:         log,
 	}
-	a.guildIdToWorld.Set("guild1", "Celesta")
+	a.guildWorlds.Set("guild1", []string{"Celesta"})
 	// when
 	err := a.RefreshOnlinePlayers("guild1")
 	// then
@@ -64,66 +86,73 @@ func TestRefreshOnlinePlayers_Error(t *testing.T) {
 	log := zaptest.NewLogger(t).Sugar()
 
 	a := &Adapter{
-		api:            mockAPI,
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
+		api:         mockAPI,
+		guildWorlds: cmap.New[[]string](),
+		players:     cmap.New[map[string]struct{}](),
+		characters:  cmap.New[map[string][]trackedCharacter](),
+		log:         log,
 	}
-	a.guildIdToWorld.Set("guild1", "Celesta")
+	a.guildWorlds.Set("guild1", []string{"Celesta"})
+	a.players.Set("Celesta", map[string]struct{}{"mariysz": {}})
 	// when
 	err := a.RefreshOnlinePlayers("guild1")
 	// then
 	assert.Error(t, err)
-	assert.Equal(t, "API failure", err.Error())
+	assert.Equal(t, "could not refresh players of Celesta: API failure", err.Error())
+	assert.False(t, a.players.Has("Celesta"))
+	assert.Equal(t, summary.Unknown, a.PlayerStatus("guild1", "member1", "Mariysz"))
 }
 
 func TestIsOnline(t *testing.T) {
 	// given
 	log := zaptest.NewLogger(t).Sugar()
 	a := &Adapter{
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
+		guildWorlds: cmap.New[[]string](),
+		players:     cmap.New[map[string]struct{}](),
+		characters:  cmap.New[map[string][]trackedCharacter](),
+		log:         log,
 	}
-	a.guildIdToWorld.Set("guild1", "Celesta")
+	a.guildWorlds.Set("guild1", []string{"Celesta"})
 	a.players.Set("Celesta", map[string]struct{}{
 		"mariysz":   {},
 		"asar cham": {},
 	})
 
 	// then
-	assert.True(t, a.IsOnline("guild1", "Mariysz"))
-	assert.True(t, a.IsOnline("guild1", "Mariysz / Irnas"))
-	assert.True(t, a.IsOnline("guild1", "Irnas / Mariysz"))
-	assert.True(t, a.IsOnline("guild1", "Asar Cham / Irnas"))
-	assert.True(t, a.IsOnline("guild1", "Asar Cham / Mariysz"))
-	assert.True(t, a.IsOnline("guild1", "Mariysz / Asar Cham"))
-	assert.True(t, a.IsOnline("guild1", "  Mariysz  /   Irnas  "))       // test spaces
-	assert.True(t, a.IsOnline("guild1", "Kai Ens / Mariysz / Miodoelo")) // more than two names, one online
-
-	assert.False(t, a.IsOnline("guild1", "Irnas"))
-	assert.False(t, a.IsOnline("guild1", "Kai Ens / Miodoelo")) // both offline
+	assert.True(t, a.IsOnline("guild1", "member1", "Mariysz"))
+	assert.True(t, a.IsOnline("guild1", "member1", "Mariysz / Irnas"))
+	assert.True(t, a.IsOnline("guild1", "member1", "Irnas / Mariysz"))
+	assert.True(t, a.IsOnline("guild1", "member1", "Asar Cham / Irnas"))
+	assert.True(t, a.IsOnline("guild1", "member1", "Asar Cham / Mariysz"))
+	assert.True(t, a.IsOnline("guild1", "member1", "Mariysz / Asar Cham"))
+	assert.True(t, a.IsOnline("guild1", "member1", "  Mariysz  /   Irnas  "))       // test spaces
+	assert.True(t, a.IsOnline("guild1", "member1", "Kai Ens / Mariysz / Miodoelo")) // more than two names, one online
+
+	assert.False(t, a.IsOnline("guild1", "member1", "Irnas"))
+	assert.False(t, a.IsOnline("guild1", "member1", "Kai Ens / Miodoelo")) // both offline
 
 	// test missing world
 	a2 := &Adapter{
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
+		guildWorlds: cmap.New[[]string](),
+		players:     cmap.New[map[string]struct{}](),
+		characters:  cmap.New[map[string][]trackedCharacter](),
+		log:         log,
 	}
 	a2.players.Set("Celesta", map[string]struct{}{
 		"mariysz":   {},
 		"asar cham": {},
 	})
-	assert.False(t, a2.IsOnline("guild1", "Mariysz"))
+	assert.False(t, a2.IsOnline("guild1", "member1", "Mariysz"))
 
 	// test missing players
 	a3 := &Adapter{
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
+		guildWorlds: cmap.New[[]string](),
+		players:     cmap.New[map[string]struct{}](),
+		characters:  cmap.New[map[string][]trackedCharacter](),
+		log:         log,
 	}
-	a3.guildIdToWorld.Set("guild1", "Celesta")
-	assert.False(t, a3.IsOnline("guild1", "Mariysz"))
+	a3.guildWorlds.Set("guild1", []string{"Celesta"})
+	assert.False(t, a3.IsOnline("guild1", "member1", "Mariysz"))
 }
 
 type MockAPIEmptyURL struct {
@@ -144,6 +173,9 @@ func TestIsConfigured(t *testing.T) {
 		name string
 		api  interface {
 			GetOnlinePlayerNames(string) ([]string, error)
+			GetCharacter(string) (*world.Character, error)
+			GetWorldNames() ([]string, error)
+			GetGuildMembers(string) ([]string, error)
 			GetBaseURL() string
 		}
 		expect bool
@@ -156,63 +188,65 @@ func TestIsConfigured(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			a := &Adapter{
-				api:            tt.api,
-				guildIdToWorld: cmap.New[string](),
-				players:        cmap.New[map[string]struct{}](),
-				log:            log,
+				api:         tt.api,
+				guildWorlds: cmap.New[[]string](),
+				players:     cmap.New[map[string]struct{}](),
+				characters:  cmap.New[map[string][]trackedCharacter](),
+				log:         log,
 			}
 			assert.Equal(t, tt.expect, a.IsConfigured())
 		})
 	}
 }
 
-func TestConfigureWorldName(t *testing.T) {
+func TestGuildWorlds(t *testing.T) {
 	log := zaptest.NewLogger(t).Sugar()
 	a := &Adapter{
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
-	}
-	guildID := "guild1"
-	world := "Celesta"
-	a.ConfigureWorldName(guildID, world)
-	val, ok := a.guildIdToWorld.Get(guildID)
-	assert.True(t, ok)
-	assert.Equal(t, world, val)
+		guildWorlds: cmap.New[[]string](),
+		players:     cmap.New[map[string]struct{}](),
+		characters:  cmap.New[map[string][]trackedCharacter](),
+		log:         log,
+	}
+	a.guildWorlds.Set("guild1", []string{"Celesta", "Secura"})
+	assert.Equal(t, []string{"Celesta", "Secura"}, a.GuildWorlds("guild1"))
+	assert.Empty(t, a.GuildWorlds("guild2"))
 }
 
 func TestPlayerStatus(t *testing.T) {
 	log := zaptest.NewLogger(t).Sugar()
 	mockAPI := &MockAPI{}
 	a := &Adapter{
-		api:            mockAPI, // ensure IsConfigured returns true
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
+		api:         mockAPI, // ensure IsConfigured returns true
+		guildWorlds: cmap.New[[]string](),
+		players:     cmap.New[map[string]struct{}](),
+		characters:  cmap.New[map[string][]trackedCharacter](),
+		log:         log,
 	}
-	a.guildIdToWorld.Set("guild1", "Celesta")
+	a.guildWorlds.Set("guild1", []string{"Celesta"})
 	a.players.Set("Celesta", map[string]struct{}{"mariysz": {}})
-	assert.Equal(t, summary.Online, a.PlayerStatus("guild1", "Mariysz"))
-	assert.Equal(t, summary.Offline, a.PlayerStatus("guild1", "Unknown"))
+	assert.Equal(t, summary.Online, a.PlayerStatus("guild1", "member1", "Mariysz"))
+	assert.Equal(t, summary.Offline, a.PlayerStatus("guild1", "member1", "Unknown"))
 
 	// not configured
 	a2 := &Adapter{
-		api:            nil, // IsConfigured returns false
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
+		api:         nil, // IsConfigured returns false
+		guildWorlds: cmap.New[[]string](),
+		players:     cmap.New[map[string]struct{}](),
+		characters:  cmap.New[map[string][]trackedCharacter](),
+		log:         log,
 	}
-	assert.Equal(t, summary.Unknown, a2.PlayerStatus("guild1", "Mariysz"))
+	assert.Equal(t, summary.Unknown, a2.PlayerStatus("guild1", "member1", "Mariysz"))
 
 	// world missing
 	a3 := &Adapter{
-		api:            mockAPI,
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
+		api:         mockAPI,
+		guildWorlds: cmap.New[[]string](),
+		players:     cmap.New[map[string]struct{}](),
+		characters:  cmap.New[map[string][]trackedCharacter](),
+		log:         log,
 	}
-	a3.guildIdToWorld.Set("guild1", "")
-	assert.Equal(t, summary.Unknown, a3.PlayerStatus("guild1", "Mariysz"))
+	a3.guildWorlds.Set("guild1", nil)
+	assert.Equal(t, summary.Unknown, a3.PlayerStatus("guild1", "member1", "Mariysz"))
 }
 
 func TestTryRefresh(t *testing.T) {
@@ -221,12 +255,13 @@ func TestTryRefresh(t *testing.T) {
 	}
 	log := zaptest.NewLogger(t).Sugar()
 	a := &Adapter{
-		api:            mockAPI,
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
+		api:         mockAPI,
+		guildWorlds: cmap.New[[]string](),
+		players:     cmap.New[map[string]struct{}](),
+		characters:  cmap.New[map[string][]trackedCharacter](),
+		log:         log,
 	}
-	a.guildIdToWorld.Set("guild1", "Celesta")
+	a.guildWorlds.Set("guild1", []string{"Celesta"})
 	a.TryRefresh("guild1")
 	players, ok := a.players.Get("Celesta")
 	assert.True(t, ok)
@@ -236,140 +271,354 @@ func TestTryRefresh(t *testing.T) {
 func TestIsOnline_KeyMisses(t *testing.T) {
 	log := zaptest.NewLogger(t).Sugar()
 
-	// guildIdToWorld key missing
+	// guildWorlds key missing
 	a := &Adapter{
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
+		guildWorlds: cmap.New[[]string](),
+		players:     cmap.New[map[string]struct{}](),
+		characters:  cmap.New[map[string][]trackedCharacter](),
+		log:         log,
 	}
 	a.players.Set("Celesta", map[string]struct{}{"mariysz": {}})
-	assert.False(t, a.IsOnline("guild1", "Mariysz"))
+	assert.False(t, a.IsOnline("guild1", "member1", "Mariysz"))
 
 	// world is empty string
 	a2 := &Adapter{
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
+		guildWorlds: cmap.New[[]string](),
+		players:     cmap.New[map[string]struct{}](),
+		characters:  cmap.New[map[string][]trackedCharacter](),
+		log:         log,
 	}
-	a2.guildIdToWorld.Set("guild1", "")
+	a2.guildWorlds.Set("guild1", nil)
 	a2.players.Set("", map[string]struct{}{"mariysz": {}})
-	assert.False(t, a2.IsOnline("guild1", "Mariysz"))
+	assert.False(t, a2.IsOnline("guild1", "member1", "Mariysz"))
 
 	// players key missing
 	a3 := &Adapter{
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
+		guildWorlds: cmap.New[[]string](),
+		players:     cmap.New[map[string]struct{}](),
+		characters:  cmap.New[map[string][]trackedCharacter](),
+		log:         log,
 	}
-	a3.guildIdToWorld.Set("guild1", "Celesta")
-	assert.False(t, a3.IsOnline("guild1", "Mariysz"))
+	a3.guildWorlds.Set("guild1", []string{"Celesta"})
+	assert.False(t, a3.IsOnline("guild1", "member1", "Mariysz"))
 }
 
 func TestIsOnline_CaseSensitivity(t *testing.T) {
 	log := zaptest.NewLogger(t).Sugar()
 	a := &Adapter{
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
+		guildWorlds: cmap.New[[]string](),
+		players:     cmap.New[map[string]struct{}](),
+		characters:  cmap.New[map[string][]trackedCharacter](),
+		log:         log,
 	}
-	a.guildIdToWorld.Set("guild1", "Celesta")
+	a.guildWorlds.Set("guild1", []string{"Celesta"})
 	a.players.Set("Celesta", map[string]struct{}{"mariysz": {}, "asar cham": {}})
-	assert.True(t, a.IsOnline("guild1", "mariysz"))
-	assert.True(t, a.IsOnline("guild1", "ASAR CHAM"))
-	assert.True(t, a.IsOnline("guild1", "Mariysz"))
-	assert.True(t, a.IsOnline("guild1", "Asar Cham"))
-	assert.True(t, a.IsOnline("guild1", "mariysz / asar cham"))
-	assert.True(t, a.IsOnline("guild1", "ASAR CHAM / MARIYSZ"))
-	assert.True(t, a.IsOnline("guild1", "Mariysz / ASAR CHAM"))
+	assert.True(t, a.IsOnline("guild1", "member1", "mariysz"))
+	assert.True(t, a.IsOnline("guild1", "member1", "ASAR CHAM"))
+	assert.True(t, a.IsOnline("guild1", "member1", "Mariysz"))
+	assert.True(t, a.IsOnline("guild1", "member1", "Asar Cham"))
+	assert.True(t, a.IsOnline("guild1", "member1", "mariysz / asar cham"))
+	assert.True(t, a.IsOnline("guild1", "member1", "ASAR CHAM / MARIYSZ"))
+	assert.True(t, a.IsOnline("guild1", "member1", "Mariysz / ASAR CHAM"))
 }
 
-func TestSetGuildWorld_Success(t *testing.T) {
+func TestAddGuildWorld_Success(t *testing.T) {
 	log := zaptest.NewLogger(t).Sugar()
 	mockRepo := mocks.NewMockWorldNameRepository(t)
-	mockRepo.On("UpsertGuildWorld", mocks.ContextMock, "guild1", "Celesta").Return(nil)
+	mockRepo.On("InsertGuildWorld", mocks.ContextMock, "guild1", "Secura").Return(true, nil)
+	mockRepo.On("SelectGuildWorlds", mocks.ContextMock, "guild1").Return([]*guildsworld.GuildsWorld{
+		{GuildID: "guild1", WorldName: "Celesta"},
+		{GuildID: "guild1", WorldName: "Secura"},
+	}, nil)
+	events := mocks.NewMockEventPublisher(t)
+	events.On("Publish", event.GuildWorldChanged{Guild: &guild.Guild{ID: "guild1"}, World: "Secura"}).Once()
 	a := &Adapter{
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
-		worldNameRepo:  mockRepo,
-	}
-	guildID := "guild1"
-	world := "Celesta"
-	err := a.SetGuildWorld(guildID, world)
+		guildWorlds:   cmap.New[[]string](),
+		players:       cmap.New[map[string]struct{}](),
+		characters:    cmap.New[map[string][]trackedCharacter](),
+		log:           log,
+		worldNameRepo: mockRepo,
+		events:        events,
+	}
+	a.guildWorlds.Set("guild1", []string{"Celesta"})
+	err := a.AddGuildWorld("guild1", "Secura")
 	assert.NoError(t, err)
-	val, ok := a.guildIdToWorld.Get(guildID)
-	assert.True(t, ok)
-	assert.Equal(t, world, val)
+	assert.Equal(t, []string{"Celesta", "Secura"}, a.GuildWorlds("guild1"))
+}
+
+func TestAddGuildWorld_AlreadyAdded(t *testing.T) {
+	log := zaptest.NewLogger(t).Sugar()
+	a := &Adapter{
+		guildWorlds:   cmap.New[[]string](),
+		players:       cmap.New[map[string]struct{}](),
+		characters:    cmap.New[map[string][]trackedCharacter](),
+		log:           log,
+		worldNameRepo: mocks.NewMockWorldNameRepository(t),
+	}
+	a.guildWorlds.Set("guild1", []string{"Celesta"})
+	err := a.AddGuildWorld("guild1", "Celesta")
+	assert.ErrorIs(t, err, guildsworld.ErrWorldTaken)
+}
+
+func TestAddGuildWorld_TooMany(t *testing.T) {
+	log := zaptest.NewLogger(t).Sugar()
+	a := &Adapter{
+		guildWorlds:   cmap.New[[]string](),
+		players:       cmap.New[map[string]struct{}](),
+		characters:    cmap.New[map[string][]trackedCharacter](),
+		log:           log,
+		worldNameRepo: mocks.NewMockWorldNameRepository(t),
+	}
+	a.guildWorlds.Set("guild1", make([]string, guildsworld.MaxWorldsPerGuild))
+	err := a.AddGuildWorld("guild1", "Celesta")
+	assert.ErrorIs(t, err, guildsworld.ErrTooManyWorlds)
 }
 
-func TestSetGuildWorld_Error(t *testing.T) {
+func TestAddGuildWorld_Error(t *testing.T) {
 	log := zaptest.NewLogger(t).Sugar()
 	mockRepo := mocks.NewMockWorldNameRepository(t)
-	mockRepo.On("UpsertGuildWorld", mocks.ContextMock, "guild1", "Celesta").Return(errors.New("something went wrong"))
+	mockRepo.On("InsertGuildWorld", mocks.ContextMock, "guild1", "Celesta").Return(false, errors.New("something went wrong"))
 	a := &Adapter{
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
-		worldNameRepo:  mockRepo,
+		guildWorlds:   cmap.New[[]string](),
+		players:       cmap.New[map[string]struct{}](),
+		characters:    cmap.New[map[string][]trackedCharacter](),
+		log:           log,
+		worldNameRepo: mockRepo,
 	}
-	err := a.SetGuildWorld("guild1", "Celesta")
+	err := a.AddGuildWorld("guild1", "Celesta")
 	assert.Error(t, err)
 }
 
-func TestSetGuildWorld_NilRepo(t *testing.T) {
+func TestAddGuildWorld_NilRepo(t *testing.T) {
 	log := zaptest.NewLogger(t).Sugar()
 	a := &Adapter{
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
-		worldNameRepo:  nil,
+		guildWorlds:   cmap.New[[]string](),
+		players:       cmap.New[map[string]struct{}](),
+		characters:    cmap.New[map[string][]trackedCharacter](),
+		log:           log,
+		worldNameRepo: nil,
 	}
-	err := a.SetGuildWorld("guild1", "Celesta")
+	err := a.AddGuildWorld("guild1", "Celesta")
 	assert.Error(t, err)
 }
 
-func TestConfigureWorldNameForGuild_Success(t *testing.T) {
+func TestRemoveGuildWorld_Success(t *testing.T) {
+	log := zaptest.NewLogger(t).Sugar()
+	mockRepo := mocks.NewMockWorldNameRepository(t)
+	mockRepo.On("DeleteGuildWorld", mocks.ContextMock, "guild1", "Celesta").Return(true, nil)
+	mockRepo.On("SelectGuildWorlds", mocks.ContextMock, "guild1").Return([]*guildsworld.GuildsWorld{}, nil)
+	events := mocks.NewMockEventPublisher(t)
+	events.On("Publish", event.GuildWorldChanged{Guild: &guild.Guild{ID: "guild1"}, World: "Celesta", Removed: true}).Once()
+	a := &Adapter{
+		guildWorlds:   cmap.New[[]string](),
+		players:       cmap.New[map[string]struct{}](),
+		characters:    cmap.New[map[string][]trackedCharacter](),
+		log:           log,
+		worldNameRepo: mockRepo,
+		events:        events,
+	}
+	a.guildWorlds.Set("guild1", []string{"Celesta"})
+	err := a.RemoveGuildWorld("guild1", "Celesta")
+	assert.NoError(t, err)
+	assert.Empty(t, a.GuildWorlds("guild1"))
+}
+
+func TestRemoveGuildWorld_UnbindsCharacters(t *testing.T) {
+	log := zaptest.NewLogger(t).Sugar()
+	mockRepo := mocks.NewMockWorldNameRepository(t)
+	mockRepo.On("DeleteGuildWorld", mocks.ContextMock, "guild1", "Celesta").Return(true, nil)
+	mockRepo.On("SelectGuildWorlds", mocks.ContextMock, "guild1").
+		Return([]*guildsworld.GuildsWorld{{GuildID: "guild1", WorldName: "Secura"}}, nil)
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("ClearCharacterWorld", mocks.ContextMock, "guild1", "Celesta").Return(nil).Once()
+	characterRepo.On("SelectGuildCharacters", mocks.ContextMock, "guild1").
+		Return([]*character.Character{{MemberID: "member1", Name: "Mariysz"}}, nil).Once()
+	events := mocks.NewMockEventPublisher(t)
+	events.On("Publish", mock.Anything).Once()
+	a := &Adapter{
+		guildWorlds:   cmap.New[[]string](),
+		players:       cmap.New[map[string]struct{}](),
+		characters:    cmap.New[map[string][]trackedCharacter](),
+		log:           log,
+		worldNameRepo: mockRepo,
+		characterRepo: characterRepo,
+		events:        events,
+	}
+	a.guildWorlds.Set("guild1", []string{"Celesta", "Secura"})
+	a.characters.Set("guild1", map[string][]trackedCharacter{"member1": {{name: "mariysz", world: "Celesta"}}})
+	a.players.Set("Secura", map[string]struct{}{"mariysz": {}})
+
+	err := a.RemoveGuildWorld("guild1", "Celesta")
+
+	assert.NoError(t, err)
+	assert.True(t, a.IsOnline("guild1", "member1", "Mariysz"))
+}
+
+func TestRemoveGuildWorld_NotFound(t *testing.T) {
+	log := zaptest.NewLogger(t).Sugar()
+	mockRepo := mocks.NewMockWorldNameRepository(t)
+	mockRepo.On("DeleteGuildWorld", mocks.ContextMock, "guild1", "Celesta").Return(false, nil)
+	a := &Adapter{
+		guildWorlds:   cmap.New[[]string](),
+		players:       cmap.New[map[string]struct{}](),
+		characters:    cmap.New[map[string][]trackedCharacter](),
+		log:           log,
+		worldNameRepo: mockRepo,
+	}
+	err := a.RemoveGuildWorld("guild1", "Celesta")
+	assert.ErrorIs(t, err, guildsworld.ErrWorldNotFound)
+}
+
+func TestConfigureGuildWorlds_Success(t *testing.T) {
 	log := zaptest.NewLogger(t).Sugar()
 	mockRepo := mocks.NewMockWorldNameRepository(t)
-	mockRepo.On("SelectGuildWorld", mocks.ContextMock, "guild1").Return(&guildsworld.GuildsWorld{
-		GuildID:   "guild1",
-		WorldName: "Celesta",
+	mockRepo.On("SelectGuildWorlds", mocks.ContextMock, "guild1").Return([]*guildsworld.GuildsWorld{
+		{GuildID: "guild1", WorldName: "Celesta"},
+		{GuildID: "guild1", WorldName: "Secura"},
 	}, nil)
 	a := &Adapter{
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
-		worldNameRepo:  mockRepo,
+		guildWorlds:   cmap.New[[]string](),
+		players:       cmap.New[map[string]struct{}](),
+		characters:    cmap.New[map[string][]trackedCharacter](),
+		log:           log,
+		worldNameRepo: mockRepo,
 	}
-	err := a.ConfigureWorldNameForGuild("guild1")
+	err := a.ConfigureGuildWorlds("guild1")
 	assert.NoError(t, err)
-	val, ok := a.guildIdToWorld.Get("guild1")
-	assert.True(t, ok)
-	assert.Equal(t, "Celesta", val)
+	assert.Equal(t, []string{"Celesta", "Secura"}, a.GuildWorlds("guild1"))
 }
 
-func TestConfigureWorldNameForGuild_Error(t *testing.T) {
+func TestConfigureGuildWorlds_Error(t *testing.T) {
 	log := zaptest.NewLogger(t).Sugar()
 	mockRepo := mocks.NewMockWorldNameRepository(t)
-	mockRepo.On("SelectGuildWorld", mocks.ContextMock, "guild1").Return(nil, errors.New("db error"))
+	mockRepo.On("SelectGuildWorlds", mocks.ContextMock, "guild1").Return(nil, errors.New("db error"))
 	a := &Adapter{
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
-		worldNameRepo:  mockRepo,
+		guildWorlds:   cmap.New[[]string](),
+		players:       cmap.New[map[string]struct{}](),
+		characters:    cmap.New[map[string][]trackedCharacter](),
+		log:           log,
+		worldNameRepo: mockRepo,
 	}
-	err := a.ConfigureWorldNameForGuild("guild1")
+	err := a.ConfigureGuildWorlds("guild1")
 	assert.Error(t, err)
 }
 
-func TestConfigureWorldNameForGuild_NilRepo(t *testing.T) {
+func TestConfigureGuildWorlds_NilRepo(t *testing.T) {
 	log := zaptest.NewLogger(t).Sugar()
 	a := &Adapter{
-		guildIdToWorld: cmap.New[string](),
-		players:        cmap.New[map[string]struct{}](),
-		log:            log,
-		worldNameRepo:  nil,
+		guildWorlds:   cmap.New[[]string](),
+		players:       cmap.New[map[string]struct{}](),
+		characters:    cmap.New[map[string][]trackedCharacter](),
+		log:           log,
+		worldNameRepo: nil,
 	}
-	err := a.ConfigureWorldNameForGuild("guild1")
+	err := a.ConfigureGuildWorlds("guild1")
 	assert.Error(t, err)
 }
+
+func TestIsOnlineChecksRegisteredCharactersFirst(t *testing.T) {
+	// given
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("SelectGuildCharacters", mock.Anything, "guild1").Return([]*character.Character{
+		{MemberID: "member1", Name: "Asar Cham"},
+		{MemberID: "member2", Name: "Irnas"},
+	}, nil).Once()
+	a := &Adapter{
+		api:           &MockAPI{players: []string{"Asar Cham", "Mariysz"}},
+		characterRepo: characterRepo,
+		guildWorlds:   cmap.New[[]string](),
+		players:       cmap.New[map[string]struct{}](),
+		characters:    cmap.New[map[string][]trackedCharacter](),
+		log:           zaptest.NewLogger(t).Sugar(),
+	}
+	a.guildWorlds.Set("guild1", []string{"Celesta"})
+
+	// when
+	err := a.RefreshOnlinePlayers("guild1")
+
+	// then
+	assert.NoError(t, err)
+	assert.True(t, a.IsOnline("guild1", "member1", "Some Nick"))
+	assert.False(t, a.IsOnline("guild1", "member2", "Mariysz"))
+	assert.True(t, a.IsOnline("guild1", "member3", "Mariysz"))
+}
+
+func TestRefreshOnlinePlayersLoadsCharactersOnce(t *testing.T) {
+	// given
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("SelectGuildCharacters", mock.Anything, "guild1").Return([]*character.Character{}, nil).Once()
+	a := &Adapter{
+		api:           &MockAPI{players: []string{"Mariysz"}},
+		characterRepo: characterRepo,
+		guildWorlds:   cmap.New[[]string](),
+		players:       cmap.New[map[string]struct{}](),
+		characters:    cmap.New[map[string][]trackedCharacter](),
+		log:           zaptest.NewLogger(t).Sugar(),
+	}
+	a.guildWorlds.Set("guild1", []string{"Celesta"})
+
+	// when
+	assert.NoError(t, a.RefreshOnlinePlayers("guild1"))
+	assert.NoError(t, a.RefreshOnlinePlayers("guild1"))
+
+	// then
+	characterRepo.AssertNumberOfCalls(t, "SelectGuildCharacters", 1)
+}
+
+func TestIsOnlineChecksCharactersOnTheirWorlds(t *testing.T) {
+	// given
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("SelectGuildCharacters", mock.Anything, "guild1").Return([]*character.Character{
+		{MemberID: "member1", Name: "Asar Cham", World: "Secura"},
+		{MemberID: "member2", Name: "Irnas", World: "Celesta"},
+		{MemberID: "member3", Name: "Mariysz"},
+	}, nil).Once()
+	a := &Adapter{
+		api: &MockAPI{byWorld: map[string][]string{
+			"Celesta": {"Asar Cham", "Mariysz"},
+			"Secura":  {"Irnas"},
+		}},
+		characterRepo: characterRepo,
+		guildWorlds:   cmap.New[[]string](),
+		players:       cmap.New[map[string]struct{}](),
+		characters:    cmap.New[map[string][]trackedCharacter](),
+		log:           zaptest.NewLogger(t).Sugar(),
+	}
+	a.guildWorlds.Set("guild1", []string{"Celesta", "Secura"})
+
+	// when
+	err := a.RefreshOnlinePlayers("guild1")
+
+	// then
+	assert.NoError(t, err)
+	assert.False(t, a.IsOnline("guild1", "member1", "Asar Cham"))
+	assert.False(t, a.IsOnline("guild1", "member2", "Irnas"))
+	assert.True(t, a.IsOnline("guild1", "member3", "Mariysz"))
+	assert.True(t, a.IsOnline("guild1", "member4", "Irnas"))
+}
+
+func TestPlayerStatusUnknownOnFailedWorld(t *testing.T) {
+	// given
+	a := &Adapter{
+		api:         &MockAPI{},
+		guildWorlds: cmap.New[[]string](),
+		players:     cmap.New[map[string]struct{}](),
+		characters:  cmap.New[map[string][]trackedCharacter](),
+		log:         zaptest.NewLogger(t).Sugar(),
+	}
+	a.guildWorlds.Set("guild1", []string{"Celesta", "Secura"})
+	a.players.Set("Celesta", map[string]struct{}{"mariysz": {}})
+	a.characters.Set("guild1", map[string][]trackedCharacter{
+		"member1": {{name: "irnas", world: "Celesta"}},
+	})
+
+	// when
+	online := a.PlayerStatus("guild1", "member2", "Mariysz")
+	unknown := a.PlayerStatus("guild1", "member2", "Asar Cham")
+	offline := a.PlayerStatus("guild1", "member1", "Irnas")
+
+	// then
+	assert.Equal(t, summary.Online, online)
+	assert.Equal(t, summary.Unknown, unknown)
+	assert.Equal(t, summary.Offline, offline)
+}
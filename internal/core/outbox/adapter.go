@@ -0,0 +1,26 @@
+package outbox
+
+import (
+	"go.uber.org/zap"
+
+	"spot-assistant/internal/ports"
+)
+
+type Adapter struct {
+	outboxRepo ports.NotificationOutboxRepository
+	commSrv    ports.CommunicationService
+	log        *zap.SugaredLogger
+}
+
+func NewAdapter(outboxRepo ports.NotificationOutboxRepository, commSrv ports.CommunicationService) *Adapter {
+	return &Adapter{
+		outboxRepo: outboxRepo,
+		commSrv:    commSrv,
+		log:        zap.NewNop().Sugar(),
+	}
+}
+
+func (a *Adapter) WithLogger(log *zap.SugaredLogger) *Adapter {
+	a.log = log.With("layer", "core", "name", "outboxService")
+	return a
+}
@@ -0,0 +1,76 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"spot-assistant/internal/core/dto/notification"
+)
+
+const (
+	// DispatchBatchSize limits notifications attempted at once.
+	DispatchBatchSize = 20
+	// DispatchLease is how long an attempted notification is hidden from other attempts.
+	// It must outlast sending a whole batch.
+	DispatchLease = 5 * time.Minute
+
+	retryBaseDelay = 30 * time.Second
+	retryMaxDelay  = 30 * time.Minute
+)
+
+// DispatchDue delivers notifications from the outbox due at the time. Notifications are claimed
+// before they are sent, so several instances never send the same one at once. Failed notifications
+// are retried with an exponential backoff, until they run out of attempts.
+func (a *Adapter) DispatchDue(now time.Time) {
+	ctx := context.Background()
+	pending, err := a.outboxRepo.ClaimDueNotifications(ctx, now, now.Add(DispatchLease), DispatchBatchSize)
+	if err != nil {
+		a.log.Errorf("could not claim due notifications: %s", err)
+
+		return
+	}
+
+	for _, n := range pending {
+		sendErr := a.send(n)
+
+		switch {
+		case sendErr == nil:
+			err = a.outboxRepo.MarkNotificationSent(ctx, n.ID)
+		case n.Attempts >= notification.MaxAttempts:
+			a.log.Warnf("notification %d of guild %s failed after %d attempts: %s", n.ID, n.GuildID, n.Attempts, sendErr)
+			err = a.outboxRepo.MarkNotificationFailed(ctx, n.ID, sendErr.Error())
+		default:
+			err = a.outboxRepo.ScheduleNotificationRetry(ctx, n.ID, now.Add(RetryDelay(n.Attempts)), sendErr.Error())
+		}
+		if err != nil {
+			a.log.Errorf("could not record attempt of notification %d: %s", n.ID, err)
+		}
+	}
+}
+
+func (a *Adapter) send(n *notification.Outbox) error {
+	switch n.Kind {
+	case notification.KindOverbook:
+		var overbook notification.Overbook
+		if err := json.Unmarshal(n.Payload, &overbook); err != nil {
+			return fmt.Errorf("could not decode overbook notification: %w", err)
+		}
+
+		return a.commSrv.NotifyOverbookedMember(overbook.Request(n.GuildID), overbook.Clipped())
+	default:
+		return fmt.Errorf("unsupported kind of notification: %s", n.Kind)
+	}
+}
+
+// RetryDelay returns how long to wait after the given number of failed attempts,
+// doubling from half a minute up to half an hour.
+func RetryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, retryMaxDelay)
+}
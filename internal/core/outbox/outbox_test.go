@@ -0,0 +1,136 @@
+package outbox
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/member"
+	"spot-assistant/internal/core/dto/notification"
+	"spot-assistant/internal/core/dto/reservation"
+)
+
+func newTestOverbook(t *testing.T, id int64, attempts int) (*notification.Outbox, notification.Overbook) {
+	overbook := notification.NewOverbook(
+		&member.Member{ID: "overbooker-id", Nick: "overbooker"},
+		"Flimsy",
+		&reservation.ClippedOrRemovedReservation{
+			Original: &reservation.Reservation{ID: id, AuthorDiscordID: "author-id"},
+			New:      []*reservation.Reservation{},
+		},
+	)
+	payload, err := json.Marshal(overbook)
+	assert.NoError(t, err)
+
+	return &notification.Outbox{
+		ID:          id,
+		GuildID:     "guild-id",
+		RecipientID: "author-id",
+		Kind:        notification.KindOverbook,
+		Payload:     payload,
+		Attempts:    attempts,
+	}, overbook
+}
+
+func TestDispatchDue(t *testing.T) {
+	// given
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	sent, sentOverbook := newTestOverbook(t, 1, 1)
+	failing, failingOverbook := newTestOverbook(t, 2, 3)
+	exhausted, exhaustedOverbook := newTestOverbook(t, 3, notification.MaxAttempts)
+	outboxRepo := mocks.NewMockNotificationOutboxRepository(t)
+	outboxRepo.On("ClaimDueNotifications", mock.Anything, now, now.Add(DispatchLease), DispatchBatchSize).
+		Return([]*notification.Outbox{sent, failing, exhausted}, nil).Once()
+	outboxRepo.On("MarkNotificationSent", mock.Anything, int64(1)).Return(nil).Once()
+	outboxRepo.On("ScheduleNotificationRetry", mock.Anything, int64(2), now.Add(2*time.Minute), "connection reset").Return(nil).Once()
+	outboxRepo.On("MarkNotificationFailed", mock.Anything, int64(3), "connection reset").Return(nil).Once()
+	commSrv := mocks.NewMockCommunicationService(t)
+	commSrv.On("NotifyOverbookedMember", sentOverbook.Request("guild-id"), sentOverbook.Clipped()).Return(nil).Once()
+	commSrv.On("NotifyOverbookedMember", failingOverbook.Request("guild-id"), failingOverbook.Clipped()).
+		Return(errors.New("connection reset")).Once()
+	commSrv.On("NotifyOverbookedMember", exhaustedOverbook.Request("guild-id"), exhaustedOverbook.Clipped()).
+		Return(errors.New("connection reset")).Once()
+	adapter := NewAdapter(outboxRepo, commSrv)
+
+	// when
+	adapter.DispatchDue(now)
+
+	// then
+	outboxRepo.AssertExpectations(t)
+}
+
+func TestDispatchDueRestoresOverbookRequest(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	n, _ := newTestOverbook(t, 1, 1)
+	outboxRepo := mocks.NewMockNotificationOutboxRepository(t)
+	outboxRepo.On("ClaimDueNotifications", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*notification.Outbox{n}, nil).Once()
+	outboxRepo.On("MarkNotificationSent", mock.Anything, int64(1)).Return(nil).Once()
+	commSrv := mocks.NewMockCommunicationService(t)
+	var request book.BookRequest
+	var res *reservation.ClippedOrRemovedReservation
+	commSrv.On("NotifyOverbookedMember", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		request = args.Get(0).(book.BookRequest)
+		res = args.Get(1).(*reservation.ClippedOrRemovedReservation)
+	}).Return(nil).Once()
+	adapter := NewAdapter(outboxRepo, commSrv)
+
+	// when
+	adapter.DispatchDue(time.Now())
+
+	// then
+	assert.Equal("guild-id", request.Guild.ID)
+	assert.Equal("overbooker-id", request.Member.ID)
+	assert.Equal("overbooker", request.Member.Nick)
+	assert.Equal("Flimsy", request.Spot)
+	assert.Equal("author-id", res.Original.AuthorDiscordID)
+	assert.Empty(res.New)
+}
+
+func TestDispatchDueRetriesUnknownKinds(t *testing.T) {
+	// given
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	outboxRepo := mocks.NewMockNotificationOutboxRepository(t)
+	outboxRepo.On("ClaimDueNotifications", mock.Anything, now, mock.Anything, mock.Anything).
+		Return([]*notification.Outbox{{ID: 1, Kind: "unknown", Attempts: 1}}, nil).Once()
+	outboxRepo.On("ScheduleNotificationRetry", mock.Anything, int64(1), now.Add(RetryDelay(1)), "unsupported kind of notification: unknown").
+		Return(nil).Once()
+	adapter := NewAdapter(outboxRepo, mocks.NewMockCommunicationService(t))
+
+	// when
+	adapter.DispatchDue(now)
+
+	// then
+	outboxRepo.AssertExpectations(t)
+}
+
+func TestDispatchDueWhenClaimFails(t *testing.T) {
+	// given
+	outboxRepo := mocks.NewMockNotificationOutboxRepository(t)
+	outboxRepo.On("ClaimDueNotifications", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("connection refused")).Once()
+	commSrv := mocks.NewMockCommunicationService(t)
+	adapter := NewAdapter(outboxRepo, commSrv)
+
+	// when
+	adapter.DispatchDue(time.Now())
+
+	// then
+	commSrv.AssertNotCalled(t, "NotifyOverbookedMember", mock.Anything, mock.Anything)
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(30*time.Second, RetryDelay(1))
+	assert.Equal(time.Minute, RetryDelay(2))
+	assert.Equal(2*time.Minute, RetryDelay(3))
+	assert.Equal(30*time.Minute, RetryDelay(20))
+}
@@ -0,0 +1,26 @@
+package reliability
+
+import (
+	"go.uber.org/zap"
+
+	"spot-assistant/internal/ports"
+)
+
+type Adapter struct {
+	reliabilityRepo   ports.ReliabilityRepository
+	guildSettingsRepo ports.GuildSettingsRepository
+	log               *zap.SugaredLogger
+}
+
+func NewAdapter(reliabilityRepo ports.ReliabilityRepository, guildSettingsRepo ports.GuildSettingsRepository) *Adapter {
+	return &Adapter{
+		reliabilityRepo:   reliabilityRepo,
+		guildSettingsRepo: guildSettingsRepo,
+		log:               zap.NewNop().Sugar(),
+	}
+}
+
+func (a *Adapter) WithLogger(log *zap.SugaredLogger) *Adapter {
+	a.log = log.With("layer", "core", "name", "reliabilityService")
+	return a
+}
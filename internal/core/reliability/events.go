@@ -0,0 +1,61 @@
+package reliability
+
+import (
+	"context"
+	"time"
+
+	"spot-assistant/internal/core/dto/event"
+	"spot-assistant/internal/core/dto/reliability"
+)
+
+// HandleEvent records incidents of cancelled and overbooked reservations.
+func (a *Adapter) HandleEvent(e event.Event) {
+	now := time.Now()
+	switch e := e.(type) {
+	case event.ReservationCancelled:
+		a.recordLateCancellation(e, now)
+	case event.ReservationClipped:
+		a.recordAbandoned(e, now)
+	}
+}
+
+// recordLateCancellation records a cancellation of a reservation starting within reliability.LateCancellationWindow.
+func (a *Adapter) recordLateCancellation(e event.ReservationCancelled, now time.Time) {
+	res := e.Reservation.Reservation
+	if res.StartAt.Sub(now) > reliability.LateCancellationWindow {
+		return
+	}
+
+	a.recordIncident(&reliability.Incident{
+		GuildID:       e.GuildID(),
+		MemberID:      res.AuthorDiscordID,
+		ReservationID: res.ID,
+		Kind:          reliability.IncidentLateCancellation,
+		StartAt:       res.StartAt,
+		OccurredAt:    now,
+	})
+}
+
+// recordAbandoned records a reservation overbooked after it started. Reservations overbooked
+// before they start are not held against their owners.
+func (a *Adapter) recordAbandoned(e event.ReservationClipped, now time.Time) {
+	original := e.Clipped.Original
+	if original.StartAt.After(now) || original.AuthorDiscordID == e.Request.Member.ID {
+		return
+	}
+
+	a.recordIncident(&reliability.Incident{
+		GuildID:       e.GuildID(),
+		MemberID:      original.AuthorDiscordID,
+		ReservationID: original.ID,
+		Kind:          reliability.IncidentAbandoned,
+		StartAt:       original.StartAt,
+		OccurredAt:    now,
+	})
+}
+
+func (a *Adapter) recordIncident(incident *reliability.Incident) {
+	if err := a.reliabilityRepo.InsertIncident(context.Background(), incident); err != nil {
+		a.log.Errorf("could not record %s of reservation %d: %s", incident.Kind, incident.ReservationID, err)
+	}
+}
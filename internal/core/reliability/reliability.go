@@ -0,0 +1,18 @@
+package reliability
+
+import (
+	"context"
+	"time"
+
+	"spot-assistant/internal/core/dto/reliability"
+)
+
+// Score scores how reliably a member hunts on their reservations, by their history within reliability.Window before now.
+func (a *Adapter) Score(guildID, memberID string, now time.Time) (reliability.Score, error) {
+	history, err := a.reliabilityRepo.SelectMemberHistory(context.Background(), guildID, memberID, now.Add(-reliability.Window), now)
+	if err != nil {
+		return reliability.Score{}, err
+	}
+
+	return reliability.NewScore(history), nil
+}
@@ -0,0 +1,144 @@
+package reliability
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/factories"
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/event"
+	"spot-assistant/internal/core/dto/guildsettings"
+	"spot-assistant/internal/core/dto/reliability"
+	"spot-assistant/internal/core/dto/reservation"
+)
+
+func TestScore(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	now := time.Date(2021, 1, 31, 12, 0, 0, 0, time.UTC)
+	history := reliability.History{Reservations: 4, Attended: 3, NoShows: 1}
+	reliabilityRepo := mocks.NewMockReliabilityRepository(t)
+	reliabilityRepo.On("SelectMemberHistory", mock.Anything, "guild-id", "member-id", now.Add(-reliability.Window), now).Return(history, nil)
+	adapter := NewAdapter(reliabilityRepo, mocks.NewMockGuildSettingsRepository(t))
+
+	// when
+	score, err := adapter.Score("guild-id", "member-id", now)
+
+	// then
+	assert.NoError(err)
+	assert.Equal(75, score.Value)
+	assert.True(score.Known)
+}
+
+func TestHandleEventRecordsLateCancellations(t *testing.T) {
+	// given
+	guild, member := factories.CreateGuild(), factories.CreateMember()
+	cancelled := func(id int64, startAt time.Time) event.ReservationCancelled {
+		return event.ReservationCancelled{
+			Request: book.UnbookRequest{Guild: guild, Member: member, ReservationID: id},
+			Reservation: &reservation.ReservationWithSpot{
+				Reservation: reservation.Reservation{ID: id, AuthorDiscordID: member.ID, StartAt: startAt},
+			},
+		}
+	}
+	reliabilityRepo := mocks.NewMockReliabilityRepository(t)
+	reliabilityRepo.On("InsertIncident", mock.Anything, mock.MatchedBy(func(i *reliability.Incident) bool {
+		return i.ReservationID == 1 && i.Kind == reliability.IncidentLateCancellation && i.MemberID == member.ID && i.GuildID == guild.ID
+	})).Return(nil).Once()
+	adapter := NewAdapter(reliabilityRepo, mocks.NewMockGuildSettingsRepository(t))
+
+	// when
+	adapter.HandleEvent(cancelled(1, time.Now().Add(30*time.Minute)))
+	adapter.HandleEvent(cancelled(2, time.Now().Add(5*time.Hour)))
+
+	// then
+	reliabilityRepo.AssertNumberOfCalls(t, "InsertIncident", 1)
+}
+
+func TestHandleEventRecordsAbandonedReservations(t *testing.T) {
+	// given
+	guild, postman := factories.CreateGuild(), factories.CreateMember()
+	request := book.BookRequest{Guild: guild, Member: postman, Spot: "Flimsy"}
+	clipped := func(id int64, startAt time.Time) event.ReservationClipped {
+		return event.ReservationClipped{
+			Request: request,
+			Clipped: &reservation.ClippedOrRemovedReservation{
+				Original: &reservation.Reservation{ID: id, AuthorDiscordID: "owner-id", StartAt: startAt},
+			},
+		}
+	}
+	reliabilityRepo := mocks.NewMockReliabilityRepository(t)
+	reliabilityRepo.On("InsertIncident", mock.Anything, mock.MatchedBy(func(i *reliability.Incident) bool {
+		return i.ReservationID == 1 && i.Kind == reliability.IncidentAbandoned && i.MemberID == "owner-id"
+	})).Return(errors.New("connection reset")).Once()
+	adapter := NewAdapter(reliabilityRepo, mocks.NewMockGuildSettingsRepository(t))
+
+	// when
+	adapter.HandleEvent(clipped(1, time.Now().Add(-time.Hour)))
+	adapter.HandleEvent(clipped(2, time.Now().Add(time.Hour)))
+
+	// then
+	reliabilityRepo.AssertNumberOfCalls(t, "InsertIncident", 1)
+}
+
+func TestCheckBooking(t *testing.T) {
+	guild, member := factories.CreateGuild(), factories.CreateMember()
+	settings := guildsettings.Default(guild.ID)
+	settings.Reliability = guildsettings.ReliabilitySettings{MinScore: 60, MaxHoursAhead: 24}
+	unreliable := reliability.History{Reservations: 4, Attended: 1, NoShows: 3}
+
+	tests := []struct {
+		name           string
+		startIn        time.Duration
+		hasPermissions bool
+		settings       *guildsettings.GuildSettings
+		history        *reliability.History
+		err            error
+	}{
+		{"booking soon", 2 * time.Hour, false, settings, nil, nil},
+		{"booking ahead with a low score", 48 * time.Hour, false, settings, &unreliable, ErrLowReliability},
+		{"booking ahead with a good score", 48 * time.Hour, false, settings, &reliability.History{Reservations: 4, Attended: 4}, nil},
+		{"booking ahead without history", 48 * time.Hour, false, settings, &reliability.History{}, nil},
+		{"booking ahead with the privileged role", 48 * time.Hour, true, nil, nil, nil},
+		{"booking ahead without restrictions", 48 * time.Hour, false, guildsettings.Default(guild.ID), nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			guildSettingsRepo := mocks.NewMockGuildSettingsRepository(t)
+			if tt.settings != nil {
+				guildSettingsRepo.On("SelectGuildSettings", mock.Anything, guild.ID).Return(tt.settings, nil)
+			}
+			reliabilityRepo := mocks.NewMockReliabilityRepository(t)
+			if tt.history != nil {
+				reliabilityRepo.On("SelectMemberHistory", mock.Anything, guild.ID, member.ID, mock.Anything, mock.Anything).Return(*tt.history, nil)
+			}
+			adapter := NewAdapter(reliabilityRepo, guildSettingsRepo)
+			startAt := time.Now().Add(tt.startIn)
+
+			// when
+			err := adapter.CheckBooking(book.BookRequest{
+				Guild:          guild,
+				Member:         member,
+				StartAt:        startAt,
+				EndAt:          startAt.Add(2 * time.Hour),
+				HasPermissions: tt.hasPermissions,
+			})
+
+			// then
+			if tt.err != nil {
+				var rejection *book.Rejection
+				assert.ErrorAs(t, err, &rejection)
+				assert.ErrorIs(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
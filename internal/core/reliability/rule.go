@@ -0,0 +1,41 @@
+package reliability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"spot-assistant/internal/core/dto/book"
+)
+
+var ErrLowReliability = errors.New("your reliability score is too low")
+
+// CheckBooking stops members scoring below the minimum of the guild from booking further ahead
+// than the guild allows. Members with the privileged role are not restricted.
+func (a *Adapter) CheckBooking(request book.BookRequest) error {
+	if request.HasPermissions {
+		return nil
+	}
+
+	settings, err := a.guildSettingsRepo.SelectGuildSettings(context.Background(), request.Guild.ID)
+	if err != nil {
+		return fmt.Errorf("could not load guild settings: %w", err)
+	}
+	restriction := settings.Reliability
+	now := time.Now()
+	if restriction.MinScore == 0 || !request.StartAt.After(now.Add(time.Duration(restriction.MaxHoursAhead)*time.Hour)) {
+		return nil
+	}
+
+	score, err := a.Score(request.Guild.ID, request.Member.ID, now)
+	if err != nil {
+		return fmt.Errorf("could not score your reliability: %w", err)
+	}
+	if score.IsBelow(restriction.MinScore) {
+		return book.Reject(fmt.Errorf("%w: it is %d, below %d required to book more than %d hours ahead",
+			ErrLowReliability, score.Value, restriction.MinScore, restriction.MaxHoursAhead))
+	}
+
+	return nil
+}
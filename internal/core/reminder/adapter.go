@@ -0,0 +1,26 @@
+package reminder
+
+import (
+	"go.uber.org/zap"
+
+	"spot-assistant/internal/ports"
+)
+
+type Adapter struct {
+	reminderRepo ports.ReminderRepository
+	commSrv      ports.CommunicationService
+	log          *zap.SugaredLogger
+}
+
+func NewAdapter(reminderRepo ports.ReminderRepository, commSrv ports.CommunicationService) *Adapter {
+	return &Adapter{
+		reminderRepo: reminderRepo,
+		commSrv:      commSrv,
+		log:          zap.NewNop().Sugar(),
+	}
+}
+
+func (a *Adapter) WithLogger(log *zap.SugaredLogger) *Adapter {
+	a.log = log.With("layer", "core", "name", "reminderService")
+	return a
+}
@@ -0,0 +1,40 @@
+package reminder
+
+import (
+	"context"
+	"time"
+)
+
+// SendDueReminders reminds owners of reservations starting soon, or following a hunt on the same spot
+// which ends soon, whichever comes first. A hunt is claimed before its reminder is sent, so a reminder
+// is never sent twice, even by several instances, after a restart or after the reservation is overbooked.
+// A reminder that fails to be delivered is not retried.
+func (a *Adapter) SendDueReminders(now time.Time) {
+	ctx := context.Background()
+	reminders, err := a.reminderRepo.SelectDueReminders(ctx, now)
+	if err != nil {
+		a.log.Errorf("could not select due reminders: %s", err)
+
+		return
+	}
+
+	for _, r := range reminders {
+		claimed, err := a.reminderRepo.ClaimReminder(ctx, r.Reservation.Reservation)
+		if err != nil {
+			a.log.Errorf("could not claim reminder of reservation %d: %s", r.Reservation.Reservation.ID, err)
+
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := a.commSrv.NotifyReminder(r); err != nil {
+			a.log.Errorf("could not send reminder of reservation %d: %s", r.Reservation.Reservation.ID, err)
+		}
+	}
+
+	if err := a.reminderRepo.DeleteReminderClaimsBefore(ctx, now); err != nil {
+		a.log.Errorf("could not delete past reminder claims: %s", err)
+	}
+}
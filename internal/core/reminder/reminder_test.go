@@ -0,0 +1,58 @@
+package reminder
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/reminder"
+	"spot-assistant/internal/core/dto/reservation"
+)
+
+func newTestReminder(id int64) *reminder.Reminder {
+	return &reminder.Reminder{
+		Reservation: reservation.ReservationWithSpot{
+			Reservation: reservation.Reservation{ID: id, GuildID: "guild-id", AuthorDiscordID: "author-id"},
+		},
+		MinutesBefore: 15,
+	}
+}
+
+func TestSendDueReminders(t *testing.T) {
+	// given
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	claimed, alreadySent, failing := newTestReminder(1), newTestReminder(2), newTestReminder(3)
+	reminderRepo := mocks.NewMockReminderRepository(t)
+	reminderRepo.On("SelectDueReminders", mock.Anything, now).Return([]*reminder.Reminder{claimed, alreadySent, failing}, nil)
+	reminderRepo.On("ClaimReminder", mock.Anything, claimed.Reservation.Reservation).Return(true, nil)
+	reminderRepo.On("ClaimReminder", mock.Anything, alreadySent.Reservation.Reservation).Return(false, nil)
+	reminderRepo.On("ClaimReminder", mock.Anything, failing.Reservation.Reservation).Return(true, nil)
+	reminderRepo.On("DeleteReminderClaimsBefore", mock.Anything, now).Return(nil).Once()
+	commSrv := mocks.NewMockCommunicationService(t)
+	commSrv.On("NotifyReminder", claimed).Return(nil).Once()
+	commSrv.On("NotifyReminder", failing).Return(errors.New("cannot send messages to this user")).Once()
+	adapter := NewAdapter(reminderRepo, commSrv)
+
+	// when
+	adapter.SendDueReminders(now)
+
+	// then
+	commSrv.AssertNotCalled(t, "NotifyReminder", alreadySent)
+}
+
+func TestSendDueRemindersWhenSelectFails(t *testing.T) {
+	// given
+	reminderRepo := mocks.NewMockReminderRepository(t)
+	reminderRepo.On("SelectDueReminders", mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+	commSrv := mocks.NewMockCommunicationService(t)
+	adapter := NewAdapter(reminderRepo, commSrv)
+
+	// when
+	adapter.SendDueReminders(time.Now())
+
+	// then
+	reminderRepo.AssertNotCalled(t, "ClaimReminder", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,30 @@
+package spotrequirements
+
+import (
+	"go.uber.org/zap"
+
+	"spot-assistant/internal/ports"
+)
+
+type Adapter struct {
+	guildSettingsRepo ports.GuildSettingsRepository
+	spotRepo          ports.SpotRepository
+	characterRepo     ports.CharacterRepository
+	api               ports.WorldApi
+	log               *zap.SugaredLogger
+}
+
+func NewAdapter(guildSettingsRepo ports.GuildSettingsRepository, spotRepo ports.SpotRepository, characterRepo ports.CharacterRepository, api ports.WorldApi) *Adapter {
+	return &Adapter{
+		guildSettingsRepo: guildSettingsRepo,
+		spotRepo:          spotRepo,
+		characterRepo:     characterRepo,
+		api:               api,
+		log:               zap.NewNop().Sugar(),
+	}
+}
+
+func (a *Adapter) WithLogger(log *zap.SugaredLogger) *Adapter {
+	a.log = log.With("layer", "core", "name", "spotRequirementsService")
+	return a
+}
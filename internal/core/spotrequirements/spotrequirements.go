@@ -0,0 +1,101 @@
+package spotrequirements
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/spot"
+	"spot-assistant/internal/core/dto/world"
+)
+
+var (
+	ErrNoVerifiedCharacter = errors.New("you need a verified character to book")
+	ErrRequirementsNotMet  = errors.New("none of your characters meets the requirements of")
+)
+
+// SetRequirements replaces level and vocation requirements of a spot.
+func (a *Adapter) SetRequirements(spotName string, requirements spot.Requirements) (*spot.Spot, error) {
+	if err := requirements.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	s, err := a.spotRepo.SelectSpotByName(ctx, spotName)
+	if err != nil {
+		return nil, fmt.Errorf("could not find spot called %s: %w", spotName, err)
+	}
+
+	updated, err := a.spotRepo.UpdateSpotRequirements(ctx, s.Name, requirements)
+	if err != nil {
+		return nil, fmt.Errorf("could not save requirements of %s: %w", s.Name, err)
+	}
+	if !updated {
+		return nil, fmt.Errorf("could not find spot called %s", spotName)
+	}
+	s.Requirements = requirements
+
+	return s, nil
+}
+
+// CheckBooking lets members book a spot, only if one of their verified characters meets its level
+// and vocation requirements, as found in the character profiles, if the guild enabled requirements.
+// Members with the privileged role are not restricted. Members are let book, if some of their
+// characters could not be looked up, rather than nobody being able to book while the world API is unavailable.
+func (a *Adapter) CheckBooking(request book.BookRequest) error {
+	if request.HasPermissions {
+		return nil
+	}
+
+	ctx := context.Background()
+	settings, err := a.guildSettingsRepo.SelectGuildSettings(ctx, request.Guild.ID)
+	if err != nil {
+		return fmt.Errorf("could not load guild settings: %w", err)
+	}
+	if !settings.SpotRequirements {
+		return nil
+	}
+
+	s, err := a.spotRepo.SelectSpotByName(ctx, request.Spot)
+	if err != nil {
+		return fmt.Errorf("could not find spot called %s: %w", request.Spot, err)
+	}
+	if s.Requirements.IsZero() {
+		return nil
+	}
+
+	characters, err := a.characterRepo.SelectMemberCharacters(ctx, request.Guild.ID, request.Member.ID)
+	if err != nil {
+		return fmt.Errorf("could not load your characters: %w", err)
+	}
+
+	checked := make([]string, 0, len(characters))
+	for _, c := range characters {
+		if !c.IsVerified() {
+			continue
+		}
+
+		profile, err := a.api.GetCharacter(c.Name)
+		if errors.Is(err, world.ErrCharacterNotFound) {
+			continue
+		}
+		if err != nil {
+			a.log.With("guild.ID", request.Guild.ID, "member.ID", request.Member.ID).
+				Warnf("could not look up %s, booking %s is not restricted: %s", c.Name, s.Name, err)
+			return nil
+		}
+		if s.Requirements.Allows(profile.Level, profile.Vocation) {
+			return nil
+		}
+		checked = append(checked, fmt.Sprintf("%s is a level %d %s", profile.Name, profile.Level, profile.Vocation))
+	}
+
+	if len(checked) == 0 {
+		return book.Reject(fmt.Errorf("%w %s (%s), register one with `/character add` and verify it with `/character verify`",
+			ErrNoVerifiedCharacter, s.Name, s.Requirements))
+	}
+
+	return book.Reject(fmt.Errorf("%w %s (%s): %s", ErrRequirementsNotMet, s.Name, s.Requirements, strings.Join(checked, ", ")))
+}
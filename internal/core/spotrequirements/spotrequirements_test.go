@@ -0,0 +1,128 @@
+package spotrequirements
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/factories"
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/character"
+	"spot-assistant/internal/core/dto/guildsettings"
+	"spot-assistant/internal/core/dto/spot"
+	"spot-assistant/internal/core/dto/world"
+)
+
+func TestCheckBooking(t *testing.T) {
+	guild, member := factories.CreateGuild(), factories.CreateMember()
+	settings := guildsettings.Default(guild.ID)
+	settings.SpotRequirements = true
+	verified := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	flimsy := &spot.Spot{Name: "Flimsy", Requirements: spot.Requirements{MinLevel: 100, MaxLevel: 200, Vocations: []string{"Knight"}}}
+	knight := &world.Character{Name: "Asar Cham", Level: 150, Vocation: "Elite Knight"}
+	druid := &world.Character{Name: "Mariysz", Level: 150, Vocation: "Elder Druid"}
+
+	tests := []struct {
+		name           string
+		hasPermissions bool
+		settings       *guildsettings.GuildSettings
+		spot           *spot.Spot
+		characters     []*character.Character
+		profiles       map[string]*world.Character
+		apiErr         error
+		err            error
+	}{
+		{"with requirements disabled", false, guildsettings.Default(guild.ID), nil, nil, nil, nil, nil},
+		{"with the privileged role", true, nil, nil, nil, nil, nil, nil},
+		{"without requirements of the spot", false, settings, &spot.Spot{Name: "Flimsy"}, nil, nil, nil, nil},
+		{"with a character meeting the requirements", false, settings, flimsy,
+			[]*character.Character{{Name: "Mariysz", VerifiedAt: verified}, {Name: "Asar Cham", VerifiedAt: verified}},
+			map[string]*world.Character{"Mariysz": druid, "Asar Cham": knight}, nil, nil},
+		{"without a character meeting the requirements", false, settings, flimsy,
+			[]*character.Character{{Name: "Mariysz", VerifiedAt: verified}, {Name: "Asar Cham"}},
+			map[string]*world.Character{"Mariysz": druid}, nil, ErrRequirementsNotMet},
+		{"without a verified character", false, settings, flimsy,
+			[]*character.Character{{Name: "Asar Cham"}}, nil, nil, ErrNoVerifiedCharacter},
+		{"with the world API unavailable", false, settings, flimsy,
+			[]*character.Character{{Name: "Asar Cham", VerifiedAt: verified}}, map[string]*world.Character{"Asar Cham": nil}, errors.New("timeout"), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			guildSettingsRepo := mocks.NewMockGuildSettingsRepository(t)
+			if tt.settings != nil {
+				guildSettingsRepo.On("SelectGuildSettings", mock.Anything, guild.ID).Return(tt.settings, nil)
+			}
+			spotRepo := mocks.NewMockSpotRepository(t)
+			if tt.spot != nil {
+				spotRepo.On("SelectSpotByName", mock.Anything, "Flimsy").Return(tt.spot, nil)
+			}
+			characterRepo := mocks.NewMockCharacterRepository(t)
+			if tt.characters != nil {
+				characterRepo.On("SelectMemberCharacters", mock.Anything, guild.ID, member.ID).Return(tt.characters, nil)
+			}
+			api := mocks.NewMockWorldApi(t)
+			for name, profile := range tt.profiles {
+				api.On("GetCharacter", name).Return(profile, tt.apiErr)
+			}
+			adapter := NewAdapter(guildSettingsRepo, spotRepo, characterRepo, api)
+
+			// when
+			err := adapter.CheckBooking(book.BookRequest{Guild: guild, Member: member, Spot: "Flimsy", HasPermissions: tt.hasPermissions})
+
+			// then
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var rejection *book.Rejection
+			assert.ErrorAs(t, err, &rejection)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestCheckBookingDescribesCharacters(t *testing.T) {
+	// given
+	guild, member := factories.CreateGuild(), factories.CreateMember()
+	settings := guildsettings.Default(guild.ID)
+	settings.SpotRequirements = true
+	guildSettingsRepo := mocks.NewMockGuildSettingsRepository(t)
+	guildSettingsRepo.On("SelectGuildSettings", mock.Anything, guild.ID).Return(settings, nil)
+	spotRepo := mocks.NewMockSpotRepository(t)
+	spotRepo.On("SelectSpotByName", mock.Anything, "flimsy").Return(&spot.Spot{Name: "Flimsy", Requirements: spot.Requirements{MinLevel: 100}}, nil)
+	characterRepo := mocks.NewMockCharacterRepository(t)
+	characterRepo.On("SelectMemberCharacters", mock.Anything, guild.ID, member.ID).Return([]*character.Character{{Name: "Mariysz", VerifiedAt: time.Now()}}, nil)
+	api := mocks.NewMockWorldApi(t)
+	api.On("GetCharacter", "Mariysz").Return(&world.Character{Name: "Mariysz", Level: 80, Vocation: "Druid"}, nil)
+	adapter := NewAdapter(guildSettingsRepo, spotRepo, characterRepo, api)
+
+	// when
+	err := adapter.CheckBooking(book.BookRequest{Guild: guild, Member: member, Spot: "flimsy"})
+
+	// then
+	assert.EqualError(t, err, "none of your characters meets the requirements of Flimsy (level 100 or higher): Mariysz is a level 80 Druid")
+}
+
+func TestSetRequirements(t *testing.T) {
+	// given
+	requirements := spot.Requirements{MinLevel: 100, Vocations: []string{"Knight"}}
+	spotRepo := mocks.NewMockSpotRepository(t)
+	spotRepo.On("SelectSpotByName", mock.Anything, "flimsy").Return(&spot.Spot{ID: 1, Name: "Flimsy"}, nil)
+	spotRepo.On("UpdateSpotRequirements", mock.Anything, "Flimsy", requirements).Return(true, nil)
+	adapter := NewAdapter(mocks.NewMockGuildSettingsRepository(t), spotRepo, mocks.NewMockCharacterRepository(t), mocks.NewMockWorldApi(t))
+
+	// when
+	s, err := adapter.SetRequirements("flimsy", requirements)
+	_, invalidErr := adapter.SetRequirements("flimsy", spot.Requirements{MinLevel: 200, MaxLevel: 100})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, &spot.Spot{ID: 1, Name: "Flimsy", Requirements: requirements}, s)
+	assert.Error(t, invalidErr)
+}
@@ -5,15 +5,17 @@ import (
 )
 
 type Adapter struct {
-	service     ports.ChartAdapter
-	onlineCheck ports.OnlineCheckService
+	service      ports.ChartAdapter
+	onlineCheck  ports.OnlineCheckService
+	settingsRepo ports.GuildSettingsRepository
 	//log     *zap.SugaredLogger
 }
 
-func NewAdapter(srv ports.ChartAdapter, onlineCheck ports.OnlineCheckService) *Adapter {
+func NewAdapter(srv ports.ChartAdapter, onlineCheck ports.OnlineCheckService, settingsRepo ports.GuildSettingsRepository) *Adapter {
 	return &Adapter{
-		service:     srv,
-		onlineCheck: onlineCheck,
+		service:      srv,
+		onlineCheck:  onlineCheck,
+		settingsRepo: settingsRepo,
 	}
 }
 
@@ -0,0 +1,28 @@
+package summary
+
+import (
+	"spot-assistant/internal/core/dto/guildsettings"
+	dto "spot-assistant/internal/core/dto/summary"
+)
+
+// applyBranding replaces texts and looks of the summary with the ones chosen by the guild.
+// Anything the guild has not chosen is left as it is.
+func applyBranding(sum *dto.Summary, branding guildsettings.SummaryBranding) {
+	if branding.Title != "" {
+		sum.Title = branding.Title
+	}
+	if branding.URL != "" {
+		sum.URL = branding.URL
+	}
+	if branding.Description != "" {
+		sum.Description = branding.Description
+	}
+	if branding.PreMessage != "" {
+		sum.PreMessage = branding.PreMessage
+	}
+	if branding.HidePreMessage {
+		sum.PreMessage = ""
+	}
+	sum.ThumbnailURL = branding.ThumbnailURL
+	sum.Color = branding.Color
+}
@@ -0,0 +1,48 @@
+package summary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/guildsettings"
+)
+
+func TestApplyBranding(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	adapter := NewAdapter(new(mocks.MockChartAdapter), new(mocks.MockOnlineCheckService), new(mocks.MockGuildSettingsRepository))
+	sum := adapter.BaseSummary()
+
+	// when
+	applyBranding(sum, guildsettings.SummaryBranding{
+		Title:        "Our Guild",
+		Description:  "Our hunts.",
+		ThumbnailURL: "https://example.com/logo.png",
+		Color:        0xff8800,
+		PreMessage:   "Welcome!",
+	})
+
+	// then
+	assert.Equal("Our Guild", sum.Title)
+	assert.Equal("https://tibialoot.com", sum.URL)
+	assert.Equal("Our hunts.", sum.Description)
+	assert.Equal("https://example.com/logo.png", sum.ThumbnailURL)
+	assert.Equal(0xff8800, sum.Color)
+	assert.Equal("Welcome!", sum.PreMessage)
+}
+
+func TestApplyBrandingWithoutPreMessage(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	adapter := NewAdapter(new(mocks.MockChartAdapter), new(mocks.MockOnlineCheckService), new(mocks.MockGuildSettingsRepository))
+	sum := adapter.BaseSummary()
+
+	// when
+	applyBranding(sum, guildsettings.SummaryBranding{PreMessage: "Welcome!", HidePreMessage: true})
+
+	// then
+	assert.Empty(sum.PreMessage)
+	assert.Equal("TibiaLoot.com - Spot Assistant", sum.Title)
+}
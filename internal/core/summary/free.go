@@ -0,0 +1,69 @@
+package summary
+
+import (
+	"strings"
+	"time"
+
+	"spot-assistant/internal/core/dto/reservation"
+	dto "spot-assistant/internal/core/dto/summary"
+)
+
+// FreeSoonWindow is how soon a spot has to become free to be listed as free soon.
+const FreeSoonWindow = time.Hour
+
+// freeSpots splits favourite spots into the ones free now and the ones becoming free soon.
+// Spots hunted on for longer than FreeSoonWindow are left out. Reservations are expected
+// to be sorted by their start.
+func freeSpots(favourites []string, spotsToReservations map[string][]*reservation.Reservation, now time.Time) ([]dto.FreeSpot, []dto.FreeSpot) {
+	reservationsBySpot := make(map[string][]*reservation.Reservation, len(spotsToReservations))
+	for spotName, reservations := range spotsToReservations {
+		reservationsBySpot[strings.ToLower(spotName)] = reservations
+	}
+
+	freeNow := make([]dto.FreeSpot, 0)
+	freeSoon := make([]dto.FreeSpot, 0)
+	for _, favourite := range favourites {
+		reservations := reservationsBySpot[strings.ToLower(favourite)]
+
+		from := occupiedUntil(reservations, now)
+		if from.After(now.Add(FreeSoonWindow)) {
+			continue
+		}
+
+		free := dto.FreeSpot{Spot: favourite, Until: nextStartAfter(reservations, from)}
+		if from.After(now) {
+			free.From = from
+			freeSoon = append(freeSoon, free)
+		} else {
+			freeNow = append(freeNow, free)
+		}
+	}
+
+	return freeNow, freeSoon
+}
+
+// occupiedUntil returns when the reservation in progress ends, following reservations
+// starting right after it. Returns now, if the spot is not occupied.
+func occupiedUntil(reservations []*reservation.Reservation, now time.Time) time.Time {
+	until := now
+	for _, res := range reservations {
+		if res.StartAt.After(until) {
+			break
+		}
+		if res.EndAt.After(until) {
+			until = res.EndAt
+		}
+	}
+
+	return until
+}
+
+func nextStartAfter(reservations []*reservation.Reservation, moment time.Time) time.Time {
+	for _, res := range reservations {
+		if !res.StartAt.Before(moment) {
+			return res.StartAt
+		}
+	}
+
+	return time.Time{}
+}
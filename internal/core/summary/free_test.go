@@ -0,0 +1,58 @@
+package summary
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/reservation"
+	dto "spot-assistant/internal/core/dto/summary"
+)
+
+func TestFreeSpots(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(hour, minute int) time.Time {
+		return time.Date(2021, 1, 1, hour, minute, 0, 0, time.UTC)
+	}
+	spotsToReservations := map[string][]*reservation.Reservation{
+		"Flimsy": {
+			{StartAt: at(14, 0), EndAt: at(16, 0)},
+		},
+		"Hero Cave": {
+			{StartAt: at(11, 0), EndAt: at(12, 15)},
+			{StartAt: at(12, 15), EndAt: at(12, 30)},
+			{StartAt: at(15, 0), EndAt: at(17, 0)},
+		},
+		"Yalahar": {
+			{StartAt: at(11, 0), EndAt: at(15, 0)},
+		},
+	}
+	favourites := []string{"flimsy", "Hero Cave", "Yalahar", "Issavi"}
+
+	// when
+	freeNow, freeSoon := freeSpots(favourites, spotsToReservations, now)
+
+	// then
+	assert.Equal([]dto.FreeSpot{
+		{Spot: "flimsy", Until: at(14, 0)},
+		{Spot: "Issavi"},
+	}, freeNow)
+	assert.Equal([]dto.FreeSpot{
+		{Spot: "Hero Cave", From: at(12, 30), Until: at(15, 0)},
+	}, freeSoon)
+}
+
+func TestFreeSpotsWithoutFavourites(t *testing.T) {
+	// given
+	assert := assert.New(t)
+
+	// when
+	freeNow, freeSoon := freeSpots(nil, map[string][]*reservation.Reservation{}, time.Now())
+
+	// then
+	assert.Empty(freeNow)
+	assert.Empty(freeSoon)
+}
@@ -16,7 +16,7 @@ func TestMapReservation(t *testing.T) {
 	assert := assert.New(t)
 	chartSrvMock := new(mocks.MockChartAdapter)
 	mockOnlineCheckService := new(mocks.MockOnlineCheckService)
-	adapter := NewAdapter(chartSrvMock, mockOnlineCheckService)
+	adapter := NewAdapter(chartSrvMock, mockOnlineCheckService, new(mocks.MockGuildSettingsRepository))
 	input := &reservation.Reservation{
 		Author:  "test author",
 		StartAt: time.Now(),
@@ -24,7 +24,7 @@ func TestMapReservation(t *testing.T) {
 		GuildID: "guild1",
 	}
 	// mock PlayerStatus to return Online for this author
-	mockOnlineCheckService.On("PlayerStatus", input.GuildID, input.Author).Return(dto.Online)
+	mockOnlineCheckService.On("PlayerStatus", input.GuildID, input.AuthorDiscordID, input.Author).Return(dto.Online)
 
 	// when
 	res := adapter.MapReservation(input)
@@ -42,7 +42,7 @@ func TestMapReservations(t *testing.T) {
 	assert := assert.New(t)
 	chartSrvMock := new(mocks.MockChartAdapter)
 	mockOnlineCheckService := new(mocks.MockOnlineCheckService)
-	adapter := NewAdapter(chartSrvMock, mockOnlineCheckService)
+	adapter := NewAdapter(chartSrvMock, mockOnlineCheckService, new(mocks.MockGuildSettingsRepository))
 	input := []*reservation.Reservation{
 		{
 			Author:  "test author",
@@ -58,8 +58,8 @@ func TestMapReservations(t *testing.T) {
 		},
 	}
 	// mock PlayerStatus for both authors
-	mockOnlineCheckService.On("PlayerStatus", input[0].GuildID, input[0].Author).Return(dto.Online)
-	mockOnlineCheckService.On("PlayerStatus", input[1].GuildID, input[1].Author).Return(dto.Offline)
+	mockOnlineCheckService.On("PlayerStatus", input[0].GuildID, input[0].AuthorDiscordID, input[0].Author).Return(dto.Online)
+	mockOnlineCheckService.On("PlayerStatus", input[1].GuildID, input[1].AuthorDiscordID, input[1].Author).Return(dto.Offline)
 
 	// when
 	res := adapter.MapReservations(input)
@@ -1,12 +1,14 @@
 package summary
 
 import (
+	"context"
 	"fmt"
 	"slices"
 	"time"
 
 	commonStrings "spot-assistant/internal/common/strings"
 	"spot-assistant/internal/common/version"
+	"spot-assistant/internal/core/dto/guildsettings"
 	"spot-assistant/internal/core/dto/reservation"
 	dto "spot-assistant/internal/core/dto/summary"
 )
@@ -23,8 +25,46 @@ func (a *Adapter) BaseSummary() *dto.Summary {
 	}
 }
 
-func (a *Adapter) PrepareSummary(reservations []*reservation.ReservationWithSpot) (*dto.Summary, error) {
+// PrepareSummary prepares the summary of reservations, along with the charts chosen by the guild.
+func (a *Adapter) PrepareSummary(guildID string, reservations []*reservation.ReservationWithSpot) (*dto.Summary, error) {
+	settings, err := a.settingsRepo.SelectGuildSettings(context.Background(), guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := a.prepareLedger(settings, reservations)
+	if err = a.renderCharts(settings, sum); err != nil {
+		return nil, err
+	}
+
+	return sum, nil
+}
+
+// PrepareLedger prepares the summary of reservations without rendering its charts,
+// so it can be compared with the previous one cheaply.
+func (a *Adapter) PrepareLedger(guildID string, reservations []*reservation.ReservationWithSpot) (*dto.Summary, error) {
+	settings, err := a.settingsRepo.SelectGuildSettings(context.Background(), guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.prepareLedger(settings, reservations), nil
+}
+
+// RenderCharts renders the charts chosen by the guild for a summary prepared by PrepareLedger.
+func (a *Adapter) RenderCharts(guildID string, sum *dto.Summary) error {
+	settings, err := a.settingsRepo.SelectGuildSettings(context.Background(), guildID)
+	if err != nil {
+		return err
+	}
+
+	return a.renderCharts(settings, sum)
+}
+
+func (a *Adapter) prepareLedger(settings *guildsettings.GuildSettings, reservations []*reservation.ReservationWithSpot) *dto.Summary {
 	sum := a.BaseSummary()
+	applyBranding(sum, settings.Branding)
+	sum.Layout = settings.SummaryLayout
 
 	spotsToReservations := a.mapToSpotsToReservations(reservations)
 
@@ -35,14 +75,7 @@ func (a *Adapter) PrepareSummary(reservations []*reservation.ReservationWithSpot
 		spotsToCounts[spotName] = float64(len(resList))
 		spotNamesAlphabetically = append(spotNamesAlphabetically, spotName)
 	}
-
-	// Chart generation
 	sum.LegendValues = a.mapToLegendValues(spotsToCounts)
-	img, err := a.newChart(sum.LegendValues)
-	if err != nil {
-		return nil, err
-	}
-	sum.Chart = img
 
 	slices.Sort(spotNamesAlphabetically)
 	ledger := make(dto.Ledger, len(spotNamesAlphabetically))
@@ -53,8 +86,30 @@ func (a *Adapter) PrepareSummary(reservations []*reservation.ReservationWithSpot
 		}
 	}
 	sum.Ledger = ledger
+	sum.FreeNow, sum.FreeSoon = freeSpots(settings.FavouriteSpots, spotsToReservations, time.Now())
 
-	return sum, nil
+	return sum
+}
+
+// renderCharts renders the pie chart and the timeline of the summary, as chosen by the guild.
+func (a *Adapter) renderCharts(settings *guildsettings.GuildSettings, sum *dto.Summary) error {
+	if settings.SummaryChart.HasPie() && len(sum.LegendValues) > 0 {
+		img, err := a.newChart(sum.LegendValues)
+		if err != nil {
+			return err
+		}
+		sum.Chart = img
+	}
+
+	if settings.SummaryChart.HasTimeline() && len(sum.Ledger) > 0 {
+		img, err := a.service.NewTimeline(a.newTimeline(sum.Ledger, time.Now()))
+		if err != nil {
+			return err
+		}
+		sum.TimelineChart = img
+	}
+
+	return nil
 }
 
 func (a *Adapter) mapToSpotsToReservations(reservations []*reservation.ReservationWithSpot) map[string][]*reservation.Reservation {
@@ -10,6 +10,7 @@ import (
 
 	"spot-assistant/internal/common/collections"
 	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/guildsettings"
 	"spot-assistant/internal/core/dto/reservation"
 	dto "spot-assistant/internal/core/dto/summary"
 )
@@ -19,7 +20,8 @@ func TestBaseSummary(t *testing.T) {
 	assert := assert.New(t)
 	mockChartAdapter := new(mocks.MockChartAdapter)
 	mockOnlineCheckService := new(mocks.MockOnlineCheckService)
-	adapter := NewAdapter(mockChartAdapter, mockOnlineCheckService)
+	mockSettingsRepo := new(mocks.MockGuildSettingsRepository)
+	adapter := NewAdapter(mockChartAdapter, mockOnlineCheckService, mockSettingsRepo)
 
 	// when
 	summary := adapter.BaseSummary()
@@ -37,7 +39,8 @@ func TestPrepareSummary(t *testing.T) {
 	assert := assert.New(t)
 	mockChartAdapter := new(mocks.MockChartAdapter)
 	mockOnlineCheckService := new(mocks.MockOnlineCheckService)
-	adapter := NewAdapter(mockChartAdapter, mockOnlineCheckService)
+	mockSettingsRepo := new(mocks.MockGuildSettingsRepository)
+	adapter := NewAdapter(mockChartAdapter, mockOnlineCheckService, mockSettingsRepo)
 	input := []*reservation.ReservationWithSpot{
 		{
 			Reservation: reservation.Reservation{
@@ -87,12 +90,14 @@ func TestPrepareSummary(t *testing.T) {
 	})
 
 	// mock PlayerStatus for authors
-	mockOnlineCheckService.On("PlayerStatus", "guild1", "test author").Return(dto.Online)
-	mockOnlineCheckService.On("PlayerStatus", "guild1", "test author 2").Return(dto.Offline)
+	mockOnlineCheckService.On("PlayerStatus", "guild1", mock.Anything, "test author").Return(dto.Online)
+	mockOnlineCheckService.On("PlayerStatus", "guild1", mock.Anything, "test author 2").Return(dto.Offline)
+
+	mockSettingsRepo.On("SelectGuildSettings", mock.Anything, "guild1").Return(guildsettings.Default("guild1"), nil)
 
 	// when
 	mockChartAdapter.On("NewChart", values, legend).Return([]byte{123}, nil)
-	summary, err := adapter.PrepareSummary(input)
+	summary, err := adapter.PrepareSummary("guild1", input)
 
 	// assert
 	assert.Nil(err)
@@ -132,7 +137,8 @@ func TestPrepareSummaryTruncated(t *testing.T) {
 	assert := assert.New(t)
 	mockChartAdapter := new(mocks.MockChartAdapter)
 	mockOnlineCheckService := new(mocks.MockOnlineCheckService)
-	adapter := NewAdapter(mockChartAdapter, mockOnlineCheckService)
+	mockSettingsRepo := new(mocks.MockGuildSettingsRepository)
+	adapter := NewAdapter(mockChartAdapter, mockOnlineCheckService, mockSettingsRepo)
 
 	input := []*reservation.ReservationWithSpot{}
 	for ind := 0; ind < 2*MAX_CHART_RESPAWNS; ind++ {
@@ -163,11 +169,12 @@ func TestPrepareSummaryTruncated(t *testing.T) {
 
 	// mock PlayerStatus for all authors
 	for _, r := range input {
-		mockOnlineCheckService.On("PlayerStatus", "guild1", r.Author).Return(dto.Offline)
+		mockOnlineCheckService.On("PlayerStatus", "guild1", mock.Anything, r.Author).Return(dto.Offline)
 	}
 
+	mockSettingsRepo.On("SelectGuildSettings", mock.Anything, "guild1").Return(guildsettings.Default("guild1"), nil)
 	mockChartAdapter.On("NewChart", mock.AnythingOfType("[]float64"), mock.AnythingOfType("[]string")).Return([]byte{123}, nil)
-	summary, err := adapter.PrepareSummary(input)
+	summary, err := adapter.PrepareSummary("guild1", input)
 
 	// assert
 	assert.Nil(err)
@@ -189,3 +196,112 @@ func TestPrepareSummaryTruncated(t *testing.T) {
 		}
 	}
 }
+
+func TestPrepareSummaryWithTimelineOnly(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mockChartAdapter := new(mocks.MockChartAdapter)
+	mockOnlineCheckService := new(mocks.MockOnlineCheckService)
+	mockSettingsRepo := new(mocks.MockGuildSettingsRepository)
+	adapter := NewAdapter(mockChartAdapter, mockOnlineCheckService, mockSettingsRepo)
+	input := []*reservation.ReservationWithSpot{
+		{
+			Reservation: reservation.Reservation{
+				Author:  "test author",
+				StartAt: time.Now(),
+				EndAt:   time.Now().Add(2 * time.Hour),
+				GuildID: "guild1",
+			},
+			Spot: reservation.Spot{
+				Name: "test-1",
+			},
+		},
+	}
+	mockSettingsRepo.On("SelectGuildSettings", mock.Anything, "guild1").Return(&guildsettings.GuildSettings{
+		GuildID:      "guild1",
+		SummaryChart: guildsettings.SummaryChartTimeline,
+	}, nil)
+	mockOnlineCheckService.On("PlayerStatus", "guild1", mock.Anything, "test author").Return(dto.Online)
+	mockChartAdapter.On("NewTimeline", mock.AnythingOfType("summary.Timeline")).Return([]byte{234}, nil)
+
+	// when
+	summary, err := adapter.PrepareSummary("guild1", input)
+
+	// assert
+	assert.Nil(err)
+	assert.Nil(summary.Chart)
+	assert.Equal([]byte{234}, summary.TimelineChart)
+	mockChartAdapter.AssertNotCalled(t, "NewChart", mock.Anything, mock.Anything)
+}
+
+func TestPrepareSummaryWithFavouriteSpots(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mockChartAdapter := new(mocks.MockChartAdapter)
+	mockSettingsRepo := new(mocks.MockGuildSettingsRepository)
+	adapter := NewAdapter(mockChartAdapter, new(mocks.MockOnlineCheckService), mockSettingsRepo)
+	mockSettingsRepo.On("SelectGuildSettings", mock.Anything, "guild1").Return(&guildsettings.GuildSettings{
+		GuildID:        "guild1",
+		SummaryChart:   guildsettings.SummaryChartBoth,
+		FavouriteSpots: []string{"test-1"},
+	}, nil)
+
+	// when
+	summary, err := adapter.PrepareSummary("guild1", []*reservation.ReservationWithSpot{})
+
+	// assert
+	assert.Nil(err)
+	assert.Empty(summary.Ledger)
+	assert.Equal([]dto.FreeSpot{{Spot: "test-1"}}, summary.FreeNow)
+	assert.Empty(summary.FreeSoon)
+	mockChartAdapter.AssertNotCalled(t, "NewChart", mock.Anything, mock.Anything)
+	mockChartAdapter.AssertNotCalled(t, "NewTimeline", mock.Anything)
+}
+
+func TestPrepareLedgerAndRenderCharts(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mockChartAdapter := new(mocks.MockChartAdapter)
+	mockOnlineCheckService := new(mocks.MockOnlineCheckService)
+	mockSettingsRepo := new(mocks.MockGuildSettingsRepository)
+	adapter := NewAdapter(mockChartAdapter, mockOnlineCheckService, mockSettingsRepo)
+	input := []*reservation.ReservationWithSpot{
+		{
+			Reservation: reservation.Reservation{
+				Author:  "test author",
+				StartAt: time.Now(),
+				EndAt:   time.Now().Add(2 * time.Hour),
+				GuildID: "guild1",
+			},
+			Spot: reservation.Spot{
+				Name: "test-1",
+			},
+		},
+	}
+	mockSettingsRepo.On("SelectGuildSettings", mock.Anything, "guild1").Return(&guildsettings.GuildSettings{
+		GuildID:      "guild1",
+		SummaryChart: guildsettings.SummaryChartBoth,
+	}, nil)
+	mockOnlineCheckService.On("PlayerStatus", "guild1", mock.Anything, "test author").Return(dto.Online)
+	mockChartAdapter.On("NewChart", []float64{1}, []string{"test-1"}).Return([]byte{123}, nil)
+	mockChartAdapter.On("NewTimeline", mock.AnythingOfType("summary.Timeline")).Return([]byte{234}, nil)
+
+	// when
+	summary, err := adapter.PrepareLedger("guild1", input)
+
+	// assert
+	assert.Nil(err)
+	assert.Len(summary.Ledger, 1)
+	assert.Nil(summary.Chart)
+	assert.Nil(summary.TimelineChart)
+	mockChartAdapter.AssertNotCalled(t, "NewChart", mock.Anything, mock.Anything)
+	mockChartAdapter.AssertNotCalled(t, "NewTimeline", mock.Anything)
+
+	// when
+	err = adapter.RenderCharts("guild1", summary)
+
+	// assert
+	assert.Nil(err)
+	assert.Equal([]byte{123}, []byte(summary.Chart))
+	assert.Equal([]byte{234}, []byte(summary.TimelineChart))
+}
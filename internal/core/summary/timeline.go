@@ -0,0 +1,55 @@
+package summary
+
+import (
+	"time"
+
+	dto "spot-assistant/internal/core/dto/summary"
+)
+
+// ServerSaveHour is the hour of the daily server save, in the local time of the bot.
+const ServerSaveHour = 10
+
+// Boundaries of the period shown on the timeline chart.
+const (
+	MinTimelineSpan = 6 * time.Hour
+	MaxTimelineSpan = 24 * time.Hour
+)
+
+// newTimeline describes the timeline chart of the ledger. It starts at the current
+// full hour and spans until the last booking ends, within MinTimelineSpan and MaxTimelineSpan.
+func (a *Adapter) newTimeline(ledger dto.Ledger, now time.Time) dto.Timeline {
+	from := now.Truncate(time.Hour)
+	to := from.Add(MinTimelineSpan)
+	for _, entry := range ledger {
+		for _, booking := range entry.Bookings {
+			if booking.EndAt.After(to) {
+				to = booking.EndAt
+			}
+		}
+	}
+
+	if to.Sub(from) > MaxTimelineSpan {
+		to = from.Add(MaxTimelineSpan)
+	}
+	if rounded := to.Truncate(time.Hour); rounded.Before(to) {
+		to = rounded.Add(time.Hour)
+	}
+
+	return dto.Timeline{
+		Ledger:     ledger,
+		From:       from,
+		To:         to,
+		Now:        now,
+		ServerSave: nextServerSave(now),
+	}
+}
+
+// nextServerSave returns the first server save happening after t.
+func nextServerSave(t time.Time) time.Time {
+	serverSave := time.Date(t.Year(), t.Month(), t.Day(), ServerSaveHour, 0, 0, 0, t.Location())
+	if !serverSave.After(t) {
+		serverSave = serverSave.AddDate(0, 0, 1)
+	}
+
+	return serverSave
+}
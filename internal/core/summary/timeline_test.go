@@ -0,0 +1,54 @@
+package summary
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/common/test/mocks"
+	dto "spot-assistant/internal/core/dto/summary"
+)
+
+func TestNewTimeline(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	adapter := NewAdapter(new(mocks.MockChartAdapter), new(mocks.MockOnlineCheckService), new(mocks.MockGuildSettingsRepository))
+	now := time.Date(2021, 1, 1, 12, 30, 0, 0, time.UTC)
+	ledger := dto.Ledger{
+		{
+			Spot: "test-1",
+			Bookings: []*dto.Booking{
+				{StartAt: now, EndAt: now.Add(7*time.Hour + 15*time.Minute)},
+			},
+		},
+	}
+
+	// when
+	timeline := adapter.newTimeline(ledger, now)
+
+	// then
+	assert.Equal(time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC), timeline.From)
+	assert.Equal(time.Date(2021, 1, 1, 20, 0, 0, 0, time.UTC), timeline.To)
+	assert.Equal(now, timeline.Now)
+	assert.Equal(time.Date(2021, 1, 2, 10, 0, 0, 0, time.UTC), timeline.ServerSave)
+	assert.Equal(ledger, timeline.Ledger)
+}
+
+func TestNewTimelineSpanBoundaries(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	adapter := NewAdapter(new(mocks.MockChartAdapter), new(mocks.MockOnlineCheckService), new(mocks.MockGuildSettingsRepository))
+	now := time.Date(2021, 1, 1, 8, 0, 0, 0, time.UTC)
+	short := dto.Ledger{{Spot: "test-1", Bookings: []*dto.Booking{{StartAt: now, EndAt: now.Add(time.Hour)}}}}
+	long := dto.Ledger{{Spot: "test-1", Bookings: []*dto.Booking{{StartAt: now, EndAt: now.Add(48 * time.Hour)}}}}
+
+	// when
+	shortTimeline := adapter.newTimeline(short, now)
+	longTimeline := adapter.newTimeline(long, now)
+
+	// then
+	assert.Equal(MinTimelineSpan, shortTimeline.To.Sub(shortTimeline.From))
+	assert.Equal(MaxTimelineSpan, longTimeline.To.Sub(longTimeline.From))
+	assert.Equal(time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC), shortTimeline.ServerSave)
+}
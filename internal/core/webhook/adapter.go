@@ -0,0 +1,34 @@
+package webhook
+
+import (
+	"context"
+	"net"
+
+	"go.uber.org/zap"
+
+	"spot-assistant/internal/ports"
+)
+
+type Adapter struct {
+	webhookRepo ports.WebhookRepository
+	sender      ports.WebhookSender
+	log         *zap.SugaredLogger
+
+	lookupIP func(ctx context.Context, host string) ([]net.IP, error)
+}
+
+func NewAdapter(webhookRepo ports.WebhookRepository, sender ports.WebhookSender) *Adapter {
+	return &Adapter{
+		webhookRepo: webhookRepo,
+		sender:      sender,
+		log:         zap.NewNop().Sugar(),
+		lookupIP: func(ctx context.Context, host string) ([]net.IP, error) {
+			return net.DefaultResolver.LookupIP(ctx, "ip", host)
+		},
+	}
+}
+
+func (a *Adapter) WithLogger(log *zap.SugaredLogger) *Adapter {
+	a.log = log.With("layer", "core", "name", "webhookService")
+	return a
+}
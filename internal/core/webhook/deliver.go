@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"spot-assistant/internal/core/dto/webhook"
+)
+
+const (
+	// DeliveryBatchSize limits deliveries attempted at once.
+	DeliveryBatchSize = 20
+	// DeliveryLease is how long an attempted delivery is hidden from other attempts.
+	// It must outlast sending a whole batch.
+	DeliveryLease = 5 * time.Minute
+	// DeliveryTimeout limits a single attempt.
+	DeliveryTimeout = 10 * time.Second
+
+	retryBaseDelay = time.Minute
+	retryMaxDelay  = time.Hour
+)
+
+// DeliverDue attempts deliveries due at the time. Failed deliveries are retried
+// with an exponential backoff, until they run out of attempts and are moved to the dead letters.
+func (a *Adapter) DeliverDue(now time.Time) {
+	ctx := context.Background()
+	deliveries, err := a.webhookRepo.ClaimDueDeliveries(ctx, now, now.Add(DeliveryLease), DeliveryBatchSize)
+	if err != nil {
+		a.log.Errorf("could not claim due webhook deliveries: %s", err)
+
+		return
+	}
+
+	for _, delivery := range deliveries {
+		attempt := a.attempt(ctx, delivery, now)
+
+		switch {
+		case attempt.Error == "":
+			err = a.webhookRepo.MarkDelivered(ctx, delivery.ID, attempt)
+		case delivery.Attempts >= webhook.MaxAttempts:
+			a.log.Warnf("webhook delivery %d of guild %s is dead after %d attempts: %s", delivery.ID, delivery.GuildID, delivery.Attempts, attempt.Error)
+			err = a.webhookRepo.DeadLetterDelivery(ctx, delivery.ID, attempt)
+		default:
+			err = a.webhookRepo.ScheduleRetry(ctx, delivery.ID, now.Add(RetryDelay(delivery.Attempts)), attempt)
+		}
+		if err != nil {
+			a.log.Errorf("could not record attempt of webhook delivery %d: %s", delivery.ID, err)
+		}
+	}
+}
+
+func (a *Adapter) attempt(ctx context.Context, delivery *webhook.Delivery, now time.Time) webhook.Attempt {
+	ctx, cancel := context.WithTimeout(ctx, DeliveryTimeout)
+	defer cancel()
+
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	status, err := a.sender.Post(ctx, delivery.URL, map[string]string{
+		webhook.HeaderEvent:     string(delivery.Event),
+		webhook.HeaderDelivery:  strconv.FormatInt(delivery.ID, 10),
+		webhook.HeaderTimestamp: timestamp,
+		webhook.HeaderSignature: Signature(delivery.Secret, timestamp, delivery.Payload),
+	}, delivery.Payload)
+	if err != nil {
+		return webhook.Attempt{Error: err.Error()}
+	}
+	if status < 200 || status >= 300 {
+		return webhook.Attempt{ResponseStatus: status, Error: fmt.Sprintf("unexpected HTTP status: %d", status)}
+	}
+
+	return webhook.Attempt{ResponseStatus: status}
+}
+
+// RetryDelay returns how long to wait after the given number of failed attempts,
+// doubling from a minute up to an hour.
+func RetryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, retryMaxDelay)
+}
+
+// Signature signs the timestamp of the attempt, a dot and the body with the secret of a webhook.
+// Receivers compute the same value from the timestamp header and the raw body they got, compare it
+// with the signature header, and reject deliveries with timestamps older than webhook.SignatureTolerance,
+// so captured deliveries cannot be replayed.
+func Signature(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/webhook"
+)
+
+func TestDeliverDue(t *testing.T) {
+	// given
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	payload := []byte(`{"event":"reservation.created"}`)
+	delivered := &webhook.Delivery{ID: 1, URL: "https://delivered.example.com", Secret: "secret", Event: webhook.EventReservationCreated, Payload: payload, Attempts: 1}
+	failing := &webhook.Delivery{ID: 2, URL: "https://failing.example.com", Secret: "secret", Event: webhook.EventReservationCreated, Payload: payload, Attempts: 3}
+	dead := &webhook.Delivery{ID: 3, URL: "https://dead.example.com", Secret: "secret", Event: webhook.EventReservationCreated, Payload: payload, Attempts: webhook.MaxAttempts}
+	webhookRepo := mocks.NewMockWebhookRepository(t)
+	webhookRepo.On("ClaimDueDeliveries", mock.Anything, now, now.Add(DeliveryLease), DeliveryBatchSize).
+		Return([]*webhook.Delivery{delivered, failing, dead}, nil)
+	webhookRepo.On("MarkDelivered", mock.Anything, int64(1), webhook.Attempt{ResponseStatus: http.StatusNoContent}).Return(nil).Once()
+	webhookRepo.On("ScheduleRetry", mock.Anything, int64(2), now.Add(4*time.Minute), webhook.Attempt{
+		ResponseStatus: http.StatusServiceUnavailable,
+		Error:          "unexpected HTTP status: 503",
+	}).Return(nil).Once()
+	webhookRepo.On("DeadLetterDelivery", mock.Anything, int64(3), webhook.Attempt{Error: "connection refused"}).Return(nil).Once()
+	sender := mocks.NewMockWebhookSender(t)
+	sender.On("Post", mock.Anything, "https://delivered.example.com", map[string]string{
+		webhook.HeaderEvent:     "reservation.created",
+		webhook.HeaderDelivery:  "1",
+		webhook.HeaderTimestamp: "1609502400",
+		webhook.HeaderSignature: Signature("secret", "1609502400", payload),
+	}, payload).Return(http.StatusNoContent, nil).Once()
+	sender.On("Post", mock.Anything, "https://failing.example.com", mock.Anything, payload).Return(http.StatusServiceUnavailable, nil).Once()
+	sender.On("Post", mock.Anything, "https://dead.example.com", mock.Anything, payload).Return(0, errors.New("connection refused")).Once()
+	adapter := NewAdapter(webhookRepo, sender)
+
+	// when
+	adapter.DeliverDue(now)
+
+	// then
+	webhookRepo.AssertExpectations(t)
+}
+
+func TestDeliverDueWhenClaimFails(t *testing.T) {
+	// given
+	webhookRepo := mocks.NewMockWebhookRepository(t)
+	webhookRepo.On("ClaimDueDeliveries", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+	sender := mocks.NewMockWebhookSender(t)
+	adapter := NewAdapter(webhookRepo, sender)
+
+	// when
+	adapter.DeliverDue(time.Now())
+
+	// then
+	sender.AssertNotCalled(t, "Post", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRetryDelay(t *testing.T) {
+	// given
+	assert := assert.New(t)
+
+	// when & then
+	assert.Equal(time.Minute, RetryDelay(1))
+	assert.Equal(2*time.Minute, RetryDelay(2))
+	assert.Equal(32*time.Minute, RetryDelay(6))
+	assert.Equal(time.Hour, RetryDelay(7))
+	assert.Equal(time.Hour, RetryDelay(100))
+}
+
+func TestSignature(t *testing.T) {
+	// given
+	assert := assert.New(t)
+
+	// when
+	signature := Signature("secret", "1609502400", []byte(`{"event":"reservation.created"}`))
+
+	// then
+	assert.Equal("sha256=30e78dcc39376e6cd12bde1548f6fe2e32b1a94568fb5afaf12d903d7c34ce3d", signature)
+}
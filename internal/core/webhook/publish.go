@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/event"
+	"spot-assistant/internal/core/dto/reservation"
+	"spot-assistant/internal/core/dto/webhook"
+)
+
+// HandleEvent sends booking events to webhooks of the guild.
+func (a *Adapter) HandleEvent(e event.Event) {
+	now := time.Now()
+	switch e := e.(type) {
+	case event.ReservationCreated:
+		a.publish(webhook.Event{
+			Type:        webhook.EventReservationCreated,
+			GuildID:     e.GuildID(),
+			OccurredAt:  now,
+			Reservation: requestedReservation(e.Request, e.Reservation),
+		})
+	case event.ReservationClipped:
+		by := requestedReservation(e.Request, e.By)
+		published := webhook.Event{
+			Type:        webhook.EventReservationClipped,
+			GuildID:     e.GuildID(),
+			OccurredAt:  now,
+			Reservation: toEventReservation(e.Clipped.Original, e.Request.Spot),
+			By:          &by,
+		}
+		if e.Removed() {
+			published.Type = webhook.EventReservationOverbooked
+		}
+		for _, remaining := range e.Clipped.New {
+			published.Remaining = append(published.Remaining, toEventReservation(remaining, e.Request.Spot))
+		}
+		a.publish(published)
+	case event.ReservationCancelled:
+		a.publish(webhook.Event{
+			Type:        webhook.EventReservationCancelled,
+			GuildID:     e.GuildID(),
+			OccurredAt:  now,
+			Reservation: toEventReservation(&e.Reservation.Reservation, e.Reservation.Spot.Name),
+		})
+	}
+}
+
+// publish stores deliveries of the event to every webhook of the guild.
+// They are sent by DeliverDue, so a slow or failing webhook never holds up a booking.
+func (a *Adapter) publish(e webhook.Event) {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		a.log.Errorf("could not encode %s event: %s", e.Type, err)
+
+		return
+	}
+
+	if _, err := a.webhookRepo.EnqueueDeliveries(context.Background(), e.GuildID, e.Type, payload); err != nil {
+		a.log.Errorf("could not enqueue %s event of guild %s: %s", e.Type, e.GuildID, err)
+	}
+}
+
+// requestedReservation returns the reservation created by the request, along with its ID,
+// or the one requested if the created reservation is not known.
+func requestedReservation(request book.BookRequest, created *reservation.Reservation) webhook.Reservation {
+	if created != nil {
+		return toEventReservation(created, request.Spot)
+	}
+
+	author := request.Member.Nick
+	if author == "" {
+		author = request.Member.Username
+	}
+
+	return webhook.Reservation{
+		Spot:            request.Spot,
+		Author:          author,
+		AuthorDiscordID: request.Member.ID,
+		StartAt:         request.StartAt,
+		EndAt:           request.EndAt,
+	}
+}
+
+func toEventReservation(res *reservation.Reservation, spotName string) webhook.Reservation {
+	return webhook.Reservation{
+		ID:              res.ID,
+		Spot:            spotName,
+		Author:          res.Author,
+		AuthorDiscordID: res.AuthorDiscordID,
+		StartAt:         res.StartAt,
+		EndAt:           res.EndAt,
+	}
+}
@@ -0,0 +1,109 @@
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/factories"
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/event"
+	"spot-assistant/internal/core/dto/reservation"
+	"spot-assistant/internal/core/dto/webhook"
+)
+
+func TestHandleBookingEvents(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	at := func(hour int) time.Time {
+		return time.Date(2021, 1, 1, hour, 0, 0, 0, time.UTC)
+	}
+	request := book.BookRequest{
+		Guild:   factories.CreateGuild(),
+		Member:  factories.CreateMember(),
+		Spot:    "Flimsy",
+		StartAt: at(12),
+		EndAt:   at(14),
+	}
+	created := &reservation.Reservation{ID: 4, Author: "Mariysz", AuthorDiscordID: request.Member.ID, StartAt: at(12), EndAt: at(14)}
+	overbooked := &reservation.Reservation{ID: 1, Author: "overbooked", StartAt: at(12), EndAt: at(13)}
+	clipped := &reservation.Reservation{ID: 2, Author: "clipped", StartAt: at(13), EndAt: at(16)}
+	conflicts := []*reservation.ClippedOrRemovedReservation{
+		{Original: overbooked},
+		{Original: clipped, New: []*reservation.Reservation{{ID: 3, Author: "clipped", StartAt: at(14), EndAt: at(16)}}},
+	}
+	events := make(map[webhook.EventType]webhook.Event)
+	webhookRepo := mocks.NewMockWebhookRepository(t)
+	webhookRepo.On("EnqueueDeliveries", mock.Anything, request.Guild.ID, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		var event webhook.Event
+		assert.NoError(json.Unmarshal(args.Get(3).([]byte), &event))
+		events[args.Get(2).(webhook.EventType)] = event
+	}).Return(1, nil).Times(3)
+	adapter := NewAdapter(webhookRepo, mocks.NewMockWebhookSender(t))
+
+	// when
+	adapter.HandleEvent(event.ReservationCreated{Request: request, Reservation: created})
+	for _, conflict := range conflicts {
+		adapter.HandleEvent(event.ReservationClipped{Request: request, By: created, Clipped: conflict})
+	}
+
+	// then
+	createdEvent := events[webhook.EventReservationCreated]
+	assert.Equal(request.Guild.ID, createdEvent.GuildID)
+	assert.Equal(int64(4), createdEvent.Reservation.ID)
+	assert.Equal("Flimsy", createdEvent.Reservation.Spot)
+	assert.Equal("Mariysz", createdEvent.Reservation.Author)
+	assert.Equal(request.Member.ID, createdEvent.Reservation.AuthorDiscordID)
+	assert.Equal(at(12), createdEvent.Reservation.StartAt)
+	assert.Nil(createdEvent.By)
+
+	assert.Equal(int64(1), events[webhook.EventReservationOverbooked].Reservation.ID)
+	assert.Equal("Flimsy", events[webhook.EventReservationOverbooked].Reservation.Spot)
+	assert.Equal(&createdEvent.Reservation, events[webhook.EventReservationOverbooked].By)
+	assert.Empty(events[webhook.EventReservationOverbooked].Remaining)
+
+	assert.Equal(int64(2), events[webhook.EventReservationClipped].Reservation.ID)
+	assert.Len(events[webhook.EventReservationClipped].Remaining, 1)
+	assert.Equal(at(14), events[webhook.EventReservationClipped].Remaining[0].StartAt)
+}
+
+func TestHandleReservationCancelled(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	request := book.UnbookRequest{Guild: factories.CreateGuild(), Member: factories.CreateMember(), ReservationID: 1}
+	res := &reservation.ReservationWithSpot{
+		Reservation: reservation.Reservation{ID: 1, GuildID: request.Guild.ID, Author: "author"},
+		Spot:        reservation.Spot{Name: "Flimsy"},
+	}
+	webhookRepo := mocks.NewMockWebhookRepository(t)
+	webhookRepo.On("EnqueueDeliveries", mock.Anything, request.Guild.ID, webhook.EventReservationCancelled, mock.MatchedBy(func(payload []byte) bool {
+		var event webhook.Event
+		return json.Unmarshal(payload, &event) == nil &&
+			event.Type == webhook.EventReservationCancelled &&
+			event.Reservation.ID == 1 &&
+			event.Reservation.Spot == "Flimsy"
+	})).Return(0, nil).Once()
+	adapter := NewAdapter(webhookRepo, mocks.NewMockWebhookSender(t))
+
+	// when
+	adapter.HandleEvent(event.ReservationCancelled{Request: request, Reservation: res})
+
+	// then
+	assert.True(webhookRepo.AssertExpectations(t))
+}
+
+func TestHandleIgnoredEvent(t *testing.T) {
+	// given
+	webhookRepo := mocks.NewMockWebhookRepository(t)
+	adapter := NewAdapter(webhookRepo, mocks.NewMockWebhookSender(t))
+
+	// when
+	adapter.HandleEvent(event.GuildWorldChanged{Guild: factories.CreateGuild(), World: "Antica"})
+
+	// then
+	webhookRepo.AssertNotCalled(t, "EnqueueDeliveries", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
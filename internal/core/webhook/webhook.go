@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"spot-assistant/internal/core/dto/webhook"
+)
+
+var (
+	ErrInvalidURL      = errors.New("webhook URL must be an absolute https:// URL")
+	ErrTooManyWebhooks = fmt.Errorf("a server can have at most %d webhooks", webhook.MaxWebhooksPerGuild)
+	ErrWebhookNotFound = errors.New("there is no such webhook on this server")
+)
+
+// AddWebhook adds a webhook to the guild, returning it with its signing secret.
+// Only https URLs are accepted, so payloads and signatures are not sent in the clear,
+// and only hosts resolving to public addresses, so guilds cannot reach the network of the bot.
+func (a *Adapter) AddWebhook(guildID, rawURL string) (*webhook.Webhook, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return nil, ErrInvalidURL
+	}
+
+	ctx := context.Background()
+	if err = a.ensurePublicHost(ctx, u.Hostname()); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := a.webhookRepo.SelectWebhooks(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("could not load webhooks: %w", err)
+	}
+	if len(webhooks) >= webhook.MaxWebhooksPerGuild {
+		return nil, ErrTooManyWebhooks
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return nil, fmt.Errorf("could not generate a secret: %w", err)
+	}
+
+	w, err := a.webhookRepo.InsertWebhook(ctx, guildID, rawURL, secret)
+	if err != nil {
+		return nil, fmt.Errorf("could not save the webhook: %w", err)
+	}
+
+	return w, nil
+}
+
+// RemoveWebhook removes a webhook of the guild, dropping its pending deliveries.
+func (a *Adapter) RemoveWebhook(guildID string, id int64) error {
+	deleted, err := a.webhookRepo.DeleteWebhook(context.Background(), guildID, id)
+	if err != nil {
+		return fmt.Errorf("could not remove the webhook: %w", err)
+	}
+	if !deleted {
+		return ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+// ListWebhooks returns all webhooks of the guild.
+func (a *Adapter) ListWebhooks(guildID string) ([]*webhook.Webhook, error) {
+	return a.webhookRepo.SelectWebhooks(context.Background(), guildID)
+}
+
+// RecentDeliveries returns the latest deliveries of the guild, newest first.
+func (a *Adapter) RecentDeliveries(guildID string, limit int) ([]*webhook.Delivery, error) {
+	return a.webhookRepo.SelectRecentDeliveries(context.Background(), guildID, limit)
+}
+
+// ensurePublicHost returns webhook.ErrNonPublicAddress, unless all addresses of the host are public.
+// The sender checks the address again when connecting, as the host may resolve differently later.
+func (a *Adapter) ensurePublicHost(ctx context.Context, host string) error {
+	ips, err := a.lookupIP(ctx, host)
+	if err != nil {
+		return fmt.Errorf("could not resolve %s: %w", host, err)
+	}
+	for _, ip := range ips {
+		if !webhook.IsPublicIP(ip) {
+			return webhook.ErrNonPublicAddress
+		}
+	}
+
+	return nil
+}
+
+func newSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secret), nil
+}
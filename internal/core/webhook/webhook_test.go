@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/webhook"
+)
+
+func TestAddWebhook(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	webhookRepo := mocks.NewMockWebhookRepository(t)
+	webhookRepo.On("SelectWebhooks", mock.Anything, "guild-id").Return([]*webhook.Webhook{}, nil)
+	webhookRepo.On("InsertWebhook", mock.Anything, "guild-id", "https://example.com/hook", mock.MatchedBy(func(secret string) bool {
+		return len(secret) == 64
+	})).Return(&webhook.Webhook{ID: 1, URL: "https://example.com/hook"}, nil)
+	adapter := NewAdapter(webhookRepo, mocks.NewMockWebhookSender(t))
+	adapter.lookupIP = resolveTo("93.184.216.34")
+
+	// when
+	w, err := adapter.AddWebhook("guild-id", "https://example.com/hook")
+
+	// then
+	assert.NoError(err)
+	assert.Equal(int64(1), w.ID)
+}
+
+func TestAddWebhookRejectsInsecureURL(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	adapter := NewAdapter(mocks.NewMockWebhookRepository(t), mocks.NewMockWebhookSender(t))
+
+	for _, rawURL := range []string{"http://example.com/hook", "example.com/hook", "https://", ""} {
+		// when
+		_, err := adapter.AddWebhook("guild-id", rawURL)
+
+		// then
+		assert.ErrorIs(err, ErrInvalidURL, rawURL)
+	}
+}
+
+func TestAddWebhookRejectsNonPublicAddress(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	adapter := NewAdapter(mocks.NewMockWebhookRepository(t), mocks.NewMockWebhookSender(t))
+
+	for _, ip := range []string{"127.0.0.1", "10.1.2.3", "169.254.169.254", "::1"} {
+		adapter.lookupIP = resolveTo("93.184.216.34", ip)
+
+		// when
+		_, err := adapter.AddWebhook("guild-id", "https://example.com/hook")
+
+		// then
+		assert.ErrorIs(err, webhook.ErrNonPublicAddress, ip)
+	}
+}
+
+func TestAddWebhookOverLimit(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	webhookRepo := mocks.NewMockWebhookRepository(t)
+	webhookRepo.On("SelectWebhooks", mock.Anything, "guild-id").Return(make([]*webhook.Webhook, webhook.MaxWebhooksPerGuild), nil)
+	adapter := NewAdapter(webhookRepo, mocks.NewMockWebhookSender(t))
+	adapter.lookupIP = resolveTo("93.184.216.34")
+
+	// when
+	_, err := adapter.AddWebhook("guild-id", "https://example.com/hook")
+
+	// then
+	assert.ErrorIs(err, ErrTooManyWebhooks)
+}
+
+func TestRemoveWebhookNotFound(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	webhookRepo := mocks.NewMockWebhookRepository(t)
+	webhookRepo.On("DeleteWebhook", mock.Anything, "guild-id", int64(1)).Return(false, nil)
+	adapter := NewAdapter(webhookRepo, mocks.NewMockWebhookSender(t))
+
+	// when
+	err := adapter.RemoveWebhook("guild-id", 1)
+
+	// then
+	assert.ErrorIs(err, ErrWebhookNotFound)
+}
+
+func resolveTo(addresses ...string) func(context.Context, string) ([]net.IP, error) {
+	return func(context.Context, string) ([]net.IP, error) {
+		ips := make([]net.IP, len(addresses))
+		for i, address := range addresses {
+			ips[i] = net.ParseIP(address)
+		}
+
+		return ips, nil
+	}
+}
@@ -0,0 +1,34 @@
+package worldlist
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"spot-assistant/internal/ports"
+)
+
+type Adapter struct {
+	worldListRepo ports.WorldListRepository
+	api           ports.WorldApi
+	log           *zap.SugaredLogger
+
+	mu            sync.RWMutex
+	names         []string
+	nextRefreshAt time.Time
+	refreshing    bool
+}
+
+func NewAdapter(worldListRepo ports.WorldListRepository, api ports.WorldApi) *Adapter {
+	return &Adapter{
+		worldListRepo: worldListRepo,
+		api:           api,
+		log:           zap.NewNop().Sugar(),
+	}
+}
+
+func (a *Adapter) WithLogger(log *zap.SugaredLogger) *Adapter {
+	a.log = log.With("layer", "core", "name", "worldListService")
+	return a
+}
@@ -0,0 +1,110 @@
+package worldlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"spot-assistant/internal/core/worlds"
+)
+
+const (
+	// RefreshInterval is how long names of worlds are kept, before they are fetched again.
+	// Worlds are opened and merged rarely, and always announced well ahead.
+	RefreshInterval = 6 * time.Hour
+
+	// RetryInterval is how long to wait after fetching names of worlds failed.
+	RetryInterval = 15 * time.Minute
+)
+
+var errNoWorlds = errors.New("the world API returned no worlds")
+
+// Worlds returns names of Tibia worlds, by name, or the built-in list, until they are fetched from the world API.
+// The names are shared by all callers, so they must not be modified.
+func (a *Adapter) Worlds() []string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if len(a.names) == 0 {
+		return worlds.Worlds
+	}
+	return a.names
+}
+
+// RefreshDue loads names of worlds stored by any instance, and fetches them from the world API,
+// once they get older than RefreshInterval. The names in use are kept, if fetching fails.
+func (a *Adapter) RefreshDue(now time.Time) {
+	if !a.startRefresh(now) {
+		return
+	}
+
+	next, err := a.refresh(now)
+	if err != nil {
+		a.log.Errorf("could not refresh worlds: %s", err)
+		next = now.Add(RetryInterval)
+	}
+	a.finishRefresh(next)
+}
+
+func (a *Adapter) refresh(now time.Time) (time.Time, error) {
+	ctx := context.Background()
+	list, err := a.worldListRepo.SelectWorlds(ctx)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("could not load worlds: %w", err)
+	}
+	if len(list.Names) > 0 {
+		a.setNames(list.Names)
+		if next := list.RefreshedAt.Add(RefreshInterval); now.Before(next) {
+			return next, nil
+		}
+	}
+	if a.api == nil || a.api.GetBaseURL() == "" {
+		return now.Add(RefreshInterval), nil
+	}
+
+	names, err := a.api.GetWorldNames()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("could not fetch worlds: %w", err)
+	}
+	if len(names) == 0 {
+		return time.Time{}, errNoWorlds
+	}
+	names = slices.Sorted(slices.Values(names))
+	a.setNames(names)
+
+	if err = a.worldListRepo.ReplaceWorlds(ctx, names, now); err != nil {
+		return time.Time{}, fmt.Errorf("could not store worlds: %w", err)
+	}
+	a.log.Infof("refreshed %d worlds", len(names))
+
+	return now.Add(RefreshInterval), nil
+}
+
+// startRefresh reports whether a refresh is due, and nobody is refreshing already.
+func (a *Adapter) startRefresh(now time.Time) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.refreshing || now.Before(a.nextRefreshAt) {
+		return false
+	}
+	a.refreshing = true
+	return true
+}
+
+func (a *Adapter) finishRefresh(next time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.refreshing = false
+	a.nextRefreshAt = next
+}
+
+func (a *Adapter) setNames(names []string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.names = names
+}
@@ -0,0 +1,99 @@
+package worldlist
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/world"
+	"spot-assistant/internal/core/worlds"
+)
+
+func TestWorldsFallBackToBuiltInList(t *testing.T) {
+	// given
+	adapter := NewAdapter(mocks.NewMockWorldListRepository(t), mocks.NewMockWorldApi(t))
+
+	// when
+	names := adapter.Worlds()
+
+	// then
+	assert.Equal(t, worlds.Worlds, names)
+}
+
+func TestRefreshDueFetchesStaleWorlds(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	now := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	repo := mocks.NewMockWorldListRepository(t)
+	repo.On("SelectWorlds", mock.Anything).
+		Return(&world.List{Names: []string{"Antica"}, RefreshedAt: now.Add(-RefreshInterval)}, nil).Once()
+	repo.On("ReplaceWorlds", mock.Anything, []string{"Antica", "Nevia"}, now).Return(nil).Once()
+	api := mocks.NewMockWorldApi(t)
+	api.On("GetBaseURL").Return("https://api.tibiadata.com/v4")
+	api.On("GetWorldNames").Return([]string{"Nevia", "Antica"}, nil).Once()
+	adapter := NewAdapter(repo, api)
+
+	// when
+	adapter.RefreshDue(now)
+	adapter.RefreshDue(now.Add(RefreshInterval - time.Minute))
+
+	// then
+	assert.Equal([]string{"Antica", "Nevia"}, adapter.Worlds())
+}
+
+func TestRefreshDueUsesWorldsStoredByAnotherInstance(t *testing.T) {
+	// given
+	now := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	repo := mocks.NewMockWorldListRepository(t)
+	repo.On("SelectWorlds", mock.Anything).
+		Return(&world.List{Names: []string{"Antica", "Nevia"}, RefreshedAt: now.Add(-time.Hour)}, nil).Once()
+	adapter := NewAdapter(repo, mocks.NewMockWorldApi(t))
+
+	// when
+	adapter.RefreshDue(now)
+
+	// then
+	assert.Equal(t, []string{"Antica", "Nevia"}, adapter.Worlds())
+}
+
+func TestRefreshDueKeepsWorldsWhenFetchingFails(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	now := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	repo := mocks.NewMockWorldListRepository(t)
+	repo.On("SelectWorlds", mock.Anything).Return(&world.List{}, nil).Twice()
+	api := mocks.NewMockWorldApi(t)
+	api.On("GetBaseURL").Return("https://api.tibiadata.com/v4")
+	api.On("GetWorldNames").Return(nil, errors.New("timeout")).Twice()
+	adapter := NewAdapter(repo, api)
+
+	// when
+	adapter.RefreshDue(now)
+	adapter.RefreshDue(now.Add(time.Minute))
+	adapter.RefreshDue(now.Add(RetryInterval))
+
+	// then
+	assert.Equal(worlds.Worlds, adapter.Worlds())
+	repo.AssertNotCalled(t, "ReplaceWorlds", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRefreshDueWithoutApi(t *testing.T) {
+	// given
+	now := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	repo := mocks.NewMockWorldListRepository(t)
+	repo.On("SelectWorlds", mock.Anything).Return(&world.List{}, nil).Once()
+	api := mocks.NewMockWorldApi(t)
+	api.On("GetBaseURL").Return("")
+	adapter := NewAdapter(repo, api)
+
+	// when
+	adapter.RefreshDue(now)
+
+	// then
+	assert.Equal(t, worlds.Worlds, adapter.Worlds())
+	api.AssertNotCalled(t, "GetWorldNames")
+}
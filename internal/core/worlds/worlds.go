@@ -1,5 +1,7 @@
 package worlds
 
+// Worlds is the built-in list of Tibia worlds, used until the list is fetched from the world API,
+// and whenever it cannot be.
 var Worlds = []string{
 	"Aethera",
 	"Ambra",
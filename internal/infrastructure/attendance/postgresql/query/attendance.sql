@@ -0,0 +1,18 @@
+-- name: SelectOngoingReservations :many
+SELECT r.id, r.author, r.created_at, r.start_at, r.end_at, r.spot_id, r.guild_id, r.author_discord_id
+FROM web_reservation r
+WHERE r.guild_id = @guild_id
+  AND r.start_at <= @now
+  AND r.end_at > @now
+ORDER BY r.start_at;
+
+-- name: RecordAttendanceSample :execrows
+INSERT INTO reservation_attendance (reservation_id, samples, online_samples, first_online_at, last_sampled_at)
+VALUES (@reservation_id, 1, @online_samples, sqlc.narg(first_online_at), @now)
+ON CONFLICT (reservation_id) DO UPDATE
+SET samples = reservation_attendance.samples + 1,
+    online_samples = reservation_attendance.online_samples + EXCLUDED.online_samples,
+    first_online_at = COALESCE(reservation_attendance.first_online_at, EXCLUDED.first_online_at),
+    last_sampled_at = EXCLUDED.last_sampled_at
+WHERE reservation_attendance.last_sampled_at <= @sampled_before;
+
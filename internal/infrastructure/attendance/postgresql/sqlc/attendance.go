@@ -0,0 +1,69 @@
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"spot-assistant/internal/core/dto/reservation"
+)
+
+type AttendanceRepository struct {
+	q *Queries
+}
+
+func NewAttendanceRepository(db DBTX) *AttendanceRepository {
+	return &AttendanceRepository{
+		q: New(db),
+	}
+}
+
+// SelectOngoingReservations returns reservations of the guild, which have started and not ended yet.
+func (repo *AttendanceRepository) SelectOngoingReservations(ctx context.Context, guildID string, now time.Time) ([]*reservation.Reservation, error) {
+	rows, err := repo.q.SelectOngoingReservations(ctx, SelectOngoingReservationsParams{
+		GuildID: guildID,
+		Now:     pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reservations := make([]*reservation.Reservation, len(rows))
+	for i, row := range rows {
+		reservations[i] = &reservation.Reservation{
+			ID:              row.ID,
+			Author:          row.Author,
+			CreatedAt:       row.CreatedAt.Time,
+			StartAt:         row.StartAt.Time,
+			EndAt:           row.EndAt.Time,
+			SpotID:          row.SpotID,
+			GuildID:         row.GuildID,
+			AuthorDiscordID: row.AuthorDiscordID,
+		}
+	}
+
+	return reservations, nil
+}
+
+// RecordAttendanceSample counts whether the owner of the reservation was online at the time.
+// The sample is skipped if the reservation has been sampled after sampledBefore already,
+// so several instances sampling at once count it once. Returns false if it was skipped.
+func (repo *AttendanceRepository) RecordAttendanceSample(ctx context.Context, reservationID int64, online bool, now, sampledBefore time.Time) (bool, error) {
+	params := RecordAttendanceSampleParams{
+		ReservationID: reservationID,
+		Now:           pgtype.Timestamptz{Time: now, Valid: true},
+		SampledBefore: pgtype.Timestamptz{Time: sampledBefore, Valid: true},
+	}
+	if online {
+		params.OnlineSamples = 1
+		params.FirstOnlineAt = pgtype.Timestamptz{Time: now, Valid: true}
+	}
+
+	recorded, err := repo.q.RecordAttendanceSample(ctx, params)
+	if err != nil {
+		return false, err
+	}
+
+	return recorded > 0, nil
+}
@@ -0,0 +1,88 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: attendance.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const recordAttendanceSample = `-- name: RecordAttendanceSample :execrows
+INSERT INTO reservation_attendance (reservation_id, samples, online_samples, first_online_at, last_sampled_at)
+VALUES ($1, 1, $2, $3, $4)
+ON CONFLICT (reservation_id) DO UPDATE
+SET samples = reservation_attendance.samples + 1,
+    online_samples = reservation_attendance.online_samples + EXCLUDED.online_samples,
+    first_online_at = COALESCE(reservation_attendance.first_online_at, EXCLUDED.first_online_at),
+    last_sampled_at = EXCLUDED.last_sampled_at
+WHERE reservation_attendance.last_sampled_at <= $5
+`
+
+type RecordAttendanceSampleParams struct {
+	ReservationID int64
+	OnlineSamples int32
+	FirstOnlineAt pgtype.Timestamptz
+	Now           pgtype.Timestamptz
+	SampledBefore pgtype.Timestamptz
+}
+
+func (q *Queries) RecordAttendanceSample(ctx context.Context, arg RecordAttendanceSampleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, recordAttendanceSample,
+		arg.ReservationID,
+		arg.OnlineSamples,
+		arg.FirstOnlineAt,
+		arg.Now,
+		arg.SampledBefore,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const selectOngoingReservations = `-- name: SelectOngoingReservations :many
+SELECT r.id, r.author, r.created_at, r.start_at, r.end_at, r.spot_id, r.guild_id, r.author_discord_id
+FROM web_reservation r
+WHERE r.guild_id = $1
+  AND r.start_at <= $2
+  AND r.end_at > $2
+ORDER BY r.start_at
+`
+
+type SelectOngoingReservationsParams struct {
+	GuildID string
+	Now     pgtype.Timestamptz
+}
+
+func (q *Queries) SelectOngoingReservations(ctx context.Context, arg SelectOngoingReservationsParams) ([]WebReservation, error) {
+	rows, err := q.db.Query(ctx, selectOngoingReservations, arg.GuildID, arg.Now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebReservation
+	for rows.Next() {
+		var i WebReservation
+		if err := rows.Scan(
+			&i.ID,
+			&i.Author,
+			&i.CreatedAt,
+			&i.StartAt,
+			&i.EndAt,
+			&i.SpotID,
+			&i.GuildID,
+			&i.AuthorDiscordID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
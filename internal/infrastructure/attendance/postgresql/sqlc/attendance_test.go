@@ -0,0 +1,69 @@
+package sqlc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectOngoingReservations(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	startAt := pgtype.Timestamptz{Time: now.Add(-time.Hour), Valid: true}
+	endAt := pgtype.Timestamptz{Time: now.Add(time.Hour), Valid: true}
+	rows := pgxmock.NewRows([]string{"id", "author", "created_at", "start_at", "end_at", "spot_id", "guild_id", "author_discord_id"}).
+		AddRow(int64(1), "Asar Cham", startAt, startAt, endAt, int64(2), "guild-id", "author-id")
+	mock.ExpectQuery("SELECT (.+) FROM web_reservation r").
+		WithArgs("guild-id", pgtype.Timestamptz{Time: now, Valid: true}).
+		WillReturnRows(rows)
+	repo := NewAttendanceRepository(mock)
+
+	// when
+	reservations, err := repo.SelectOngoingReservations(context.Background(), "guild-id", now)
+
+	// then
+	assert.NoError(err)
+	assert.Len(reservations, 1)
+	assert.Equal(int64(1), reservations[0].ID)
+	assert.Equal("author-id", reservations[0].AuthorDiscordID)
+	assert.Equal(endAt.Time, reservations[0].EndAt)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestRecordAttendanceSample(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	sampledBefore := now.Add(-time.Minute)
+	mock.ExpectExec("INSERT INTO reservation_attendance").
+		WithArgs(int64(1), int32(1), pgtype.Timestamptz{Time: now, Valid: true},
+			pgtype.Timestamptz{Time: now, Valid: true}, pgtype.Timestamptz{Time: sampledBefore, Valid: true}).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec("INSERT INTO reservation_attendance").
+		WithArgs(int64(2), int32(0), pgtype.Timestamptz{},
+			pgtype.Timestamptz{Time: now, Valid: true}, pgtype.Timestamptz{Time: sampledBefore, Valid: true}).
+		WillReturnResult(pgxmock.NewResult("INSERT", 0))
+	repo := NewAttendanceRepository(mock)
+
+	// when
+	online, errOnline := repo.RecordAttendanceSample(context.Background(), 1, true, now, sampledBefore)
+	skipped, errSkipped := repo.RecordAttendanceSample(context.Background(), 2, false, now, sampledBefore)
+
+	// then
+	assert.NoError(errOnline)
+	assert.True(online)
+	assert.NoError(errSkipped)
+	assert.False(skipped)
+	assert.NoError(mock.ExpectationsWereMet())
+}
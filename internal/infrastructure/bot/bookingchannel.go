@@ -0,0 +1,84 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"spot-assistant/internal/common/collections"
+	stringsHelper "spot-assistant/internal/common/strings"
+	"spot-assistant/internal/core/dto/discord"
+	"spot-assistant/internal/core/dto/guild"
+)
+
+// redirectToBookingChannels tells the member, who invoked a booking command outside
+// of the booking channels, where to use it. Only the member can see the response.
+func (b *Bot) redirectToBookingChannels(i *discordgo.InteractionCreate, channelIDs []string) error {
+	err := b.interactionRespond(i, &discordgo.InteractionResponseData{
+		Content: formatBookingChannelsRedirect(channelIDs),
+		Flags:   discordgo.MessageFlagsEphemeral,
+	}, discordgo.InteractionResponseChannelMessageWithSource)
+	if err != nil {
+		return fmt.Errorf("%w: could not redirect to booking channels: %w", errNotResponded, err)
+	}
+
+	return nil
+}
+
+func formatBookingChannelsRedirect(channelIDs []string) string {
+	return fmt.Sprintf("Booking commands can only be used in %s.", formatChannelMentions(channelIDs))
+}
+
+func formatChannelMentions(channelIDs []string) string {
+	mentions := collections.PoorMansMap(channelIDs, func(channelID string) string {
+		return fmt.Sprintf("<#%s>", channelID)
+	})
+
+	return strings.Join(mentions, ", ")
+}
+
+// mirrorBooking posts the booking response to the command channel, if the guild enabled mirroring
+// and the booking was made elsewhere, so every booking is visible in one place.
+func (b *Bot) mirrorBooking(g *guild.Guild, i *discordgo.InteractionCreate, message string) {
+	// Settings, which could not be loaded, are nil, and nothing is mirrored then.
+	if !b.guildSettings(g.ID).MirrorsBookings() {
+		return
+	}
+
+	commandChannel, err := b.FindChannelByName(g, discord.CommandChannel)
+	if err != nil {
+		b.log.With("guild.ID", g.ID).Warnf("could not find the command channel to mirror a booking: %s", err)
+
+		return
+	}
+	if commandChannel.ID == i.ChannelID {
+		return
+	}
+
+	author := ""
+	if i.Member != nil && i.Member.User != nil {
+		author = fmt.Sprintf("<@%s> in <#%s>:\n", i.Member.User.ID, i.ChannelID)
+	}
+
+	gID, err := stringsHelper.StrToInt64(g.ID)
+	if err != nil {
+		b.log.Errorf("could not parse guild ID: %s", err)
+
+		return
+	}
+
+	_, err = b.mgr.SessionForGuild(gID).ChannelMessageSendComplex(commandChannel.ID, &discordgo.MessageSend{
+		Content: author + message,
+		// Members were already notified by the original response
+		AllowedMentions: &discordgo.MessageAllowedMentions{},
+	})
+	if err != nil {
+		b.log.With("guild.ID", g.ID).Errorf("could not mirror a booking: %s", err)
+
+		return
+	}
+	if b.metrics != nil {
+		b.metrics.IncMessagesSent(commandChannel.ID, commandChannel.Name)
+	}
+}
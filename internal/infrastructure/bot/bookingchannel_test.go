@@ -0,0 +1,82 @@
+package bot
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/guild"
+	"spot-assistant/internal/core/dto/guildsettings"
+)
+
+func TestFormatBookingChannelsRedirect(t *testing.T) {
+	// given
+	assert := assert.New(t)
+
+	// when
+	message := formatBookingChannelsRedirect([]string{"1", "2"})
+
+	// then
+	assert.Equal("Booking commands can only be used in <#1>, <#2>.", message)
+}
+
+func TestApplyBookingChannelSetting(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	settings := guildsettings.Default("guild-id")
+	option := func(action, channelID string) []*discordgo.ApplicationCommandInteractionDataOption {
+		options := []*discordgo.ApplicationCommandInteractionDataOption{
+			{Name: "action", Type: discordgo.ApplicationCommandOptionString, Value: action},
+		}
+		if channelID != "" {
+			options = append(options, &discordgo.ApplicationCommandInteractionDataOption{
+				Name: "channel", Type: discordgo.ApplicationCommandOptionChannel, Value: channelID,
+			})
+		}
+
+		return options
+	}
+
+	// when
+	_, errAdd := applyBookingChannelSetting(settings, option("add", "1"))
+	_, errAddAgain := applyBookingChannelSetting(settings, option("add", "1"))
+	message, errAddOther := applyBookingChannelSetting(settings, option("add", "2"))
+	allowed := []bool{settings.AllowsBookingIn("1"), settings.AllowsBookingIn("3")}
+	_, errRemove := applyBookingChannelSetting(settings, option("remove", "1"))
+	remaining := append([]string{}, settings.BookingChannelIDs...)
+	_, errMissingChannel := applyBookingChannelSetting(settings, option("add", ""))
+	cleared, errClear := applyBookingChannelSetting(settings, option("clear", ""))
+
+	// then
+	assert.Nil(errAdd)
+	assert.Nil(errAddAgain)
+	assert.Nil(errAddOther)
+	assert.Equal("Booking commands can be used in: <#1>, <#2>", message)
+	assert.Equal([]bool{true, false}, allowed)
+	assert.Nil(errRemove)
+	assert.Equal([]string{"2"}, remaining)
+	assert.NotNil(errMissingChannel)
+	assert.Nil(errClear)
+	assert.Equal("Booking commands can be used in any channel.", cleared)
+	assert.True(settings.AllowsBookingIn("3"))
+}
+
+func TestMirrorBookingWithoutSettings(t *testing.T) {
+	// given
+	guildSettingsRepo := mocks.NewMockGuildSettingsRepository(t)
+	guildSettingsRepo.On("SelectGuildSettings", mock.Anything, "guild-id").Return(nil, errors.New("connection reset"))
+	b := &Bot{guildSettingsRepo: guildSettingsRepo, log: zap.NewNop().Sugar()}
+
+	// when
+	mirror := func() {
+		b.mirrorBooking(&guild.Guild{ID: "guild-id"}, &discordgo.InteractionCreate{}, "Booked.")
+	}
+
+	// then
+	assert.NotPanics(t, mirror)
+}
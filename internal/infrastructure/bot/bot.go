@@ -15,6 +15,7 @@ import (
 	"github.com/servusdei2018/shards/v2"
 	"go.uber.org/zap"
 
+	stringsHelper "spot-assistant/internal/common/strings"
 	"spot-assistant/internal/infrastructure/bot/formatter"
 	"spot-assistant/internal/ports"
 )
@@ -22,21 +23,39 @@ import (
 type cfg struct {
 	Token           string
 	CharactersLimit int `default:"5000"`
+	// SpotImport enables the /import-spots and /spot-requirements commands. Spots are shared by all guilds,
+	// so it should be enabled only for the bot used by a single community.
+	SpotImport bool `default:"false"`
 }
 
 type Bot struct {
-	summarySrv         ports.SummaryService
-	reservationRepo    ports.ReservationRepository
-	onlineCheckService ports.OnlineCheckService
-	eventHandler       ports.APIPort
-	metrics            ports.MetricsPort
-	mgr                *shards.Manager
-	log                *zap.SugaredLogger
-	quit               chan struct{}
-	formatter          *formatter.DiscordFormatter
-	channelLocks       cmap.ConcurrentMap[string, *sync.RWMutex]
-	started            atomic.Bool
-	stopped            atomic.Bool
+	summarySrv           ports.SummaryService
+	reservationRepo      ports.ReservationRepository
+	summaryMessageRepo   ports.SummaryMessageRepository
+	guildSettingsRepo    ports.GuildSettingsRepository
+	onlineCheckService   ports.OnlineCheckService
+	calendarService      ports.CalendarService
+	exportService        ports.ExportService
+	reminderRepo         ports.ReminderRepository
+	notificationPrefRepo ports.NotificationPreferenceRepository
+	webhookService       ports.WebhookService
+	characterService     ports.CharacterService
+	attendanceService    ports.AttendanceService
+	reliabilityService   ports.ReliabilityService
+	requirementsService  ports.SpotRequirementsService
+	worldListService     ports.WorldListService
+	eventHandler         ports.APIPort
+	metrics              ports.MetricsPort
+	mgr                  *shards.Manager
+	log                  *zap.SugaredLogger
+	quit                 chan struct{}
+	formatter            *formatter.DiscordFormatter
+	channelLocks         cmap.ConcurrentMap[string, *sync.RWMutex]
+	letterDigests        cmap.ConcurrentMap[string, string]
+	letterFingerprints   cmap.ConcurrentMap[string, string]
+	interactions         *interactionDeduper
+	started              atomic.Bool
+	stopped              atomic.Bool
 }
 
 var (
@@ -58,6 +77,9 @@ func NewManager(summarySrv ports.SummaryService, reservationRepo ports.Reservati
 		mgr:                mgr,
 		quit:               make(chan struct{}),
 		channelLocks:       cmap.New[*sync.RWMutex](),
+		letterDigests:      cmap.New[string](),
+		letterFingerprints: cmap.New[string](),
+		interactions:       newInteractionDeduper(),
 		summarySrv:         summarySrv,
 		reservationRepo:    reservationRepo,
 		onlineCheckService: checkOnlineSrv,
@@ -85,6 +107,85 @@ func (b *Bot) WithMetrics(m ports.MetricsPort) *Bot {
 	return b
 }
 
+// WithSummaryMessageRepository sets repository used to track posted summary messages,
+// so they can be edited in place instead of being reposted.
+func (b *Bot) WithSummaryMessageRepository(repo ports.SummaryMessageRepository) *Bot {
+	b.summaryMessageRepo = repo
+	return b
+}
+
+// WithGuildSettingsRepository sets repository of per-guild preferences,
+// enabling the /settings command.
+func (b *Bot) WithGuildSettingsRepository(repo ports.GuildSettingsRepository) *Bot {
+	b.guildSettingsRepo = repo
+	return b
+}
+
+// WithCalendarService sets service issuing calendar feed links,
+// enabling the /calendar command.
+func (b *Bot) WithCalendarService(srv ports.CalendarService) *Bot {
+	b.calendarService = srv
+	return b
+}
+
+// WithExportService sets service exporting reservations and spots,
+// enabling the /export command.
+func (b *Bot) WithExportService(srv ports.ExportService) *Bot {
+	b.exportService = srv
+	return b
+}
+
+// WithReminderRepository sets repository of reminder preferences,
+// enabling the /reminders command.
+func (b *Bot) WithReminderRepository(repo ports.ReminderRepository) *Bot {
+	b.reminderRepo = repo
+	return b
+}
+
+// WithNotificationPreferenceRepository sets repository of notification preferences,
+// enabling the /notifications command.
+func (b *Bot) WithNotificationPreferenceRepository(repo ports.NotificationPreferenceRepository) *Bot {
+	b.notificationPrefRepo = repo
+	return b
+}
+
+// WithWebhookService sets service managing webhooks of guilds,
+// enabling the /webhooks command.
+func (b *Bot) WithWebhookService(srv ports.WebhookService) *Bot {
+	b.webhookService = srv
+	return b
+}
+
+// WithCharacterService sets service registering Tibia characters of members.
+func (b *Bot) WithCharacterService(srv ports.CharacterService) *Bot {
+	b.characterService = srv
+	return b
+}
+
+// WithAttendanceService sets service sampling attendance of ongoing reservations on every tick.
+func (b *Bot) WithAttendanceService(srv ports.AttendanceService) *Bot {
+	b.attendanceService = srv
+	return b
+}
+
+// WithReliabilityService sets service scoring how reliably members hunt on their reservations.
+func (b *Bot) WithReliabilityService(srv ports.ReliabilityService) *Bot {
+	b.reliabilityService = srv
+	return b
+}
+
+// WithSpotRequirementsService sets service changing level and vocation requirements of spots.
+func (b *Bot) WithSpotRequirementsService(srv ports.SpotRequirementsService) *Bot {
+	b.requirementsService = srv
+	return b
+}
+
+// WithWorldListService sets service listing Tibia worlds, which guilds can add.
+func (b *Bot) WithWorldListService(srv ports.WorldListService) *Bot {
+	b.worldListService = srv
+	return b
+}
+
 // WithEVentHandler sets bot's event handler to the provided port
 func (b *Bot) WithEventHandler(port ports.APIPort) ports.BotPort {
 	b.eventHandler = port
@@ -133,6 +234,19 @@ func (b *Bot) IsRunning() bool {
 	return b.started.Load() && !b.stopped.Load()
 }
 
+// followup sends a followup message for the interaction, and records it,
+// so it can be replayed to duplicates of this interaction.
+func (b *Bot) followup(i *discordgo.InteractionCreate, params *discordgo.WebhookParams) error {
+	gID, err := stringsHelper.StrToInt64(i.GuildID)
+	if err != nil {
+		return fmt.Errorf("could not parse guild id: %v", i.GuildID)
+	}
+
+	b.interactions.Record(i.ID, params)
+	_, err = b.mgr.SessionForGuild(gID).FollowupMessageCreate(i.Interaction, false, params)
+	return err
+}
+
 func (b *Bot) interactionRespond(i *discordgo.InteractionCreate, responseData *discordgo.InteractionResponseData, responseType discordgo.InteractionResponseType) error {
 	return b.mgr.Gateway.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: responseType,
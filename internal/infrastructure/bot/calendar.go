@@ -0,0 +1,72 @@
+package bot
+
+import (
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+
+	"spot-assistant/internal/core/dto/member"
+)
+
+const (
+	calendarScopeMine   = "mine"
+	calendarScopeServer = "server"
+)
+
+// Calendar DMs the member a link to an iCalendar feed of their own, or all reservations of the guild.
+// Links are secret, so they are never posted in the guild channel.
+func (b *Bot) Calendar(i *discordgo.InteractionCreate) error {
+	options := i.ApplicationCommandData().Options
+	scope := stringOption(options, "scope")
+	rotate := boolOption(options, "rotate")
+
+	memberID := i.Member.User.ID
+	feedMemberID := memberID
+	if scope == calendarScopeServer {
+		feedMemberID = ""
+		if rotate {
+			if err := b.ensureGuildOwner(i); err != nil {
+				return err
+			}
+		}
+	}
+
+	var (
+		url string
+		err error
+	)
+	if rotate {
+		url, err = b.calendarService.RotateFeedURL(i.GuildID, feedMemberID)
+	} else {
+		url, err = b.calendarService.FeedURL(i.GuildID, feedMemberID)
+	}
+	if err != nil {
+		return err
+	}
+
+	dm, err := b.OpenDM(&member.Member{ID: memberID})
+	if err != nil {
+		return fmt.Errorf("could not open a DM: %w", err)
+	}
+
+	_, err = b.mgr.SessionForDM().ChannelMessageSend(dm.ID, formatCalendarMessage(scope == calendarScopeServer, rotate, url))
+	if err != nil {
+		return fmt.Errorf("could not send the calendar link: %w", err)
+	}
+
+	return b.followup(i, &discordgo.WebhookParams{Content: "Check your DM!"})
+}
+
+func formatCalendarMessage(server, rotated bool, url string) string {
+	subject := "your reservations"
+	if server {
+		subject = "all reservations of the server"
+	}
+
+	message := fmt.Sprintf("Subscribe to %s in your calendar app with this link:\n<%s>\nKeep it to yourself, anyone with the link can see the hunts.", subject, url)
+	if rotated {
+		message += "\nThe previous link no longer works."
+	}
+
+	return message
+}
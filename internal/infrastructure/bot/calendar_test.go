@@ -0,0 +1,24 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatCalendarMessage(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	url := "https://bot.example.com/calendar/token.ics"
+
+	// when
+	mine := formatCalendarMessage(false, false, url)
+	server := formatCalendarMessage(true, true, url)
+
+	// then
+	assert.Contains(mine, "Subscribe to your reservations")
+	assert.Contains(mine, "<https://bot.example.com/calendar/token.ics>")
+	assert.NotContains(mine, "previous link")
+	assert.Contains(server, "Subscribe to all reservations of the server")
+	assert.Contains(server, "The previous link no longer works.")
+}
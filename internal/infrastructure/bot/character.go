@@ -0,0 +1,83 @@
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"spot-assistant/internal/core/dto/character"
+)
+
+// Characters manages Tibia characters of the member. Their reservations are signed
+// with the characters, and the characters are checked when showing who is online.
+func (b *Bot) Characters(i *discordgo.InteractionCreate) error {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return errors.New("choose what to do with your characters")
+	}
+
+	subcommand := options[0]
+	memberID := i.Member.User.ID
+	var content string
+	switch subcommand.Name {
+	case "add":
+		c, err := b.characterService.AddCharacter(i.GuildID, memberID, stringOption(subcommand.Options, "name"), stringOption(subcommand.Options, "world"))
+		if err != nil {
+			return err
+		}
+		content = formatAddedCharacter(c, b.characterService.CanVerify())
+	case "remove":
+		name := stringOption(subcommand.Options, "name")
+		if err := b.characterService.RemoveCharacter(i.GuildID, memberID, name); err != nil {
+			return err
+		}
+		content = fmt.Sprintf("Character **%s** removed.", name)
+	case "list":
+		characters, err := b.characterService.ListCharacters(i.GuildID, memberID)
+		if err != nil {
+			return fmt.Errorf("could not load your characters: %w", err)
+		}
+		content = formatCharacters(characters)
+	case "verify":
+		c, err := b.characterService.VerifyCharacter(i.GuildID, memberID, stringOption(subcommand.Options, "name"))
+		if err != nil {
+			return err
+		}
+		content = fmt.Sprintf("Character **%s** verified, you can remove the code from its comment now.", c.Name)
+	default:
+		return fmt.Errorf("unknown character command: %s", subcommand.Name)
+	}
+
+	return b.followup(i, &discordgo.WebhookParams{Content: content})
+}
+
+func formatAddedCharacter(c *character.Character, canVerify bool) string {
+	content := fmt.Sprintf("Character **%s** registered, your reservations are signed with it from now on.", c.Name)
+	if canVerify {
+		content += fmt.Sprintf("\nTo verify you own it, put `%s` in the character comment and run `/character verify`.", c.VerificationCode)
+	}
+
+	return content
+}
+
+func formatCharacters(characters []*character.Character) string {
+	if len(characters) == 0 {
+		return "You have not registered any characters yet, your nick is used instead. Add one with `/character add`."
+	}
+
+	var message strings.Builder
+	message.WriteString("Your characters:")
+	for _, c := range characters {
+		message.WriteString(fmt.Sprintf("\n* **%s**", c.Name))
+		if c.World != "" {
+			message.WriteString(fmt.Sprintf(" on %s", c.World))
+		}
+		if c.IsVerified() {
+			message.WriteString(" ✅ verified")
+		}
+	}
+
+	return message.String()
+}
@@ -0,0 +1,43 @@
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/character"
+)
+
+func TestFormatAddedCharacter(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	c := &character.Character{Name: "Asar Cham", VerificationCode: "SA-0A1B2C3D"}
+
+	// when
+	withVerification := formatAddedCharacter(c, true)
+	withoutVerification := formatAddedCharacter(c, false)
+
+	// then
+	assert.Contains(withVerification, "**Asar Cham**")
+	assert.Contains(withVerification, "`SA-0A1B2C3D`")
+	assert.Contains(withoutVerification, "**Asar Cham**")
+	assert.NotContains(withoutVerification, "SA-0A1B2C3D")
+}
+
+func TestFormatCharacters(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	verifiedAt := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	// when
+	empty := formatCharacters(nil)
+	message := formatCharacters([]*character.Character{
+		{Name: "Asar Cham", VerifiedAt: verifiedAt},
+		{Name: "Irnas", World: "Celesta"},
+	})
+
+	// then
+	assert.Contains(empty, "/character add")
+	assert.Equal("Your characters:\n* **Asar Cham** ✅ verified\n* **Irnas** on Celesta", message)
+}
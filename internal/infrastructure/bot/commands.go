@@ -1,9 +1,21 @@
 package bot
 
 import (
+	"errors"
 	"fmt"
+	"slices"
 
 	"spot-assistant/internal/common/strings"
+	"spot-assistant/internal/core/dto/character"
+	"spot-assistant/internal/core/dto/discord"
+	"spot-assistant/internal/core/dto/export"
+	"spot-assistant/internal/core/dto/guildsettings"
+	"spot-assistant/internal/core/dto/guildsworld"
+	"spot-assistant/internal/core/dto/notification"
+	"spot-assistant/internal/core/dto/reliability"
+	"spot-assistant/internal/core/dto/reminder"
+	"spot-assistant/internal/core/dto/spot"
+	"spot-assistant/internal/core/dto/summary"
 
 	"github.com/bwmarrin/discordgo"
 )
@@ -20,6 +32,15 @@ func (b *Bot) handleCommand(i *discordgo.InteractionCreate) {
 		return
 	}
 
+	// Discord may redeliver an interaction, and members may double-submit a command.
+	// Only the first one is handled; duplicates get its response replayed.
+	record, isNew := b.interactions.Begin(i.ID, interactionFingerprint(i))
+	if !isNew {
+		b.replayInteraction(i, record)
+		return
+	}
+	defer b.interactions.Finish(i.ID)
+
 	// metrics: count non-autocomplete slash command invocations
 	if b.metrics != nil {
 		var guildName string
@@ -31,14 +52,12 @@ func (b *Bot) handleCommand(i *discordgo.InteractionCreate) {
 		b.metrics.IncSlashCommand(i.GuildID, guildName, name)
 	}
 
-	// Send deferred response for slash commands
-	if err := b.interactionRespond(i, &discordgo.InteractionResponseData{}, discordgo.InteractionResponseDeferredChannelMessageWithSource); err != nil {
-		b.log.Error(fmt.Errorf("could not send a deferred response: %w", err))
+	err := b.handleSlash(i)
+	if errors.Is(err, errNotResponded) {
+		log.Error(err)
 		return
 	}
 
-	err := b.handleSlash(i)
-
 	if err != nil {
 		log.Error(err)
 		if b.metrics != nil {
@@ -51,13 +70,7 @@ func (b *Bot) handleCommand(i *discordgo.InteractionCreate) {
 			b.metrics.IncCommandError(i.GuildID, guildName, name)
 		}
 		webhookParams := &discordgo.WebhookParams{Content: b.formatter.FormatGenericError(err)}
-		gID, convErr := strings.StrToInt64(i.GuildID)
-		if convErr != nil {
-			b.log.Errorf("could not translate guildID: %s", convErr)
-			return
-		}
-		dcSession := b.mgr.SessionForGuild(gID)
-		if _, respErr := dcSession.FollowupMessageCreate(i.Interaction, false, webhookParams); respErr != nil {
+		if respErr := b.followup(i, webhookParams); respErr != nil {
 			b.log.Errorf("could not respond with an error message: %s", respErr)
 		}
 	}
@@ -65,19 +78,79 @@ func (b *Bot) handleCommand(i *discordgo.InteractionCreate) {
 
 // duplicate helpers removed
 
+// replayInteraction responds to a duplicate interaction with the response of the original one.
+func (b *Bot) replayInteraction(i *discordgo.InteractionCreate, original *interactionRecord) {
+	log := b.log.With("interaction_id", i.ID, "original_interaction_id", original.interactionID)
+
+	// A redelivery of the very same interaction has already been acknowledged,
+	// and Discord does not accept a second response to it.
+	if original.interactionID == i.ID {
+		log.Warn("ignoring redelivered interaction")
+		return
+	}
+
+	log.Warn("replaying response of a duplicated interaction")
+	if err := b.interactionRespond(i, &discordgo.InteractionResponseData{}, discordgo.InteractionResponseDeferredChannelMessageWithSource); err != nil {
+		log.Error(fmt.Errorf("could not send a deferred response: %w", err))
+		return
+	}
+
+	response, completed := b.interactions.Wait(original, InteractionReplayTimeout)
+	if !completed || response == nil {
+		response = &discordgo.WebhookParams{Content: "The same command is already being processed, please wait a moment."}
+	}
+
+	if err := b.followup(i, response); err != nil {
+		log.Errorf("could not replay the response: %s", err)
+	}
+}
+
+// errNotResponded is returned when the interaction could not be responded to at all,
+// so a followup with an error message cannot be sent either.
+var errNotResponded = errors.New("could not respond to the interaction")
+
+// bookingCommands can be restricted to the booking channels of the guild.
+var bookingCommands = []string{"book", "unbook"}
+
+// slashHandlers handle slash commands by their names.
+var slashHandlers = map[string]func(b *Bot, i *discordgo.InteractionCreate) error{
+	"book":              (*Bot).Book,
+	"unbook":            (*Bot).Unbook,
+	"summary":           (*Bot).PrivateSummary,
+	"world":             (*Bot).Worlds,
+	"settings":          (*Bot).Settings,
+	"setup":             (*Bot).Setup,
+	"calendar":          (*Bot).Calendar,
+	"export":            (*Bot).Export,
+	"reminders":         (*Bot).Reminders,
+	"notifications":     (*Bot).Notifications,
+	"webhooks":          (*Bot).Webhooks,
+	"character":         (*Bot).Characters,
+	"stats":             (*Bot).Stats,
+	"import-spots":      (*Bot).ImportSpots,
+	"spot-requirements": (*Bot).SpotRequirements,
+}
+
 func (b *Bot) handleSlash(i *discordgo.InteractionCreate) error {
-	switch i.ApplicationCommandData().Name {
-	case "book":
-		return b.Book(i)
-	case "unbook":
-		return b.Unbook(i)
-	case "summary":
-		return b.PrivateSummary(i)
-	case "world-set":
-		return b.SetWorld(i)
-	default:
-		return fmt.Errorf("missing handler for command: %s", i.ApplicationCommandData().Name)
+	name := i.ApplicationCommandData().Name
+	if slices.Contains(bookingCommands, name) {
+		settings := b.guildSettings(i.GuildID)
+		if !settings.AllowsBookingIn(i.ChannelID) {
+			return b.redirectToBookingChannels(i, settings.BookingChannelIDs)
+		}
+	}
+
+	// Send deferred response for slash commands
+	if err := b.interactionRespond(i, &discordgo.InteractionResponseData{}, discordgo.InteractionResponseDeferredChannelMessageWithSource); err != nil {
+		return fmt.Errorf("%w: could not send a deferred response: %w", errNotResponded, err)
 	}
+
+	handler, ok := slashHandlers[name]
+	if !ok {
+		return fmt.Errorf("missing handler for command: %s", name)
+	}
+
+	return handler(b, i)
 }
 
 func (b *Bot) handleAutocomplete(i *discordgo.InteractionCreate) error {
@@ -86,10 +159,16 @@ func (b *Bot) handleAutocomplete(i *discordgo.InteractionCreate) error {
 		return b.BookAutocomplete(i)
 	case "unbook":
 		return b.UnbookAutocomplete(i)
-	case "world-set":
-		return b.SetWorldAutocomplete(i)
+	case "world":
+		return b.WorldAutocomplete(i)
+	case "character":
+		return b.CharacterAutocomplete(i)
 	case "summary":
 		return b.SummaryAutocomplete(i)
+	case "settings":
+		return b.SettingsAutocomplete(i)
+	case "spot-requirements":
+		return b.SpotRequirementsAutocomplete(i)
 	default:
 		return fmt.Errorf("missing handler for command: %s", i.ApplicationCommandData().Name)
 	}
@@ -148,7 +227,7 @@ func (b *Bot) getCommands() []*discordgo.ApplicationCommand {
 		},
 		{
 			Name:        "summary",
-			Description: "Request a summary snapshot (optionally for a specific respawn)",
+			Description: "Request a summary snapshot (optionally filtered)",
 			Type:        discordgo.ChatApplicationCommand,
 			Options: []*discordgo.ApplicationCommandOption{
 				{
@@ -158,27 +237,709 @@ func (b *Bot) getCommands() []*discordgo.ApplicationCommand {
 					Required:     false,
 					Autocomplete: true,
 				},
+				{
+					Name:        "mine",
+					Description: "Only your reservations (optional)",
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Required:    false,
+				},
+				{
+					Name:        "member",
+					Description: "Only reservations of a member (optional)",
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Required:    false,
+				},
+				{
+					Name:        "window",
+					Description: "Only reservations within a time window (optional)",
+					Type:        discordgo.ApplicationCommandOptionString,
+					Required:    false,
+					Choices:     timeWindowChoices(),
+				},
+				{
+					Name:        "free-now",
+					Description: "Only respawns nobody is hunting on right now, of favourites and booked ones (optional)",
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Required:    false,
+				},
 			},
 		},
 	}
-	// it's intentionally not 'set-world' as it would appear alphabetically higher than summary and unbook - purely for UX - its only used once and only by owner
-	// only register world-set if onlineCheckService is configured
+	// only register world if onlineCheckService is configured
 	if b.onlineCheckService != nil && b.onlineCheckService.IsConfigured() {
-		commands = append(commands, &discordgo.ApplicationCommand{
-			Name:        "world-set",
-			Description: "Set the Tibia world for this server (owner only)",
-			Type:        discordgo.ChatApplicationCommand,
-			Options: []*discordgo.ApplicationCommandOption{
-				{
-					Name:         "world",
-					Description:  "Tibia world name",
-					Type:         discordgo.ApplicationCommandOptionString,
-					Required:     true,
-					Autocomplete: true,
+		commands = append(commands, worldCommand())
+	}
+
+	if b.guildSettingsRepo != nil {
+		commands = append(commands, settingsCommand(), setupCommand())
+	}
+
+	if b.calendarService != nil && b.calendarService.IsConfigured() {
+		commands = append(commands, calendarCommand())
+	}
+
+	if b.reminderRepo != nil {
+		commands = append(commands, remindersCommand())
+	}
+
+	if b.notificationPrefRepo != nil {
+		commands = append(commands, notificationsCommand())
+	}
+
+	if b.webhookService != nil {
+		commands = append(commands, webhooksCommand())
+	}
+
+	if b.characterService != nil {
+		commands = append(commands, characterCommand(b.characterService.CanVerify()))
+	}
+
+	if b.reliabilityService != nil {
+		commands = append(commands, statsCommand())
+	}
+
+	if b.exportService != nil {
+		commands = append(commands, exportCommand())
+		if Config.SpotImport {
+			commands = append(commands, importSpotsCommand())
+		}
+	}
+
+	if b.requirementsService != nil && Config.SpotImport {
+		commands = append(commands, spotRequirementsCommand())
+	}
+
+	return commands
+}
+
+func timeWindowChoices() []*discordgo.ApplicationCommandOptionChoice {
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(summary.TimeWindows))
+	for _, window := range summary.TimeWindows {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: string(window), Value: string(window)})
+	}
+
+	return choices
+}
+
+func settingsCommand() *discordgo.ApplicationCommand {
+	minReminderMinutes := float64(0)
+	minScore, minHoursAhead := float64(0), float64(1)
+	chartChoices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(guildsettings.SummaryCharts))
+	for _, chart := range guildsettings.SummaryCharts {
+		chartChoices = append(chartChoices, &discordgo.ApplicationCommandOptionChoice{Name: string(chart), Value: string(chart)})
+	}
+	layoutChoices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(guildsettings.SummaryLayouts))
+	for _, layout := range guildsettings.SummaryLayouts {
+		layoutChoices = append(layoutChoices, &discordgo.ApplicationCommandOptionChoice{Name: string(layout), Value: string(layout)})
+	}
+
+	return &discordgo.ApplicationCommand{
+		Name:        "settings",
+		Description: "Change settings of the bot for this server (owner only)",
+		Type:        discordgo.ChatApplicationCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        "chart",
+				Description: "Choose charts attached to the summary",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:        "type",
+						Description: "Pie chart of bookings per respawn, timeline of upcoming bookings, or both",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    true,
+						Choices:     chartChoices,
+					},
+				},
+			},
+			{
+				Name:        "layout",
+				Description: "Choose how reservations are laid out in the summary",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:        "type",
+						Description: "By respawn, by starting hour, compact table of now and next, or only hunts in progress",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    true,
+						Choices:     layoutChoices,
+					},
+				},
+			},
+			{
+				Name:        "booking-channel",
+				Description: "Restrict /book and /unbook to chosen channels",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:        "action",
+						Description: "Add or remove a channel, or clear the list to allow booking anywhere",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    true,
+						Choices: []*discordgo.ApplicationCommandOptionChoice{
+							{Name: "add", Value: "add"},
+							{Name: "remove", Value: "remove"},
+							{Name: "clear", Value: "clear"},
+						},
+					},
+					{
+						Name:         "channel",
+						Description:  "Channel to add or remove",
+						Type:         discordgo.ApplicationCommandOptionChannel,
+						ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+					},
+				},
+			},
+			{
+				Name:        "favourites",
+				Description: "Choose respawns listed as free now or free soon in the summary",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:        "action",
+						Description: "Add or remove a respawn, or clear the list to hide free respawns",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    true,
+						Choices: []*discordgo.ApplicationCommandOptionChoice{
+							{Name: "add", Value: "add"},
+							{Name: "remove", Value: "remove"},
+							{Name: "clear", Value: "clear"},
+						},
+					},
+					{
+						Name:         "respawn",
+						Description:  "Respawn to add or remove",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Autocomplete: true,
+					},
+				},
+			},
+			{
+				Name:        "branding",
+				Description: "Change texts and looks of the summary",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:        "title",
+						Description: "Title of the summary",
+						Type:        discordgo.ApplicationCommandOptionString,
+					},
+					{
+						Name:        "url",
+						Description: "Link opened by clicking the title",
+						Type:        discordgo.ApplicationCommandOptionString,
+					},
+					{
+						Name:        "description",
+						Description: "Text shown below the title",
+						Type:        discordgo.ApplicationCommandOptionString,
+					},
+					{
+						Name:        "color",
+						Description: "Colour of the summary, e.g. #ff8800",
+						Type:        discordgo.ApplicationCommandOptionString,
+					},
+					{
+						Name:        "thumbnail",
+						Description: "Link to an image shown next to the title",
+						Type:        discordgo.ApplicationCommandOptionString,
+					},
+					{
+						Name:        "pre-message",
+						Description: "Message posted above the summary",
+						Type:        discordgo.ApplicationCommandOptionString,
+					},
+					{
+						Name:        "pre-message-enabled",
+						Description: "Whether any message should be posted above the summary",
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+					},
+					{
+						Name:        "reset",
+						Description: "Restore the default texts and looks",
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+					},
+				},
+			},
+			{
+				Name:        "reminders",
+				Description: "Choose how early members are reminded of their hunts by default",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:        "minutes",
+						Description: "Minutes before the hunt, 0 turns reminders off",
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						Required:    true,
+						MinValue:    &minReminderMinutes,
+						MaxValue:    reminder.MaxMinutesBefore,
+					},
+				},
+			},
+			{
+				Name:        "reliability",
+				Description: "Restrict members, who often cancel late or do not show up",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:        "min-score",
+						Description: "Members scoring below cannot book far ahead, 0 turns restrictions off",
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						Required:    true,
+						MinValue:    &minScore,
+						MaxValue:    reliability.MaxScore,
+					},
+					{
+						Name:        "hours-ahead",
+						Description: fmt.Sprintf("How far ahead restricted members can book (%d by default)", guildsettings.DefaultReliabilityMaxHoursAhead),
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						MinValue:    &minHoursAhead,
+						MaxValue:    guildsettings.MaxReliabilityHoursAhead,
+					},
+					{
+						Name:        "overbook-dm",
+						Description: fmt.Sprintf("DM members with the @%s role how reliable members they overbook are", discord.PrivilegedRole),
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+					},
+				},
+			},
+			{
+				Name:        "spot-requirements",
+				Description: "Let members book only respawns, whose requirements one of their characters meets",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:        "enabled",
+						Description: "Whether level and vocation requirements of respawns should be checked",
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Required:    true,
+					},
+				},
+			},
+			{
+				Name:        "tibia-guild",
+				Description: "Let only members with a character in an in-game guild book, everyone while TibiaData is down",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:        "name",
+						Description: "Name of the in-game guild, leave empty to let everyone book",
+						Type:        discordgo.ApplicationCommandOptionString,
+						MaxLength:   guildsettings.MaxTibiaGuildLength,
+					},
 				},
 			},
+			{
+				Name:        "booking-mirror",
+				Description: "Mirror bookings made elsewhere to the command channel",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:        "enabled",
+						Description: "Whether bookings should be mirrored",
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Required:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func setupCommand() *discordgo.ApplicationCommand {
+	return &discordgo.ApplicationCommand{
+		Name:        "setup",
+		Description: "Choose channels and the role used by the bot, or show them (owner only)",
+		Type:        discordgo.ChatApplicationCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:         "summary-channel",
+				Description:  "Channel where the summary is posted",
+				Type:         discordgo.ApplicationCommandOptionChannel,
+				ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+				Required:     false,
+			},
+			{
+				Name:         "command-channel",
+				Description:  "Channel where members use the commands",
+				Type:         discordgo.ApplicationCommandOptionChannel,
+				ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+				Required:     false,
+			},
+			{
+				Name:        "privileged-role",
+				Description: "Role allowed to overbook reservations",
+				Type:        discordgo.ApplicationCommandOptionRole,
+				Required:    false,
+			},
+		},
+	}
+}
+
+func calendarCommand() *discordgo.ApplicationCommand {
+	return &discordgo.ApplicationCommand{
+		Name:        "calendar",
+		Description: "Get a link to subscribe to hunts in your calendar app",
+		Type:        discordgo.ChatApplicationCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        "scope",
+				Description: "Only your reservations (default), or all reservations of the server",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    false,
+				Choices: []*discordgo.ApplicationCommandOptionChoice{
+					{Name: "mine", Value: calendarScopeMine},
+					{Name: "server", Value: calendarScopeServer},
+				},
+			},
+			{
+				Name:        "rotate",
+				Description: "Issue a new link, so the previous one stops working (server links: owner only)",
+				Type:        discordgo.ApplicationCommandOptionBoolean,
+				Required:    false,
+			},
+		},
+	}
+}
+
+func remindersCommand() *discordgo.ApplicationCommand {
+	minReminderMinutes := float64(0)
+
+	return &discordgo.ApplicationCommand{
+		Name:        "reminders",
+		Description: "Choose how early you are reminded of your hunts, or show it",
+		Type:        discordgo.ChatApplicationCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        "minutes",
+				Description: "Minutes before the hunt, 0 turns reminders off",
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Required:    false,
+				MinValue:    &minReminderMinutes,
+				MaxValue:    reminder.MaxMinutesBefore,
+			},
+			{
+				Name:        "default",
+				Description: "Follow the default of the server instead",
+				Type:        discordgo.ApplicationCommandOptionBoolean,
+				Required:    false,
+			},
+		},
+	}
+}
+
+func notificationsCommand() *discordgo.ApplicationCommand {
+	kindChoices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(notification.Kinds))
+	for _, kind := range notification.Kinds {
+		kindChoices = append(kindChoices, &discordgo.ApplicationCommandOptionChoice{Name: string(kind), Value: string(kind)})
+	}
+	channelChoices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(notification.Channels))
+	for _, channel := range notification.Channels {
+		channelChoices = append(channelChoices, &discordgo.ApplicationCommandOptionChoice{Name: string(channel), Value: string(channel)})
+	}
+
+	return &discordgo.ApplicationCommand{
+		Name:        "notifications",
+		Description: "Choose how you are notified, or show it",
+		Type:        discordgo.ChatApplicationCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        "kind",
+				Description: "Kind of notifications",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    false,
+				Choices:     kindChoices,
+			},
+			{
+				Name:        "channel",
+				Description: "DM, a mention in the command channel, or none at all",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    false,
+				Choices:     channelChoices,
+			},
+		},
+	}
+}
+
+func exportCommand() *discordgo.ApplicationCommand {
+	formatChoices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(export.Formats))
+	for _, format := range export.Formats {
+		formatChoices = append(formatChoices, &discordgo.ApplicationCommandOptionChoice{Name: string(format), Value: string(format)})
+	}
+	formatOption := &discordgo.ApplicationCommandOption{
+		Name:        "format",
+		Description: "Format of the file (csv by default)",
+		Type:        discordgo.ApplicationCommandOptionString,
+		Required:    false,
+		Choices:     formatChoices,
+	}
+
+	return &discordgo.ApplicationCommand{
+		Name:        "export",
+		Description: "Export reservations or respawns as a file (owner only)",
+		Type:        discordgo.ChatApplicationCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        "reservations",
+				Description: "Export reservations of this server",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					formatOption,
+					{
+						Name:        "from",
+						Description: "First day, e.g. 2024-05-01 (a week ago by default)",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    false,
+					},
+					{
+						Name:        "to",
+						Description: "Last day, e.g. 2024-05-07 (a week ahead by default)",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    false,
+					},
+				},
+			},
+			{
+				Name:        "spots",
+				Description: "Export all respawns",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options:     []*discordgo.ApplicationCommandOption{formatOption},
+			},
+		},
+	}
+}
+
+func importSpotsCommand() *discordgo.ApplicationCommand {
+	return &discordgo.ApplicationCommand{
+		Name:        "import-spots",
+		Description: "Import respawns from a CSV or JSON file (owner only)",
+		Type:        discordgo.ChatApplicationCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        "file",
+				Description: "A .csv file with a name per line, or a .json file with [{\"name\": ...}]",
+				Type:        discordgo.ApplicationCommandOptionAttachment,
+				Required:    true,
+			},
+			{
+				Name:        "dry-run",
+				Description: "Only report what would be imported (true by default)",
+				Type:        discordgo.ApplicationCommandOptionBoolean,
+				Required:    false,
+			},
+		},
+	}
+}
+
+func spotRequirementsCommand() *discordgo.ApplicationCommand {
+	minLevel := float64(0)
+
+	return &discordgo.ApplicationCommand{
+		Name:        "spot-requirements",
+		Description: "Set level and vocation requirements of a respawn, for all servers (owner only)",
+		Type:        discordgo.ChatApplicationCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:         "respawn",
+				Description:  "Name of the respawn",
+				Type:         discordgo.ApplicationCommandOptionString,
+				Required:     true,
+				Autocomplete: true,
+			},
+			{
+				Name:        "min-level",
+				Description: "Lowest level of characters hunting there, 0 for any",
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				MinValue:    &minLevel,
+				MaxValue:    spot.MaxLevel,
+			},
+			{
+				Name:        "max-level",
+				Description: "Highest level of characters hunting there, 0 for any",
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				MinValue:    &minLevel,
+				MaxValue:    spot.MaxLevel,
+			},
+			{
+				Name:        "vocations",
+				Description: "Comma separated vocations allowed there, e.g. Knight, Paladin; empty for any",
+				Type:        discordgo.ApplicationCommandOptionString,
+			},
+		},
+	}
+}
+
+func webhooksCommand() *discordgo.ApplicationCommand {
+	minWebhookID := float64(1)
+	minDeliveries := float64(1)
+
+	return &discordgo.ApplicationCommand{
+		Name:        "webhooks",
+		Description: "Send booking events of this server to your services (owner only)",
+		Type:        discordgo.ChatApplicationCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        "add",
+				Description: "Add a webhook, its signing secret is sent to you in a DM",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:        "url",
+						Description: "An https:// URL receiving POST requests with JSON events",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    true,
+					},
+				},
+			},
+			{
+				Name:        "remove",
+				Description: "Remove a webhook together with its pending deliveries",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:        "id",
+						Description: "ID of the webhook, as shown by /webhooks list",
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						Required:    true,
+						MinValue:    &minWebhookID,
+					},
+				},
+			},
+			{
+				Name:        "list",
+				Description: "List webhooks of this server",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+			},
+			{
+				Name:        "deliveries",
+				Description: "List recent deliveries of events",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:        "count",
+						Description: fmt.Sprintf("Number of deliveries (%d by default)", DefaultListedDeliveries),
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						Required:    false,
+						MinValue:    &minDeliveries,
+						MaxValue:    MaxListedDeliveries,
+					},
+				},
+			},
+		},
+	}
+}
+
+func characterCommand(canVerify bool) *discordgo.ApplicationCommand {
+	nameOption := func(description string) []*discordgo.ApplicationCommandOption {
+		return []*discordgo.ApplicationCommandOption{
+			{
+				Name:        "name",
+				Description: description,
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    true,
+				MaxLength:   29,
+			},
+		}
+	}
+
+	addOptions := nameOption("Name of the character")
+	if canVerify {
+		addOptions = append(addOptions, &discordgo.ApplicationCommandOption{
+			Name:         "world",
+			Description:  "World the character plays on (optional, found when verifying)",
+			Type:         discordgo.ApplicationCommandOptionString,
+			Required:     false,
+			Autocomplete: true,
 		})
 	}
 
-	return commands
+	command := &discordgo.ApplicationCommand{
+		Name:        "character",
+		Description: "Register Tibia characters your reservations are signed with",
+		Type:        discordgo.ChatApplicationCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        "add",
+				Description: fmt.Sprintf("Register a character (at most %d)", character.MaxCharactersPerMember),
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options:     addOptions,
+			},
+			{
+				Name:        "remove",
+				Description: "Remove a registered character",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options:     nameOption("Name of the character"),
+			},
+			{
+				Name:        "list",
+				Description: "List your characters",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+			},
+		},
+	}
+	if canVerify {
+		command.Options = append(command.Options, &discordgo.ApplicationCommandOption{
+			Name:        "verify",
+			Description: "Prove you own a character by putting its code in the character comment",
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Options:     nameOption("Name of the character"),
+		})
+	}
+
+	return command
+}
+
+func worldCommand() *discordgo.ApplicationCommand {
+	worldOption := func(description string) []*discordgo.ApplicationCommandOption {
+		return []*discordgo.ApplicationCommandOption{
+			{
+				Name:         "world",
+				Description:  description,
+				Type:         discordgo.ApplicationCommandOptionString,
+				Required:     true,
+				Autocomplete: true,
+			},
+		}
+	}
+
+	return &discordgo.ApplicationCommand{
+		Name:        "world",
+		Description: "Manage Tibia worlds members of this server play on",
+		Type:        discordgo.ChatApplicationCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        "add",
+				Description: fmt.Sprintf("Add a Tibia world (owner only, at most %d)", guildsworld.MaxWorldsPerGuild),
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options:     worldOption("Tibia world name"),
+			},
+			{
+				Name:        "remove",
+				Description: "Remove a Tibia world (owner only)",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options:     worldOption("Tibia world name"),
+			},
+			{
+				Name:        "list",
+				Description: "List Tibia worlds of this server",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+			},
+		},
+	}
+}
+
+func statsCommand() *discordgo.ApplicationCommand {
+	return &discordgo.ApplicationCommand{
+		Name:        "stats",
+		Description: "Show how reliably you hunt on your reservations",
+		Type:        discordgo.ChatApplicationCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        "member",
+				Description: fmt.Sprintf("Show stats of another member (@%s only)", discord.PrivilegedRole),
+				Type:        discordgo.ApplicationCommandOptionUser,
+				Required:    false,
+			},
+		},
+	}
 }
@@ -1,27 +1,33 @@
 package bot
 
-import "github.com/bwmarrin/discordgo"
+import (
+	"fmt"
 
-func (b *Bot) baseEmbed() *discordgo.MessageEmbed {
-	return &discordgo.MessageEmbed{
-		URL:         "https://tibialoot.com",
-		Type:        discordgo.EmbedTypeRich,
-		Title:       "TibiaLoot.com - Spot Assistant",
-		Description: "Current and upcoming hunts. Times are in **Europe/Berlin**. \n :green_circle: **Online** \n :red_circle: **Offline**",
-	}
-}
+	"github.com/bwmarrin/discordgo"
+
+	"spot-assistant/internal/core/dto/summary"
+)
+
+// statusLegend explains the online status icons used in the summary.
+const statusLegend = ":green_circle: **Online** \n :red_circle: **Offline**"
 
 func (b *Bot) newEmbed(
-	title string,
-	url string,
-	description string,
+	sum *summary.Summary,
 	fields []*discordgo.MessageEmbedField,
 	footer *discordgo.MessageEmbedFooter,
 ) *discordgo.MessageEmbed {
-	embed := b.baseEmbed()
-
-	embed.Fields = fields
-	embed.Footer = footer
+	embed := &discordgo.MessageEmbed{
+		URL:         sum.URL,
+		Type:        discordgo.EmbedTypeRich,
+		Title:       sum.Title,
+		Description: fmt.Sprintf("%s \n %s", sum.Description, statusLegend),
+		Color:       sum.Color,
+		Fields:      fields,
+		Footer:      footer,
+	}
+	if sum.ThumbnailURL != "" {
+		embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: sum.ThumbnailURL}
+	}
 
 	return embed
 }
@@ -0,0 +1,34 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/summary"
+)
+
+func TestNewEmbed(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	sum := &summary.Summary{
+		Title:        "Our Guild",
+		URL:          "https://example.com",
+		Description:  "Our hunts.",
+		ThumbnailURL: "https://example.com/logo.png",
+		Color:        0xff8800,
+	}
+	footer := &discordgo.MessageEmbedFooter{Text: "footer"}
+
+	// when
+	embed := (&Bot{}).newEmbed(sum, []*discordgo.MessageEmbedField{}, footer)
+
+	// then
+	assert.Equal("Our Guild", embed.Title)
+	assert.Equal("https://example.com", embed.URL)
+	assert.Equal("Our hunts. \n "+statusLegend, embed.Description)
+	assert.Equal(0xff8800, embed.Color)
+	assert.Equal("https://example.com/logo.png", embed.Thumbnail.URL)
+	assert.Equal(footer, embed.Footer)
+}
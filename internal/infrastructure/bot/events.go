@@ -0,0 +1,21 @@
+package bot
+
+import "spot-assistant/internal/core/dto/event"
+
+// HandleEvent refreshes the summary of a guild whenever its reservations, its world
+// or characters of its members change.
+// Clipped reservations always come with the reservation which clipped them, so they are skipped.
+func (b *Bot) HandleEvent(e event.Event) {
+	switch e.(type) {
+	case event.ReservationCreated, event.ReservationCancelled:
+		b.refreshGuildLetter(e.GuildID())
+	case event.GuildWorldChanged:
+		b.onlineCheckService.TryRefresh(e.GuildID())
+		b.refreshGuildLetter(e.GuildID())
+	case event.MemberCharactersChanged:
+		if err := b.onlineCheckService.RefreshCharacters(e.GuildID()); err != nil {
+			b.log.Errorf("could not refresh characters of guild %s: %s", e.GuildID(), err)
+		}
+		b.refreshGuildLetter(e.GuildID())
+	}
+}
@@ -0,0 +1,168 @@
+package bot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"spot-assistant/internal/core/dto/export"
+)
+
+// Limits of spot lists imported from attachments.
+const (
+	MaxImportSize    = 1 << 20
+	ImportTimeout    = 10 * time.Second
+	MaxReportedSkips = 15
+)
+
+var exportContentTypes = map[export.Format]string{
+	export.FormatCSV:  "text/csv",
+	export.FormatJSON: "application/json",
+}
+
+// Export attaches reservations of the guild, or all spots, as a CSV or JSON file (owner only).
+func (b *Bot) Export(i *discordgo.InteractionCreate) error {
+	if err := b.ensureGuildOwner(i); err != nil {
+		return err
+	}
+
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return errors.New("choose what to export")
+	}
+
+	subcommand := options[0]
+	format := export.Format(stringOption(subcommand.Options, "format"))
+	if format == "" {
+		format = export.FormatCSV
+	}
+
+	var (
+		data     []byte
+		fileName string
+		message  string
+		err      error
+	)
+	switch subcommand.Name {
+	case "reservations":
+		from, to, rangeErr := export.ParseRange(stringOption(subcommand.Options, "from"), stringOption(subcommand.Options, "to"), time.Now())
+		if rangeErr != nil {
+			return rangeErr
+		}
+		data, err = b.exportService.ExportReservations(i.GuildID, from, to, format)
+		fileName = fmt.Sprintf("reservations-%s-%s.%s", from.Format(export.DateFormat), to.AddDate(0, 0, -1).Format(export.DateFormat), format)
+		message = fmt.Sprintf("Reservations from %s to %s:", from.Format(export.DateFormat), to.AddDate(0, 0, -1).Format(export.DateFormat))
+	case "spots":
+		data, err = b.exportService.ExportSpots(format)
+		fileName = fmt.Sprintf("spots.%s", format)
+		message = "All respawns:"
+	default:
+		err = fmt.Errorf("unknown export: %s", subcommand.Name)
+	}
+	if err != nil {
+		return err
+	}
+
+	return b.followup(i, &discordgo.WebhookParams{
+		Content: message,
+		Files: []*discordgo.File{{
+			Name:        fileName,
+			ContentType: exportContentTypes[format],
+			Reader:      bytes.NewReader(data),
+		}},
+	})
+}
+
+// ImportSpots adds respawns listed in an attached CSV or JSON file (owner only).
+// Unless dry-run is turned off, it only reports what would be added.
+func (b *Bot) ImportSpots(i *discordgo.InteractionCreate) error {
+	if err := b.ensureGuildOwner(i); err != nil {
+		return err
+	}
+
+	data := i.ApplicationCommandData()
+	if data.Resolved == nil {
+		return errors.New("attach a file with respawns to import")
+	}
+	attachment, ok := data.Resolved.Attachments[idOption(data.Options, "file")]
+	if !ok {
+		return errors.New("attach a file with respawns to import")
+	}
+	if attachment.Size > MaxImportSize {
+		return fmt.Errorf("the file must not be larger than %d KiB", MaxImportSize/1024)
+	}
+
+	format := export.Format(strings.TrimPrefix(path.Ext(attachment.Filename), "."))
+	if !format.IsValid() {
+		return fmt.Errorf("unsupported file %s, attach a .csv or .json file", attachment.Filename)
+	}
+
+	content, err := downloadAttachment(attachment.URL)
+	if err != nil {
+		return err
+	}
+
+	dryRun := true
+	for _, opt := range data.Options {
+		if opt.Name == "dry-run" {
+			dryRun = opt.BoolValue()
+		}
+	}
+
+	report, err := b.exportService.ImportSpots(content, format, dryRun)
+	if err != nil {
+		return err
+	}
+
+	return b.followup(i, &discordgo.WebhookParams{Content: formatImportReport(report)})
+}
+
+func downloadAttachment(url string) ([]byte, error) {
+	client := &http.Client{Timeout: ImportTimeout}
+	res, err := client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("could not download the attachment: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not download the attachment: %s", res.Status)
+	}
+
+	return io.ReadAll(io.LimitReader(res.Body, MaxImportSize))
+}
+
+func formatImportReport(report *export.ImportReport) string {
+	sb := strings.Builder{}
+	if report.DryRun {
+		sb.WriteString(fmt.Sprintf("Dry run: **%d** respawns would be added, **%d** skipped. Run again with `dry-run: False` to import them.\n", len(report.Added), len(report.Skipped)))
+	} else {
+		sb.WriteString(fmt.Sprintf("**%d** respawns added, **%d** skipped.\n", len(report.Added), len(report.Skipped)))
+	}
+
+	for index, skipped := range report.Skipped {
+		if index == MaxReportedSkips {
+			sb.WriteString(fmt.Sprintf("...and %d more.\n", len(report.Skipped)-MaxReportedSkips))
+			break
+		}
+		sb.WriteString(fmt.Sprintf("- line %d `%s`: %s\n", skipped.Line, truncate(skipped.Name, 40), skipped.Reason))
+	}
+
+	return sb.String()
+}
+
+func truncate(s string, length int) string {
+	runes := []rune(s)
+	if len(runes) <= length {
+		return s
+	}
+
+	return string(runes[:length-3]) + "..."
+}
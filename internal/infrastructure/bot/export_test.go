@@ -0,0 +1,45 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/export"
+)
+
+func TestFormatImportReport(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	report := &export.ImportReport{
+		DryRun:  true,
+		Added:   []string{"Flimsy"},
+		Skipped: []export.SkippedSpot{{Line: 3, Name: "Lost Souls", Reason: "already exists"}},
+	}
+
+	// when
+	message := formatImportReport(report)
+
+	// then
+	assert.Equal("Dry run: **1** respawns would be added, **1** skipped. Run again with `dry-run: False` to import them.\n"+
+		"- line 3 `Lost Souls`: already exists\n", message)
+}
+
+func TestFormatImportReportTruncatesSkips(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	report := &export.ImportReport{Added: []string{}}
+	for line := 1; line <= MaxReportedSkips+5; line++ {
+		report.Skipped = append(report.Skipped, export.SkippedSpot{Line: line, Name: fmt.Sprintf("spot %d", line), Reason: "already exists"})
+	}
+
+	// when
+	message := formatImportReport(report)
+
+	// then
+	assert.True(strings.HasPrefix(message, "**0** respawns added, **20** skipped.\n"))
+	assert.Equal(MaxReportedSkips, strings.Count(message, "already exists"))
+	assert.True(strings.HasSuffix(message, "...and 5 more.\n"))
+}
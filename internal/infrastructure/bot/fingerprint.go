@@ -0,0 +1,55 @@
+package bot
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"spot-assistant/internal/core/dto/summary"
+)
+
+// ledgerFingerprint identifies the content of the ledger: spots, bookings and online statuses.
+// Whether a booking has already started is a part of the fingerprint too,
+// so the letter is refreshed once a reservation begins. Finished reservations
+// are no longer upcoming, so they change the ledger on their own.
+func ledgerFingerprint(ledger summary.Ledger, now time.Time) string {
+	hash := sha256.New()
+	for _, entry := range ledger {
+		fmt.Fprintf(hash, "spot:%s\n", entry.Spot)
+		for _, booking := range entry.Bookings {
+			fmt.Fprintf(
+				hash,
+				"booking:%s|%s|%d|%d|%d|%t\n",
+				booking.Author,
+				booking.AuthorDiscordID,
+				booking.Status,
+				booking.StartAt.Unix(),
+				booking.EndAt.Unix(),
+				!now.Before(booking.StartAt),
+			)
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// summaryFingerprint identifies the content of the summary: the ledger and the free spots.
+func summaryFingerprint(sum *summary.Summary, now time.Time) string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "ledger:%s\n", ledgerFingerprint(sum.Ledger, now))
+	for _, spot := range sum.FreeNow {
+		fmt.Fprintf(hash, "free-now:%s|%d\n", spot.Spot, spot.Until.Unix())
+	}
+	for _, spot := range sum.FreeSoon {
+		fmt.Fprintf(hash, "free-soon:%s|%d|%d\n", spot.Spot, spot.From.Unix(), spot.Until.Unix())
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// invalidateLetter forces the next letter update of the guild to be sent,
+// even if the ledger did not change.
+func (b *Bot) invalidateLetter(guildID string) {
+	b.letterFingerprints.Remove(guildID)
+}
@@ -0,0 +1,84 @@
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/summary"
+)
+
+func newTestLedger(startAt time.Time, status summary.OnlineStatus) summary.Ledger {
+	return summary.Ledger{
+		{
+			Spot: "Asura Palace",
+			Bookings: []*summary.Booking{
+				{
+					Author:          "test-author",
+					AuthorDiscordID: "test-author-discord-id",
+					Status:          status,
+					StartAt:         startAt,
+					EndAt:           startAt.Add(2 * time.Hour),
+				},
+			},
+		},
+	}
+}
+
+func TestLedgerFingerprint_SameLedger(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	tNow := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	startAt := tNow.Add(time.Hour)
+
+	// when
+	first := ledgerFingerprint(newTestLedger(startAt, summary.Online), tNow)
+	second := ledgerFingerprint(newTestLedger(startAt, summary.Online), tNow.Add(2*time.Minute))
+
+	// then
+	assert.Equal(first, second)
+}
+
+func TestLedgerFingerprint_StatusChanged(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	tNow := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	startAt := tNow.Add(time.Hour)
+
+	// when
+	online := ledgerFingerprint(newTestLedger(startAt, summary.Online), tNow)
+	offline := ledgerFingerprint(newTestLedger(startAt, summary.Offline), tNow)
+
+	// then
+	assert.NotEqual(online, offline)
+}
+
+func TestLedgerFingerprint_ReservationStarted(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	tNow := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	startAt := tNow.Add(time.Minute)
+	ledger := newTestLedger(startAt, summary.Online)
+
+	// when
+	before := ledgerFingerprint(ledger, tNow)
+	after := ledgerFingerprint(ledger, tNow.Add(2*time.Minute))
+
+	// then
+	assert.NotEqual(before, after)
+}
+
+func TestSummaryFingerprint_FreeSpotsChanged(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	tNow := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	ledger := newTestLedger(tNow.Add(time.Hour), summary.Online)
+
+	// when
+	withoutFree := summaryFingerprint(&summary.Summary{Ledger: ledger}, tNow)
+	withFree := summaryFingerprint(&summary.Summary{Ledger: ledger, FreeNow: []summary.FreeSpot{{Spot: "Flimsy"}}}, tNow)
+
+	// then
+	assert.NotEqual(withoutFree, withFree)
+}
@@ -1,6 +1,7 @@
 package formatter
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -9,6 +10,7 @@ import (
 	"spot-assistant/internal/common/collections"
 	stringsHelper "spot-assistant/internal/common/strings"
 	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/reminder"
 	"spot-assistant/internal/core/dto/reservation"
 )
 
@@ -28,6 +30,11 @@ func (f *DiscordFormatter) FormatUnbookResponse(res *reservation.ReservationWith
 
 // FormatBookError formats book error to Discord format
 func (f *DiscordFormatter) FormatBookError(response book.BookResponse, err error) string {
+	var rejection *book.Rejection
+	if errors.As(err, &rejection) {
+		return fmt.Sprintf("Sorry, but you cannot book this spot: %s.", rejection.Error())
+	}
+
 	var message strings.Builder
 	message.WriteString(f.FormatGenericError(err))
 
@@ -110,3 +117,26 @@ func (f *DiscordFormatter) FormatOverbookedMemberNotification(
 
 	return msgBody.String()
 }
+
+// FormatReminder formats a reminder of a reservation about to start, mentioning
+// the previous hunt on the spot, so the member knows whom the spot is taken over from.
+func (f *DiscordFormatter) FormatReminder(r *reminder.Reminder) string {
+	var msgBody strings.Builder
+
+	msgBody.WriteString(fmt.Sprintf(
+		"Reminder: your hunt on **%s** starts at **%s** and lasts until **%s**.",
+		r.Reservation.Spot.Name,
+		r.Reservation.StartAt.Format(stringsHelper.DcLongTimeFormat),
+		r.Reservation.EndAt.Format(stringsHelper.DcLongTimeFormat),
+	))
+	if r.Previous != nil {
+		msgBody.WriteString(fmt.Sprintf(
+			"\n%s (<@!%s>) is hunting there until **%s**.",
+			r.Previous.Author,
+			r.Previous.AuthorDiscordID,
+			r.Previous.EndAt.Format(stringsHelper.DcLongTimeFormat),
+		))
+	}
+
+	return msgBody.String()
+}
@@ -2,6 +2,7 @@ package formatter
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"spot-assistant/internal/core/dto/book"
 	"spot-assistant/internal/core/dto/guild"
 	"spot-assistant/internal/core/dto/member"
+	"spot-assistant/internal/core/dto/reminder"
 	"spot-assistant/internal/core/dto/reservation"
 )
 
@@ -92,6 +94,18 @@ func TestDiscordFormatter_FormatBookError(t *testing.T) {
 	snaps.MatchSnapshot(t, output)
 }
 
+func TestDiscordFormatter_FormatBookError_Rejection(t *testing.T) {
+	// given
+	formatter := NewFormatter()
+	err := book.Reject(errors.New("none of your characters is a member of Red Rose"))
+
+	// when
+	output := formatter.FormatBookError(book.BookResponse{}, fmt.Errorf("rule failed: %w", err))
+
+	// assert
+	snaps.MatchSnapshot(t, output)
+}
+
 func TestDiscordFormatter_FormatUnbookResponse1(t *testing.T) {
 	// given
 	formatter := NewFormatter()
@@ -192,3 +206,29 @@ func TestDiscordFormatter_FormatOverbookedMemberNotification(t *testing.T) {
 	// assert
 	snaps.MatchSnapshot(t, output)
 }
+
+func TestDiscordFormatter_FormatReminder(t *testing.T) {
+	// given
+	formatter := NewFormatter()
+	r := &reminder.Reminder{
+		Reservation: reservation.ReservationWithSpot{
+			Reservation: reservation.Reservation{
+				StartAt: time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC),
+				EndAt:   time.Date(2021, 1, 1, 14, 0, 0, 0, time.UTC),
+			},
+			Spot: reservation.Spot{Name: "Flimsy"},
+		},
+		MinutesBefore: 15,
+		Previous: &reminder.PreviousHunt{
+			Author:          "test-previous",
+			AuthorDiscordID: "test-previous-id",
+			EndAt:           time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	// when
+	output := formatter.FormatReminder(r)
+
+	// assert
+	snaps.MatchSnapshot(t, output)
+}
@@ -0,0 +1,51 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"spot-assistant/internal/core/dto/summary"
+)
+
+// freeSpotsFields lists the favourite spots of the guild, which are free now or soon.
+// No fields are returned if none of them is.
+func freeSpotsFields(sum *summary.Summary) []*discordgo.MessageEmbedField {
+	fields := make([]*discordgo.MessageEmbedField, 0, 2)
+	if len(sum.FreeNow) > 0 {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Free now",
+			Value: formatFreeSpots(sum.FreeNow),
+		})
+	}
+	if len(sum.FreeSoon) > 0 {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "Free soon",
+			Value: formatFreeSpots(sum.FreeSoon),
+		})
+	}
+
+	return fields
+}
+
+func formatFreeSpots(spots []summary.FreeSpot) string {
+	lines := make([]string, len(spots))
+	for i, spot := range spots {
+		lines[i] = formatFreeSpot(spot)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func formatFreeSpot(spot summary.FreeSpot) string {
+	line := fmt.Sprintf("`%s`", spot.Spot)
+	if !spot.From.IsZero() {
+		line += fmt.Sprintf(" from **%s**", spot.From.Format("15:04"))
+	}
+	if spot.Until.IsZero() {
+		return line + ", no upcoming hunts"
+	}
+
+	return line + fmt.Sprintf(" until **%s**", spot.Until.Format("15:04"))
+}
@@ -0,0 +1,72 @@
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/guildsettings"
+	"spot-assistant/internal/core/dto/summary"
+)
+
+func TestFreeSpotsFields(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	tNow := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	sum := &summary.Summary{
+		FreeNow: []summary.FreeSpot{
+			{Spot: "Flimsy", Until: tNow.Add(2 * time.Hour)},
+			{Spot: "Issavi"},
+		},
+		FreeSoon: []summary.FreeSpot{
+			{Spot: "Hero Cave", From: tNow.Add(30 * time.Minute), Until: tNow.Add(3 * time.Hour)},
+		},
+	}
+
+	// when
+	fields := freeSpotsFields(sum)
+
+	// then
+	assert.Equal([]*discordgo.MessageEmbedField{
+		{Name: "Free now", Value: "`Flimsy` until **14:00**\n`Issavi`, no upcoming hunts"},
+		{Name: "Free soon", Value: "`Hero Cave` from **12:30** until **15:00**"},
+	}, fields)
+	assert.Empty(freeSpotsFields(&summary.Summary{}))
+}
+
+func TestApplyFavouritesSetting(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	eventHandler := mocks.NewMockAPIPort(t)
+	eventHandler.On("OnBookAutocomplete", book.BookAutocompleteRequest{Field: book.BookAutocompleteSpot, Value: "flimsy"}).
+		Return(book.BookAutocompleteResponse{"Flimsy Lost Souls", "Flimsy"}, nil)
+	eventHandler.On("OnBookAutocomplete", book.BookAutocompleteRequest{Field: book.BookAutocompleteSpot, Value: "unknown"}).
+		Return(book.BookAutocompleteResponse{}, nil)
+	b := &Bot{eventHandler: eventHandler}
+	settings := guildsettings.Default("guild-id")
+	option := func(action, spotName string) []*discordgo.ApplicationCommandInteractionDataOption {
+		return []*discordgo.ApplicationCommandInteractionDataOption{
+			{Name: "action", Type: discordgo.ApplicationCommandOptionString, Value: action},
+			{Name: "respawn", Type: discordgo.ApplicationCommandOptionString, Value: spotName},
+		}
+	}
+
+	// when
+	added, addErr := b.applyFavouritesSetting(settings, option("add", "flimsy"))
+	_, unknownErr := b.applyFavouritesSetting(settings, option("add", "unknown"))
+	favourites := append([]string{}, settings.FavouriteSpots...)
+	removed, removeErr := b.applyFavouritesSetting(settings, option("remove", "FLIMSY"))
+
+	// then
+	assert.Nil(addErr)
+	assert.Equal("The summary will list these respawns when free: Flimsy", added)
+	assert.EqualError(unknownErr, "unknown respawn: unknown")
+	assert.Equal([]string{"Flimsy"}, favourites)
+	assert.Nil(removeErr)
+	assert.Equal("The summary will not list free respawns.", removed)
+	assert.Empty(settings.FavouriteSpots)
+}
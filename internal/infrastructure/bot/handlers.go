@@ -3,7 +3,6 @@ package bot
 import (
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
@@ -12,8 +11,6 @@ import (
 	stringsHelper "spot-assistant/internal/common/strings"
 	"spot-assistant/internal/core/dto/book"
 	"spot-assistant/internal/core/dto/discord"
-	"spot-assistant/internal/core/dto/summary"
-	"spot-assistant/internal/core/worlds"
 )
 
 /*
@@ -33,30 +30,35 @@ func (b *Bot) GuildCreate(s *discordgo.Session, g *discordgo.GuildCreate) {
 	}
 	//
 	err = b.EnsureChannel(guild)
-	if err != nil {
+	if errors.Is(err, ErrMissingChannels) {
+		b.log.With("guild_name", guild.Name).Warnf("could not ensure channels: %s", err)
+	} else if err != nil {
 		b.log.Errorf("could not ensure channels: %s", err)
 
 		return
 	}
 	//
 	err = b.EnsureRoles(guild)
-	if err != nil {
+	if errors.Is(err, ErrMissingRoles) {
+		b.log.With("guild_name", guild.Name).Warnf("could not ensure roles: %s", err)
+	} else if err != nil {
 		b.log.Errorf("could not ensure roles: %s", err)
 
 		return
 	}
-	if err := b.onlineCheckService.ConfigureWorldNameForGuild(guild.ID); err != nil {
-		b.log.Errorf("ConfigureWorldNameForGuild failed for guild %s: %v", guild.ID, err)
+	if err := b.onlineCheckService.ConfigureGuildWorlds(guild.ID); err != nil {
+		b.log.Errorf("ConfigureGuildWorlds failed for guild %s: %v", guild.ID, err)
 	}
 	go b.onlineCheckService.TryRefresh(guild.ID)
+	b.invalidateLetter(guild.ID)
 	go b.TryUpdateGuildLetter(guild)
 	defer b.eventHandler.OnGuildCreate(MapGuild(g.Guild))
 }
 
 func (b *Bot) Ready(s *discordgo.Session, r *discordgo.Ready) {
 	for _, g := range s.State.Guilds {
-		if err := b.onlineCheckService.ConfigureWorldNameForGuild(g.ID); err != nil {
-			b.log.Errorf("ConfigureWorldNameForGuild failed for guild %s: %v", g.ID, err)
+		if err := b.onlineCheckService.ConfigureGuildWorlds(g.ID); err != nil {
+			b.log.Errorf("ConfigureGuildWorlds failed for guild %s: %v", g.ID, err)
 		}
 	}
 	b.StartTicking()
@@ -81,21 +83,30 @@ func (b *Bot) Tick() {
 	guilds := b.GetGuilds()
 	for _, guild := range guilds {
 		guild := guild
-		go b.onlineCheckService.TryRefresh(guild.ID)
+		go b.refreshOnlinePlayers(guild.ID)
 		go b.TryUpdateGuildLetter(guild)
 	}
 }
 
+// refreshOnlinePlayers refreshes players online in the worlds of the guild,
+// and samples attendance of ongoing reservations against them. Worlds, which failed to refresh,
+// leave their players unknown, so they are skipped by sampling, while the other worlds are sampled.
+func (b *Bot) refreshOnlinePlayers(guildID string) {
+	if err := b.onlineCheckService.RefreshOnlinePlayers(guildID); err != nil {
+		b.log.Errorf("could not refresh online players of guild %s: %v", guildID, err)
+	}
+	if b.attendanceService != nil {
+		b.attendanceService.SampleAttendance(guildID, time.Now())
+	}
+}
+
 func (b *Bot) Book(i *discordgo.InteractionCreate) error {
 	b.log.Info("Book")
-	interaction := i.Interaction
 	tNow := time.Now()
 	gID, err := stringsHelper.StrToInt64(i.GuildID)
 	if err != nil {
 		return err
 	}
-	dcSession := b.mgr.SessionForGuild(gID)
-
 	// Flag parsing
 	overbook := false
 	switch len(i.ApplicationCommandData().Options) {
@@ -164,18 +175,18 @@ func (b *Bot) Book(i *discordgo.InteractionCreate) error {
 	if err != nil {
 		message = b.formatter.FormatBookError(response, err)
 	} else {
-		go b.TryUpdateGuildLetter(guild)
 		message = b.formatter.FormatBookResponse(response)
+		b.mirrorBooking(guild, i, message)
+		go b.sendOverbookedReliability(guild, member, response)
 	}
 
 	bookLog.Info("booking request handled")
-	_, err = dcSession.FollowupMessageCreate(interaction, false, &discordgo.WebhookParams{
+	return b.followup(i, &discordgo.WebhookParams{
 		Content: message,
 		AllowedMentions: &discordgo.MessageAllowedMentions{
 			Parse: []discordgo.AllowedMentionType{discordgo.AllowedMentionTypeUsers},
 		},
 	})
-	return err
 }
 
 func (b *Bot) BookAutocomplete(i *discordgo.InteractionCreate) error {
@@ -230,12 +241,12 @@ func (b *Bot) Unbook(i *discordgo.InteractionCreate) error {
 		return err
 	}
 
-	go b.TryUpdateGuildLetter(guild)
+	message := b.formatter.FormatUnbookResponse(res)
+	b.mirrorBooking(guild, i, message)
 
-	_, err = b.mgr.SessionForGuild(gID).FollowupMessageCreate(i.Interaction, false, &discordgo.WebhookParams{
-		Content: b.formatter.FormatUnbookResponse(res),
+	return b.followup(i, &discordgo.WebhookParams{
+		Content: message,
 	})
-	return err
 }
 
 func (b *Bot) UnbookAutocomplete(i *discordgo.InteractionCreate) error {
@@ -286,24 +297,19 @@ func (b *Bot) PrivateSummary(i *discordgo.InteractionCreate) error {
 		return err
 	}
 
-	var spotName string
-	for _, opt := range i.ApplicationCommandData().Options {
-		if opt.Name == "respawn" {
-			spotName = opt.StringValue()
-			break
-		}
+	request, err := mapPrivateSummaryRequest(i.ApplicationCommandData().Options, i.Member.User.ID)
+	if err != nil {
+		return err
 	}
-	err = b.eventHandler.OnPrivateSummary(summary.PrivateSummaryRequest{
-		GuildID:  gID,
-		UserID:   uID,
-		SpotName: spotName,
-	})
+	request.GuildID = gID
+	request.UserID = uID
+
+	err = b.eventHandler.OnPrivateSummary(request)
 	if err != nil {
 		return err
 	}
 
-	_, err = b.mgr.SessionForGuild(gID).FollowupMessageCreate(i.Interaction, false, &discordgo.WebhookParams{Content: "Check your DM!"})
-	return err
+	return b.followup(i, &discordgo.WebhookParams{Content: "Check your DM!"})
 }
 
 func (b *Bot) SummaryAutocomplete(i *discordgo.InteractionCreate) error {
@@ -330,81 +336,3 @@ func (b *Bot) SummaryAutocomplete(i *discordgo.InteractionCreate) error {
 
 	return b.interactionRespond(i, &discordgo.InteractionResponseData{Choices: choices}, discordgo.InteractionApplicationCommandAutocompleteResult)
 }
-
-func (b *Bot) SetWorld(i *discordgo.InteractionCreate) error {
-	guildID := i.GuildID
-	userID := i.Member.User.ID
-
-	// Fetch guild to check owner
-	guild, err := b.mgr.Gateway.Guild(guildID)
-	if err != nil {
-		return fmt.Errorf("could not fetch guild: %w", err)
-	}
-	if guild.OwnerID != userID {
-		return fmt.Errorf("only the server owner can use this command")
-	}
-
-	world := ""
-	for _, opt := range i.ApplicationCommandData().Options {
-		if opt.Name == "world" {
-			world = opt.StringValue()
-			break
-		}
-	}
-	if world == "" {
-		return fmt.Errorf("world name is required")
-	}
-
-	// Validate world against the allowed list
-	existingWorld, idx := collections.PoorMansFind(worlds.Worlds, func(w string) bool {
-		return strings.EqualFold(w, world)
-	})
-	if idx == -1 {
-		return fmt.Errorf("invalid world name: %s, please select a valid Tibia world", world)
-	}
-	world = existingWorld
-
-	err = b.onlineCheckService.SetGuildWorld(guildID, world)
-	if err != nil {
-		return fmt.Errorf("failed to save world name: %w", err)
-	}
-
-	// Configure the online checker with the new world name
-	err = b.onlineCheckService.ConfigureWorldNameForGuild(guildID)
-	if err != nil {
-		b.log.Errorf("ConfigureWorldNameForGuild failed for guild %s: %v", guildID, err)
-	}
-
-	gID, err := stringsHelper.StrToInt64(guildID)
-	if err != nil {
-		return fmt.Errorf("could not parse guild id: %v", guildID)
-	}
-	_, err = b.mgr.SessionForGuild(gID).FollowupMessageCreate(i.Interaction, false, &discordgo.WebhookParams{
-		Content: fmt.Sprintf("Tibia world for this server set to: **%s**", world),
-	})
-	return err
-}
-
-func (b *Bot) SetWorldAutocomplete(i *discordgo.InteractionCreate) error {
-	var userInput string
-	for _, opt := range i.ApplicationCommandData().Options {
-		if opt.Focused && opt.Name == "world" {
-			userInput = opt.StringValue()
-			break
-		}
-	}
-
-	var filtered []*discordgo.ApplicationCommandOptionChoice
-	for _, w := range worlds.Worlds {
-		if userInput == "" || strings.Contains(strings.ToLower(w), strings.ToLower(userInput)) {
-			filtered = append(filtered, &discordgo.ApplicationCommandOptionChoice{Name: w, Value: w})
-		}
-		if len(filtered) >= 25 { // Discord max choices
-			break
-		}
-	}
-
-	return b.interactionRespond(i, &discordgo.InteractionResponseData{
-		Choices: filtered,
-	}, discordgo.InteractionApplicationCommandAutocompleteResult)
-}
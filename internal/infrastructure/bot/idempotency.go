@@ -0,0 +1,156 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// InteractionRetention is how long a handled interaction ID is remembered.
+// Interaction tokens are valid for 15 minutes, so Discord cannot redeliver
+// an interaction later than that.
+const InteractionRetention = 15 * time.Minute
+
+// InteractionReplayTimeout bounds how long a duplicate waits
+// for the original interaction to complete.
+const InteractionReplayTimeout = 15 * time.Second
+
+// interactionRecord holds the state of a single handled interaction.
+// done is closed once the original interaction has been handled.
+type interactionRecord struct {
+	interactionID string
+	done          chan struct{}
+	response      *discordgo.WebhookParams
+}
+
+type interactionEntry struct {
+	record    *interactionRecord
+	expiresAt time.Time // zero while the interaction is in flight
+}
+
+// interactionDeduper remembers in-flight and recently completed interactions by interaction ID,
+// and in-flight interactions by a fingerprint (member, command and options) of the command.
+// Fingerprints are forgotten once the command completes, so members can repeat a command on purpose,
+// e.g. book a spot again right after unbooking it.
+// It is shared by all shards of the manager, so it also survives shard reconnects.
+type interactionDeduper struct {
+	mu            sync.Mutex
+	byID          map[string]*interactionEntry
+	byFingerprint map[string]*interactionRecord
+	now           func() time.Time
+}
+
+func newInteractionDeduper() *interactionDeduper {
+	return &interactionDeduper{
+		byID:          map[string]*interactionEntry{},
+		byFingerprint: map[string]*interactionRecord{},
+		now:           time.Now,
+	}
+}
+
+// Begin registers an interaction. If an interaction with the same ID is in flight or was recently
+// completed, or one with the same fingerprint is in flight, it returns its record and false.
+func (d *interactionDeduper) Begin(interactionID, fingerprint string) (*interactionRecord, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.prune()
+
+	if entry, ok := d.byID[interactionID]; ok {
+		return entry.record, false
+	}
+	if record, ok := d.byFingerprint[fingerprint]; ok {
+		return record, false
+	}
+
+	record := &interactionRecord{
+		interactionID: interactionID,
+		done:          make(chan struct{}),
+	}
+	d.byID[interactionID] = &interactionEntry{record: record}
+	d.byFingerprint[fingerprint] = record
+
+	return record, true
+}
+
+// Record stores the latest response sent for the interaction, so it can be replayed.
+func (d *interactionDeduper) Record(interactionID string, response *discordgo.WebhookParams) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if entry, ok := d.byID[interactionID]; ok {
+		entry.record.response = response
+	}
+}
+
+// Finish marks the interaction as completed, starts the expiry of its ID and forgets its fingerprint.
+func (d *interactionDeduper) Finish(interactionID string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entry, ok := d.byID[interactionID]
+	if !ok {
+		return
+	}
+
+	entry.expiresAt = d.now().Add(InteractionRetention)
+	for key, record := range d.byFingerprint {
+		if record == entry.record {
+			delete(d.byFingerprint, key)
+		}
+	}
+
+	close(entry.record.done)
+}
+
+// prune removes expired entries. Must be called with the lock held.
+func (d *interactionDeduper) prune() {
+	now := d.now()
+	for key, entry := range d.byID {
+		if !entry.expiresAt.IsZero() && now.After(entry.expiresAt) {
+			delete(d.byID, key)
+		}
+	}
+}
+
+// Wait blocks until the original interaction completes or the timeout elapses.
+// Returns the recorded response and whether the interaction completed in time.
+func (d *interactionDeduper) Wait(record *interactionRecord, timeout time.Duration) (*discordgo.WebhookParams, bool) {
+	select {
+	case <-record.done:
+	case <-time.After(timeout):
+		return nil, false
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return record.response, true
+}
+
+// interactionFingerprint identifies a command invocation by guild, member, command name and options,
+// so the same command submitted twice can be recognised even if Discord assigned it a new ID.
+func interactionFingerprint(i *discordgo.InteractionCreate) string {
+	var userID string
+	if i.Member != nil && i.Member.User != nil {
+		userID = i.Member.User.ID
+	} else if i.User != nil {
+		userID = i.User.ID
+	}
+
+	fingerprint := strings.Builder{}
+	fingerprint.WriteString(fmt.Sprintf("%s|%s|%s", i.GuildID, userID, i.ApplicationCommandData().Name))
+	writeOptionsFingerprint(&fingerprint, i.ApplicationCommandData().Options)
+
+	return fingerprint.String()
+}
+
+func writeOptionsFingerprint(sb *strings.Builder, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	for _, opt := range options {
+		sb.WriteString(fmt.Sprintf("|%s=%v", opt.Name, opt.Value))
+		writeOptionsFingerprint(sb, opt.Options)
+	}
+}
@@ -0,0 +1,152 @@
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestInteraction(id, guildID, userID, command string, options ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{
+		Interaction: &discordgo.Interaction{
+			ID:      id,
+			GuildID: guildID,
+			Type:    discordgo.InteractionApplicationCommand,
+			Member:  &discordgo.Member{User: &discordgo.User{ID: userID}},
+			Data: discordgo.ApplicationCommandInteractionData{
+				Name:    command,
+				Options: options,
+			},
+		},
+	}
+}
+
+func TestInteractionDeduper_BeginNewInteraction(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	deduper := newInteractionDeduper()
+
+	// when
+	record, isNew := deduper.Begin("interaction-1", "fingerprint-1")
+
+	// then
+	assert.True(isNew)
+	assert.Equal("interaction-1", record.interactionID)
+}
+
+func TestInteractionDeduper_BeginRedeliveredInteraction(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	deduper := newInteractionDeduper()
+	original, _ := deduper.Begin("interaction-1", "fingerprint-1")
+
+	// when
+	record, isNew := deduper.Begin("interaction-1", "fingerprint-2")
+
+	// then
+	assert.False(isNew)
+	assert.Same(original, record)
+}
+
+func TestInteractionDeduper_BeginDoubleSubmittedInteraction(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	deduper := newInteractionDeduper()
+	original, _ := deduper.Begin("interaction-1", "fingerprint-1")
+
+	// when
+	record, isNew := deduper.Begin("interaction-2", "fingerprint-1")
+
+	// then
+	assert.False(isNew)
+	assert.Same(original, record)
+}
+
+func TestInteractionDeduper_FingerprintForgottenOnFinish(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	deduper := newInteractionDeduper()
+	deduper.Begin("interaction-1", "fingerprint-1")
+	deduper.Finish("interaction-1")
+
+	// when
+	_, isNewFingerprint := deduper.Begin("interaction-2", "fingerprint-1")
+	_, isNewID := deduper.Begin("interaction-1", "fingerprint-3")
+
+	// then
+	assert.True(isNewFingerprint)
+	assert.False(isNewID)
+}
+
+func TestInteractionDeduper_InFlightInteractionDoesNotExpire(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	tNow := time.Now()
+	deduper := newInteractionDeduper()
+	deduper.now = func() time.Time { return tNow }
+	deduper.Begin("interaction-1", "fingerprint-1")
+	deduper.now = func() time.Time { return tNow.Add(InteractionRetention + time.Second) }
+
+	// when
+	_, isNew := deduper.Begin("interaction-2", "fingerprint-1")
+
+	// then
+	assert.False(isNew)
+}
+
+func TestInteractionDeduper_WaitReturnsRecordedResponse(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	deduper := newInteractionDeduper()
+	record, _ := deduper.Begin("interaction-1", "fingerprint-1")
+	response := &discordgo.WebhookParams{Content: "booked"}
+	go func() {
+		deduper.Record("interaction-1", response)
+		deduper.Finish("interaction-1")
+	}()
+
+	// when
+	replayed, completed := deduper.Wait(record, time.Second)
+
+	// then
+	assert.True(completed)
+	assert.Same(response, replayed)
+}
+
+func TestInteractionDeduper_WaitTimesOut(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	deduper := newInteractionDeduper()
+	record, _ := deduper.Begin("interaction-1", "fingerprint-1")
+
+	// when
+	replayed, completed := deduper.Wait(record, 10*time.Millisecond)
+
+	// then
+	assert.False(completed)
+	assert.Nil(replayed)
+}
+
+func TestInteractionFingerprint(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	options := []*discordgo.ApplicationCommandInteractionDataOption{
+		{Name: "respawn", Type: discordgo.ApplicationCommandOptionString, Value: "Asura Palace"},
+		{Name: "start-at", Type: discordgo.ApplicationCommandOptionString, Value: "19:00"},
+	}
+	first := newTestInteraction("interaction-1", "guild-1", "user-1", "book", options...)
+	second := newTestInteraction("interaction-2", "guild-1", "user-1", "book", options...)
+	otherMember := newTestInteraction("interaction-3", "guild-1", "user-2", "book", options...)
+
+	// when
+	firstFingerprint := interactionFingerprint(first)
+	secondFingerprint := interactionFingerprint(second)
+	otherMemberFingerprint := interactionFingerprint(otherMember)
+
+	// then
+	assert.Equal(firstFingerprint, secondFingerprint)
+	assert.NotEqual(firstFingerprint, otherMemberFingerprint)
+	assert.Equal("guild-1|user-1|book|respawn=Asura Palace|start-at=19:00", firstFingerprint)
+}
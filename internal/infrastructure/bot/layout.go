@@ -0,0 +1,257 @@
+package bot
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"spot-assistant/internal/core/dto/guildsettings"
+	"spot-assistant/internal/core/dto/summary"
+)
+
+// Discord limits the length of a single embed field value.
+const MaxEmbedFieldLength = 1024
+
+// emptyFieldName is a zero-width space, as Discord refuses embed fields without a name.
+const emptyFieldName = "\u200b"
+
+// summaryLayout renders the ledger of a summary into embed fields.
+type summaryLayout interface {
+	// fields renders the ledger as embed fields.
+	fields(ledger summary.Ledger, now time.Time) []*discordgo.MessageEmbedField
+
+	// fieldsPerEmbed is the maximum amount of fields put in a single embed.
+	fieldsPerEmbed() int
+}
+
+// layoutFor returns the layout selected in guild settings, grouped by spot by default.
+func layoutFor(layout guildsettings.SummaryLayout) summaryLayout {
+	switch layout {
+	case guildsettings.SummaryLayoutByHour:
+		return byHourLayout{}
+	case guildsettings.SummaryLayoutCompact:
+		return compactLayout{}
+	case guildsettings.SummaryLayoutCurrent:
+		return currentLayout{}
+	default:
+		return bySpotLayout{}
+	}
+}
+
+// bySpotLayout shows an inline field for every spot, listing all of its bookings.
+type bySpotLayout struct{}
+
+func (bySpotLayout) fields(ledger summary.Ledger, _ time.Time) []*discordgo.MessageEmbedField {
+	fields := make([]*discordgo.MessageEmbedField, 0, len(ledger))
+	for _, entry := range ledger {
+		fields = append(fields, spotField(entry.Spot, entry.Bookings))
+	}
+
+	return fields
+}
+
+// Discord seems to have a limit of embeds per message
+// this means we should limit ourselves to send maximum 13 fields
+// per embed; and continue sending messages until we're done
+func (bySpotLayout) fieldsPerEmbed() int {
+	return 13
+}
+
+// byHourLayout lists bookings chronologically, grouped by the hour they start at.
+type byHourLayout struct{}
+
+func (byHourLayout) fields(ledger summary.Ledger, now time.Time) []*discordgo.MessageEmbedField {
+	type spotBooking struct {
+		spot    string
+		booking *summary.Booking
+	}
+
+	bookings := make([]spotBooking, 0)
+	for _, entry := range ledger {
+		for _, booking := range entry.Bookings {
+			bookings = append(bookings, spotBooking{spot: entry.Spot, booking: booking})
+		}
+	}
+	slices.SortStableFunc(bookings, func(a, b spotBooking) int {
+		return a.booking.StartAt.Compare(b.booking.StartAt)
+	})
+
+	fields := make([]*discordgo.MessageEmbedField, 0)
+	var current *discordgo.MessageEmbedField
+	var currentHour time.Time
+	for _, sb := range bookings {
+		hour := startOfHour(sb.booking.StartAt)
+		if current == nil || !hour.Equal(currentHour) {
+			current = &discordgo.MessageEmbedField{Name: hourFieldName(hour, now)}
+			currentHour = hour
+			fields = append(fields, current)
+		}
+
+		line := fmt.Sprintf("%s `%s`\n", bookingLine(sb.booking), sb.spot)
+		if len(current.Value)+len(line) > MaxEmbedFieldLength {
+			current = &discordgo.MessageEmbedField{Name: emptyFieldName}
+			fields = append(fields, current)
+		}
+		current.Value += line
+	}
+
+	return fields
+}
+
+func (byHourLayout) fieldsPerEmbed() int {
+	return 5
+}
+
+// compactLayout shows the current and the next booking of every spot,
+// as a table in a code block.
+type compactLayout struct{}
+
+func (compactLayout) fields(ledger summary.Ledger, now time.Time) []*discordgo.MessageEmbedField {
+	rows := make([]string, 0, len(ledger))
+	for _, entry := range ledger {
+		current, next := currentAndNextBooking(entry.Bookings, now)
+		rows = append(rows, strings.TrimRight(fmt.Sprintf(
+			"%-20.20s %-20.20s %-20.20s",
+			entry.Spot,
+			compactBooking(current),
+			compactBooking(next),
+		), " "))
+	}
+
+	header := fmt.Sprintf("%-20s %-20s %s", "Respawn", "Now", "Next")
+	fields := make([]*discordgo.MessageEmbedField, 0)
+	table := strings.Builder{}
+	flush := func() {
+		name := emptyFieldName
+		if len(fields) == 0 {
+			name = "Now and next"
+		}
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  name,
+			Value: fmt.Sprintf("```\n%s\n%s```", header, table.String()),
+		})
+		table.Reset()
+	}
+
+	// Code block fences and the header count towards the field length as well
+	limit := MaxEmbedFieldLength - len(header) - 10
+	for _, row := range rows {
+		if table.Len()+len(row)+1 > limit {
+			flush()
+		}
+		table.WriteString(row + "\n")
+	}
+	flush()
+
+	return fields
+}
+
+func (compactLayout) fieldsPerEmbed() int {
+	return 5
+}
+
+// currentLayout shows only the bookings that are in progress.
+type currentLayout struct{}
+
+func (currentLayout) fields(ledger summary.Ledger, now time.Time) []*discordgo.MessageEmbedField {
+	fields := make([]*discordgo.MessageEmbedField, 0)
+	for _, entry := range ledger {
+		current, _ := currentAndNextBooking(entry.Bookings, now)
+		if current == nil {
+			continue
+		}
+
+		fields = append(fields, spotField(entry.Spot, []*summary.Booking{current}))
+	}
+
+	if len(fields) == 0 {
+		return []*discordgo.MessageEmbedField{{
+			Name:  "Now",
+			Value: "No hunts in progress.",
+		}}
+	}
+
+	return fields
+}
+
+func (currentLayout) fieldsPerEmbed() int {
+	return 13
+}
+
+// spotField lists bookings of a spot in an inline field.
+func spotField(spot string, bookings []*summary.Booking) *discordgo.MessageEmbedField {
+	writtenReservations := strings.Builder{}
+	for _, booking := range bookings {
+		writtenReservations.WriteString(bookingLine(booking) + "\n")
+	}
+
+	return &discordgo.MessageEmbedField{
+		Name:   fmt.Sprintf("**`%s`**", spot),
+		Value:  writtenReservations.String(),
+		Inline: true,
+	}
+}
+
+func bookingLine(booking *summary.Booking) string {
+	return fmt.Sprintf(
+		"%s**%s** - **%s** %s",
+		MapOnlineStatus(booking.Status),
+		booking.StartAt.Format("15:04"),
+		booking.EndAt.Format("15:04"),
+		booking.Author,
+	)
+}
+
+// hourFieldName names the group of bookings starting within the hour,
+// mentioning the day only if it is not today.
+// startOfHour returns the start of the hour in the location the time is displayed in.
+// Unlike Truncate, it respects zones offset from UTC by a fraction of an hour.
+func startOfHour(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+}
+
+func hourFieldName(hour, now time.Time) string {
+	if hour.Year() == now.Year() && hour.YearDay() == now.YearDay() {
+		return fmt.Sprintf("**%s**", hour.Format("15:04"))
+	}
+
+	return fmt.Sprintf("**%s**", hour.Format("Mon 15:04"))
+}
+
+// currentAndNextBooking returns the booking in progress and the first one after it, if any.
+// Bookings are expected to be sorted by their start.
+func currentAndNextBooking(bookings []*summary.Booking, now time.Time) (*summary.Booking, *summary.Booking) {
+	var current, next *summary.Booking
+	for _, booking := range bookings {
+		if !now.Before(booking.StartAt) && now.Before(booking.EndAt) {
+			current = booking
+
+			continue
+		}
+
+		if booking.StartAt.After(now) && next == nil {
+			next = booking
+		}
+	}
+
+	return current, next
+}
+
+func compactBooking(booking *summary.Booking) string {
+	if booking == nil {
+		return "-"
+	}
+
+	status := " "
+	switch booking.Status {
+	case summary.Online:
+		status = "+"
+	case summary.Offline:
+		status = "x"
+	}
+
+	return fmt.Sprintf("%s%s-%s %s", status, booking.StartAt.Format("15:04"), booking.EndAt.Format("15:04"), booking.Author)
+}
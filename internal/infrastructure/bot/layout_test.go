@@ -0,0 +1,165 @@
+package bot
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/guildsettings"
+	"spot-assistant/internal/core/dto/summary"
+)
+
+func newLayoutTestLedger(now time.Time) summary.Ledger {
+	return summary.Ledger{
+		{
+			Spot: "Asura Palace",
+			Bookings: []*summary.Booking{
+				{Author: "first", Status: summary.Online, StartAt: now.Add(-time.Hour), EndAt: now.Add(time.Hour)},
+				{Author: "second", Status: summary.Offline, StartAt: now.Add(2 * time.Hour), EndAt: now.Add(4 * time.Hour)},
+			},
+		},
+		{
+			Spot: "Cobra Bastion",
+			Bookings: []*summary.Booking{
+				{Author: "third", StartAt: now.Add(30 * time.Minute), EndAt: now.Add(2 * time.Hour)},
+			},
+		},
+	}
+}
+
+func TestLayoutFor(t *testing.T) {
+	// given
+	assert := assert.New(t)
+
+	// when
+	defaultLayout := layoutFor("")
+	byHour := layoutFor(guildsettings.SummaryLayoutByHour)
+	compact := layoutFor(guildsettings.SummaryLayoutCompact)
+	current := layoutFor(guildsettings.SummaryLayoutCurrent)
+
+	// then
+	assert.IsType(bySpotLayout{}, defaultLayout)
+	assert.IsType(byHourLayout{}, byHour)
+	assert.IsType(compactLayout{}, compact)
+	assert.IsType(currentLayout{}, current)
+}
+
+func TestBySpotLayout(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// when
+	fields := bySpotLayout{}.fields(newLayoutTestLedger(now), now)
+
+	// then
+	assert.Len(fields, 2)
+	assert.Equal("**`Asura Palace`**", fields[0].Name)
+	assert.Equal(":green_circle: **11:00** - **13:00** first\n:red_circle: **14:00** - **16:00** second\n", fields[0].Value)
+	assert.True(fields[0].Inline)
+}
+
+func TestByHourLayout(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// when
+	fields := byHourLayout{}.fields(newLayoutTestLedger(now), now)
+
+	// then
+	assert.Len(fields, 3)
+	assert.Equal("**11:00**", fields[0].Name)
+	assert.Equal("**12:00**", fields[1].Name)
+	assert.Equal("**14:00**", fields[2].Name)
+	assert.Equal("**12:30** - **14:00** third `Cobra Bastion`\n", fields[1].Value)
+}
+
+func TestByHourLayoutGroupsByHourInDisplayLocation(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	kolkata := time.FixedZone("IST", 5*60*60+30*60)
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, kolkata)
+	ledger := summary.Ledger{
+		{
+			Spot: "Asura Palace",
+			Bookings: []*summary.Booking{
+				{Author: "first", StartAt: now.Add(10 * time.Minute), EndAt: now.Add(time.Hour)},
+				{Author: "second", StartAt: now.Add(40 * time.Minute), EndAt: now.Add(2 * time.Hour)},
+			},
+		},
+	}
+
+	// when
+	fields := byHourLayout{}.fields(ledger, now)
+
+	// then
+	assert.Len(fields, 1)
+	assert.Equal("**12:00**", fields[0].Name)
+}
+
+func TestByHourLayoutOtherDay(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	now := time.Date(2021, 1, 1, 23, 0, 0, 0, time.UTC)
+
+	// when
+	name := hourFieldName(now.Add(2*time.Hour).Truncate(time.Hour), now)
+
+	// then
+	assert.Equal("**Sat 01:00**", name)
+}
+
+func TestCompactLayout(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// when
+	fields := compactLayout{}.fields(newLayoutTestLedger(now), now)
+
+	// then
+	assert.Len(fields, 1)
+	assert.Equal("Now and next", fields[0].Name)
+	assert.Contains(fields[0].Value, "Asura Palace         +11:00-13:00 first   x14:00-16:00 second\n")
+	assert.Contains(fields[0].Value, "Cobra Bastion        -                     12:30-14:00 third\n")
+}
+
+func TestCompactLayoutSplitsLongTables(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	ledger := summary.Ledger{}
+	for i := 0; i < 40; i++ {
+		ledger = append(ledger, newLayoutTestLedger(now)...)
+	}
+
+	// when
+	fields := compactLayout{}.fields(ledger, now)
+
+	// then
+	assert.Greater(len(fields), 1)
+	for _, field := range fields {
+		assert.LessOrEqual(len(field.Value), MaxEmbedFieldLength)
+		assert.True(strings.HasPrefix(field.Value, "```"))
+	}
+}
+
+func TestCurrentLayout(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// when
+	fields := currentLayout{}.fields(newLayoutTestLedger(now), now)
+	emptyFields := currentLayout{}.fields(newLayoutTestLedger(now), now.Add(24*time.Hour))
+
+	// then
+	assert.Len(fields, 1)
+	assert.Equal("**`Asura Palace`**", fields[0].Name)
+	assert.Equal(":green_circle: **11:00** - **13:00** first\n", fields[0].Value)
+	assert.Len(emptyFields, 1)
+	assert.Equal("No hunts in progress.", emptyFields[0].Value)
+}
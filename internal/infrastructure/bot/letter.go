@@ -0,0 +1,202 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/bwmarrin/discordgo"
+
+	stringsHelper "spot-assistant/internal/common/strings"
+	"spot-assistant/internal/core/dto/discord"
+	"spot-assistant/internal/core/dto/guild"
+)
+
+// letterPart is a single message of the letter: a text, an image or an embed.
+type letterPart struct {
+	content  string
+	fileName string
+	file     []byte
+	embed    *discordgo.MessageEmbed
+}
+
+func (p *letterPart) files() []*discordgo.File {
+	if p.file == nil {
+		return nil
+	}
+
+	return []*discordgo.File{{
+		Name:        p.fileName,
+		ContentType: "image/png",
+		Reader:      bytes.NewReader(p.file),
+	}}
+}
+
+func (p *letterPart) embeds() []*discordgo.MessageEmbed {
+	if p.embed == nil {
+		return []*discordgo.MessageEmbed{}
+	}
+
+	return []*discordgo.MessageEmbed{p.embed}
+}
+
+func (p *letterPart) messageSend() *discordgo.MessageSend {
+	return &discordgo.MessageSend{
+		Content: p.content,
+		Embeds:  p.embeds(),
+		Files:   p.files(),
+	}
+}
+
+// messageEdit replaces the whole message with this part: previous content,
+// embeds and attachments are overwritten.
+func (p *letterPart) messageEdit(channelID, messageID string) *discordgo.MessageEdit {
+	content := p.content
+	attachments := []*discordgo.MessageAttachment{}
+
+	edit := discordgo.NewMessageEdit(channelID, messageID)
+	edit.Content = &content
+	edit.Embeds = p.embeds()
+	edit.Attachments = &attachments
+	edit.Files = p.files()
+
+	return edit
+}
+
+// digest identifies the rendered part, so unchanged messages do not have to be edited.
+// The footer of the embed is left out, as it holds the time of the update, which changes every time.
+func (p *letterPart) digest() string {
+	hash := sha256.New()
+	hash.Write([]byte(p.content))
+	hash.Write([]byte(p.fileName))
+	hash.Write(p.file)
+	if p.embed != nil {
+		withoutFooter := *p.embed
+		withoutFooter.Footer = nil
+		embed, _ := json.Marshal(withoutFooter)
+		hash.Write(embed)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// sendLetterParts sends parts as new messages, and returns IDs of the sent messages.
+// Parts that fail to be sent are skipped, and the first of the errors is returned.
+func (b *Bot) sendLetterParts(dcSession *discordgo.Session, channel *discord.Channel, parts []*letterPart) ([]string, error) {
+	var firstErr error
+	ids := make([]string, 0, len(parts))
+	for _, part := range parts {
+		msg, err := dcSession.ChannelMessageSendComplex(channel.ID, part.messageSend())
+		if err != nil {
+			b.log.Errorf("something went wrong when sending letter message: %s", err)
+			if firstErr == nil {
+				firstErr = dmError(err)
+			}
+
+			continue
+		}
+
+		ids = append(ids, msg.ID)
+		b.letterDigests.Set(msg.ID, part.digest())
+		b.metrics.IncMessagesSent(channel.ID, channel.Name)
+	}
+
+	return ids, firstErr
+}
+
+// syncLetter brings the letter in the channel up to date, editing messages posted
+// previously in place. Only the messages whose content changed are edited.
+// Whenever the message count changes or the previously posted messages
+// cannot be reused, the letter is reposted.
+func (b *Bot) syncLetter(dcSession *discordgo.Session, g *guild.Guild, channel *discord.Channel, parts []*letterPart) error {
+	// Without tracked messages, there is nothing to edit.
+	if b.summaryMessageRepo == nil {
+		return b.repostLetter(dcSession, g, channel, []string{}, parts)
+	}
+
+	tracked, err := b.summaryMessageRepo.SelectSummaryMessageIDs(context.Background(), g.ID, channel.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(tracked) != len(parts) {
+		return b.repostLetter(dcSession, g, channel, tracked, parts)
+	}
+
+	for index, messageID := range tracked {
+		part := parts[index]
+		if digest, ok := b.letterDigests.Get(messageID); ok && digest == part.digest() {
+			continue
+		}
+
+		_, err := dcSession.ChannelMessageEditComplex(part.messageEdit(channel.ID, messageID))
+		if err != nil {
+			// The message is gone (e.g. removed by a moderator), so the order
+			// of the letter cannot be preserved by editing anymore.
+			b.log.With("message_id", messageID).Warnf("could not edit letter message, reposting: %s", err)
+
+			return b.repostLetter(dcSession, g, channel, tracked, parts)
+		}
+
+		b.letterDigests.Set(messageID, part.digest())
+		b.metrics.IncMessagesEdited(channel.ID, channel.Name)
+	}
+
+	return nil
+}
+
+// repostLetter removes previously posted messages, tracked in the summary message table,
+// and posts the whole letter again. Other messages in the channel are left alone.
+func (b *Bot) repostLetter(dcSession *discordgo.Session, g *guild.Guild, channel *discord.Channel, tracked []string, parts []*letterPart) error {
+	b.deleteLetterMessages(dcSession, channel, tracked)
+
+	ids, _ := b.sendLetterParts(dcSession, channel, parts)
+	if b.summaryMessageRepo == nil {
+		return nil
+	}
+
+	return b.summaryMessageRepo.ReplaceSummaryMessageIDs(context.Background(), g.ID, channel.ID, ids)
+}
+
+// deleteLetterMessages deletes messages of a letter posted in the channel.
+func (b *Bot) deleteLetterMessages(dcSession *discordgo.Session, channel *discord.Channel, tracked []string) {
+	// Tracked messages are deleted one by one, as bulk delete
+	// refuses to delete messages older than two weeks.
+	for _, messageID := range tracked {
+		b.letterDigests.Remove(messageID)
+		if err := dcSession.ChannelMessageDelete(channel.ID, messageID); err != nil {
+			b.log.With("message_id", messageID).Debugf("could not delete tracked letter message: %s", err)
+
+			continue
+		}
+		b.metrics.AddMessagesDeleted(channel.ID, channel.Name, 1)
+	}
+}
+
+// removeLetter deletes the letter posted in a channel, which is no longer the summary channel
+// of the guild, and stops tracking its messages.
+func (b *Bot) removeLetter(guildID, channelID string) error {
+	if b.summaryMessageRepo == nil {
+		return nil
+	}
+	gID, err := stringsHelper.StrToInt64(guildID)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	tracked, err := b.summaryMessageRepo.SelectSummaryMessageIDs(ctx, guildID, channelID)
+	if err != nil {
+		return err
+	}
+
+	// The channel may be gone already, along with its messages.
+	dcSession := b.mgr.SessionForGuild(gID)
+	if channel, err := dcSession.Channel(channelID); err == nil {
+		b.deleteLetterMessages(dcSession, MapChannel(channel), tracked)
+	}
+
+	return b.summaryMessageRepo.ReplaceSummaryMessageIDs(ctx, guildID, channelID, []string{})
+}
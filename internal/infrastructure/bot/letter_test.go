@@ -0,0 +1,66 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLetterPart_DigestIsStable(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	first := &letterPart{embed: &discordgo.MessageEmbed{Title: "Spots"}}
+	second := &letterPart{embed: &discordgo.MessageEmbed{Title: "Spots"}}
+
+	// when
+	firstDigest := first.digest()
+	secondDigest := second.digest()
+
+	// then
+	assert.Equal(firstDigest, secondDigest)
+}
+
+func TestLetterPart_DigestIgnoresFooter(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	first := &letterPart{embed: &discordgo.MessageEmbed{Title: "Spots", Footer: &discordgo.MessageEmbedFooter{Text: "Version: 1.0 (12:00 01.02)"}}}
+	second := &letterPart{embed: &discordgo.MessageEmbed{Title: "Spots", Footer: &discordgo.MessageEmbedFooter{Text: "Version: 1.0 (12:01 01.02)"}}}
+
+	// when
+	firstDigest := first.digest()
+	secondDigest := second.digest()
+
+	// then
+	assert.Equal(firstDigest, secondDigest)
+	assert.NotNil(first.embed.Footer)
+}
+
+func TestLetterPart_DigestChangesWithContent(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	first := &letterPart{fileName: "spots.png", file: []byte{1, 2, 3}}
+	second := &letterPart{fileName: "spots.png", file: []byte{1, 2, 4}}
+
+	// when
+	firstDigest := first.digest()
+	secondDigest := second.digest()
+
+	// then
+	assert.NotEqual(firstDigest, secondDigest)
+}
+
+func TestLetterPart_MessageEditOverwritesAttachments(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	part := &letterPart{content: "pre-message"}
+
+	// when
+	edit := part.messageEdit("channel-id", "message-id")
+
+	// then
+	assert.Equal("pre-message", *edit.Content)
+	assert.Empty(*edit.Attachments)
+	assert.Empty(edit.Embeds)
+	assert.Nil(edit.Files)
+}
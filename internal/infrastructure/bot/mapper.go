@@ -1,6 +1,7 @@
 package bot
 
 import (
+	"errors"
 	"fmt"
 	"spot-assistant/internal/core/dto/guild"
 	"spot-assistant/internal/core/dto/member"
@@ -143,3 +144,30 @@ func MapOnlineStatus(status summary.OnlineStatus) string {
 		return ""
 	}
 }
+
+// mapPrivateSummaryRequest maps filters of the /summary command; "mine" filters by the invoking member.
+func mapPrivateSummaryRequest(options []*discordgo.ApplicationCommandInteractionDataOption, invokerID string) (summary.PrivateSummaryRequest, error) {
+	request := summary.PrivateSummaryRequest{
+		SpotName:        stringOption(options, "respawn"),
+		AuthorDiscordID: idOption(options, "member"),
+		Window:          summary.TimeWindow(stringOption(options, "window")),
+		FreeNow:         boolOption(options, "free-now"),
+	}
+
+	if boolOption(options, "mine") {
+		if request.AuthorDiscordID != "" && request.AuthorDiscordID != invokerID {
+			return request, errors.New("choose either your own reservations or a member, not both")
+		}
+		request.AuthorDiscordID = invokerID
+	}
+
+	if request.FreeNow && (request.AuthorDiscordID != "" || request.Window != "") {
+		return request, errors.New("free respawns can be narrowed down only by the respawn")
+	}
+
+	if request.Window != "" && !request.Window.IsValid() {
+		return request, fmt.Errorf("invalid time window: %s", request.Window)
+	}
+
+	return request, nil
+}
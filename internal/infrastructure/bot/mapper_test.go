@@ -336,3 +336,65 @@ func TestMapOnlineStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestMapPrivateSummaryRequest(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	options := []*discordgo.ApplicationCommandInteractionDataOption{
+		{Name: "respawn", Type: discordgo.ApplicationCommandOptionString, Value: "Flimsy"},
+		{Name: "mine", Type: discordgo.ApplicationCommandOptionBoolean, Value: true},
+		{Name: "window", Type: discordgo.ApplicationCommandOptionString, Value: "tonight"},
+	}
+
+	// when
+	request, err := mapPrivateSummaryRequest(options, "invoker-id")
+
+	// then
+	assert.Nil(err)
+	assert.Equal("Flimsy", request.SpotName)
+	assert.Equal("invoker-id", request.AuthorDiscordID)
+	assert.Equal(summary.WindowTonight, request.Window)
+	assert.False(request.FreeNow)
+	assert.True(request.IsFiltered())
+}
+
+func TestMapPrivateSummaryRequestFreeNow(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	options := []*discordgo.ApplicationCommandInteractionDataOption{
+		{Name: "respawn", Type: discordgo.ApplicationCommandOptionString, Value: "Flimsy"},
+		{Name: "free-now", Type: discordgo.ApplicationCommandOptionBoolean, Value: true},
+	}
+	mine := append(options, &discordgo.ApplicationCommandInteractionDataOption{
+		Name: "mine", Type: discordgo.ApplicationCommandOptionBoolean, Value: true,
+	})
+
+	// when
+	request, err := mapPrivateSummaryRequest(options, "invoker-id")
+	_, mineErr := mapPrivateSummaryRequest(mine, "invoker-id")
+
+	// then
+	assert.Nil(err)
+	assert.Equal("Flimsy", request.SpotName)
+	assert.True(request.FreeNow)
+	assert.True(request.IsFiltered())
+	assert.EqualError(mineErr, "free respawns can be narrowed down only by the respawn")
+}
+
+func TestMapPrivateSummaryRequestMineAndMember(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	options := []*discordgo.ApplicationCommandInteractionDataOption{
+		{Name: "mine", Type: discordgo.ApplicationCommandOptionBoolean, Value: true},
+		{Name: "member", Type: discordgo.ApplicationCommandOptionUser, Value: "member-id"},
+	}
+
+	// when
+	_, err := mapPrivateSummaryRequest(options, "invoker-id")
+	request, noFiltersErr := mapPrivateSummaryRequest(nil, "invoker-id")
+
+	// then
+	assert.NotNil(err)
+	assert.Nil(noFiltersErr)
+	assert.False(request.IsFiltered())
+}
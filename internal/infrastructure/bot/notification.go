@@ -0,0 +1,102 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	stringsHelper "spot-assistant/internal/common/strings"
+	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/discord"
+	"spot-assistant/internal/core/dto/guild"
+	"spot-assistant/internal/core/dto/member"
+	"spot-assistant/internal/core/dto/notification"
+	"spot-assistant/internal/core/dto/reminder"
+	"spot-assistant/internal/core/dto/reservation"
+)
+
+// dmError marks errors of members not accepting DMs from the bot with notification.ErrCannotDM.
+func dmError(err error) error {
+	var restErr *discordgo.RESTError
+	if errors.As(err, &restErr) && restErr.Message != nil && restErr.Message.Code == discordgo.ErrCodeCannotSendMessagesToThisUser {
+		return fmt.Errorf("%w: %s", notification.ErrCannotDM, err)
+	}
+
+	return err
+}
+
+func (b *Bot) MentionOverbookedNotification(g *guild.Guild, member *member.Member, request book.BookRequest, res *reservation.ClippedOrRemovedReservation) error {
+	return b.mentionInCommandChannel(g, member, b.formatter.FormatOverbookedMemberNotification(member, request, res))
+}
+
+func (b *Bot) MentionReminder(g *guild.Guild, member *member.Member, r *reminder.Reminder) error {
+	return b.mentionInCommandChannel(g, member, b.formatter.FormatReminder(r))
+}
+
+// mentionInCommandChannel posts the message to the command channel of the guild,
+// mentioning only the member it is meant for.
+func (b *Bot) mentionInCommandChannel(g *guild.Guild, member *member.Member, message string) error {
+	commandChannel, err := b.FindChannelByName(g, discord.CommandChannel)
+	if err != nil {
+		return err
+	}
+
+	gID, err := stringsHelper.StrToInt64(g.ID)
+	if err != nil {
+		return fmt.Errorf("could not parse guild ID: %w", err)
+	}
+
+	_, err = b.mgr.SessionForGuild(gID).ChannelMessageSendComplex(commandChannel.ID, &discordgo.MessageSend{
+		Content:         fmt.Sprintf("<@%s> %s", member.ID, message),
+		AllowedMentions: &discordgo.MessageAllowedMentions{Users: []string{member.ID}},
+	})
+	if err != nil {
+		return err
+	}
+	b.metrics.IncMessagesSent(commandChannel.ID, commandChannel.Name)
+
+	return nil
+}
+
+// Notifications changes the channel a kind of notifications is delivered through.
+// Invoked without options, it reports the current preferences.
+func (b *Bot) Notifications(i *discordgo.InteractionCreate) error {
+	ctx := context.Background()
+	options := i.ApplicationCommandData().Options
+	memberID := i.Member.User.ID
+
+	kind := notification.Kind(stringOption(options, "kind"))
+	channel := notification.Channel(stringOption(options, "channel"))
+	if kind != "" || channel != "" {
+		if !kind.IsValid() {
+			return fmt.Errorf("invalid kind of notifications: %s", kind)
+		}
+		if !channel.IsValid() {
+			return fmt.Errorf("invalid channel: %s", channel)
+		}
+
+		if err := b.notificationPrefRepo.UpsertNotificationChannel(ctx, i.GuildID, memberID, kind, channel); err != nil {
+			return fmt.Errorf("could not save your notification preference: %w", err)
+		}
+	}
+
+	channels, err := b.notificationPrefRepo.SelectNotificationChannels(ctx, i.GuildID, memberID)
+	if err != nil {
+		return fmt.Errorf("could not load your notification preferences: %w", err)
+	}
+
+	return b.followup(i, &discordgo.WebhookParams{Content: formatNotificationPreferences(channels)})
+}
+
+func formatNotificationPreferences(channels map[notification.Kind]notification.Channel) string {
+	var message strings.Builder
+	message.WriteString("Your notifications:")
+	for _, kind := range notification.Kinds {
+		message.WriteString(fmt.Sprintf("\n* %s: **%s**", kind, channels[kind]))
+	}
+
+	return message.String()
+}
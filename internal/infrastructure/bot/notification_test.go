@@ -0,0 +1,44 @@
+package bot
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/notification"
+)
+
+func TestDMError(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	closedDMs := &discordgo.RESTError{Message: &discordgo.APIErrorMessage{
+		Code:    discordgo.ErrCodeCannotSendMessagesToThisUser,
+		Message: "Cannot send messages to this user",
+	}}
+	other := errors.New("connection reset")
+
+	// when
+	closedDMsErr := dmError(closedDMs)
+	otherErr := dmError(other)
+
+	// then
+	assert.ErrorIs(closedDMsErr, notification.ErrCannotDM)
+	assert.NotErrorIs(otherErr, notification.ErrCannotDM)
+	assert.Equal(other, otherErr)
+}
+
+func TestFormatNotificationPreferences(t *testing.T) {
+	// given
+	assert := assert.New(t)
+
+	// when
+	message := formatNotificationPreferences(map[notification.Kind]notification.Channel{
+		notification.KindOverbook: notification.ChannelDM,
+		notification.KindReminder: notification.ChannelMention,
+	})
+
+	// then
+	assert.Equal("Your notifications:\n* overbook: **dm**\n* reminder: **mention**", message)
+}
@@ -1,7 +1,6 @@
 package bot
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"math"
@@ -17,7 +16,9 @@ import (
 	"spot-assistant/internal/core/dto/book"
 	"spot-assistant/internal/core/dto/discord"
 	"spot-assistant/internal/core/dto/guild"
+	"spot-assistant/internal/core/dto/guildsettings"
 	"spot-assistant/internal/core/dto/member"
+	"spot-assistant/internal/core/dto/reminder"
 	"spot-assistant/internal/core/dto/reservation"
 	"spot-assistant/internal/core/dto/role"
 	"spot-assistant/internal/core/dto/summary"
@@ -51,6 +52,10 @@ func (b *Bot) SendDMOverbookedNotification(member *member.Member, request book.B
 	return b.SendDM(member, b.formatter.FormatOverbookedMemberNotification(member, request, res))
 }
 
+func (b *Bot) SendDMReminder(member *member.Member, r *reminder.Reminder) error {
+	return b.SendDM(member, b.formatter.FormatReminder(r))
+}
+
 func (b *Bot) ChannelMessages(g *guild.Guild, ch *discord.Channel, limit int) ([]*discord.Message, error) {
 	gID, err := stringsHelper.StrToInt64(g.ID)
 	if err != nil {
@@ -90,10 +95,11 @@ func (b *Bot) CleanChannel(g *guild.Guild, channel *discord.Channel) error {
 	return nil
 }
 
+// EnsureChannel makes sure the summary and the command channels exist. The channels are resolved
+// by their bound IDs, and channels that are not bound yet by their default names, adopting them
+// if the guild settings are configured. Missing channels are reported instead of being created,
+// as they have to be chosen with /setup.
 func (b *Bot) EnsureChannel(guild *guild.Guild) error {
-	letterSummaryChannelFound := false
-	letterChannelFound := false
-
 	g, err := b.mgr.Gateway.Guild(guild.ID)
 	if err != nil {
 
@@ -105,29 +111,51 @@ func (b *Bot) EnsureChannel(guild *guild.Guild) error {
 		return err
 	}
 
-	for _, ch := range channels {
-		if ch.Name == discord.SummaryChannel {
-			letterSummaryChannelFound = true
+	settings, err := b.ensuredSettings(guild.ID)
+	if err != nil {
+		return err
+	}
+
+	missing := make([]string, 0)
+	adopted := make(map[string]string)
+	for _, channelName := range []string{discord.SummaryChannel, discord.CommandChannel} {
+		channelID := settings.ChannelID(channelName)
+		channel, _ := collections.PoorMansFind(channels, func(ch *discordgo.Channel) bool {
+			if channelID != "" {
+				return ch.ID == channelID
+			}
+
+			return ch.Name == channelName
+		})
+		if channel == nil {
+			missing = append(missing, channelName)
+
+			continue
 		}
 
-		if ch.Name == discord.CommandChannel {
-			letterChannelFound = true
+		if channelID == "" {
+			adopted[channelName] = channel.ID
 		}
 	}
 
-	if !letterSummaryChannelFound {
-		_, err := b.mgr.Gateway.GuildChannelCreate(g.ID, discord.SummaryChannel, discordgo.ChannelTypeGuildText)
+	if len(adopted) > 0 && b.guildSettingsRepo != nil {
+		_, err := b.guildSettingsRepo.UpdateGuildSettings(context.Background(), guild.ID, func(settings *guildsettings.GuildSettings) error {
+			for channelName, channelID := range adopted {
+				// Channels bound in the meantime, e.g. with /setup, are kept.
+				if settings.ChannelID(channelName) == "" {
+					settings.BindChannel(channelName, channelID)
+				}
+			}
+
+			return nil
+		})
 		if err != nil {
-			return err
+			return fmt.Errorf("could not bind channels: %w", err)
 		}
 	}
 
-	if !letterChannelFound {
-		_, err := b.mgr.Gateway.GuildChannelCreate(g.ID, discord.CommandChannel, discordgo.ChannelTypeGuildText)
-		if err != nil {
-
-			return err
-		}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", ErrMissingChannels, strings.Join(missing, ", "))
 	}
 
 	return nil
@@ -150,7 +178,13 @@ func (b *Bot) FindChannelById(g *guild.Guild, channelId string) (*discord.Channe
 	return nil, fmt.Errorf("channel with id '%s' not found in guild '%s'", channelId, g.Name)
 }
 
+// FindChannelByName finds a channel by its default name. If the channel is bound
+// in guild settings, the bound channel is returned instead, whatever its current name is.
 func (b *Bot) FindChannelByName(g *guild.Guild, channelName string) (*discord.Channel, error) {
+	if channelID := b.guildSettings(g.ID).ChannelID(channelName); channelID != "" {
+		return b.FindChannelById(g, channelID)
+	}
+
 	channels, err := b.mgr.Gateway.GuildChannels(g.ID)
 	if err != nil {
 		return nil, fmt.Errorf("error when fetching guild channels: %s", err)
@@ -165,9 +199,11 @@ func (b *Bot) FindChannelByName(g *guild.Guild, channelName string) (*discord.Ch
 	return nil, fmt.Errorf("channel '%s' not found in guild '%s'", channelName, g.Name)
 }
 
+// EnsureRoles makes sure the privileged role exists. The role is resolved by its bound ID,
+// and a role that is not bound yet by its default name, adopting it if the guild settings
+// are configured. A missing role is reported instead of being created.
 func (b *Bot) EnsureRoles(g *guild.Guild) error {
-	guild, err := b.mgr.Gateway.Guild(g.ID)
-	if err != nil {
+	if _, err := b.mgr.Gateway.Guild(g.ID); err != nil {
 		return fmt.Errorf("error when fetching guild: %s", err)
 	}
 
@@ -175,18 +211,54 @@ func (b *Bot) EnsureRoles(g *guild.Guild) error {
 	if err != nil {
 		return err
 	}
-	for _, role := range roles {
-		if role.Name == discord.PrivilegedRole {
+
+	settings, err := b.ensuredSettings(g.ID)
+	if err != nil {
+		return err
+	}
+
+	roleID := settings.RoleID(discord.PrivilegedRole)
+	privilegedRole, _ := collections.PoorMansFind(roles, func(r *role.Role) bool {
+		if roleID != "" {
+			return r.ID == roleID
+		}
+
+		return r.Name == discord.PrivilegedRole
+	})
+	if privilegedRole == nil {
+		return fmt.Errorf("%w: %s", ErrMissingRoles, discord.PrivilegedRole)
+	}
+
+	if roleID == "" && b.guildSettingsRepo != nil {
+		_, err := b.guildSettingsRepo.UpdateGuildSettings(context.Background(), g.ID, func(settings *guildsettings.GuildSettings) error {
+			// A role bound in the meantime, e.g. with /setup, is kept.
+			if settings.RoleID(discord.PrivilegedRole) == "" {
+				settings.BindRole(discord.PrivilegedRole, privilegedRole.ID)
+			}
+
 			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("could not bind roles: %w", err)
 		}
 	}
 
-	_, err = b.mgr.Gateway.GuildRoleCreate(guild.ID, &discordgo.RoleParams{Name: discord.PrivilegedRole})
+	return nil
+}
+
+// ensuredSettings returns settings of a guild to resolve its channels and roles with,
+// or the defaults, binding nothing, if the guild settings are not configured.
+func (b *Bot) ensuredSettings(guildID string) (*guildsettings.GuildSettings, error) {
+	if b.guildSettingsRepo == nil {
+		return guildsettings.Default(guildID), nil
+	}
+
+	settings, err := b.guildSettingsRepo.SelectGuildSettings(context.Background(), guildID)
 	if err != nil {
-		return fmt.Errorf("error when creating a postman role: %s", err)
+		return nil, fmt.Errorf("could not load guild settings: %w", err)
 	}
 
-	return nil
+	return settings, nil
 }
 
 func (b *Bot) GetGuilds() []*guild.Guild {
@@ -242,19 +314,44 @@ func (b *Bot) UpdateGuildLetter(guild *guild.Guild) error {
 		b.metrics.SetUpcomingReservations(guild.ID, guild.Name, len(reservationsWithSpots))
 	}
 
-	sum, err := b.summarySrv.PrepareSummary(reservationsWithSpots)
+	sum, err := b.summarySrv.PrepareLedger(guild.ID, reservationsWithSpots)
+	if err != nil {
+		return err
+	}
+
+	// Nothing has changed since the last update, leave the letter as it is.
+	// Charts are rendered only once the letter is going to be sent.
+	fingerprint := summaryFingerprint(sum, time.Now())
+	if previous, ok := b.letterFingerprints.Get(guild.ID); ok && previous == fingerprint {
+		if b.metrics != nil {
+			b.metrics.IncSummarySkips(guild.ID, guild.Name)
+		}
+
+		return nil
+	}
+
+	if err = b.summarySrv.RenderCharts(guild.ID, sum); err != nil {
+		return err
+	}
+
+	err = b.SendLetterMessage(guild, summaryChannel, sum)
 	if err != nil {
 		return err
 	}
 
-	return b.SendLetterMessage(guild, summaryChannel, sum)
+	b.letterFingerprints.Set(guild.ID, fingerprint)
+	if b.metrics != nil {
+		b.metrics.IncSummaryUpdates(guild.ID, guild.Name)
+	}
+
+	return nil
 }
 
 // SendLetterMessage sends a message to a guild channel,
 // or in a DM if guild is nil.
 func (b *Bot) SendLetterMessage(guild *guild.Guild, channel *discord.Channel, sum *summary.Summary) error {
-	if len(sum.Ledger) == 0 {
-		return fmt.Errorf("SendLetterMessage requires at least 1 ledger entry to be present")
+	if len(sum.Ledger) == 0 && len(sum.FreeNow) == 0 && len(sum.FreeSoon) == 0 {
+		return fmt.Errorf("SendLetterMessage requires at least 1 ledger entry or free spot to be present")
 	}
 
 	// Do not allow for asynchronous modification
@@ -281,80 +378,49 @@ func (b *Bot) SendLetterMessage(guild *guild.Guild, channel *discord.Channel, su
 		dcSession = b.mgr.SessionForGuild(gID)
 	}
 
-	// Transfrom into lines of text describing reservation
-	fields := collections.PoorMansMap(sum.Ledger, func(el summary.LedgerEntry) *discordgo.MessageEmbedField {
-		writtenReservations := strings.Builder{}
-
-		for _, booking := range el.Bookings {
-			statusStr := MapOnlineStatus(booking.Status)
-			writtenReservations.WriteString(
-				fmt.Sprintf(
-					"%s**%s** - **%s** %s\n",
-					statusStr,
-					booking.StartAt.Format("15:04"),
-					booking.EndAt.Format("15:04"),
-					booking.Author,
-				),
-			)
-		}
-
-		value := writtenReservations.String()
-		name := fmt.Sprintf("**`%s`**", el.Spot)
-
-		return &discordgo.MessageEmbedField{
-			Name:   name,
-			Value:  value,
-			Inline: true,
-		}
-	})
+	layout := layoutFor(sum.Layout)
+	fields := make([]*discordgo.MessageEmbedField, 0)
+	if len(sum.Ledger) > 0 {
+		fields = layout.fields(sum.Ledger, time.Now())
+	}
 	footer := MapFooter(sum.Footer)
 
-	// Discord seems to have a limit of embeds per message
-	// this means we should limit ourselves to send maximum 20 embeds
-	// per message; and continue sending messages until we're done
-	batchLimit := int(math.Min(13.0, float64(len(fields))))
+	// Discord limits the amount of fields per embed, so the fields
+	// are split into several embeds, as many as the layout allows
+	batchLimit := int(math.Min(float64(layout.fieldsPerEmbed()), float64(len(fields))))
 	batches := collections.PoorMansPartition(fields, batchLimit)
 	embeds := collections.PoorMansMap(batches, func(batch []*discordgo.MessageEmbedField) *discordgo.MessageEmbed {
-		return b.newEmbed(sum.Title, sum.URL, sum.Description, batch, footer)
+		return b.newEmbed(sum, batch, footer)
 	})
 
-	if channel.Type != discord.ChannelTypeDM {
-		err := b.CleanChannel(guild, channel)
-		if err != nil {
-			return err
-		}
+	if freeFields := freeSpotsFields(sum); len(freeFields) > 0 {
+		embeds = append([]*discordgo.MessageEmbed{b.newEmbed(sum, freeFields, footer)}, embeds...)
 	}
 
+	parts := make([]*letterPart, 0, len(embeds)+3)
 	if sum.PreMessage != "" {
-		_, err := dcSession.ChannelMessageSend(channel.ID, sum.PreMessage)
-		if err != nil {
-			return err
-		}
-		defer b.metrics.IncMessagesSent(channel.ID, channel.Name)
+		parts = append(parts, &letterPart{content: sum.PreMessage})
 	}
-
-	_, err := dcSession.ChannelFileSend(channel.ID, "spots.png", bytes.NewReader(sum.Chart))
-	if err != nil {
-		return err
+	if sum.Chart != nil {
+		parts = append(parts, &letterPart{fileName: "spots.png", file: sum.Chart})
+	}
+	if sum.TimelineChart != nil {
+		parts = append(parts, &letterPart{fileName: "timeline.png", file: sum.TimelineChart})
 	}
-	defer b.metrics.IncMessagesSent(channel.ID, channel.Name)
 
 	// It seems that discord applies the same validation to 1 embed and to bulk sent embeds,
 	// without treating them as separate messages. Because of that, we're gonna need to send embeds 1 by 1.
-	// _, err = dcSession.ChannelMessageSendEmbeds(channel.ID, embeds)
-	// if err != nil {
-	// 	return err
-	// }
 	for _, embed := range embeds {
-		_, err = dcSession.ChannelMessageSendEmbed(channel.ID, embed)
-		if err != nil {
-			b.log.Errorf("something went wrong when sending embed: %s", err)
-		}
+		parts = append(parts, &letterPart{embed: embed})
+	}
+
+	if channel.Type == discord.ChannelTypeDM {
+		_, err := b.sendLetterParts(dcSession, channel, parts)
 
-		defer b.metrics.IncMessagesSent(channel.ID, channel.Name)
+		return err
 	}
 
-	return err
+	return b.syncLetter(dcSession, guild, channel, parts)
 }
 
 func (b *Bot) SendDM(member *member.Member, message string) error {
@@ -368,7 +434,7 @@ func (b *Bot) SendDM(member *member.Member, message string) error {
 		message)
 
 	if err != nil {
-		return err
+		return dmError(err)
 	}
 	defer b.metrics.IncMessagesSent(channel.ID, member.Username)
 
@@ -437,24 +503,30 @@ func (b *Bot) GetRoles(g *guild.Guild) ([]*role.Role, error) {
 	return MapRoles(roles), nil
 }
 
+// MemberHasRole checks if a member has a role with the default name. If the role is bound
+// in guild settings, the bound role is checked instead, whatever its current name is.
 func (b *Bot) MemberHasRole(g *guild.Guild, m *member.Member, targetRoleName string) bool {
-	roles, err := b.GetRoles(g)
-	if err != nil {
-		b.log.Errorf("error occured when getting roles: %s", err)
+	targetRoleID := b.guildSettings(g.ID).RoleID(targetRoleName)
+	if targetRoleID == "" {
+		roles, err := b.GetRoles(g)
+		if err != nil {
+			b.log.Errorf("error occured when getting roles: %s", err)
 
-		return false
-	}
+			return false
+		}
 
-	targetRole, _ := collections.PoorMansFind(roles, func(r *role.Role) bool {
-		return r.Name == targetRoleName
-	})
+		targetRole, _ := collections.PoorMansFind(roles, func(r *role.Role) bool {
+			return r.Name == targetRoleName
+		})
 
-	if targetRole == nil {
-		return false
+		if targetRole == nil {
+			return false
+		}
+		targetRoleID = targetRole.ID
 	}
 
 	for _, memberRole := range m.Roles {
-		if memberRole == targetRole.ID {
+		if memberRole == targetRoleID {
 			return true
 		}
 	}
@@ -0,0 +1,64 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+
+	"spot-assistant/internal/core/dto/reminder"
+)
+
+// Reminders changes how early the member is reminded of their reservations.
+// Invoked without options, it reports the current preference.
+func (b *Bot) Reminders(i *discordgo.InteractionCreate) error {
+	ctx := context.Background()
+	options := i.ApplicationCommandData().Options
+	memberID := i.Member.User.ID
+
+	guildDefault := 0
+	if settings := b.guildSettings(i.GuildID); settings != nil {
+		guildDefault = settings.ReminderMinutesBefore
+	}
+
+	switch {
+	case boolOption(options, "default"):
+		if err := b.reminderRepo.DeleteMemberReminder(ctx, i.GuildID, memberID); err != nil {
+			return fmt.Errorf("could not save your reminder preference: %w", err)
+		}
+	case hasOption(options, "minutes"):
+		minutes := intOption(options, "minutes")
+		if minutes < 0 || minutes > reminder.MaxMinutesBefore {
+			return fmt.Errorf("reminders can be sent at most %d minutes before the hunt", reminder.MaxMinutesBefore)
+		}
+
+		err := b.reminderRepo.UpsertMemberReminder(ctx, &reminder.MemberReminder{
+			GuildID:       i.GuildID,
+			MemberID:      memberID,
+			MinutesBefore: minutes,
+		})
+		if err != nil {
+			return fmt.Errorf("could not save your reminder preference: %w", err)
+		}
+	}
+
+	memberReminder, err := b.reminderRepo.SelectMemberReminder(ctx, i.GuildID, memberID)
+	if err != nil {
+		return fmt.Errorf("could not load your reminder preference: %w", err)
+	}
+
+	return b.followup(i, &discordgo.WebhookParams{Content: formatReminderPreference(memberReminder, guildDefault)})
+}
+
+func formatReminderPreference(memberReminder *reminder.MemberReminder, guildDefault int) string {
+	minutes, source := guildDefault, " (server default)"
+	if memberReminder != nil {
+		minutes, source = memberReminder.MinutesBefore, ""
+	}
+
+	if minutes == 0 {
+		return fmt.Sprintf("Reminders are **off**%s.", source)
+	}
+
+	return fmt.Sprintf("You will be reminded **%d minutes** before your hunts%s.", minutes, source)
+}
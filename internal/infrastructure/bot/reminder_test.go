@@ -0,0 +1,30 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/reminder"
+)
+
+func TestFormatReminderPreference(t *testing.T) {
+	// given
+	assert := assert.New(t)
+
+	// when
+	messages := []string{
+		formatReminderPreference(nil, 0),
+		formatReminderPreference(nil, 15),
+		formatReminderPreference(&reminder.MemberReminder{MinutesBefore: 30}, 15),
+		formatReminderPreference(&reminder.MemberReminder{MinutesBefore: 0}, 15),
+	}
+
+	// then
+	assert.Equal([]string{
+		"Reminders are **off** (server default).",
+		"You will be reminded **15 minutes** before your hunts (server default).",
+		"You will be reminded **30 minutes** before your hunts.",
+		"Reminders are **off**.",
+	}, messages)
+}
@@ -0,0 +1,463 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	stringsHelper "spot-assistant/internal/common/strings"
+	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/discord"
+	"spot-assistant/internal/core/dto/guildsettings"
+	"spot-assistant/internal/core/dto/reliability"
+	"spot-assistant/internal/core/dto/reminder"
+)
+
+// Settings handles /settings subcommands, which change preferences of the guild (owner only).
+func (b *Bot) Settings(i *discordgo.InteractionCreate) error {
+	if err := b.ensureGuildOwner(i); err != nil {
+		return err
+	}
+
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return errors.New("a setting to change is required")
+	}
+
+	var message string
+	var settingErr error
+	_, err := b.guildSettingsRepo.UpdateGuildSettings(context.Background(), i.GuildID, func(settings *guildsettings.GuildSettings) error {
+		message, settingErr = b.applySetting(settings, options[0])
+
+		return settingErr
+	})
+	if settingErr != nil {
+		return settingErr
+	}
+	if err != nil {
+		return fmt.Errorf("could not save guild settings: %w", err)
+	}
+
+	b.refreshGuildLetter(i.GuildID)
+
+	return b.followup(i, &discordgo.WebhookParams{Content: message})
+}
+
+// applySetting changes the setting of the subcommand, returning the message confirming the change.
+func (b *Bot) applySetting(settings *guildsettings.GuildSettings, subcommand *discordgo.ApplicationCommandInteractionDataOption) (string, error) {
+	switch subcommand.Name {
+	case "chart":
+		return applyChartSetting(settings, subcommand.Options)
+	case "layout":
+		return applyLayoutSetting(settings, subcommand.Options)
+	case "booking-channel":
+		return applyBookingChannelSetting(settings, subcommand.Options)
+	case "booking-mirror":
+		return applyBookingMirrorSetting(settings, subcommand.Options), nil
+	case "favourites":
+		return b.applyFavouritesSetting(settings, subcommand.Options)
+	case "branding":
+		return applyBrandingSetting(settings, subcommand.Options)
+	case "reminders":
+		return applyRemindersSetting(settings, subcommand.Options)
+	case "reliability":
+		return applyReliabilitySetting(settings, subcommand.Options)
+	case "spot-requirements":
+		return applySpotRequirementsSetting(settings, subcommand.Options), nil
+	case "tibia-guild":
+		return applyTibiaGuildSetting(settings, subcommand.Options)
+	default:
+		return "", fmt.Errorf("unknown setting: %s", subcommand.Name)
+	}
+}
+
+func applyChartSetting(settings *guildsettings.GuildSettings, options []*discordgo.ApplicationCommandInteractionDataOption) (string, error) {
+	chart := guildsettings.SummaryChart(stringOption(options, "type"))
+	if !chart.IsValid() {
+		return "", fmt.Errorf("invalid chart type: %s", chart)
+	}
+	settings.SummaryChart = chart
+
+	return fmt.Sprintf("Summary chart for this server set to: **%s**", chart), nil
+}
+
+func applyLayoutSetting(settings *guildsettings.GuildSettings, options []*discordgo.ApplicationCommandInteractionDataOption) (string, error) {
+	layout := guildsettings.SummaryLayout(stringOption(options, "type"))
+	if !layout.IsValid() {
+		return "", fmt.Errorf("invalid summary layout: %s", layout)
+	}
+	settings.SummaryLayout = layout
+
+	return fmt.Sprintf("Summary layout for this server set to: **%s**", layout), nil
+}
+
+// applyBookingChannelSetting adds or removes a channel, where booking commands are allowed.
+// Booking commands are allowed everywhere, as long as no channel is configured.
+func applyBookingChannelSetting(settings *guildsettings.GuildSettings, options []*discordgo.ApplicationCommandInteractionDataOption) (string, error) {
+	action := stringOption(options, "action")
+	channelID := idOption(options, "channel")
+	if action != "clear" && channelID == "" {
+		return "", fmt.Errorf("a channel is required to %s it", action)
+	}
+
+	switch action {
+	case "add":
+		if !slices.Contains(settings.BookingChannelIDs, channelID) {
+			settings.BookingChannelIDs = append(settings.BookingChannelIDs, channelID)
+		}
+	case "remove":
+		settings.BookingChannelIDs = slices.DeleteFunc(settings.BookingChannelIDs, func(id string) bool {
+			return id == channelID
+		})
+	case "clear":
+		settings.BookingChannelIDs = []string{}
+	default:
+		return "", fmt.Errorf("invalid action: %s", action)
+	}
+
+	if len(settings.BookingChannelIDs) == 0 {
+		return "Booking commands can be used in any channel.", nil
+	}
+
+	return fmt.Sprintf("Booking commands can be used in: %s", formatChannelMentions(settings.BookingChannelIDs)), nil
+}
+
+func applyBookingMirrorSetting(settings *guildsettings.GuildSettings, options []*discordgo.ApplicationCommandInteractionDataOption) string {
+	settings.MirrorBookings = boolOption(options, "enabled")
+	if settings.MirrorBookings {
+		return "Bookings made outside of the command channel will be mirrored to it."
+	}
+
+	return "Bookings will no longer be mirrored."
+}
+
+// applyFavouritesSetting adds or removes a spot listed in the free now and free soon sections of the summary.
+func (b *Bot) applyFavouritesSetting(settings *guildsettings.GuildSettings, options []*discordgo.ApplicationCommandInteractionDataOption) (string, error) {
+	action := stringOption(options, "action")
+	spotName := strings.TrimSpace(stringOption(options, "respawn"))
+	if action != "clear" && spotName == "" {
+		return "", fmt.Errorf("a respawn is required to %s it", action)
+	}
+
+	switch action {
+	case "add":
+		canonicalName, err := b.findSpotName(spotName)
+		if err != nil {
+			return "", err
+		}
+		if slices.Contains(settings.FavouriteSpots, canonicalName) {
+			break
+		}
+		if len(settings.FavouriteSpots) >= guildsettings.MaxFavouriteSpots {
+			return "", fmt.Errorf("a server can have at most %d favourite respawns", guildsettings.MaxFavouriteSpots)
+		}
+		settings.FavouriteSpots = append(settings.FavouriteSpots, canonicalName)
+	case "remove":
+		settings.FavouriteSpots = slices.DeleteFunc(settings.FavouriteSpots, func(name string) bool {
+			return strings.EqualFold(name, spotName)
+		})
+	case "clear":
+		settings.FavouriteSpots = []string{}
+	default:
+		return "", fmt.Errorf("invalid action: %s", action)
+	}
+
+	if len(settings.FavouriteSpots) == 0 {
+		return "The summary will not list free respawns.", nil
+	}
+
+	return fmt.Sprintf("The summary will list these respawns when free: %s", strings.Join(settings.FavouriteSpots, ", ")), nil
+}
+
+// applyBrandingSetting changes texts and looks of the summary. Only the given options are changed,
+// unless the branding is reset to the defaults of the bot.
+func applyBrandingSetting(settings *guildsettings.GuildSettings, options []*discordgo.ApplicationCommandInteractionDataOption) (string, error) {
+	if boolOption(options, "reset") {
+		settings.Branding = guildsettings.SummaryBranding{}
+
+		return "Summary branding restored to the defaults.", nil
+	}
+
+	branding := settings.Branding
+	if title := stringOption(options, "title"); title != "" {
+		if len(title) > guildsettings.MaxBrandingTitleLength {
+			return "", fmt.Errorf("title can be at most %d characters long", guildsettings.MaxBrandingTitleLength)
+		}
+		branding.Title = title
+	}
+	if description := stringOption(options, "description"); description != "" {
+		if len(description) > guildsettings.MaxBrandingDescriptionLength {
+			return "", fmt.Errorf("description can be at most %d characters long", guildsettings.MaxBrandingDescriptionLength)
+		}
+		branding.Description = description
+	}
+	if preMessage := stringOption(options, "pre-message"); preMessage != "" {
+		if len(preMessage) > guildsettings.MaxBrandingPreMessageLength {
+			return "", fmt.Errorf("pre-message can be at most %d characters long", guildsettings.MaxBrandingPreMessageLength)
+		}
+		branding.PreMessage = preMessage
+	}
+	if link := stringOption(options, "url"); link != "" {
+		if !isWebURL(link) {
+			return "", fmt.Errorf("invalid url: %s", link)
+		}
+		branding.URL = link
+	}
+	if thumbnail := stringOption(options, "thumbnail"); thumbnail != "" {
+		if !isWebURL(thumbnail) {
+			return "", fmt.Errorf("invalid thumbnail url: %s", thumbnail)
+		}
+		branding.ThumbnailURL = thumbnail
+	}
+	if color := stringOption(options, "color"); color != "" {
+		value, err := parseColor(color)
+		if err != nil {
+			return "", err
+		}
+		branding.Color = value
+	}
+	if hasOption(options, "pre-message-enabled") {
+		branding.HidePreMessage = !boolOption(options, "pre-message-enabled")
+	}
+	settings.Branding = branding
+
+	return "Summary branding updated.", nil
+}
+
+// applyRemindersSetting changes how early members are reminded of their reservations,
+// unless they choose otherwise with /reminders.
+func applyRemindersSetting(settings *guildsettings.GuildSettings, options []*discordgo.ApplicationCommandInteractionDataOption) (string, error) {
+	minutes := intOption(options, "minutes")
+	if minutes < 0 || minutes > reminder.MaxMinutesBefore {
+		return "", fmt.Errorf("reminders can be sent at most %d minutes before the hunt", reminder.MaxMinutesBefore)
+	}
+	settings.ReminderMinutesBefore = minutes
+
+	if minutes == 0 {
+		return "Reminders are off by default.", nil
+	}
+
+	return fmt.Sprintf("Members will be reminded %d minutes before their hunts by default.", minutes), nil
+}
+
+// isWebURL reports whether the value is an absolute http or https URL.
+func isWebURL(value string) bool {
+	parsed, err := url.ParseRequestURI(value)
+	if err != nil {
+		return false
+	}
+
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// parseColor parses a colour written as RRGGBB, optionally prefixed with #.
+func parseColor(value string) (int, error) {
+	hex := strings.TrimPrefix(value, "#")
+	if len(hex) != 6 {
+		return 0, fmt.Errorf("invalid colour: %s, expected a hex value such as #ff8800", value)
+	}
+
+	color, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid colour: %s, expected a hex value such as #ff8800", value)
+	}
+
+	return int(color), nil
+}
+
+// findSpotName returns the name of the spot as it is stored, matching it case-insensitively.
+func (b *Bot) findSpotName(spotName string) (string, error) {
+	names, err := b.eventHandler.OnBookAutocomplete(book.BookAutocompleteRequest{
+		Field: book.BookAutocompleteSpot,
+		Value: spotName,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for _, name := range names {
+		if strings.EqualFold(name, spotName) {
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown respawn: %s", spotName)
+}
+
+// SettingsAutocomplete suggests respawns for the favourites setting.
+func (b *Bot) SettingsAutocomplete(i *discordgo.InteractionCreate) error {
+	var spotFilter string
+	for _, subcommand := range i.ApplicationCommandData().Options {
+		for _, opt := range subcommand.Options {
+			if opt.Focused && opt.Name == "respawn" {
+				spotFilter = opt.StringValue()
+			}
+		}
+	}
+
+	return b.respondWithSpots(i, spotFilter)
+}
+
+// respondWithSpots suggests spots matching the filter.
+func (b *Bot) respondWithSpots(i *discordgo.InteractionCreate, filter string) error {
+	response, err := b.eventHandler.OnBookAutocomplete(book.BookAutocompleteRequest{
+		Field: book.BookAutocompleteSpot,
+		Value: filter,
+	})
+	if err != nil {
+		return err
+	}
+
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(response))
+	for _, v := range response {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: v, Value: v})
+	}
+
+	return b.interactionRespond(i, &discordgo.InteractionResponseData{Choices: choices}, discordgo.InteractionApplicationCommandAutocompleteResult)
+}
+
+// ensureGuildOwner returns an error, unless the interaction was invoked by the owner of the guild.
+func (b *Bot) ensureGuildOwner(i *discordgo.InteractionCreate) error {
+	guild, err := b.mgr.Gateway.Guild(i.GuildID)
+	if err != nil {
+		return fmt.Errorf("could not fetch guild: %w", err)
+	}
+	if i.Member == nil || i.Member.User == nil || guild.OwnerID != i.Member.User.ID {
+		return fmt.Errorf("only the server owner can use this command")
+	}
+
+	return nil
+}
+
+// refreshGuildLetter pushes the guild letter again, even if reservations did not change.
+func (b *Bot) refreshGuildLetter(guildID string) {
+	gID, err := stringsHelper.StrToInt64(guildID)
+	if err != nil {
+		b.log.Errorf("could not parse guild ID: %s", err)
+
+		return
+	}
+
+	guild, err := b.GetGuild(gID)
+	if err != nil {
+		b.log.Errorf("could not fetch guild: %s", err)
+
+		return
+	}
+
+	b.invalidateLetter(guild.ID)
+	go b.TryUpdateGuildLetter(guild)
+}
+
+// stringOption returns value of the option with a given name, or an empty string if it is missing.
+func stringOption(options []*discordgo.ApplicationCommandInteractionDataOption, name string) string {
+	for _, opt := range options {
+		if opt.Name == name {
+			return opt.StringValue()
+		}
+	}
+
+	return ""
+}
+
+// hasOption reports whether the option with a given name was provided.
+func hasOption(options []*discordgo.ApplicationCommandInteractionDataOption, name string) bool {
+	for _, opt := range options {
+		if opt.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applyReliabilitySetting restricts how far ahead members with a low reliability score can book,
+// and chooses whether members overbooking others are told how reliable they are.
+func applyReliabilitySetting(settings *guildsettings.GuildSettings, options []*discordgo.ApplicationCommandInteractionDataOption) (string, error) {
+	minScore := intOption(options, "min-score")
+	if minScore < 0 || minScore > reliability.MaxScore {
+		return "", fmt.Errorf("the minimum score has to be between 0 and %d", reliability.MaxScore)
+	}
+	settings.Reliability.MinScore = minScore
+
+	if hasOption(options, "hours-ahead") {
+		hours := intOption(options, "hours-ahead")
+		if hours < 1 || hours > guildsettings.MaxReliabilityHoursAhead {
+			return "", fmt.Errorf("restricted members can be let book between 1 and %d hours ahead", guildsettings.MaxReliabilityHoursAhead)
+		}
+		settings.Reliability.MaxHoursAhead = hours
+	}
+	if hasOption(options, "overbook-dm") {
+		settings.Reliability.OverbookDM = boolOption(options, "overbook-dm")
+	}
+
+	return formatReliabilitySetting(settings.Reliability), nil
+}
+
+func formatReliabilitySetting(s guildsettings.ReliabilitySettings) string {
+	var message strings.Builder
+	if s.MinScore == 0 {
+		message.WriteString("Members are not restricted by their reliability.")
+	} else {
+		message.WriteString(fmt.Sprintf("Members scoring below %d can book at most %d hours ahead.", s.MinScore, s.MaxHoursAhead))
+	}
+	if s.OverbookDM {
+		message.WriteString(fmt.Sprintf(" Members with the @%s role are told how reliable members they overbook are.", discord.PrivilegedRole))
+	}
+
+	return message.String()
+}
+
+// applySpotRequirementsSetting chooses whether members can book only spots, whose requirements one of their characters meets.
+func applySpotRequirementsSetting(settings *guildsettings.GuildSettings, options []*discordgo.ApplicationCommandInteractionDataOption) string {
+	settings.SpotRequirements = boolOption(options, "enabled")
+	if settings.SpotRequirements {
+		return fmt.Sprintf("Members can book only respawns, whose level and vocation requirements one of their verified characters meets. Members with the @%s role are not restricted.", discord.PrivilegedRole)
+	}
+
+	return "Requirements of respawns are no longer checked."
+}
+
+// applyTibiaGuildSetting restricts booking to members with a character in the in-game guild.
+// Booking is not restricted without a name.
+func applyTibiaGuildSetting(settings *guildsettings.GuildSettings, options []*discordgo.ApplicationCommandInteractionDataOption) (string, error) {
+	name := strings.TrimSpace(stringOption(options, "name"))
+	if len(name) > guildsettings.MaxTibiaGuildLength {
+		return "", fmt.Errorf("names of in-game guilds are at most %d characters long", guildsettings.MaxTibiaGuildLength)
+	}
+	settings.TibiaGuild = name
+	if name == "" {
+		return "Booking is no longer restricted to members of an in-game guild.", nil
+	}
+
+	return fmt.Sprintf("Only members with a verified character in **%s** can book now. Members with the @%s role are not restricted. "+
+		"While members of the guild cannot be fetched from TibiaData, everyone can book.", name, discord.PrivilegedRole), nil
+}
+
+// intOption returns value of the integer option with a given name, or zero if it is missing.
+func intOption(options []*discordgo.ApplicationCommandInteractionDataOption, name string) int {
+	for _, opt := range options {
+		if opt.Name == name {
+			return int(opt.IntValue())
+		}
+	}
+
+	return 0
+}
+
+// boolOption returns value of the boolean option with a given name, or false if it is missing.
+func boolOption(options []*discordgo.ApplicationCommandInteractionDataOption, name string) bool {
+	for _, opt := range options {
+		if opt.Name == name {
+			return opt.BoolValue()
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,145 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/guildsettings"
+)
+
+func TestApplyBrandingSetting(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	settings := guildsettings.Default("guild-id")
+	settings.Branding.Title = "Old title"
+	options := []*discordgo.ApplicationCommandInteractionDataOption{
+		{Name: "description", Type: discordgo.ApplicationCommandOptionString, Value: "Our hunts."},
+		{Name: "color", Type: discordgo.ApplicationCommandOptionString, Value: "#FF8800"},
+		{Name: "thumbnail", Type: discordgo.ApplicationCommandOptionString, Value: "https://example.com/logo.png"},
+		{Name: "pre-message-enabled", Type: discordgo.ApplicationCommandOptionBoolean, Value: false},
+	}
+
+	// when
+	message, err := applyBrandingSetting(settings, options)
+
+	// then
+	assert.Nil(err)
+	assert.Equal("Summary branding updated.", message)
+	assert.Equal(guildsettings.SummaryBranding{
+		Title:          "Old title",
+		Description:    "Our hunts.",
+		ThumbnailURL:   "https://example.com/logo.png",
+		Color:          0xff8800,
+		HidePreMessage: true,
+	}, settings.Branding)
+}
+
+func TestApplyBrandingSettingInvalid(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	settings := guildsettings.Default("guild-id")
+	option := func(name, value string) []*discordgo.ApplicationCommandInteractionDataOption {
+		return []*discordgo.ApplicationCommandInteractionDataOption{
+			{Name: name, Type: discordgo.ApplicationCommandOptionString, Value: value},
+		}
+	}
+
+	// when
+	_, colorErr := applyBrandingSetting(settings, option("color", "orange"))
+	_, urlErr := applyBrandingSetting(settings, option("url", "javascript:alert(1)"))
+
+	// then
+	assert.EqualError(colorErr, "invalid colour: orange, expected a hex value such as #ff8800")
+	assert.EqualError(urlErr, "invalid url: javascript:alert(1)")
+	assert.Equal(guildsettings.SummaryBranding{}, settings.Branding)
+}
+
+func TestApplyBrandingSettingReset(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	settings := guildsettings.Default("guild-id")
+	settings.Branding = guildsettings.SummaryBranding{Title: "Our Guild", HidePreMessage: true}
+
+	// when
+	message, err := applyBrandingSetting(settings, []*discordgo.ApplicationCommandInteractionDataOption{
+		{Name: "reset", Type: discordgo.ApplicationCommandOptionBoolean, Value: true},
+	})
+
+	// then
+	assert.Nil(err)
+	assert.Equal("Summary branding restored to the defaults.", message)
+	assert.Equal(guildsettings.SummaryBranding{}, settings.Branding)
+}
+
+func TestApplyReliabilitySetting(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	settings := guildsettings.Default("guild-id")
+	options := []*discordgo.ApplicationCommandInteractionDataOption{
+		{Name: "min-score", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(60)},
+		{Name: "overbook-dm", Type: discordgo.ApplicationCommandOptionBoolean, Value: true},
+	}
+
+	// when
+	message, err := applyReliabilitySetting(settings, options)
+
+	// then
+	assert.Nil(err)
+	assert.Equal("Members scoring below 60 can book at most 24 hours ahead. Members with the @Postman role are told how reliable members they overbook are.", message)
+	assert.Equal(guildsettings.ReliabilitySettings{MinScore: 60, MaxHoursAhead: 24, OverbookDM: true}, settings.Reliability)
+}
+
+func TestApplyReliabilitySettingInvalid(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	settings := guildsettings.Default("guild-id")
+	options := []*discordgo.ApplicationCommandInteractionDataOption{
+		{Name: "min-score", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(60)},
+		{Name: "hours-ahead", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(0)},
+	}
+
+	// when
+	_, err := applyReliabilitySetting(settings, options)
+
+	// then
+	assert.NotNil(err)
+}
+
+func TestApplyTibiaGuildSetting(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	settings := guildsettings.Default("guild-id")
+
+	// when
+	set, setErr := applyTibiaGuildSetting(settings, []*discordgo.ApplicationCommandInteractionDataOption{
+		{Name: "name", Type: discordgo.ApplicationCommandOptionString, Value: " Red Rose "},
+	})
+	setGuild := settings.TibiaGuild
+	cleared, clearErr := applyTibiaGuildSetting(settings, nil)
+
+	// then
+	assert.Nil(setErr)
+	assert.Equal("Only members with a verified character in **Red Rose** can book now. Members with the @Postman role are not restricted. "+
+		"While members of the guild cannot be fetched from TibiaData, everyone can book.", set)
+	assert.Equal("Red Rose", setGuild)
+	assert.Nil(clearErr)
+	assert.Equal("Booking is no longer restricted to members of an in-game guild.", cleared)
+	assert.Empty(settings.TibiaGuild)
+}
+
+func TestApplySpotRequirementsSetting(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	settings := guildsettings.Default("guild-id")
+
+	// when
+	message := applySpotRequirementsSetting(settings, []*discordgo.ApplicationCommandInteractionDataOption{
+		{Name: "enabled", Type: discordgo.ApplicationCommandOptionBoolean, Value: true},
+	})
+
+	// then
+	assert.Equal("Members can book only respawns, whose level and vocation requirements one of their verified characters meets. Members with the @Postman role are not restricted.", message)
+	assert.True(settings.SpotRequirements)
+}
@@ -0,0 +1,156 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"spot-assistant/internal/core/dto/discord"
+	"spot-assistant/internal/core/dto/guildsettings"
+)
+
+var (
+	ErrMissingChannels = errors.New("channels are missing, bind them with /setup")
+	ErrMissingRoles    = errors.New("roles are missing, bind them with /setup")
+)
+
+// guildSettings returns settings of the guild, or nil if they are not configured or could not be loaded.
+func (b *Bot) guildSettings(guildID string) *guildsettings.GuildSettings {
+	if b.guildSettingsRepo == nil {
+		return nil
+	}
+
+	settings, err := b.guildSettingsRepo.SelectGuildSettings(context.Background(), guildID)
+	if err != nil {
+		b.log.With("guild.ID", guildID).Errorf("could not load guild settings: %s", err)
+
+		return nil
+	}
+
+	return settings
+}
+
+// Setup binds the channels and the role used by the bot (owner only).
+// Invoked without options, it reports the current bindings.
+func (b *Bot) Setup(i *discordgo.InteractionCreate) error {
+	if err := b.ensureGuildOwner(i); err != nil {
+		return err
+	}
+
+	options := i.ApplicationCommandData().Options
+	settings, err := b.bindSetup(i.GuildID, options)
+	if err != nil {
+		return err
+	}
+
+	report, err := b.bindingsReport(i.GuildID, settings)
+	if err != nil {
+		return err
+	}
+
+	return b.followup(i, &discordgo.WebhookParams{
+		Content: report,
+		// Do not ping the privileged role when reporting it
+		AllowedMentions: &discordgo.MessageAllowedMentions{},
+	})
+}
+
+// bindSetup binds the channels and the role chosen in the options, returning the settings of the guild.
+// The letter posted in the previous summary channel is removed, as it is not updated anymore.
+func (b *Bot) bindSetup(guildID string, options []*discordgo.ApplicationCommandInteractionDataOption) (*guildsettings.GuildSettings, error) {
+	ctx := context.Background()
+	if len(options) == 0 {
+		settings, err := b.guildSettingsRepo.SelectGuildSettings(ctx, guildID)
+		if err != nil {
+			return nil, fmt.Errorf("could not load guild settings: %w", err)
+		}
+
+		return settings, nil
+	}
+
+	var previousSummaryChannelID string
+	settings, err := b.guildSettingsRepo.UpdateGuildSettings(ctx, guildID, func(settings *guildsettings.GuildSettings) error {
+		previousSummaryChannelID = settings.SummaryChannelID
+		if channelID := idOption(options, "summary-channel"); channelID != "" {
+			settings.BindChannel(discord.SummaryChannel, channelID)
+		}
+		if channelID := idOption(options, "command-channel"); channelID != "" {
+			settings.BindChannel(discord.CommandChannel, channelID)
+		}
+		if roleID := idOption(options, "privileged-role"); roleID != "" {
+			settings.BindRole(discord.PrivilegedRole, roleID)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not save guild settings: %w", err)
+	}
+
+	if previousSummaryChannelID != "" && previousSummaryChannelID != settings.SummaryChannelID {
+		if err := b.removeLetter(guildID, previousSummaryChannelID); err != nil {
+			b.log.With("guild.ID", guildID).Errorf("could not remove the letter from the previous summary channel: %s", err)
+		}
+	}
+	b.refreshGuildLetter(guildID)
+
+	return settings, nil
+}
+
+// bindingsReport describes the channels and the role bound in the guild,
+// pointing out the ones that are not bound or no longer exist.
+func (b *Bot) bindingsReport(guildID string, settings *guildsettings.GuildSettings) (string, error) {
+	channels, err := b.mgr.Gateway.GuildChannels(guildID)
+	if err != nil {
+		return "", fmt.Errorf("error when fetching guild channels: %s", err)
+	}
+	roles, err := b.mgr.Gateway.GuildRoles(guildID)
+	if err != nil {
+		return "", fmt.Errorf("error when fetching guild roles: %s", err)
+	}
+
+	channelIDs := make(map[string]bool, len(channels))
+	for _, ch := range channels {
+		channelIDs[ch.ID] = true
+	}
+	roleIDs := make(map[string]bool, len(roles))
+	for _, r := range roles {
+		roleIDs[r.ID] = true
+	}
+
+	report := strings.Builder{}
+	report.WriteString("Bot setup for this server:\n")
+	report.WriteString(bindingLine("Summary channel", settings.SummaryChannelID, "<#%s>", channelIDs))
+	report.WriteString(bindingLine("Command channel", settings.CommandChannelID, "<#%s>", channelIDs))
+	report.WriteString(bindingLine("Privileged role", settings.PrivilegedRoleID, "<@&%s>", roleIDs))
+
+	return report.String(), nil
+}
+
+func bindingLine(label, id, mentionFormat string, existing map[string]bool) string {
+	switch {
+	case id == "":
+		return fmt.Sprintf("- %s: **not set**\n", label)
+	case !existing[id]:
+		return fmt.Sprintf("- %s: **missing** (it was removed, choose another one)\n", label)
+	default:
+		return fmt.Sprintf("- %s: %s\n", label, fmt.Sprintf(mentionFormat, id))
+	}
+}
+
+// idOption returns ID of the channel, role or user chosen in the option with a given name,
+// or an empty string if it is missing.
+func idOption(options []*discordgo.ApplicationCommandInteractionDataOption, name string) string {
+	for _, opt := range options {
+		if opt.Name == name {
+			if id, ok := opt.Value.(string); ok {
+				return id
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,43 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBindingLine(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	existing := map[string]bool{"channel-id": true}
+
+	// when
+	bound := bindingLine("Summary channel", "channel-id", "<#%s>", existing)
+	missing := bindingLine("Summary channel", "removed-id", "<#%s>", existing)
+	notSet := bindingLine("Summary channel", "", "<#%s>", existing)
+
+	// then
+	assert.Equal("- Summary channel: <#channel-id>\n", bound)
+	assert.Equal("- Summary channel: **missing** (it was removed, choose another one)\n", missing)
+	assert.Equal("- Summary channel: **not set**\n", notSet)
+}
+
+func TestIdOption(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	options := []*discordgo.ApplicationCommandInteractionDataOption{
+		{Name: "summary-channel", Type: discordgo.ApplicationCommandOptionChannel, Value: "channel-id"},
+		{Name: "privileged-role", Type: discordgo.ApplicationCommandOptionRole, Value: "role-id"},
+	}
+
+	// when
+	channelID := idOption(options, "summary-channel")
+	roleID := idOption(options, "privileged-role")
+	missingID := idOption(options, "command-channel")
+
+	// then
+	assert.Equal("channel-id", channelID)
+	assert.Equal("role-id", roleID)
+	assert.Empty(missingID)
+}
@@ -0,0 +1,47 @@
+package bot
+
+import (
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+
+	"spot-assistant/internal/core/dto/spot"
+)
+
+// SpotRequirements sets level and vocation requirements of a respawn (owner only). Respawns are shared
+// by all servers, so the command is registered only along with /import-spots.
+func (b *Bot) SpotRequirements(i *discordgo.InteractionCreate) error {
+	if err := b.ensureGuildOwner(i); err != nil {
+		return err
+	}
+
+	options := i.ApplicationCommandData().Options
+	vocations, err := spot.ParseVocations(stringOption(options, "vocations"))
+	if err != nil {
+		return err
+	}
+
+	s, err := b.requirementsService.SetRequirements(stringOption(options, "respawn"), spot.Requirements{
+		MinLevel:  intOption(options, "min-level"),
+		MaxLevel:  intOption(options, "max-level"),
+		Vocations: vocations,
+	})
+	if err != nil {
+		return err
+	}
+
+	return b.followup(i, &discordgo.WebhookParams{Content: formatSpotRequirements(s)})
+}
+
+// SpotRequirementsAutocomplete suggests respawns to set requirements of.
+func (b *Bot) SpotRequirementsAutocomplete(i *discordgo.InteractionCreate) error {
+	return b.respondWithSpots(i, focusedValue(i.ApplicationCommandData().Options, "respawn"))
+}
+
+func formatSpotRequirements(s *spot.Spot) string {
+	if s.Requirements.IsZero() {
+		return fmt.Sprintf("**%s** has no requirements now.", s.Name)
+	}
+
+	return fmt.Sprintf("Requirements of **%s** set to: %s.", s.Name, s.Requirements)
+}
@@ -0,0 +1,17 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/spot"
+)
+
+func TestFormatSpotRequirements(t *testing.T) {
+	assert.Equal(t, "Requirements of **Flimsy** set to: level 100 to 200, Knight or Paladin.", formatSpotRequirements(&spot.Spot{
+		Name:         "Flimsy",
+		Requirements: spot.Requirements{MinLevel: 100, MaxLevel: 200, Vocations: []string{"Knight", "Paladin"}},
+	}))
+	assert.Equal(t, "**Flimsy** has no requirements now.", formatSpotRequirements(&spot.Spot{Name: "Flimsy"}))
+}
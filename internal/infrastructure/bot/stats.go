@@ -0,0 +1,109 @@
+package bot
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	stringsHelper "spot-assistant/internal/common/strings"
+	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/discord"
+	"spot-assistant/internal/core/dto/guild"
+	"spot-assistant/internal/core/dto/member"
+	"spot-assistant/internal/core/dto/reliability"
+)
+
+// Stats shows how reliably a member hunts on their reservations. Members with the privileged role
+// can see stats of other members, everyone else only their own.
+func (b *Bot) Stats(i *discordgo.InteractionCreate) error {
+	memberID := i.Member.User.ID
+	if target := idOption(i.ApplicationCommandData().Options, "member"); target != "" && target != memberID {
+		gID, err := stringsHelper.StrToInt64(i.GuildID)
+		if err != nil {
+			return err
+		}
+		g, err := b.GetGuild(gID)
+		if err != nil {
+			return err
+		}
+		if !b.MemberHasRole(g, MapMember(i.Member), discord.PrivilegedRole) {
+			return fmt.Errorf("only members with the @%s role can see stats of other members", discord.PrivilegedRole)
+		}
+		memberID = target
+	}
+
+	score, err := b.reliabilityService.Score(i.GuildID, memberID, time.Now())
+	if err != nil {
+		return fmt.Errorf("could not score reliability: %w", err)
+	}
+
+	return b.followup(i, &discordgo.WebhookParams{
+		Content:         formatStats(memberID, score),
+		AllowedMentions: &discordgo.MessageAllowedMentions{},
+	})
+}
+
+// sendOverbookedReliability tells a member with the privileged role, who overbooked others,
+// how reliable the overbooked members are, if the guild wants it.
+func (b *Bot) sendOverbookedReliability(g *guild.Guild, m *member.Member, response book.BookResponse) {
+	if b.reliabilityService == nil || !response.Request.HasPermissions || len(response.ConflictingReservations) == 0 {
+		return
+	}
+	if settings := b.guildSettings(g.ID); settings == nil || !settings.Reliability.OverbookDM {
+		return
+	}
+
+	now := time.Now()
+	scored := make(map[string]struct{})
+	lines := make([]string, 0, len(response.ConflictingReservations))
+	for _, res := range response.ConflictingReservations {
+		memberID := res.Original.AuthorDiscordID
+		if _, ok := scored[memberID]; ok {
+			continue
+		}
+		scored[memberID] = struct{}{}
+
+		score, err := b.reliabilityService.Score(g.ID, memberID, now)
+		if err != nil {
+			b.log.With("guild.ID", g.ID).Errorf("could not score reliability of member %s: %s", memberID, err)
+
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("* <@%s> (%s): %s", memberID, res.Original.Author, formatScore(score)))
+	}
+	if len(lines) == 0 {
+		return
+	}
+
+	message := fmt.Sprintf("Reliability of members you overbooked on **%s**:\n%s", response.Request.Spot, strings.Join(lines, "\n"))
+	if err := b.SendDM(m, message); err != nil {
+		b.log.With("guild.ID", g.ID).Warnf("could not send reliability of overbooked members: %s", err)
+	}
+}
+
+func formatStats(memberID string, score reliability.Score) string {
+	h := score.History
+	var message strings.Builder
+	message.WriteString(fmt.Sprintf("Reliability of <@%s> over the last %d days: %s\n",
+		memberID, int(reliability.Window.Hours()/24), formatScore(score)))
+	message.WriteString(fmt.Sprintf("* Hunts: %d", h.Reservations))
+	if h.Reservations > 0 {
+		message.WriteString(fmt.Sprintf(", attended %d%%", int(math.Round(100*h.Attended/float64(h.Reservations)))))
+	}
+	message.WriteString(fmt.Sprintf("\n* No-shows: %d", h.NoShows))
+	message.WriteString(fmt.Sprintf("\n* Late cancellations: %d", h.LateCancellations))
+	message.WriteString(fmt.Sprintf("\n* Abandoned and overbooked: %d", h.Abandoned))
+
+	return message.String()
+}
+
+func formatScore(score reliability.Score) string {
+	if !score.Known {
+		return fmt.Sprintf("not scored yet, it takes %d reservations", reliability.MinReservations)
+	}
+
+	return fmt.Sprintf("**%d/%d**", score.Value, reliability.MaxScore)
+}
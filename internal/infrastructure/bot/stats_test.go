@@ -0,0 +1,37 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/reliability"
+)
+
+func TestFormatStats(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	score := reliability.NewScore(reliability.History{Reservations: 4, Attended: 3, NoShows: 1, LateCancellations: 1})
+
+	// when
+	message := formatStats("member-id", score)
+
+	// then
+	assert.Equal("Reliability of <@member-id> over the last 30 days: **60/100**\n"+
+		"* Hunts: 4, attended 75%\n"+
+		"* No-shows: 1\n"+
+		"* Late cancellations: 1\n"+
+		"* Abandoned and overbooked: 0", message)
+}
+
+func TestFormatStatsWithoutHistory(t *testing.T) {
+	// given
+	assert := assert.New(t)
+
+	// when
+	message := formatStats("member-id", reliability.NewScore(reliability.History{}))
+
+	// then
+	assert.Contains(message, "not scored yet, it takes 3 reservations")
+	assert.Contains(message, "* Hunts: 0\n")
+}
@@ -0,0 +1,153 @@
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"spot-assistant/internal/core/dto/member"
+	"spot-assistant/internal/core/dto/webhook"
+)
+
+// Limits of deliveries listed by /webhooks deliveries, so the list fits in a message.
+const (
+	DefaultListedDeliveries = 5
+	MaxListedDeliveries     = 10
+	maxListedErrorLength    = 80
+)
+
+// Webhooks manages webhooks receiving booking events of the guild (owner only).
+// URLs may carry credentials, so only their hosts are posted in the guild channel,
+// and signing secrets are sent in a DM. Webhooks, whose secret cannot be sent, are removed again.
+func (b *Bot) Webhooks(i *discordgo.InteractionCreate) error {
+	if err := b.ensureGuildOwner(i); err != nil {
+		return err
+	}
+
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return errors.New("choose what to do with webhooks")
+	}
+
+	subcommand := options[0]
+	var content string
+	switch subcommand.Name {
+	case "add":
+		w, err := b.webhookService.AddWebhook(i.GuildID, stringOption(subcommand.Options, "url"))
+		if err != nil {
+			return err
+		}
+		if err = b.sendWebhookSecret(i.Member.User.ID, w); err != nil {
+			// Without its secret, the webhook cannot verify events, so it is not kept.
+			if removeErr := b.webhookService.RemoveWebhook(i.GuildID, w.ID); removeErr != nil {
+				b.log.Errorf("could not remove webhook %d of guild %s: %s", w.ID, i.GuildID, removeErr)
+			}
+
+			return fmt.Errorf("%w, so the webhook was not added; allow DMs from this server and try again", err)
+		}
+		content = fmt.Sprintf("Webhook **#%d** to `%s` added, check your DM for its signing secret.", w.ID, webhookHost(w.URL))
+	case "remove":
+		id := int64(intOption(subcommand.Options, "id"))
+		if err := b.webhookService.RemoveWebhook(i.GuildID, id); err != nil {
+			return err
+		}
+		content = fmt.Sprintf("Webhook **#%d** removed.", id)
+	case "list":
+		webhooks, err := b.webhookService.ListWebhooks(i.GuildID)
+		if err != nil {
+			return fmt.Errorf("could not load webhooks: %w", err)
+		}
+		content = formatWebhooks(webhooks)
+	case "deliveries":
+		count := DefaultListedDeliveries
+		if hasOption(subcommand.Options, "count") {
+			count = min(max(intOption(subcommand.Options, "count"), 1), MaxListedDeliveries)
+		}
+		deliveries, err := b.webhookService.RecentDeliveries(i.GuildID, count)
+		if err != nil {
+			return fmt.Errorf("could not load webhook deliveries: %w", err)
+		}
+		content = formatDeliveries(deliveries)
+	default:
+		return fmt.Errorf("unknown webhooks command: %s", subcommand.Name)
+	}
+
+	return b.followup(i, &discordgo.WebhookParams{Content: content})
+}
+
+func (b *Bot) sendWebhookSecret(memberID string, w *webhook.Webhook) error {
+	dm, err := b.OpenDM(&member.Member{ID: memberID})
+	if err != nil {
+		return fmt.Errorf("could not open a DM: %w", err)
+	}
+
+	_, err = b.mgr.SessionForDM().ChannelMessageSend(dm.ID, formatWebhookSecret(w))
+	if err != nil {
+		return fmt.Errorf("could not send the webhook secret: %w", err)
+	}
+
+	return nil
+}
+
+func formatWebhookSecret(w *webhook.Webhook) string {
+	return fmt.Sprintf("Webhook **#%d** to <%s> will receive booking events.\n"+
+		"Every request carries the `%s` header: `sha256=` followed by a hex encoded HMAC-SHA256 of the `%s` header, a dot and the body, keyed with this secret:\n"+
+		"||`%s`||\nReject requests with timestamps older than %d minutes, as they may be replayed. Keep the secret to yourself, anyone with it can forge events.",
+		w.ID, w.URL, webhook.HeaderSignature, webhook.HeaderTimestamp, w.Secret, int(webhook.SignatureTolerance.Minutes()))
+}
+
+func formatWebhooks(webhooks []*webhook.Webhook) string {
+	if len(webhooks) == 0 {
+		return "There are no webhooks yet, add one with `/webhooks add`."
+	}
+
+	var message strings.Builder
+	message.WriteString("Webhooks of this server:")
+	for _, w := range webhooks {
+		message.WriteString(fmt.Sprintf("\n* **#%d** `%s`, added <t:%d:R>", w.ID, webhookHost(w.URL), w.CreatedAt.Unix()))
+	}
+
+	return message.String()
+}
+
+func formatDeliveries(deliveries []*webhook.Delivery) string {
+	if len(deliveries) == 0 {
+		return "No events were sent to webhooks yet."
+	}
+
+	var message strings.Builder
+	message.WriteString("Recent deliveries:")
+	for _, d := range deliveries {
+		message.WriteString(fmt.Sprintf("\n* `%s` to **#%d** `%s` <t:%d:R>: ", d.Event, d.WebhookID, webhookHost(d.URL), d.CreatedAt.Unix()))
+		switch d.Status {
+		case webhook.DeliveryDelivered:
+			message.WriteString(fmt.Sprintf("**delivered** (HTTP %d)", d.ResponseStatus))
+		case webhook.DeliveryDead:
+			message.WriteString(fmt.Sprintf("**failed** after %d attempts", d.Attempts))
+		default:
+			message.WriteString("**pending**")
+			if d.Attempts > 0 {
+				message.WriteString(fmt.Sprintf(", attempt %d failed, retrying <t:%d:R>", d.Attempts, d.NextAttemptAt.Unix()))
+			}
+		}
+		if d.Status != webhook.DeliveryDelivered && d.LastError != "" {
+			message.WriteString(fmt.Sprintf(" - %s", truncate(d.LastError, maxListedErrorLength)))
+		}
+	}
+
+	return message.String()
+}
+
+// webhookHost returns the host of a webhook URL, leaving out paths and queries,
+// which often carry tokens.
+func webhookHost(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return "invalid URL"
+	}
+
+	return u.Host
+}
@@ -0,0 +1,69 @@
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/webhook"
+)
+
+func TestFormatWebhooks(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	createdAt := time.Unix(1609502400, 0)
+
+	// when
+	messages := []string{
+		formatWebhooks(nil),
+		formatWebhooks([]*webhook.Webhook{
+			{ID: 1, URL: "https://example.com/hooks/secret-token", CreatedAt: createdAt},
+		}),
+	}
+
+	// then
+	assert.Equal([]string{
+		"There are no webhooks yet, add one with `/webhooks add`.",
+		"Webhooks of this server:\n* **#1** `example.com`, added <t:1609502400:R>",
+	}, messages)
+}
+
+func TestFormatDeliveries(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	createdAt := time.Unix(1609502400, 0)
+	nextAttemptAt := time.Unix(1609502640, 0)
+	deliveries := []*webhook.Delivery{
+		{WebhookID: 1, URL: "https://example.com/hook", Event: webhook.EventReservationCreated, Status: webhook.DeliveryDelivered, Attempts: 1, ResponseStatus: 204, CreatedAt: createdAt},
+		{WebhookID: 1, URL: "https://example.com/hook", Event: webhook.EventReservationClipped, Status: webhook.DeliveryPending, CreatedAt: createdAt},
+		{WebhookID: 2, URL: "https://other.example.com", Event: webhook.EventReservationCancelled, Status: webhook.DeliveryPending, Attempts: 3, NextAttemptAt: nextAttemptAt, LastError: "unexpected HTTP status: 503", CreatedAt: createdAt},
+		{WebhookID: 2, URL: "https://other.example.com", Event: webhook.EventReservationOverbooked, Status: webhook.DeliveryDead, Attempts: 8, LastError: "connection refused", CreatedAt: createdAt},
+	}
+
+	// when
+	message := formatDeliveries(deliveries)
+
+	// then
+	assert.Equal("Recent deliveries:"+
+		"\n* `reservation.created` to **#1** `example.com` <t:1609502400:R>: **delivered** (HTTP 204)"+
+		"\n* `reservation.clipped` to **#1** `example.com` <t:1609502400:R>: **pending**"+
+		"\n* `reservation.cancelled` to **#2** `other.example.com` <t:1609502400:R>: **pending**, attempt 3 failed, retrying <t:1609502640:R> - unexpected HTTP status: 503"+
+		"\n* `reservation.overbooked` to **#2** `other.example.com` <t:1609502400:R>: **failed** after 8 attempts - connection refused",
+		message)
+	assert.Equal("No events were sent to webhooks yet.", formatDeliveries(nil))
+}
+
+func TestFormatWebhookSecret(t *testing.T) {
+	// given
+	assert := assert.New(t)
+
+	// when
+	message := formatWebhookSecret(&webhook.Webhook{ID: 1, URL: "https://example.com/hook", Secret: "abc"})
+
+	// then
+	assert.Contains(message, "<https://example.com/hook>")
+	assert.Contains(message, "`X-Spot-Assistant-Signature`")
+	assert.Contains(message, "`X-Spot-Assistant-Timestamp`")
+	assert.Contains(message, "||`abc`||")
+}
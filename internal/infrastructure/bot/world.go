@@ -0,0 +1,152 @@
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"spot-assistant/internal/common/collections"
+	"spot-assistant/internal/core/dto/guildsworld"
+	"spot-assistant/internal/core/worlds"
+)
+
+// Worlds manages Tibia worlds members of the guild play on. Online status of members is checked on these worlds.
+func (b *Bot) Worlds(i *discordgo.InteractionCreate) error {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return errors.New("choose what to do with worlds of this server")
+	}
+
+	subcommand := options[0]
+	var content string
+	switch subcommand.Name {
+	case "add":
+		if err := b.ensureGuildOwner(i); err != nil {
+			return err
+		}
+		world, err := findWorld(b.tibiaWorlds(), stringOption(subcommand.Options, "world"))
+		if err != nil {
+			return err
+		}
+		if err := b.onlineCheckService.AddGuildWorld(i.GuildID, world); err != nil {
+			return err
+		}
+		content = fmt.Sprintf("Tibia world **%s** added to this server.", world)
+	case "remove":
+		if err := b.ensureGuildOwner(i); err != nil {
+			return err
+		}
+		world, err := findWorld(b.onlineCheckService.GuildWorlds(i.GuildID), stringOption(subcommand.Options, "world"))
+		if errors.Is(err, errUnknownWorld) {
+			return guildsworld.ErrWorldNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if err := b.onlineCheckService.RemoveGuildWorld(i.GuildID, world); err != nil {
+			return err
+		}
+		content = fmt.Sprintf("Tibia world **%s** removed from this server.", world)
+	case "list":
+		content = formatWorlds(b.onlineCheckService.GuildWorlds(i.GuildID))
+	default:
+		return fmt.Errorf("unknown world command: %s", subcommand.Name)
+	}
+
+	return b.followup(i, &discordgo.WebhookParams{Content: content})
+}
+
+// WorldAutocomplete suggests all Tibia worlds to add, and worlds of the guild otherwise.
+func (b *Bot) WorldAutocomplete(i *discordgo.InteractionCreate) error {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return nil
+	}
+
+	candidates := b.onlineCheckService.GuildWorlds(i.GuildID)
+	if options[0].Name == "add" {
+		candidates = b.tibiaWorlds()
+	}
+
+	return b.respondWithWorlds(i, candidates, focusedValue(options[0].Options, "world"))
+}
+
+// CharacterAutocomplete suggests worlds of the guild, which the character plays on.
+func (b *Bot) CharacterAutocomplete(i *discordgo.InteractionCreate) error {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return nil
+	}
+
+	return b.respondWithWorlds(i, b.onlineCheckService.GuildWorlds(i.GuildID), focusedValue(options[0].Options, "world"))
+}
+
+// tibiaWorlds returns names of all Tibia worlds.
+func (b *Bot) tibiaWorlds() []string {
+	if b.worldListService == nil {
+		return worlds.Worlds
+	}
+
+	return b.worldListService.Worlds()
+}
+
+func (b *Bot) respondWithWorlds(i *discordgo.InteractionCreate, candidates []string, filter string) error {
+	var filtered []*discordgo.ApplicationCommandOptionChoice
+	for _, w := range candidates {
+		if filter == "" || strings.Contains(strings.ToLower(w), strings.ToLower(filter)) {
+			filtered = append(filtered, &discordgo.ApplicationCommandOptionChoice{Name: w, Value: w})
+		}
+		if len(filtered) >= 25 { // Discord max choices
+			break
+		}
+	}
+
+	return b.interactionRespond(i, &discordgo.InteractionResponseData{
+		Choices: filtered,
+	}, discordgo.InteractionApplicationCommandAutocompleteResult)
+}
+
+func focusedValue(options []*discordgo.ApplicationCommandInteractionDataOption, name string) string {
+	for _, opt := range options {
+		if opt.Focused && opt.Name == name {
+			return opt.StringValue()
+		}
+	}
+
+	return ""
+}
+
+var errUnknownWorld = errors.New("please select a valid Tibia world")
+
+// findWorld returns the world named like the given one, as it is spelled in the list.
+func findWorld(candidates []string, world string) (string, error) {
+	world = strings.TrimSpace(world)
+	if world == "" {
+		return "", fmt.Errorf("world name is required")
+	}
+
+	existingWorld, idx := collections.PoorMansFind(candidates, func(w string) bool {
+		return strings.EqualFold(w, world)
+	})
+	if idx == -1 {
+		return "", fmt.Errorf("%w: %s", errUnknownWorld, world)
+	}
+
+	return existingWorld, nil
+}
+
+func formatWorlds(guildWorlds []string) string {
+	if len(guildWorlds) == 0 {
+		return "This server has no Tibia worlds yet. The owner can add one with `/world add`."
+	}
+
+	var message strings.Builder
+	message.WriteString("Tibia worlds of this server:")
+	for _, w := range guildWorlds {
+		message.WriteString(fmt.Sprintf("\n* **%s**", w))
+	}
+
+	return message.String()
+}
@@ -0,0 +1,37 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindWorld(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	candidates := []string{"Celesta", "Secura"}
+
+	// when
+	found, foundErr := findWorld(candidates, " secura ")
+	_, unknownErr := findWorld(candidates, "Antica")
+	_, emptyErr := findWorld(candidates, "")
+
+	// then
+	assert.NoError(foundErr)
+	assert.Equal("Secura", found)
+	assert.ErrorIs(unknownErr, errUnknownWorld)
+	assert.Error(emptyErr)
+}
+
+func TestFormatWorlds(t *testing.T) {
+	// given
+	assert := assert.New(t)
+
+	// when
+	empty := formatWorlds(nil)
+	message := formatWorlds([]string{"Celesta", "Secura"})
+
+	// then
+	assert.Contains(empty, "/world add")
+	assert.Equal("Tibia worlds of this server:\n* **Celesta**\n* **Secura**", message)
+}
@@ -0,0 +1,13 @@
+-- name: SelectCalendarFeedNonce :one
+SELECT nonce
+FROM calendar_feed
+WHERE guild_id = @guild_id
+  AND member_id = @member_id
+LIMIT 1;
+
+-- name: UpsertCalendarFeedNonce :exec
+INSERT INTO calendar_feed (guild_id, member_id, nonce, created_at, updated_at)
+VALUES (@guild_id, @member_id, @nonce, now(), now())
+ON CONFLICT (guild_id, member_id)
+DO UPDATE SET nonce = EXCLUDED.nonce,
+              updated_at = now();
@@ -0,0 +1,41 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type CalendarFeedRepository struct {
+	q *Queries
+}
+
+func NewCalendarFeedRepository(db DBTX) *CalendarFeedRepository {
+	return &CalendarFeedRepository{
+		q: New(db),
+	}
+}
+
+// SelectCalendarFeedNonce returns the nonce of a calendar feed, or an empty string if the feed was never issued.
+// An empty member ID stands for the feed of the whole guild.
+func (repo *CalendarFeedRepository) SelectCalendarFeedNonce(ctx context.Context, guildID, memberID string) (string, error) {
+	nonce, err := repo.q.SelectCalendarFeedNonce(ctx, SelectCalendarFeedNonceParams{
+		GuildID:  guildID,
+		MemberID: memberID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+
+	return nonce, err
+}
+
+// UpsertCalendarFeedNonce saves the nonce of a calendar feed, invalidating links signed with the previous one.
+func (repo *CalendarFeedRepository) UpsertCalendarFeedNonce(ctx context.Context, guildID, memberID, nonce string) error {
+	return repo.q.UpsertCalendarFeedNonce(ctx, UpsertCalendarFeedNonceParams{
+		GuildID:  guildID,
+		MemberID: memberID,
+		Nonce:    nonce,
+	})
+}
@@ -0,0 +1,49 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: calendarfeed.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const selectCalendarFeedNonce = `-- name: SelectCalendarFeedNonce :one
+SELECT nonce
+FROM calendar_feed
+WHERE guild_id = $1
+  AND member_id = $2
+LIMIT 1
+`
+
+type SelectCalendarFeedNonceParams struct {
+	GuildID  string
+	MemberID string
+}
+
+func (q *Queries) SelectCalendarFeedNonce(ctx context.Context, arg SelectCalendarFeedNonceParams) (string, error) {
+	row := q.db.QueryRow(ctx, selectCalendarFeedNonce, arg.GuildID, arg.MemberID)
+	var nonce string
+	err := row.Scan(&nonce)
+	return nonce, err
+}
+
+const upsertCalendarFeedNonce = `-- name: UpsertCalendarFeedNonce :exec
+INSERT INTO calendar_feed (guild_id, member_id, nonce, created_at, updated_at)
+VALUES ($1, $2, $3, now(), now())
+ON CONFLICT (guild_id, member_id)
+DO UPDATE SET nonce = EXCLUDED.nonce,
+              updated_at = now()
+`
+
+type UpsertCalendarFeedNonceParams struct {
+	GuildID  string
+	MemberID string
+	Nonce    string
+}
+
+func (q *Queries) UpsertCalendarFeedNonce(ctx context.Context, arg UpsertCalendarFeedNonceParams) error {
+	_, err := q.db.Exec(ctx, upsertCalendarFeedNonce, arg.GuildID, arg.MemberID, arg.Nonce)
+	return err
+}
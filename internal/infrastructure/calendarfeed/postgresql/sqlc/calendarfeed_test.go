@@ -0,0 +1,69 @@
+package sqlc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectCalendarFeedNonce(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	mock.ExpectQuery("SELECT nonce FROM calendar_feed").
+		WithArgs("guild-id", "member-id").
+		WillReturnRows(pgxmock.NewRows([]string{"nonce"}).AddRow("nonce"))
+	repo := NewCalendarFeedRepository(mock)
+
+	// when
+	nonce, err := repo.SelectCalendarFeedNonce(context.Background(), "guild-id", "member-id")
+
+	// then
+	assert.NoError(err)
+	assert.Equal("nonce", nonce)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestSelectCalendarFeedNonceNeverIssued(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	mock.ExpectQuery("SELECT nonce FROM calendar_feed").
+		WithArgs("guild-id", "").
+		WillReturnError(pgx.ErrNoRows)
+	repo := NewCalendarFeedRepository(mock)
+
+	// when
+	nonce, err := repo.SelectCalendarFeedNonce(context.Background(), "guild-id", "")
+
+	// then
+	assert.NoError(err)
+	assert.Empty(nonce)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestUpsertCalendarFeedNonce(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	mock.ExpectExec("INSERT INTO calendar_feed").
+		WithArgs("guild-id", "member-id", "rotated").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	repo := NewCalendarFeedRepository(mock)
+
+	// when
+	err = repo.UpsertCalendarFeedNonce(context.Background(), "guild-id", "member-id", "rotated")
+
+	// then
+	assert.NoError(err)
+	assert.NoError(mock.ExpectationsWereMet())
+}
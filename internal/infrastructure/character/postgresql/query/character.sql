@@ -0,0 +1,40 @@
+-- name: InsertCharacter :one
+INSERT INTO member_character (guild_id, member_id, name, world_name, verification_code, created_at)
+SELECT @guild_id::text, @member_id::text, @name::text, @world_name::text, @verification_code::text, now()
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM member_character
+    WHERE guild_id = @guild_id::text AND lower(name) = lower(@name::text) AND verified_at IS NOT NULL
+)
+ON CONFLICT DO NOTHING
+RETURNING id, guild_id, member_id, name, verification_code, verified_at, created_at, world_name;
+
+-- name: DeleteCharacter :execrows
+DELETE FROM member_character
+WHERE guild_id = @guild_id AND member_id = @member_id AND lower(name) = lower(@name::text);
+
+-- name: SelectMemberCharacters :many
+SELECT id, guild_id, member_id, name, verification_code, verified_at, created_at, world_name
+FROM member_character
+WHERE guild_id = @guild_id AND member_id = @member_id
+ORDER BY id;
+
+-- name: SelectGuildCharacters :many
+SELECT id, guild_id, member_id, name, verification_code, verified_at, created_at, world_name
+FROM member_character
+WHERE guild_id = @guild_id
+ORDER BY id;
+
+-- name: DeleteUnverifiedClaims :exec
+DELETE FROM member_character
+WHERE guild_id = @guild_id AND member_id <> @member_id AND lower(name) = lower(@name::text) AND verified_at IS NULL;
+
+-- name: MarkCharacterVerified :execrows
+UPDATE member_character
+SET verified_at = @verified_at, world_name = @world_name
+WHERE guild_id = @guild_id AND member_id = @member_id AND lower(name) = lower(@name::text);
+
+-- name: ClearCharacterWorld :exec
+UPDATE member_character
+SET world_name = ''
+WHERE guild_id = @guild_id AND lower(world_name) = lower(@world_name::text);
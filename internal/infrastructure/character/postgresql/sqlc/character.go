@@ -0,0 +1,161 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	commonErrors "spot-assistant/internal/common/errors"
+	"spot-assistant/internal/core/dto/character"
+)
+
+// uniqueViolation is the Postgres error code of a unique index violation.
+const uniqueViolation = "23505"
+
+type DBTXWrapper interface {
+	DBTX
+
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+type CharacterRepository struct {
+	q  *Queries
+	db DBTXWrapper
+}
+
+func NewCharacterRepository(db DBTXWrapper) *CharacterRepository {
+	return &CharacterRepository{
+		q:  New(db),
+		db: db,
+	}
+}
+
+// InsertCharacter registers a character of a member. Returns character.ErrCharacterTaken
+// if the member already registered the character, or another member verified it.
+// Unverified characters do not stop other members from registering them.
+func (repo *CharacterRepository) InsertCharacter(ctx context.Context, c *character.Character) (*character.Character, error) {
+	row, err := repo.q.InsertCharacter(ctx, InsertCharacterParams{
+		GuildID:          c.GuildID,
+		MemberID:         c.MemberID,
+		Name:             c.Name,
+		WorldName:        c.World,
+		VerificationCode: c.VerificationCode,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, character.ErrCharacterTaken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toCharacter(row), nil
+}
+
+// DeleteCharacter removes a character of a member. Returns false if the member has no such character.
+func (repo *CharacterRepository) DeleteCharacter(ctx context.Context, guildID, memberID, name string) (bool, error) {
+	deleted, err := repo.q.DeleteCharacter(ctx, DeleteCharacterParams{
+		GuildID:  guildID,
+		MemberID: memberID,
+		Name:     name,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return deleted > 0, nil
+}
+
+// SelectMemberCharacters returns characters of a member, in the order they were registered.
+func (repo *CharacterRepository) SelectMemberCharacters(ctx context.Context, guildID, memberID string) ([]*character.Character, error) {
+	rows, err := repo.q.SelectMemberCharacters(ctx, SelectMemberCharactersParams{
+		GuildID:  guildID,
+		MemberID: memberID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toCharacters(rows), nil
+}
+
+// SelectGuildCharacters returns characters of all members of a guild.
+func (repo *CharacterRepository) SelectGuildCharacters(ctx context.Context, guildID string) ([]*character.Character, error) {
+	rows, err := repo.q.SelectGuildCharacters(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCharacters(rows), nil
+}
+
+// MarkCharacterVerified marks a character of a member as verified, on the world found in its profile,
+// taking it over from other members, who registered it without verifying. Returns false if the member
+// has no such character, or character.ErrCharacterTaken if another member verified it in the meantime.
+func (repo *CharacterRepository) MarkCharacterVerified(ctx context.Context, guildID, memberID, name, world string, at time.Time) (bool, error) {
+	tx, err := repo.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer commonErrors.ExecuteAndIgnoreErrorF(tx.Rollback, ctx)
+	qtx := repo.q.WithTx(tx)
+
+	err = qtx.DeleteUnverifiedClaims(ctx, DeleteUnverifiedClaimsParams{
+		GuildID:  guildID,
+		MemberID: memberID,
+		Name:     name,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := qtx.MarkCharacterVerified(ctx, MarkCharacterVerifiedParams{
+		VerifiedAt: pgtype.Timestamptz{Time: at, Valid: true},
+		WorldName:  world,
+		GuildID:    guildID,
+		MemberID:   memberID,
+		Name:       name,
+	})
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return false, character.ErrCharacterTaken
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return updated > 0, tx.Commit(ctx)
+}
+
+// ClearCharacterWorld unbinds characters of a guild from a world, which the guild no longer plays on.
+func (repo *CharacterRepository) ClearCharacterWorld(ctx context.Context, guildID, world string) error {
+	return repo.q.ClearCharacterWorld(ctx, ClearCharacterWorldParams{
+		GuildID:   guildID,
+		WorldName: world,
+	})
+}
+
+func toCharacters(rows []MemberCharacter) []*character.Character {
+	characters := make([]*character.Character, len(rows))
+	for i, row := range rows {
+		characters[i] = toCharacter(row)
+	}
+
+	return characters
+}
+
+func toCharacter(row MemberCharacter) *character.Character {
+	return &character.Character{
+		ID:               row.ID,
+		GuildID:          row.GuildID,
+		MemberID:         row.MemberID,
+		Name:             row.Name,
+		World:            row.WorldName,
+		VerificationCode: row.VerificationCode,
+		VerifiedAt:       row.VerifiedAt.Time,
+		CreatedAt:        row.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,210 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: character.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const clearCharacterWorld = `-- name: ClearCharacterWorld :exec
+UPDATE member_character
+SET world_name = ''
+WHERE guild_id = $1 AND lower(world_name) = lower($2::text)
+`
+
+type ClearCharacterWorldParams struct {
+	GuildID   string
+	WorldName string
+}
+
+func (q *Queries) ClearCharacterWorld(ctx context.Context, arg ClearCharacterWorldParams) error {
+	_, err := q.db.Exec(ctx, clearCharacterWorld, arg.GuildID, arg.WorldName)
+	return err
+}
+
+const deleteCharacter = `-- name: DeleteCharacter :execrows
+DELETE FROM member_character
+WHERE guild_id = $1 AND member_id = $2 AND lower(name) = lower($3::text)
+`
+
+type DeleteCharacterParams struct {
+	GuildID  string
+	MemberID string
+	Name     string
+}
+
+func (q *Queries) DeleteCharacter(ctx context.Context, arg DeleteCharacterParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCharacter, arg.GuildID, arg.MemberID, arg.Name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUnverifiedClaims = `-- name: DeleteUnverifiedClaims :exec
+DELETE FROM member_character
+WHERE guild_id = $1 AND member_id <> $2 AND lower(name) = lower($3::text) AND verified_at IS NULL
+`
+
+type DeleteUnverifiedClaimsParams struct {
+	GuildID  string
+	MemberID string
+	Name     string
+}
+
+func (q *Queries) DeleteUnverifiedClaims(ctx context.Context, arg DeleteUnverifiedClaimsParams) error {
+	_, err := q.db.Exec(ctx, deleteUnverifiedClaims, arg.GuildID, arg.MemberID, arg.Name)
+	return err
+}
+
+const insertCharacter = `-- name: InsertCharacter :one
+INSERT INTO member_character (guild_id, member_id, name, world_name, verification_code, created_at)
+SELECT $1::text, $2::text, $3::text, $4::text, $5::text, now()
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM member_character
+    WHERE guild_id = $1::text AND lower(name) = lower($3::text) AND verified_at IS NOT NULL
+)
+ON CONFLICT DO NOTHING
+RETURNING id, guild_id, member_id, name, verification_code, verified_at, created_at, world_name
+`
+
+type InsertCharacterParams struct {
+	GuildID          string
+	MemberID         string
+	Name             string
+	WorldName        string
+	VerificationCode string
+}
+
+func (q *Queries) InsertCharacter(ctx context.Context, arg InsertCharacterParams) (MemberCharacter, error) {
+	row := q.db.QueryRow(ctx, insertCharacter,
+		arg.GuildID,
+		arg.MemberID,
+		arg.Name,
+		arg.WorldName,
+		arg.VerificationCode,
+	)
+	var i MemberCharacter
+	err := row.Scan(
+		&i.ID,
+		&i.GuildID,
+		&i.MemberID,
+		&i.Name,
+		&i.VerificationCode,
+		&i.VerifiedAt,
+		&i.CreatedAt,
+		&i.WorldName,
+	)
+	return i, err
+}
+
+const markCharacterVerified = `-- name: MarkCharacterVerified :execrows
+UPDATE member_character
+SET verified_at = $1, world_name = $2
+WHERE guild_id = $3 AND member_id = $4 AND lower(name) = lower($5::text)
+`
+
+type MarkCharacterVerifiedParams struct {
+	VerifiedAt pgtype.Timestamptz
+	WorldName  string
+	GuildID    string
+	MemberID   string
+	Name       string
+}
+
+func (q *Queries) MarkCharacterVerified(ctx context.Context, arg MarkCharacterVerifiedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markCharacterVerified,
+		arg.VerifiedAt,
+		arg.WorldName,
+		arg.GuildID,
+		arg.MemberID,
+		arg.Name,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const selectGuildCharacters = `-- name: SelectGuildCharacters :many
+SELECT id, guild_id, member_id, name, verification_code, verified_at, created_at, world_name
+FROM member_character
+WHERE guild_id = $1
+ORDER BY id
+`
+
+func (q *Queries) SelectGuildCharacters(ctx context.Context, guildID string) ([]MemberCharacter, error) {
+	rows, err := q.db.Query(ctx, selectGuildCharacters, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MemberCharacter
+	for rows.Next() {
+		var i MemberCharacter
+		if err := rows.Scan(
+			&i.ID,
+			&i.GuildID,
+			&i.MemberID,
+			&i.Name,
+			&i.VerificationCode,
+			&i.VerifiedAt,
+			&i.CreatedAt,
+			&i.WorldName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectMemberCharacters = `-- name: SelectMemberCharacters :many
+SELECT id, guild_id, member_id, name, verification_code, verified_at, created_at, world_name
+FROM member_character
+WHERE guild_id = $1 AND member_id = $2
+ORDER BY id
+`
+
+type SelectMemberCharactersParams struct {
+	GuildID  string
+	MemberID string
+}
+
+func (q *Queries) SelectMemberCharacters(ctx context.Context, arg SelectMemberCharactersParams) ([]MemberCharacter, error) {
+	rows, err := q.db.Query(ctx, selectMemberCharacters, arg.GuildID, arg.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MemberCharacter
+	for rows.Next() {
+		var i MemberCharacter
+		if err := rows.Scan(
+			&i.ID,
+			&i.GuildID,
+			&i.MemberID,
+			&i.Name,
+			&i.VerificationCode,
+			&i.VerifiedAt,
+			&i.CreatedAt,
+			&i.WorldName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
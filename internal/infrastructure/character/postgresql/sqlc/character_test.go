@@ -0,0 +1,164 @@
+package sqlc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/character"
+)
+
+var characterColumns = []string{"id", "guild_id", "member_id", "name", "verification_code", "verified_at", "created_at", "world_name"}
+
+func TestInsertCharacter(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	createdAt := pgtype.Timestamptz{Time: time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC), Valid: true}
+	mock.ExpectQuery("INSERT INTO member_character").
+		WithArgs("guild-id", "member-id", "Mariysz", "Celesta", "code").
+		WillReturnRows(pgxmock.NewRows(characterColumns).
+			AddRow(int64(1), "guild-id", "member-id", "Mariysz", "code", pgtype.Timestamptz{}, createdAt, "Celesta"))
+	mock.ExpectQuery("INSERT INTO member_character").
+		WithArgs("guild-id", "other-member-id", "Mariysz", "", "other-code").
+		WillReturnError(pgx.ErrNoRows)
+	repo := NewCharacterRepository(mock)
+
+	// when
+	inserted, insertedErr := repo.InsertCharacter(context.Background(), &character.Character{
+		GuildID: "guild-id", MemberID: "member-id", Name: "Mariysz", World: "Celesta", VerificationCode: "code",
+	})
+	_, takenErr := repo.InsertCharacter(context.Background(), &character.Character{
+		GuildID: "guild-id", MemberID: "other-member-id", Name: "Mariysz", VerificationCode: "other-code",
+	})
+
+	// then
+	assert.NoError(insertedErr)
+	assert.Equal(&character.Character{
+		ID:               1,
+		GuildID:          "guild-id",
+		MemberID:         "member-id",
+		Name:             "Mariysz",
+		World:            "Celesta",
+		VerificationCode: "code",
+		CreatedAt:        createdAt.Time,
+	}, inserted)
+	assert.False(inserted.IsVerified())
+	assert.ErrorIs(takenErr, character.ErrCharacterTaken)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestSelectGuildCharacters(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	verifiedAt := pgtype.Timestamptz{Time: time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC), Valid: true}
+	mock.ExpectQuery("SELECT (.+) FROM member_character").
+		WithArgs("guild-id").
+		WillReturnRows(pgxmock.NewRows(characterColumns).
+			AddRow(int64(1), "guild-id", "member-id", "Mariysz", "code", verifiedAt, verifiedAt, ""))
+	repo := NewCharacterRepository(mock)
+
+	// when
+	characters, err := repo.SelectGuildCharacters(context.Background(), "guild-id")
+
+	// then
+	assert.NoError(err)
+	assert.Len(characters, 1)
+	assert.True(characters[0].IsVerified())
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDeleteAndVerifyCharacter(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM member_character").
+		WithArgs("guild-id", "member-id", "Mariysz").
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectExec("UPDATE member_character").
+		WithArgs(mocks.NewPgTimestamptzTime(now), "Celesta", "guild-id", "member-id", "Mariysz").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectCommit()
+	mock.ExpectExec("DELETE FROM member_character").
+		WithArgs("guild-id", "member-id", "Mariysz").
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectExec("DELETE FROM member_character").
+		WithArgs("guild-id", "member-id", "Unknown").
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	repo := NewCharacterRepository(mock)
+
+	// when
+	verified, verifiedErr := repo.MarkCharacterVerified(context.Background(), "guild-id", "member-id", "Mariysz", "Celesta", now)
+	deleted, deletedErr := repo.DeleteCharacter(context.Background(), "guild-id", "member-id", "Mariysz")
+	missing, missingErr := repo.DeleteCharacter(context.Background(), "guild-id", "member-id", "Unknown")
+
+	// then
+	assert.NoError(verifiedErr)
+	assert.True(verified)
+	assert.NoError(deletedErr)
+	assert.True(deleted)
+	assert.NoError(missingErr)
+	assert.False(missing)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestMarkCharacterVerifiedTaken(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM member_character").
+		WithArgs("guild-id", "member-id", "Mariysz").
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	mock.ExpectExec("UPDATE member_character").
+		WithArgs(mocks.NewPgTimestamptzTime(now), "Celesta", "guild-id", "member-id", "Mariysz").
+		WillReturnError(&pgconn.PgError{Code: uniqueViolation})
+	mock.ExpectRollback()
+	repo := NewCharacterRepository(mock)
+
+	// when
+	verified, err := repo.MarkCharacterVerified(context.Background(), "guild-id", "member-id", "Mariysz", "Celesta", now)
+
+	// then
+	assert.ErrorIs(err, character.ErrCharacterTaken)
+	assert.False(verified)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestClearCharacterWorld(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	mock.ExpectExec("UPDATE member_character").
+		WithArgs("guild-id", "Celesta").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+	repo := NewCharacterRepository(mock)
+
+	// when
+	err = repo.ClearCharacterWorld(context.Background(), "guild-id", "Celesta")
+
+	// then
+	assert.NoError(err)
+	assert.NoError(mock.ExpectationsWereMet())
+}
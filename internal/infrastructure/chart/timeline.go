@@ -0,0 +1,185 @@
+package chart
+
+import (
+	"time"
+
+	"github.com/vicanso/go-charts/v2"
+
+	"spot-assistant/internal/core/dto/summary"
+)
+
+// Dimensions of the timeline chart, in pixels.
+const (
+	timelineWidth        = 900
+	timelinePadding      = 20
+	timelineTitleHeight  = 40
+	timelineAxisHeight   = 24
+	timelineLabelWidth   = 190
+	timelineRowHeight    = 28
+	timelineBarMargin    = 6
+	timelineMaxSpotChars = 26
+)
+
+var (
+	timelineOnlineColor     = charts.Color{R: 67, G: 181, B: 129, A: 255}
+	timelineOfflineColor    = charts.Color{R: 240, G: 71, B: 71, A: 255}
+	timelineUnknownColor    = charts.Color{R: 148, G: 155, B: 164, A: 255}
+	timelineNowColor        = charts.Color{R: 250, G: 200, B: 88, A: 255}
+	timelineServerSaveColor = charts.Color{R: 88, G: 101, B: 242, A: 255}
+)
+
+// timelineCanvas maps time onto the plot area of the timeline chart.
+type timelineCanvas struct {
+	painter *charts.Painter
+	theme   charts.ColorPalette
+	from    time.Time
+	to      time.Time
+	left    int
+	right   int
+	top     int
+	bottom  int
+}
+
+func (c *timelineCanvas) x(t time.Time) int {
+	if t.Before(c.from) {
+		t = c.from
+	}
+	if t.After(c.to) {
+		t = c.to
+	}
+
+	ratio := float64(t.Sub(c.from)) / float64(c.to.Sub(c.from))
+
+	return c.left + int(ratio*float64(c.right-c.left))
+}
+
+func (c *timelineCanvas) contains(t time.Time) bool {
+	return !t.Before(c.from) && !t.After(c.to)
+}
+
+func (c *timelineCanvas) verticalLine(t time.Time, color charts.Color, width float64) {
+	x := c.x(t)
+	c.painter.SetDrawingStyle(charts.Style{StrokeColor: color, StrokeWidth: width})
+	c.painter.MoveTo(x, c.top)
+	c.painter.LineTo(x, c.bottom)
+	c.painter.Stroke()
+}
+
+func (c *timelineCanvas) text(body string, x, y int, color charts.Color, size float64) {
+	c.painter.OverrideTextStyle(charts.Style{FontColor: color, FontSize: size})
+	c.painter.Text(body, x, y)
+}
+
+// NewTimeline renders a Gantt-like chart, where each spot is a row
+// and each booking is a bar coloured by the online status of its author.
+func (a *Adapter) NewTimeline(timeline summary.Timeline) ([]byte, error) {
+	rows := len(timeline.Ledger)
+	height := 2*timelinePadding + timelineTitleHeight + timelineAxisHeight + rows*timelineRowHeight
+
+	theme := charts.NewTheme(charts.ThemeDark)
+	p, err := charts.NewPainter(
+		charts.PainterOptions{
+			Type:   charts.ChartOutputPNG,
+			Width:  timelineWidth,
+			Height: height,
+		},
+		charts.PainterThemeOption(theme),
+	)
+	if err != nil {
+		return nil, err
+	}
+	p.SetBackground(timelineWidth, height, theme.GetBackgroundColor())
+
+	canvas := &timelineCanvas{
+		painter: p,
+		theme:   theme,
+		from:    timeline.From,
+		to:      timeline.To,
+		left:    timelinePadding + timelineLabelWidth,
+		right:   timelineWidth - 2*timelinePadding,
+		top:     timelinePadding + timelineTitleHeight,
+		bottom:  timelinePadding + timelineTitleHeight + rows*timelineRowHeight,
+	}
+
+	canvas.text("Upcoming reservations", timelinePadding, timelinePadding+16, theme.GetTextColor(), 16)
+	drawTimelineHours(canvas)
+	for index, entry := range timeline.Ledger {
+		drawTimelineRow(canvas, index, entry)
+	}
+	drawTimelineMarkers(canvas, timeline)
+
+	return p.Bytes()
+}
+
+// drawTimelineHours draws a vertical grid line and a label for every full hour.
+func drawTimelineHours(c *timelineCanvas) {
+	labelEvery := 1
+	if c.to.Sub(c.from) > 12*time.Hour {
+		labelEvery = 2
+	}
+
+	hour := c.from.Truncate(time.Hour)
+	for index := 0; !hour.After(c.to); index++ {
+		if c.contains(hour) {
+			c.verticalLine(hour, c.theme.GetAxisSplitLineColor(), 1)
+			if index%labelEvery == 0 {
+				c.text(hour.Format("15:04"), c.x(hour)-16, c.bottom+16, c.theme.GetTextColor(), 10)
+			}
+		}
+		hour = hour.Add(time.Hour)
+	}
+}
+
+// drawTimelineRow draws the spot label and booking bars of a single ledger entry.
+func drawTimelineRow(c *timelineCanvas, index int, entry summary.LedgerEntry) {
+	top := c.top + index*timelineRowHeight
+	c.text(truncateLabel(entry.Spot), timelinePadding, top+timelineRowHeight/2+5, c.theme.GetTextColor(), 11)
+
+	for _, booking := range entry.Bookings {
+		if !booking.EndAt.After(c.from) || !booking.StartAt.Before(c.to) {
+			continue
+		}
+
+		color := timelineStatusColor(booking.Status)
+		c.painter.SetDrawingStyle(charts.Style{FillColor: color, StrokeColor: color, StrokeWidth: 1})
+		c.painter.Rect(charts.Box{
+			Left:   c.x(booking.StartAt),
+			Top:    top + timelineBarMargin,
+			Right:  c.x(booking.EndAt),
+			Bottom: top + timelineRowHeight - timelineBarMargin,
+		})
+	}
+}
+
+// drawTimelineMarkers draws the "now" marker and the server save line.
+func drawTimelineMarkers(c *timelineCanvas, timeline summary.Timeline) {
+	if c.contains(timeline.ServerSave) {
+		c.verticalLine(timeline.ServerSave, timelineServerSaveColor, 2)
+		c.text("Server save", c.x(timeline.ServerSave)+4, c.top-6, timelineServerSaveColor, 10)
+	}
+
+	if c.contains(timeline.Now) {
+		c.verticalLine(timeline.Now, timelineNowColor, 2)
+		c.text("Now", c.x(timeline.Now)+4, c.top-6, timelineNowColor, 10)
+	}
+}
+
+func timelineStatusColor(status summary.OnlineStatus) charts.Color {
+	switch status {
+	case summary.Online:
+		return timelineOnlineColor
+	case summary.Offline:
+		return timelineOfflineColor
+	default:
+		return timelineUnknownColor
+	}
+}
+
+func truncateLabel(label string) string {
+	runes := []rune(label)
+	if len(runes) <= timelineMaxSpotChars {
+		return label
+	}
+
+	return string(runes[:timelineMaxSpotChars-3]) + "..."
+}
@@ -0,0 +1,58 @@
+package chart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/summary"
+)
+
+func TestNewTimeline(t *testing.T) {
+	// Given
+	assert := assert.New(t)
+	now := time.Date(2021, 1, 1, 8, 30, 0, 0, time.UTC)
+	timeline := summary.Timeline{
+		Ledger: summary.Ledger{
+			{
+				Spot: "Asura Palace",
+				Bookings: []*summary.Booking{
+					{Author: "one", Status: summary.Online, StartAt: now.Add(-time.Hour), EndAt: now.Add(time.Hour)},
+					{Author: "two", Status: summary.Offline, StartAt: now.Add(2 * time.Hour), EndAt: now.Add(4 * time.Hour)},
+				},
+			},
+			{
+				Spot: "A spot with a very, very long name that has to be truncated",
+				Bookings: []*summary.Booking{
+					{Author: "three", Status: summary.Unknown, StartAt: now.Add(3 * time.Hour), EndAt: now.Add(30 * time.Hour)},
+				},
+			},
+		},
+		From:       now.Truncate(time.Hour),
+		To:         now.Truncate(time.Hour).Add(8 * time.Hour),
+		Now:        now,
+		ServerSave: time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC),
+	}
+	adapter := NewAdapter()
+
+	// When
+	res, err := adapter.NewTimeline(timeline)
+
+	// Assert
+	assert.Nil(err)
+	assert.Greater(len(res), 0)
+}
+
+func TestTruncateLabel(t *testing.T) {
+	// Given
+	assert := assert.New(t)
+
+	// When
+	short := truncateLabel("Asura Palace")
+	long := truncateLabel("A spot with a very, very long name")
+
+	// Assert
+	assert.Equal("Asura Palace", short)
+	assert.Equal("A spot with a very, ver...", long)
+}
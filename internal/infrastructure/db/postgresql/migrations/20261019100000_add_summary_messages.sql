@@ -0,0 +1,11 @@
+-- Create "summary_message" table
+CREATE TABLE "public"."summary_message" (
+  "id" bigserial NOT NULL,
+  "guild_id" character varying(255) NOT NULL,
+  "channel_id" character varying(255) NOT NULL,
+  "message_id" character varying(255) NOT NULL,
+  "position" integer NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "summary_message_channel_id_position_key" UNIQUE ("channel_id", "position")
+);
@@ -0,0 +1,8 @@
+-- Create "guild_settings" table
+CREATE TABLE "public"."guild_settings" (
+  "guild_id" character varying(255) NOT NULL,
+  "summary_chart" character varying(32) NOT NULL DEFAULT 'pie',
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("guild_id")
+);
@@ -0,0 +1,2 @@
+-- Modify "guild_settings" table
+ALTER TABLE "public"."guild_settings" ADD COLUMN "summary_layout" character varying(32) NOT NULL DEFAULT 'by-spot';
@@ -0,0 +1,2 @@
+-- Modify "guild_settings" table
+ALTER TABLE "public"."guild_settings" ADD COLUMN "summary_channel_id" character varying(255) NOT NULL DEFAULT '', ADD COLUMN "command_channel_id" character varying(255) NOT NULL DEFAULT '', ADD COLUMN "privileged_role_id" character varying(255) NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+-- Modify "guild_settings" table
+ALTER TABLE "public"."guild_settings" ADD COLUMN "booking_channel_ids" text[] NOT NULL DEFAULT '{}', ADD COLUMN "mirror_bookings" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,9 @@
+-- Create "calendar_feed" table
+CREATE TABLE "public"."calendar_feed" (
+  "guild_id" character varying(255) NOT NULL,
+  "member_id" character varying(255) NOT NULL DEFAULT '',
+  "nonce" character varying(64) NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("guild_id", "member_id")
+);
@@ -0,0 +1,2 @@
+-- Modify "guild_settings" table
+ALTER TABLE "public"."guild_settings" ADD COLUMN "favourite_spots" text[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,2 @@
+-- Modify "guild_settings" table
+ALTER TABLE "public"."guild_settings" ADD COLUMN "branding_title" character varying(256) NOT NULL DEFAULT '', ADD COLUMN "branding_url" text NOT NULL DEFAULT '', ADD COLUMN "branding_description" text NOT NULL DEFAULT '', ADD COLUMN "branding_color" integer NOT NULL DEFAULT 0, ADD COLUMN "branding_thumbnail_url" text NOT NULL DEFAULT '', ADD COLUMN "branding_pre_message" text NOT NULL DEFAULT '', ADD COLUMN "branding_hide_pre_message" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,6 @@
+-- Modify "guild_settings" table
+ALTER TABLE "public"."guild_settings" ADD COLUMN "reminder_minutes_before" integer NOT NULL DEFAULT 0;
+-- Create "member_reminder" table
+CREATE TABLE "public"."member_reminder" ("guild_id" character varying(255) NOT NULL, "member_id" character varying(255) NOT NULL, "minutes_before" integer NOT NULL, "created_at" timestamptz NOT NULL DEFAULT now(), "updated_at" timestamptz NOT NULL DEFAULT now(), PRIMARY KEY ("guild_id", "member_id"));
+-- Create "reservation_reminder" table
+CREATE TABLE "public"."reservation_reminder" ("reservation_id" bigint NOT NULL, "sent_at" timestamptz NOT NULL DEFAULT now(), PRIMARY KEY ("reservation_id"), CONSTRAINT "reservation_reminder_reservation_id_fk" FOREIGN KEY ("reservation_id") REFERENCES "public"."web_reservation" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
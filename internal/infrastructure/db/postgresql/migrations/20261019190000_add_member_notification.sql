@@ -0,0 +1,2 @@
+-- Create "member_notification" table
+CREATE TABLE "public"."member_notification" ("guild_id" character varying(255) NOT NULL, "member_id" character varying(255) NOT NULL, "kind" character varying(32) NOT NULL, "channel" character varying(32) NOT NULL, "created_at" timestamptz NOT NULL DEFAULT now(), "updated_at" timestamptz NOT NULL DEFAULT now(), PRIMARY KEY ("guild_id", "member_id", "kind"));
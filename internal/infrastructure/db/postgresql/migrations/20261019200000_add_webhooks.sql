@@ -0,0 +1,10 @@
+-- Create "webhook" table
+CREATE TABLE "public"."webhook" ("id" bigserial NOT NULL, "guild_id" character varying(255) NOT NULL, "url" character varying(2048) NOT NULL, "secret" character varying(128) NOT NULL, "created_at" timestamptz NOT NULL DEFAULT now(), PRIMARY KEY ("id"), CONSTRAINT "webhook_guild_id_url_key" UNIQUE ("guild_id", "url"));
+-- Create "webhook_delivery" table
+CREATE TABLE "public"."webhook_delivery" ("id" bigserial NOT NULL, "webhook_id" bigint NOT NULL, "guild_id" character varying(255) NOT NULL, "event" character varying(64) NOT NULL, "payload" jsonb NOT NULL, "status" character varying(32) NOT NULL DEFAULT 'pending', "attempts" integer NOT NULL DEFAULT 0, "next_attempt_at" timestamptz NOT NULL DEFAULT now(), "response_status" integer NOT NULL DEFAULT 0, "last_error" text NOT NULL DEFAULT '', "created_at" timestamptz NOT NULL DEFAULT now(), "updated_at" timestamptz NOT NULL DEFAULT now(), PRIMARY KEY ("id"), CONSTRAINT "webhook_delivery_webhook_id_fk" FOREIGN KEY ("webhook_id") REFERENCES "public"."webhook" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "webhook_delivery_status_next_attempt_at_idx" to table: "webhook_delivery"
+CREATE INDEX "webhook_delivery_status_next_attempt_at_idx" ON "public"."webhook_delivery" ("status", "next_attempt_at");
+-- Create index "webhook_delivery_guild_id_created_at_idx" to table: "webhook_delivery"
+CREATE INDEX "webhook_delivery_guild_id_created_at_idx" ON "public"."webhook_delivery" ("guild_id", "created_at");
+-- Create "webhook_dead_letter" table
+CREATE TABLE "public"."webhook_dead_letter" ("id" bigserial NOT NULL, "delivery_id" bigint NOT NULL, "webhook_id" bigint NOT NULL, "guild_id" character varying(255) NOT NULL, "url" character varying(2048) NOT NULL, "event" character varying(64) NOT NULL, "payload" jsonb NOT NULL, "attempts" integer NOT NULL, "response_status" integer NOT NULL DEFAULT 0, "last_error" text NOT NULL DEFAULT '', "created_at" timestamptz NOT NULL DEFAULT now(), PRIMARY KEY ("id"));
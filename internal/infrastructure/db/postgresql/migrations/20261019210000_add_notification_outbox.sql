@@ -0,0 +1,4 @@
+-- Create "notification_outbox" table
+CREATE TABLE "public"."notification_outbox" ("id" bigserial NOT NULL, "guild_id" character varying(255) NOT NULL, "recipient_discord_id" character varying(255) NOT NULL, "kind" character varying(32) NOT NULL, "payload" jsonb NOT NULL, "status" character varying(32) NOT NULL DEFAULT 'pending', "attempts" integer NOT NULL DEFAULT 0, "next_attempt_at" timestamptz NOT NULL DEFAULT now(), "last_error" text NOT NULL DEFAULT '', "created_at" timestamptz NOT NULL DEFAULT now(), "updated_at" timestamptz NOT NULL DEFAULT now(), PRIMARY KEY ("id"));
+-- Create index "notification_outbox_status_next_attempt_at_idx" to table: "notification_outbox"
+CREATE INDEX "notification_outbox_status_next_attempt_at_idx" ON "public"."notification_outbox" ("status", "next_attempt_at");
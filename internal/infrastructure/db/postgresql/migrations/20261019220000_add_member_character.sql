@@ -0,0 +1,6 @@
+-- Create "member_character" table
+CREATE TABLE "public"."member_character" ("id" bigserial NOT NULL, "guild_id" character varying(255) NOT NULL, "member_id" character varying(255) NOT NULL, "name" character varying(64) NOT NULL, "verification_code" character varying(64) NOT NULL, "verified_at" timestamptz NULL, "created_at" timestamptz NOT NULL DEFAULT now(), PRIMARY KEY ("id"));
+-- Create index "member_character_guild_id_name_key" to table: "member_character"
+CREATE UNIQUE INDEX "member_character_guild_id_name_key" ON "public"."member_character" ("guild_id", (lower(("name")::text)));
+-- Create index "member_character_guild_id_member_id_idx" to table: "member_character"
+CREATE INDEX "member_character_guild_id_member_id_idx" ON "public"."member_character" ("guild_id", "member_id");
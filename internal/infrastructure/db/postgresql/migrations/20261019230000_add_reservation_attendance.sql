@@ -0,0 +1,2 @@
+-- Create "reservation_attendance" table
+CREATE TABLE "public"."reservation_attendance" ("reservation_id" bigint NOT NULL, "samples" integer NOT NULL DEFAULT 0, "online_samples" integer NOT NULL DEFAULT 0, "first_online_at" timestamptz NULL, "last_sampled_at" timestamptz NOT NULL, PRIMARY KEY ("reservation_id"), CONSTRAINT "reservation_attendance_reservation_id_fk" FOREIGN KEY ("reservation_id") REFERENCES "public"."web_reservation" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
@@ -0,0 +1,8 @@
+-- Modify "guild_settings" table
+ALTER TABLE "public"."guild_settings" ADD COLUMN "reliability_min_score" integer NOT NULL DEFAULT 0, ADD COLUMN "reliability_max_hours_ahead" integer NOT NULL DEFAULT 24, ADD COLUMN "reliability_overbook_dm" boolean NOT NULL DEFAULT false;
+-- Create "reservation_incident" table
+CREATE TABLE "public"."reservation_incident" ("id" bigserial NOT NULL, "guild_id" character varying(255) NOT NULL, "member_id" character varying(255) NOT NULL, "reservation_id" bigint NOT NULL, "kind" character varying(32) NOT NULL, "start_at" timestamptz NOT NULL, "occurred_at" timestamptz NOT NULL DEFAULT now(), PRIMARY KEY ("id"));
+-- Create index "reservation_incident_guild_id_member_id_occurred_at_idx" to table: "reservation_incident"
+CREATE INDEX "reservation_incident_guild_id_member_id_occurred_at_idx" ON "public"."reservation_incident" ("guild_id", "member_id", "occurred_at");
+-- Create index "reservation_incident_reservation_id_kind_key" to table: "reservation_incident"
+CREATE UNIQUE INDEX "reservation_incident_reservation_id_kind_key" ON "public"."reservation_incident" ("reservation_id", "kind");
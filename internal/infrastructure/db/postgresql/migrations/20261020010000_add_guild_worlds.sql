@@ -0,0 +1,4 @@
+-- Modify "guilds_world" table
+ALTER TABLE "public"."guilds_world" DROP CONSTRAINT "guilds_world_guild_id_key", ADD CONSTRAINT "guilds_world_guild_id_world_name_key" UNIQUE ("guild_id", "world_name");
+-- Modify "member_character" table
+ALTER TABLE "public"."member_character" ADD COLUMN "world_name" character varying(100) NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+-- Create "tibia_world" table
+CREATE TABLE "public"."tibia_world" ("name" character varying(100) NOT NULL, "refreshed_at" timestamptz NOT NULL, PRIMARY KEY ("name"));
@@ -0,0 +1,2 @@
+-- Modify "guild_settings" table
+ALTER TABLE "public"."guild_settings" ADD COLUMN "tibia_guild" character varying(100) NOT NULL DEFAULT '';
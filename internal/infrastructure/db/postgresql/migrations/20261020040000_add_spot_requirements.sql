@@ -0,0 +1,4 @@
+-- Modify "guild_settings" table
+ALTER TABLE "public"."guild_settings" ADD COLUMN "spot_requirements" boolean NOT NULL DEFAULT false;
+-- Modify "web_spot" table
+ALTER TABLE "public"."web_spot" ADD COLUMN "min_level" integer NOT NULL DEFAULT 0, ADD COLUMN "max_level" integer NOT NULL DEFAULT 0, ADD COLUMN "vocations" text[] NOT NULL DEFAULT '{}';
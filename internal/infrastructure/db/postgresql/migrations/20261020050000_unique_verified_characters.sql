@@ -0,0 +1,6 @@
+-- Drop index "member_character_guild_id_name_key" from table: "member_character"
+DROP INDEX "public"."member_character_guild_id_name_key";
+-- Create index "member_character_guild_id_member_id_name_key" to table: "member_character"
+CREATE UNIQUE INDEX "member_character_guild_id_member_id_name_key" ON "public"."member_character" ("guild_id", "member_id", (lower(("name")::text)));
+-- Create index "member_character_guild_id_name_key" to table: "member_character"
+CREATE UNIQUE INDEX "member_character_guild_id_name_key" ON "public"."member_character" ("guild_id", (lower(("name")::text))) WHERE (verified_at IS NOT NULL);
@@ -0,0 +1,6 @@
+-- Modify "reservation_reminder" table
+ALTER TABLE "public"."reservation_reminder" ADD COLUMN "guild_id" character varying(255) NULL, ADD COLUMN "author_discord_id" character varying(200) NULL, ADD COLUMN "spot_id" bigint NULL, ADD COLUMN "start_at" timestamptz NULL;
+-- Carry over claims of existing reservations
+UPDATE "public"."reservation_reminder" rr SET "guild_id" = r."guild_id", "author_discord_id" = r."author_discord_id", "spot_id" = r."spot_id", "start_at" = r."start_at" FROM "public"."web_reservation" r WHERE r."id" = rr."reservation_id";
+-- Modify "reservation_reminder" table
+ALTER TABLE "public"."reservation_reminder" DROP CONSTRAINT "reservation_reminder_pkey", DROP COLUMN "reservation_id", ALTER COLUMN "guild_id" SET NOT NULL, ALTER COLUMN "author_discord_id" SET NOT NULL, ALTER COLUMN "spot_id" SET NOT NULL, ALTER COLUMN "start_at" SET NOT NULL, ADD PRIMARY KEY ("guild_id", "author_discord_id", "spot_id", "start_at");
@@ -383,7 +383,10 @@ ALTER SEQUENCE public.web_reservation_id_seq OWNED BY public.web_reservation.id;
 CREATE TABLE public.web_spot (
     id bigint NOT NULL,
     name character varying(120) NOT NULL,
-    created_at timestamp with time zone NOT NULL
+    created_at timestamp with time zone NOT NULL,
+    min_level integer NOT NULL DEFAULT 0,
+    max_level integer NOT NULL DEFAULT 0,
+    vocations text[] NOT NULL DEFAULT '{}'
 );
 
 
@@ -835,8 +838,182 @@ ALTER TABLE ONLY public.web_reservation
 
 CREATE TABLE public.guilds_world (
     id bigserial PRIMARY KEY,
-    guild_id character varying(255) NOT NULL UNIQUE,
+    guild_id character varying(255) NOT NULL,
     world_name character varying(100) NOT NULL,
     created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (guild_id, world_name)
+);
+
+CREATE TABLE public.summary_message (
+    id bigserial PRIMARY KEY,
+    guild_id character varying(255) NOT NULL,
+    channel_id character varying(255) NOT NULL,
+    message_id character varying(255) NOT NULL,
+    position integer NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (channel_id, position)
+);
+
+CREATE TABLE public.guild_settings (
+    guild_id character varying(255) PRIMARY KEY,
+    summary_chart character varying(32) NOT NULL DEFAULT 'pie',
+    summary_layout character varying(32) NOT NULL DEFAULT 'by-spot',
+    summary_channel_id character varying(255) NOT NULL DEFAULT '',
+    command_channel_id character varying(255) NOT NULL DEFAULT '',
+    privileged_role_id character varying(255) NOT NULL DEFAULT '',
+    booking_channel_ids text[] NOT NULL DEFAULT '{}',
+    mirror_bookings boolean NOT NULL DEFAULT false,
+    favourite_spots text[] NOT NULL DEFAULT '{}',
+    branding_title character varying(256) NOT NULL DEFAULT '',
+    branding_url text NOT NULL DEFAULT '',
+    branding_description text NOT NULL DEFAULT '',
+    branding_color integer NOT NULL DEFAULT 0,
+    branding_thumbnail_url text NOT NULL DEFAULT '',
+    branding_pre_message text NOT NULL DEFAULT '',
+    branding_hide_pre_message boolean NOT NULL DEFAULT false,
+    reminder_minutes_before integer NOT NULL DEFAULT 0,
+    reliability_min_score integer NOT NULL DEFAULT 0,
+    reliability_max_hours_ahead integer NOT NULL DEFAULT 24,
+    reliability_overbook_dm boolean NOT NULL DEFAULT false,
+    tibia_guild character varying(100) NOT NULL DEFAULT '',
+    spot_requirements boolean NOT NULL DEFAULT false,
+    created_at timestamptz NOT NULL DEFAULT now(),
     updated_at timestamptz NOT NULL DEFAULT now()
 );
+
+CREATE TABLE public.calendar_feed (
+    guild_id character varying(255) NOT NULL,
+    member_id character varying(255) NOT NULL DEFAULT '',
+    nonce character varying(64) NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (guild_id, member_id)
+);
+
+CREATE TABLE public.member_reminder (
+    guild_id character varying(255) NOT NULL,
+    member_id character varying(255) NOT NULL,
+    minutes_before integer NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (guild_id, member_id)
+);
+
+CREATE TABLE public.reservation_reminder (
+    guild_id character varying(255) NOT NULL,
+    author_discord_id character varying(200) NOT NULL,
+    spot_id bigint NOT NULL,
+    start_at timestamptz NOT NULL,
+    sent_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (guild_id, author_discord_id, spot_id, start_at)
+);
+
+CREATE TABLE public.member_notification (
+    guild_id character varying(255) NOT NULL,
+    member_id character varying(255) NOT NULL,
+    kind character varying(32) NOT NULL,
+    channel character varying(32) NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (guild_id, member_id, kind)
+);
+
+CREATE TABLE public.webhook (
+    id bigserial PRIMARY KEY,
+    guild_id character varying(255) NOT NULL,
+    url character varying(2048) NOT NULL,
+    secret character varying(128) NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (guild_id, url)
+);
+
+CREATE TABLE public.webhook_delivery (
+    id bigserial PRIMARY KEY,
+    webhook_id bigint NOT NULL REFERENCES public.webhook(id) ON DELETE CASCADE,
+    guild_id character varying(255) NOT NULL,
+    event character varying(64) NOT NULL,
+    payload jsonb NOT NULL,
+    status character varying(32) NOT NULL DEFAULT 'pending',
+    attempts integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL DEFAULT now(),
+    response_status integer NOT NULL DEFAULT 0,
+    last_error text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX webhook_delivery_status_next_attempt_at_idx ON public.webhook_delivery (status, next_attempt_at);
+
+CREATE INDEX webhook_delivery_guild_id_created_at_idx ON public.webhook_delivery (guild_id, created_at);
+
+CREATE TABLE public.webhook_dead_letter (
+    id bigserial PRIMARY KEY,
+    delivery_id bigint NOT NULL,
+    webhook_id bigint NOT NULL,
+    guild_id character varying(255) NOT NULL,
+    url character varying(2048) NOT NULL,
+    event character varying(64) NOT NULL,
+    payload jsonb NOT NULL,
+    attempts integer NOT NULL,
+    response_status integer NOT NULL DEFAULT 0,
+    last_error text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE public.notification_outbox (
+    id bigserial PRIMARY KEY,
+    guild_id character varying(255) NOT NULL,
+    recipient_discord_id character varying(255) NOT NULL,
+    kind character varying(32) NOT NULL,
+    payload jsonb NOT NULL,
+    status character varying(32) NOT NULL DEFAULT 'pending',
+    attempts integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL DEFAULT now(),
+    last_error text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX notification_outbox_status_next_attempt_at_idx ON public.notification_outbox (status, next_attempt_at);
+
+CREATE TABLE public.member_character (
+    id bigserial PRIMARY KEY,
+    guild_id character varying(255) NOT NULL,
+    member_id character varying(255) NOT NULL,
+    name character varying(64) NOT NULL,
+    verification_code character varying(64) NOT NULL,
+    verified_at timestamptz NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    world_name character varying(100) NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX member_character_guild_id_member_id_name_key ON public.member_character (guild_id, member_id, lower(name));
+CREATE UNIQUE INDEX member_character_guild_id_name_key ON public.member_character (guild_id, lower(name)) WHERE verified_at IS NOT NULL;
+CREATE INDEX member_character_guild_id_member_id_idx ON public.member_character (guild_id, member_id);
+
+CREATE TABLE public.reservation_attendance (
+    reservation_id bigint NOT NULL PRIMARY KEY REFERENCES public.web_reservation(id) ON DELETE CASCADE,
+    samples integer NOT NULL DEFAULT 0,
+    online_samples integer NOT NULL DEFAULT 0,
+    first_online_at timestamptz NULL,
+    last_sampled_at timestamptz NOT NULL
+);
+
+CREATE TABLE public.reservation_incident (
+    id bigserial PRIMARY KEY,
+    guild_id character varying(255) NOT NULL,
+    member_id character varying(255) NOT NULL,
+    reservation_id bigint NOT NULL,
+    kind character varying(32) NOT NULL,
+    start_at timestamptz NOT NULL,
+    occurred_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX reservation_incident_guild_id_member_id_occurred_at_idx ON public.reservation_incident (guild_id, member_id, occurred_at);
+CREATE UNIQUE INDEX reservation_incident_reservation_id_kind_key ON public.reservation_incident (reservation_id, kind);
+
+CREATE TABLE public.tibia_world (
+    name character varying(100) PRIMARY KEY,
+    refreshed_at timestamptz NOT NULL
+);
@@ -0,0 +1,57 @@
+package eventbus
+
+import (
+	"go.uber.org/zap"
+
+	"spot-assistant/internal/core/dto/event"
+)
+
+// AuditLog writes every domain event to the log, so it can be traced who did what and when.
+type AuditLog struct {
+	log *zap.SugaredLogger
+}
+
+func NewAuditLog(log *zap.SugaredLogger) *AuditLog {
+	return &AuditLog{
+		log: log.With("layer", "infrastructure", "name", "auditLog"),
+	}
+}
+
+func (a *AuditLog) HandleEvent(e event.Event) {
+	a.log.With(auditFields(e)...).Info("domain event")
+}
+
+// auditFields returns key-value pairs describing the event.
+func auditFields(e event.Event) []any {
+	fields := []any{"event", e.Name(), "guild.id", e.GuildID()}
+	switch e := e.(type) {
+	case event.ReservationCreated:
+		fields = append(fields,
+			"member.id", e.Request.Member.ID,
+			"spot", e.Request.Spot,
+			"startAt", e.Request.StartAt,
+			"endAt", e.Request.EndAt,
+			"overbook", e.Request.Overbook,
+		)
+	case event.ReservationClipped:
+		fields = append(fields,
+			"member.id", e.Request.Member.ID,
+			"spot", e.Request.Spot,
+			"reservation.id", e.Clipped.Original.ID,
+			"reservation.author.id", e.Clipped.Original.AuthorDiscordID,
+			"removed", e.Removed(),
+		)
+	case event.ReservationCancelled:
+		fields = append(fields,
+			"member.id", e.Request.Member.ID,
+			"spot", e.Reservation.Spot.Name,
+			"reservation.id", e.Reservation.Reservation.ID,
+		)
+	case event.GuildWorldChanged:
+		fields = append(fields, "world", e.World, "removed", e.Removed)
+	case event.MemberCharactersChanged:
+		fields = append(fields, "member.id", e.Member.ID)
+	}
+
+	return fields
+}
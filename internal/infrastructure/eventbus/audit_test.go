@@ -0,0 +1,40 @@
+package eventbus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"spot-assistant/internal/common/test/factories"
+	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/event"
+	"spot-assistant/internal/core/dto/reservation"
+)
+
+func TestAuditLog_LogsEvents(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	core, logs := observer.New(zap.InfoLevel)
+	audit := NewAuditLog(zap.New(core).Sugar())
+	guild, member := factories.CreateGuild(), factories.CreateMember()
+
+	// when
+	audit.HandleEvent(event.ReservationCancelled{
+		Request: book.UnbookRequest{Guild: guild, Member: member, ReservationID: 1},
+		Reservation: &reservation.ReservationWithSpot{
+			Reservation: reservation.Reservation{ID: 1},
+			Spot:        reservation.Spot{Name: "Flimsy"},
+		},
+	})
+
+	// then
+	assert.Equal(1, logs.Len())
+	fields := logs.All()[0].ContextMap()
+	assert.Equal(event.NameReservationCancelled, fields["event"])
+	assert.Equal(guild.ID, fields["guild.id"])
+	assert.Equal(member.ID, fields["member.id"])
+	assert.Equal("Flimsy", fields["spot"])
+	assert.Equal(int64(1), fields["reservation.id"])
+}
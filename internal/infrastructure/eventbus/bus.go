@@ -0,0 +1,67 @@
+package eventbus
+
+import (
+	"sync"
+
+	"go.uber.org/zap"
+
+	"spot-assistant/internal/core/dto/event"
+	"spot-assistant/internal/ports"
+)
+
+// Bus publishes domain events to subscribers in the same process.
+// Every subscriber handles an event in its own goroutine, so a slow or failing
+// subscriber neither holds up the publisher, nor other subscribers.
+type Bus struct {
+	mu          sync.RWMutex
+	subscribers []ports.EventSubscriber
+	pending     sync.WaitGroup
+	log         *zap.SugaredLogger
+}
+
+func New() *Bus {
+	return &Bus{
+		log: zap.NewNop().Sugar(),
+	}
+}
+
+func (b *Bus) WithLogger(log *zap.SugaredLogger) *Bus {
+	b.log = log.With("layer", "infrastructure", "name", "eventBus")
+	return b
+}
+
+// Subscribe adds subscribers receiving all events published from now on.
+func (b *Bus) Subscribe(subscribers ...ports.EventSubscriber) *Bus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers = append(b.subscribers, subscribers...)
+
+	return b
+}
+
+// Publish notifies subscribers of a domain event, without waiting for them to react.
+func (b *Bus) Publish(e event.Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, subscriber := range b.subscribers {
+		b.pending.Add(1)
+		go b.deliver(subscriber, e)
+	}
+}
+
+// Wait blocks until subscribers handle all events published so far.
+func (b *Bus) Wait() {
+	b.pending.Wait()
+}
+
+func (b *Bus) deliver(subscriber ports.EventSubscriber, e event.Event) {
+	defer b.pending.Done()
+	defer func() {
+		if r := recover(); r != nil {
+			b.log.Errorf("subscriber %T panicked handling %s event of guild %s: %v", subscriber, e.Name(), e.GuildID(), r)
+		}
+	}()
+
+	subscriber.HandleEvent(e)
+}
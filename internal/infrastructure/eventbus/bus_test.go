@@ -0,0 +1,63 @@
+package eventbus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/factories"
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/event"
+)
+
+func TestBus_PublishNotifiesAllSubscribers(t *testing.T) {
+	// given
+	e := event.ReservationCreated{Request: book.BookRequest{Guild: factories.CreateGuild(), Member: factories.CreateMember()}}
+	first := mocks.NewMockEventSubscriber(t)
+	first.On("HandleEvent", e).Once()
+	second := mocks.NewMockEventSubscriber(t)
+	second.On("HandleEvent", e).Once()
+	bus := New().Subscribe(first, second)
+
+	// when
+	bus.Publish(e)
+	bus.Wait()
+
+	// then
+	first.AssertExpectations(t)
+	second.AssertExpectations(t)
+}
+
+func TestBus_PublishSurvivesPanickingSubscriber(t *testing.T) {
+	// given
+	e := event.GuildWorldChanged{Guild: factories.CreateGuild(), World: "Antica"}
+	panicking := mocks.NewMockEventSubscriber(t)
+	panicking.On("HandleEvent", e).Run(func(mock.Arguments) {
+		panic("subscriber failed")
+	}).Once()
+	healthy := mocks.NewMockEventSubscriber(t)
+	healthy.On("HandleEvent", e).Once()
+	bus := New().Subscribe(panicking, healthy)
+
+	// when
+	bus.Publish(e)
+	bus.Wait()
+
+	// then
+	healthy.AssertExpectations(t)
+}
+
+func TestMetricsSubscriber_CountsEvents(t *testing.T) {
+	// given
+	guild := factories.CreateGuild()
+	metrics := mocks.NewMockMetricsPort(t)
+	metrics.On("IncDomainEvent", guild.ID, event.NameGuildWorldChanged).Once()
+	subscriber := NewMetricsSubscriber(metrics)
+
+	// when
+	subscriber.HandleEvent(event.GuildWorldChanged{Guild: guild, World: "Antica"})
+
+	// then
+	metrics.AssertExpectations(t)
+}
@@ -0,0 +1,21 @@
+package eventbus
+
+import (
+	"spot-assistant/internal/core/dto/event"
+	"spot-assistant/internal/ports"
+)
+
+// MetricsSubscriber counts domain events per guild.
+type MetricsSubscriber struct {
+	metrics ports.MetricsPort
+}
+
+func NewMetricsSubscriber(metrics ports.MetricsPort) *MetricsSubscriber {
+	return &MetricsSubscriber{
+		metrics: metrics,
+	}
+}
+
+func (m *MetricsSubscriber) HandleEvent(e event.Event) {
+	m.metrics.IncDomainEvent(e.GuildID(), e.Name())
+}
@@ -193,7 +193,7 @@ func TestHandler_OnPrivateSummary(t *testing.T) {
 		return ok && time.Until(deadline) > 0
 	}), "123").Return([]*reservation.ReservationWithSpot{}, nil)
 
-	mockSummarySrv.On("PrepareSummary", mock.Anything).Return(summary.Summary{}, nil)
+	mockSummarySrv.On("PrepareSummary", mock.Anything, mock.Anything).Return(summary.Summary{}, nil)
 	mockCommSrv.On("SendPrivateSummary", mock.Anything, mock.Anything).Return(nil)
 
 	request := summary.PrivateSummaryRequest{
@@ -209,3 +209,127 @@ func TestHandler_OnPrivateSummary(t *testing.T) {
 	assert.Nil(err)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestHandler_OnPrivateSummaryFiltered(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mockRepo := new(mocks.MockReservationRepository)
+	mockSummarySrv := new(mocks.MockSummaryService)
+	mockCommSrv := new(mocks.MockCommunicationService)
+
+	adapter := NewHandler(
+		new(mocks.MockBookingService),
+		mockRepo,
+		mockCommSrv,
+		mockSummarySrv,
+	)
+
+	reservations := []*reservation.ReservationWithSpot{{Spot: reservation.Spot{Name: "Flimsy"}}}
+	mockRepo.On("SelectFilteredReservationsWithSpot", mock.Anything, mock.MatchedBy(func(filter reservation.Filter) bool {
+		return filter.GuildID == "123" && filter.AuthorDiscordID == "456" &&
+			filter.From.Before(filter.To) && filter.To.Sub(filter.From) == 3*time.Hour
+	})).Return(reservations, nil)
+	mockSummarySrv.On("PrepareSummary", "123", reservations).Return(&summary.Summary{Description: "Hunts."}, nil)
+	mockCommSrv.On("SendPrivateSummary", mock.Anything, mock.MatchedBy(func(s *summary.Summary) bool {
+		return s.Description == "Hunts.\nFiltered by: booked by <@456>, time window **next-3h**."
+	})).Return(nil)
+
+	request := summary.PrivateSummaryRequest{
+		GuildID:         123,
+		UserID:          456,
+		AuthorDiscordID: "456",
+		Window:          summary.WindowNextThreeHours,
+	}
+
+	// when
+	err := adapter.OnPrivateSummary(request)
+
+	// assert
+	assert.Nil(err)
+	mockRepo.AssertExpectations(t)
+	mockCommSrv.AssertExpectations(t)
+}
+
+func TestHandler_OnPrivateSummaryFilteredEmpty(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mockRepo := new(mocks.MockReservationRepository)
+
+	adapter := NewHandler(
+		new(mocks.MockBookingService),
+		mockRepo,
+		new(mocks.MockCommunicationService),
+		new(mocks.MockSummaryService),
+	)
+
+	mockRepo.On("SelectFilteredReservationsWithSpot", mock.Anything, mock.Anything).
+		Return([]*reservation.ReservationWithSpot{}, nil)
+
+	// when
+	err := adapter.OnPrivateSummary(summary.PrivateSummaryRequest{GuildID: 123, UserID: 456, Window: summary.WindowTonight})
+
+	// assert
+	assert.EqualError(err, "no reservations match the selected filters")
+}
+
+func TestHandler_OnPrivateSummaryFreeNow(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mockRepo := new(mocks.MockReservationRepository)
+	mockSummarySrv := new(mocks.MockSummaryService)
+	mockCommSrv := new(mocks.MockCommunicationService)
+
+	adapter := NewHandler(
+		new(mocks.MockBookingService),
+		mockRepo,
+		mockCommSrv,
+		mockSummarySrv,
+	)
+
+	nextStartAt := time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC)
+	mockRepo.On("SelectFreeSpots", mock.Anything, "123", "").Return([]*reservation.FreeSpot{
+		{Name: "Flimsy", NextStartAt: nextStartAt},
+		{Name: "Issavi"},
+	}, nil)
+	mockSummarySrv.On("PrepareLedger", "123", []*reservation.ReservationWithSpot{}).Return(&summary.Summary{
+		Description: "Hunts.",
+		Ledger:      summary.Ledger{{Spot: "Flimsy"}},
+		FreeSoon:    []summary.FreeSpot{{Spot: "Issavi"}},
+	}, nil)
+	mockCommSrv.On("SendPrivateSummary", mock.Anything, mock.MatchedBy(func(s *summary.Summary) bool {
+		return s.Description == "Hunts.\nFiltered by: respawns **nobody is hunting on right now**." &&
+			len(s.Ledger) == 0 && len(s.FreeSoon) == 0 &&
+			len(s.FreeNow) == 2 &&
+			s.FreeNow[0] == summary.FreeSpot{Spot: "Flimsy", Until: nextStartAt} &&
+			s.FreeNow[1] == summary.FreeSpot{Spot: "Issavi"}
+	})).Return(nil)
+
+	// when
+	err := adapter.OnPrivateSummary(summary.PrivateSummaryRequest{GuildID: 123, UserID: 456, FreeNow: true})
+
+	// assert
+	assert.Nil(err)
+	mockRepo.AssertNotCalled(t, "SelectFilteredReservationsWithSpot", mock.Anything, mock.Anything)
+	mockCommSrv.AssertExpectations(t)
+}
+
+func TestHandler_OnPrivateSummaryFreeNowEmpty(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mockRepo := new(mocks.MockReservationRepository)
+
+	adapter := NewHandler(
+		new(mocks.MockBookingService),
+		mockRepo,
+		new(mocks.MockCommunicationService),
+		new(mocks.MockSummaryService),
+	)
+
+	mockRepo.On("SelectFreeSpots", mock.Anything, "123", "Flimsy").Return([]*reservation.FreeSpot{}, nil)
+
+	// when
+	err := adapter.OnPrivateSummary(summary.PrivateSummaryRequest{GuildID: 123, UserID: 456, SpotName: "Flimsy", FreeNow: true})
+
+	// assert
+	assert.EqualError(err, "no respawns are free right now")
+}
@@ -9,11 +9,16 @@ import (
 const DefaultInteractionTimeout = 15 * time.Second
 
 type Handler struct {
-	bookingSrv ports.BookingService
-	db         ports.ReservationRepository
-	commSrv    ports.CommunicationService
-	summarySrv ports.SummaryService
-	metrics    ports.MetricsPort
+	bookingSrv  ports.BookingService
+	db          ports.ReservationRepository
+	commSrv     ports.CommunicationService
+	summarySrv  ports.SummaryService
+	reminderSrv ports.ReminderService
+	webhookSrv  ports.WebhookService
+	outboxSrv   ports.OutboxService
+	worldsSrv   ports.WorldListService
+	membersSrv  ports.GuildMembershipService
+	metrics     ports.MetricsPort
 }
 
 func NewHandler(bookingSrv ports.BookingService, db ports.ReservationRepository, commSrv ports.CommunicationService, summarySrv ports.SummaryService) *Handler {
@@ -29,3 +34,33 @@ func (h *Handler) WithMetrics(m ports.MetricsPort) *Handler {
 	h.metrics = m
 	return h
 }
+
+// WithReminderService sets service reminding members of their reservations on every tick.
+func (h *Handler) WithReminderService(srv ports.ReminderService) *Handler {
+	h.reminderSrv = srv
+	return h
+}
+
+// WithWebhookService sets service sending booking events to webhooks of guilds.
+func (h *Handler) WithWebhookService(srv ports.WebhookService) *Handler {
+	h.webhookSrv = srv
+	return h
+}
+
+// WithOutboxService sets service delivering notifications from the outbox on every tick.
+func (h *Handler) WithOutboxService(srv ports.OutboxService) *Handler {
+	h.outboxSrv = srv
+	return h
+}
+
+// WithWorldListService sets service refreshing names of Tibia worlds, once they get stale.
+func (h *Handler) WithWorldListService(srv ports.WorldListService) *Handler {
+	h.worldsSrv = srv
+	return h
+}
+
+// WithGuildMembershipService sets service refreshing members of in-game guilds, once they get stale.
+func (h *Handler) WithGuildMembershipService(srv ports.GuildMembershipService) *Handler {
+	h.membersSrv = srv
+	return h
+}
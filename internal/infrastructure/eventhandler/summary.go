@@ -2,8 +2,11 @@ package eventhandler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"spot-assistant/internal/core/dto/reservation"
 	"spot-assistant/internal/core/dto/summary"
@@ -15,38 +18,111 @@ func (a *Handler) OnPrivateSummary(request summary.PrivateSummaryRequest) error
 
 	guildIDStr := strconv.FormatInt(request.GuildID, 10)
 
-	var (
-		res []*reservation.ReservationWithSpot
-		err error
-	)
-
-	if request.SpotName != "" {
-		res, err = a.db.SelectUpcomingReservationsWithSpotForSpot(ctx, guildIDStr, request.SpotName)
+	if !request.IsFiltered() {
+		res, err := a.db.SelectUpcomingReservationsWithSpot(ctx, guildIDStr)
 		if err != nil {
 			return err
 		}
 		if len(res) == 0 {
-			return fmt.Errorf("no reservations for %s", request.SpotName)
+			return nil
+		}
+		// metrics: update gauge for upcoming reservations in this guild
+		if a.metrics != nil {
+			// Guild name is not available in this handler; pass empty string
+			a.metrics.SetUpcomingReservations(guildIDStr, "", len(res))
 		}
-	} else {
-		res, err = a.db.SelectUpcomingReservationsWithSpot(ctx, guildIDStr)
+
+		return a.sendPrivateSummary(guildIDStr, request, res)
+	}
+
+	if request.FreeNow {
+		return a.sendFreeSpots(ctx, guildIDStr, request)
+	}
+
+	filter := reservation.Filter{
+		GuildID:         guildIDStr,
+		SpotName:        request.SpotName,
+		AuthorDiscordID: request.AuthorDiscordID,
+	}
+	if request.Window != "" {
+		from, to, err := request.Window.Range(time.Now())
 		if err != nil {
 			return err
 		}
-		if len(res) == 0 {
-			return nil
+		filter.From, filter.To = from, to
+	}
+
+	res, err := a.db.SelectFilteredReservationsWithSpot(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if len(res) == 0 {
+		if request.AuthorDiscordID == "" && request.Window == "" {
+			return fmt.Errorf("no reservations for %s", request.SpotName)
 		}
+
+		return errors.New("no reservations match the selected filters")
+	}
+
+	return a.sendPrivateSummary(guildIDStr, request, res)
+}
+
+func (a *Handler) sendPrivateSummary(guildID string, request summary.PrivateSummaryRequest, res []*reservation.ReservationWithSpot) error {
+	summ, err := a.summarySrv.PrepareSummary(guildID, res)
+	if err != nil {
+		return err
+	}
+
+	summ.Description = describeFilters(summ.Description, request)
+
+	return a.commSrv.SendPrivateSummary(request, summ)
+}
+
+// sendFreeSpots sends the member spots of the guild, which nobody is hunting on right now,
+// instead of reservations.
+func (a *Handler) sendFreeSpots(ctx context.Context, guildID string, request summary.PrivateSummaryRequest) error {
+	freeSpots, err := a.db.SelectFreeSpots(ctx, guildID, request.SpotName)
+	if err != nil {
+		return err
 	}
-	// metrics: update gauge for upcoming reservations in this guild
-	if a.metrics != nil {
-		// Guild name is not available in this handler; pass empty string
-		a.metrics.SetUpcomingReservations(strconv.FormatInt(request.GuildID, 10), "", len(res))
+	if len(freeSpots) == 0 {
+		return errors.New("no respawns are free right now")
 	}
 
-	summ, err := a.summarySrv.PrepareSummary(res)
+	summ, err := a.summarySrv.PrepareLedger(guildID, []*reservation.ReservationWithSpot{})
 	if err != nil {
 		return err
 	}
+	summ.Ledger = nil
+	summ.FreeSoon = nil
+	summ.FreeNow = make([]summary.FreeSpot, len(freeSpots))
+	for i, spot := range freeSpots {
+		summ.FreeNow[i] = summary.FreeSpot{Spot: spot.Name, Until: spot.NextStartAt}
+	}
+	summ.Description = describeFilters(summ.Description, request)
 
 	return a.commSrv.SendPrivateSummary(request, summ)
 }
+
+// describeFilters appends filters of the request to the summary description,
+// so the member knows the summary is not complete.
+func describeFilters(description string, request summary.PrivateSummaryRequest) string {
+	filters := make([]string, 0)
+	if request.SpotName != "" {
+		filters = append(filters, fmt.Sprintf("respawn **%s**", request.SpotName))
+	}
+	if request.AuthorDiscordID != "" {
+		filters = append(filters, fmt.Sprintf("booked by <@%s>", request.AuthorDiscordID))
+	}
+	if request.Window != "" {
+		filters = append(filters, fmt.Sprintf("time window **%s**", request.Window))
+	}
+	if request.FreeNow {
+		filters = append(filters, "respawns **nobody is hunting on right now**")
+	}
+	if len(filters) == 0 {
+		return description
+	}
+
+	return fmt.Sprintf("%s\nFiltered by: %s.", description, strings.Join(filters, ", "))
+}
@@ -1,4 +1,21 @@
 package eventhandler
 
+import "time"
+
 func (a *Handler) OnTick() {
+	if a.reminderSrv != nil {
+		go a.reminderSrv.SendDueReminders(time.Now())
+	}
+	if a.webhookSrv != nil {
+		go a.webhookSrv.DeliverDue(time.Now())
+	}
+	if a.outboxSrv != nil {
+		go a.outboxSrv.DispatchDue(time.Now())
+	}
+	if a.worldsSrv != nil {
+		go a.worldsSrv.RefreshDue(time.Now())
+	}
+	if a.membersSrv != nil {
+		go a.membersSrv.RefreshDue(time.Now())
+	}
 }
@@ -0,0 +1,141 @@
+package eventhandler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/mocks"
+)
+
+func TestHandler_OnTickSendsDueReminders(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	reminderSrv := mocks.NewMockReminderService(t)
+	sent := make(chan struct{})
+	reminderSrv.On("SendDueReminders", mock.AnythingOfType("time.Time")).Run(func(mock.Arguments) {
+		close(sent)
+	}).Once()
+	adapter := NewHandler(
+		new(mocks.MockBookingService),
+		new(mocks.MockReservationRepository),
+		new(mocks.MockCommunicationService),
+		new(mocks.MockSummaryService),
+	).WithReminderService(reminderSrv)
+
+	// when
+	adapter.OnTick()
+
+	// then
+	select {
+	case <-sent:
+	case <-time.After(time.Second):
+		assert.Fail("reminders were not sent")
+	}
+}
+
+func TestHandler_OnTickDeliversWebhooks(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	webhookSrv := mocks.NewMockWebhookService(t)
+	delivered := make(chan struct{})
+	webhookSrv.On("DeliverDue", mock.AnythingOfType("time.Time")).Run(func(mock.Arguments) {
+		close(delivered)
+	}).Once()
+	adapter := NewHandler(
+		new(mocks.MockBookingService),
+		new(mocks.MockReservationRepository),
+		new(mocks.MockCommunicationService),
+		new(mocks.MockSummaryService),
+	).WithWebhookService(webhookSrv)
+
+	// when
+	adapter.OnTick()
+
+	// then
+	select {
+	case <-delivered:
+	case <-time.After(time.Second):
+		assert.Fail("webhooks were not delivered")
+	}
+}
+
+func TestHandler_OnTickDispatchesNotifications(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	outboxSrv := mocks.NewMockOutboxService(t)
+	dispatched := make(chan struct{})
+	outboxSrv.On("DispatchDue", mock.AnythingOfType("time.Time")).Run(func(mock.Arguments) {
+		close(dispatched)
+	}).Once()
+	adapter := NewHandler(
+		new(mocks.MockBookingService),
+		new(mocks.MockReservationRepository),
+		new(mocks.MockCommunicationService),
+		new(mocks.MockSummaryService),
+	).WithOutboxService(outboxSrv)
+
+	// when
+	adapter.OnTick()
+
+	// then
+	select {
+	case <-dispatched:
+	case <-time.After(time.Second):
+		assert.Fail("notifications were not dispatched")
+	}
+}
+
+func TestHandler_OnTickRefreshesWorlds(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	worldListSrv := mocks.NewMockWorldListService(t)
+	refreshed := make(chan struct{})
+	worldListSrv.On("RefreshDue", mock.AnythingOfType("time.Time")).Run(func(mock.Arguments) {
+		close(refreshed)
+	}).Once()
+	adapter := NewHandler(
+		new(mocks.MockBookingService),
+		new(mocks.MockReservationRepository),
+		new(mocks.MockCommunicationService),
+		new(mocks.MockSummaryService),
+	).WithWorldListService(worldListSrv)
+
+	// when
+	adapter.OnTick()
+
+	// then
+	select {
+	case <-refreshed:
+	case <-time.After(time.Second):
+		assert.Fail("worlds were not refreshed")
+	}
+}
+
+func TestHandler_OnTickRefreshesGuildMembers(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	membersSrv := mocks.NewMockGuildMembershipService(t)
+	refreshed := make(chan struct{})
+	membersSrv.On("RefreshDue", mock.AnythingOfType("time.Time")).Run(func(mock.Arguments) {
+		close(refreshed)
+	}).Once()
+	adapter := NewHandler(
+		new(mocks.MockBookingService),
+		new(mocks.MockReservationRepository),
+		new(mocks.MockCommunicationService),
+		new(mocks.MockSummaryService),
+	).WithGuildMembershipService(membersSrv)
+
+	// when
+	adapter.OnTick()
+
+	// then
+	select {
+	case <-refreshed:
+	case <-time.After(time.Second):
+		assert.Fail("guild members were not refreshed")
+	}
+}
@@ -0,0 +1,65 @@
+-- name: SelectGuildSettings :one
+SELECT guild_id, summary_chart, summary_layout, summary_channel_id, command_channel_id, privileged_role_id,
+       booking_channel_ids, mirror_bookings, favourite_spots,
+       branding_title, branding_url, branding_description, branding_color, branding_thumbnail_url,
+       branding_pre_message, branding_hide_pre_message, reminder_minutes_before,
+       reliability_min_score, reliability_max_hours_ahead, reliability_overbook_dm, tibia_guild, spot_requirements
+FROM guild_settings
+WHERE guild_id = @guild_id
+LIMIT 1;
+
+-- name: SelectTibiaGuilds :many
+SELECT DISTINCT tibia_guild
+FROM guild_settings
+WHERE tibia_guild <> ''
+ORDER BY tibia_guild;
+
+-- name: InsertDefaultGuildSettings :exec
+INSERT INTO guild_settings (guild_id, created_at, updated_at)
+VALUES (@guild_id, now(), now())
+ON CONFLICT (guild_id) DO NOTHING;
+
+-- name: SelectGuildSettingsForUpdate :one
+SELECT guild_id, summary_chart, summary_layout, summary_channel_id, command_channel_id, privileged_role_id,
+       booking_channel_ids, mirror_bookings, favourite_spots,
+       branding_title, branding_url, branding_description, branding_color, branding_thumbnail_url,
+       branding_pre_message, branding_hide_pre_message, reminder_minutes_before,
+       reliability_min_score, reliability_max_hours_ahead, reliability_overbook_dm, tibia_guild, spot_requirements
+FROM guild_settings
+WHERE guild_id = @guild_id
+FOR UPDATE;
+
+-- name: UpsertGuildSettings :exec
+INSERT INTO guild_settings (guild_id, summary_chart, summary_layout, summary_channel_id, command_channel_id, privileged_role_id,
+                            booking_channel_ids, mirror_bookings, favourite_spots,
+                            branding_title, branding_url, branding_description, branding_color, branding_thumbnail_url,
+                            branding_pre_message, branding_hide_pre_message, reminder_minutes_before,
+                            reliability_min_score, reliability_max_hours_ahead, reliability_overbook_dm, tibia_guild, spot_requirements, created_at, updated_at)
+VALUES (@guild_id, @summary_chart, @summary_layout, @summary_channel_id, @command_channel_id, @privileged_role_id,
+        @booking_channel_ids, @mirror_bookings, @favourite_spots,
+        @branding_title, @branding_url, @branding_description, @branding_color, @branding_thumbnail_url,
+        @branding_pre_message, @branding_hide_pre_message, @reminder_minutes_before,
+        @reliability_min_score, @reliability_max_hours_ahead, @reliability_overbook_dm, @tibia_guild, @spot_requirements, now(), now())
+ON CONFLICT (guild_id)
+DO UPDATE SET summary_chart = EXCLUDED.summary_chart,
+              summary_layout = EXCLUDED.summary_layout,
+              summary_channel_id = EXCLUDED.summary_channel_id,
+              command_channel_id = EXCLUDED.command_channel_id,
+              privileged_role_id = EXCLUDED.privileged_role_id,
+              booking_channel_ids = EXCLUDED.booking_channel_ids,
+              mirror_bookings = EXCLUDED.mirror_bookings,
+              favourite_spots = EXCLUDED.favourite_spots,
+              branding_title = EXCLUDED.branding_title,
+              branding_url = EXCLUDED.branding_url,
+              branding_description = EXCLUDED.branding_description,
+              branding_color = EXCLUDED.branding_color,
+              branding_thumbnail_url = EXCLUDED.branding_thumbnail_url,
+              branding_pre_message = EXCLUDED.branding_pre_message,
+              branding_hide_pre_message = EXCLUDED.branding_hide_pre_message,
+              reminder_minutes_before = EXCLUDED.reminder_minutes_before,
+              reliability_min_score = EXCLUDED.reliability_min_score,
+              reliability_max_hours_ahead = EXCLUDED.reliability_max_hours_ahead,
+              reliability_overbook_dm = EXCLUDED.reliability_overbook_dm,
+              tibia_guild = EXCLUDED.tibia_guild,
+              spot_requirements = EXCLUDED.spot_requirements,
+              updated_at = now();
@@ -0,0 +1,141 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	commonErrors "spot-assistant/internal/common/errors"
+	"spot-assistant/internal/core/dto/guildsettings"
+)
+
+type DBTXWrapper interface {
+	DBTX
+
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+type GuildSettingsRepository struct {
+	q  *Queries
+	db DBTXWrapper
+}
+
+func NewGuildSettingsRepository(db DBTXWrapper) *GuildSettingsRepository {
+	return &GuildSettingsRepository{
+		q:  New(db),
+		db: db,
+	}
+}
+
+// SelectGuildSettings returns settings of a guild, or the defaults if the guild has not saved any.
+func (repo *GuildSettingsRepository) SelectGuildSettings(ctx context.Context, guildID string) (*guildsettings.GuildSettings, error) {
+	res, err := repo.q.SelectGuildSettings(ctx, guildID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return guildsettings.Default(guildID), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toGuildSettings(res), nil
+}
+
+// SelectTibiaGuilds returns names of in-game guilds, which any guild restricts booking to.
+func (repo *GuildSettingsRepository) SelectTibiaGuilds(ctx context.Context) ([]string, error) {
+	return repo.q.SelectTibiaGuilds(ctx)
+}
+
+// UpdateGuildSettings changes settings of a guild with the update and saves them. The settings are locked
+// until they are saved, so concurrent updates of the guild do not overwrite each other's changes.
+// Nothing is saved, if the update returns an error.
+func (repo *GuildSettingsRepository) UpdateGuildSettings(ctx context.Context, guildID string, update func(*guildsettings.GuildSettings) error) (*guildsettings.GuildSettings, error) {
+	tx, err := repo.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer commonErrors.ExecuteAndIgnoreErrorF(tx.Rollback, ctx)
+	qtx := repo.q.WithTx(tx)
+
+	// Guilds without settings get the defaults first, so there is a row to lock.
+	if err = qtx.InsertDefaultGuildSettings(ctx, guildID); err != nil {
+		return nil, err
+	}
+	res, err := qtx.SelectGuildSettingsForUpdate(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := toGuildSettings(SelectGuildSettingsRow(res))
+	if err = update(settings); err != nil {
+		return nil, err
+	}
+
+	if err = qtx.UpsertGuildSettings(ctx, toUpsertParams(settings)); err != nil {
+		return nil, err
+	}
+
+	return settings, tx.Commit(ctx)
+}
+
+func toGuildSettings(res SelectGuildSettingsRow) *guildsettings.GuildSettings {
+	return &guildsettings.GuildSettings{
+		GuildID:           res.GuildID,
+		SummaryChart:      guildsettings.SummaryChart(res.SummaryChart),
+		SummaryLayout:     guildsettings.SummaryLayout(res.SummaryLayout),
+		SummaryChannelID:  res.SummaryChannelID,
+		CommandChannelID:  res.CommandChannelID,
+		PrivilegedRoleID:  res.PrivilegedRoleID,
+		BookingChannelIDs: res.BookingChannelIds,
+		MirrorBookings:    res.MirrorBookings,
+		FavouriteSpots:    res.FavouriteSpots,
+		Branding: guildsettings.SummaryBranding{
+			Title:          res.BrandingTitle,
+			URL:            res.BrandingUrl,
+			Description:    res.BrandingDescription,
+			ThumbnailURL:   res.BrandingThumbnailUrl,
+			Color:          int(res.BrandingColor),
+			PreMessage:     res.BrandingPreMessage,
+			HidePreMessage: res.BrandingHidePreMessage,
+		},
+		ReminderMinutesBefore: int(res.ReminderMinutesBefore),
+		Reliability: guildsettings.ReliabilitySettings{
+			MinScore:      int(res.ReliabilityMinScore),
+			MaxHoursAhead: int(res.ReliabilityMaxHoursAhead),
+			OverbookDM:    res.ReliabilityOverbookDm,
+		},
+		TibiaGuild:       res.TibiaGuild,
+		SpotRequirements: res.SpotRequirements,
+	}
+}
+
+func toUpsertParams(settings *guildsettings.GuildSettings) UpsertGuildSettingsParams {
+	return UpsertGuildSettingsParams{
+		GuildID:           settings.GuildID,
+		SummaryChart:      string(settings.SummaryChart),
+		SummaryLayout:     string(settings.SummaryLayout),
+		SummaryChannelID:  settings.SummaryChannelID,
+		CommandChannelID:  settings.CommandChannelID,
+		PrivilegedRoleID:  settings.PrivilegedRoleID,
+		BookingChannelIds: settings.BookingChannelIDs,
+		MirrorBookings:    settings.MirrorBookings,
+		FavouriteSpots:    settings.FavouriteSpots,
+
+		BrandingTitle:          settings.Branding.Title,
+		BrandingUrl:            settings.Branding.URL,
+		BrandingDescription:    settings.Branding.Description,
+		BrandingColor:          int32(settings.Branding.Color),
+		BrandingThumbnailUrl:   settings.Branding.ThumbnailURL,
+		BrandingPreMessage:     settings.Branding.PreMessage,
+		BrandingHidePreMessage: settings.Branding.HidePreMessage,
+
+		ReminderMinutesBefore: int32(settings.ReminderMinutesBefore),
+
+		ReliabilityMinScore:      int32(settings.Reliability.MinScore),
+		ReliabilityMaxHoursAhead: int32(settings.Reliability.MaxHoursAhead),
+		ReliabilityOverbookDm:    settings.Reliability.OverbookDM,
+
+		TibiaGuild:       settings.TibiaGuild,
+		SpotRequirements: settings.SpotRequirements,
+	}
+}
@@ -0,0 +1,269 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: guildsettings.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const insertDefaultGuildSettings = `-- name: InsertDefaultGuildSettings :exec
+INSERT INTO guild_settings (guild_id, created_at, updated_at)
+VALUES ($1, now(), now())
+ON CONFLICT (guild_id) DO NOTHING
+`
+
+func (q *Queries) InsertDefaultGuildSettings(ctx context.Context, guildID string) error {
+	_, err := q.db.Exec(ctx, insertDefaultGuildSettings, guildID)
+	return err
+}
+
+const selectGuildSettings = `-- name: SelectGuildSettings :one
+SELECT guild_id, summary_chart, summary_layout, summary_channel_id, command_channel_id, privileged_role_id,
+       booking_channel_ids, mirror_bookings, favourite_spots,
+       branding_title, branding_url, branding_description, branding_color, branding_thumbnail_url,
+       branding_pre_message, branding_hide_pre_message, reminder_minutes_before,
+       reliability_min_score, reliability_max_hours_ahead, reliability_overbook_dm, tibia_guild, spot_requirements
+FROM guild_settings
+WHERE guild_id = $1
+LIMIT 1
+`
+
+type SelectGuildSettingsRow struct {
+	GuildID                  string
+	SummaryChart             string
+	SummaryLayout            string
+	SummaryChannelID         string
+	CommandChannelID         string
+	PrivilegedRoleID         string
+	BookingChannelIds        []string
+	MirrorBookings           bool
+	FavouriteSpots           []string
+	BrandingTitle            string
+	BrandingUrl              string
+	BrandingDescription      string
+	BrandingColor            int32
+	BrandingThumbnailUrl     string
+	BrandingPreMessage       string
+	BrandingHidePreMessage   bool
+	ReminderMinutesBefore    int32
+	ReliabilityMinScore      int32
+	ReliabilityMaxHoursAhead int32
+	ReliabilityOverbookDm    bool
+	TibiaGuild               string
+	SpotRequirements         bool
+}
+
+func (q *Queries) SelectGuildSettings(ctx context.Context, guildID string) (SelectGuildSettingsRow, error) {
+	row := q.db.QueryRow(ctx, selectGuildSettings, guildID)
+	var i SelectGuildSettingsRow
+	err := row.Scan(
+		&i.GuildID,
+		&i.SummaryChart,
+		&i.SummaryLayout,
+		&i.SummaryChannelID,
+		&i.CommandChannelID,
+		&i.PrivilegedRoleID,
+		&i.BookingChannelIds,
+		&i.MirrorBookings,
+		&i.FavouriteSpots,
+		&i.BrandingTitle,
+		&i.BrandingUrl,
+		&i.BrandingDescription,
+		&i.BrandingColor,
+		&i.BrandingThumbnailUrl,
+		&i.BrandingPreMessage,
+		&i.BrandingHidePreMessage,
+		&i.ReminderMinutesBefore,
+		&i.ReliabilityMinScore,
+		&i.ReliabilityMaxHoursAhead,
+		&i.ReliabilityOverbookDm,
+		&i.TibiaGuild,
+		&i.SpotRequirements,
+	)
+	return i, err
+}
+
+const selectGuildSettingsForUpdate = `-- name: SelectGuildSettingsForUpdate :one
+SELECT guild_id, summary_chart, summary_layout, summary_channel_id, command_channel_id, privileged_role_id,
+       booking_channel_ids, mirror_bookings, favourite_spots,
+       branding_title, branding_url, branding_description, branding_color, branding_thumbnail_url,
+       branding_pre_message, branding_hide_pre_message, reminder_minutes_before,
+       reliability_min_score, reliability_max_hours_ahead, reliability_overbook_dm, tibia_guild, spot_requirements
+FROM guild_settings
+WHERE guild_id = $1
+FOR UPDATE
+`
+
+type SelectGuildSettingsForUpdateRow struct {
+	GuildID                  string
+	SummaryChart             string
+	SummaryLayout            string
+	SummaryChannelID         string
+	CommandChannelID         string
+	PrivilegedRoleID         string
+	BookingChannelIds        []string
+	MirrorBookings           bool
+	FavouriteSpots           []string
+	BrandingTitle            string
+	BrandingUrl              string
+	BrandingDescription      string
+	BrandingColor            int32
+	BrandingThumbnailUrl     string
+	BrandingPreMessage       string
+	BrandingHidePreMessage   bool
+	ReminderMinutesBefore    int32
+	ReliabilityMinScore      int32
+	ReliabilityMaxHoursAhead int32
+	ReliabilityOverbookDm    bool
+	TibiaGuild               string
+	SpotRequirements         bool
+}
+
+func (q *Queries) SelectGuildSettingsForUpdate(ctx context.Context, guildID string) (SelectGuildSettingsForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, selectGuildSettingsForUpdate, guildID)
+	var i SelectGuildSettingsForUpdateRow
+	err := row.Scan(
+		&i.GuildID,
+		&i.SummaryChart,
+		&i.SummaryLayout,
+		&i.SummaryChannelID,
+		&i.CommandChannelID,
+		&i.PrivilegedRoleID,
+		&i.BookingChannelIds,
+		&i.MirrorBookings,
+		&i.FavouriteSpots,
+		&i.BrandingTitle,
+		&i.BrandingUrl,
+		&i.BrandingDescription,
+		&i.BrandingColor,
+		&i.BrandingThumbnailUrl,
+		&i.BrandingPreMessage,
+		&i.BrandingHidePreMessage,
+		&i.ReminderMinutesBefore,
+		&i.ReliabilityMinScore,
+		&i.ReliabilityMaxHoursAhead,
+		&i.ReliabilityOverbookDm,
+		&i.TibiaGuild,
+		&i.SpotRequirements,
+	)
+	return i, err
+}
+
+const selectTibiaGuilds = `-- name: SelectTibiaGuilds :many
+SELECT DISTINCT tibia_guild
+FROM guild_settings
+WHERE tibia_guild <> ''
+ORDER BY tibia_guild
+`
+
+func (q *Queries) SelectTibiaGuilds(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, selectTibiaGuilds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var tibia_guild string
+		if err := rows.Scan(&tibia_guild); err != nil {
+			return nil, err
+		}
+		items = append(items, tibia_guild)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertGuildSettings = `-- name: UpsertGuildSettings :exec
+INSERT INTO guild_settings (guild_id, summary_chart, summary_layout, summary_channel_id, command_channel_id, privileged_role_id,
+                            booking_channel_ids, mirror_bookings, favourite_spots,
+                            branding_title, branding_url, branding_description, branding_color, branding_thumbnail_url,
+                            branding_pre_message, branding_hide_pre_message, reminder_minutes_before,
+                            reliability_min_score, reliability_max_hours_ahead, reliability_overbook_dm, tibia_guild, spot_requirements, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6,
+        $7, $8, $9,
+        $10, $11, $12, $13, $14,
+        $15, $16, $17,
+        $18, $19, $20, $21, $22, now(), now())
+ON CONFLICT (guild_id)
+DO UPDATE SET summary_chart = EXCLUDED.summary_chart,
+              summary_layout = EXCLUDED.summary_layout,
+              summary_channel_id = EXCLUDED.summary_channel_id,
+              command_channel_id = EXCLUDED.command_channel_id,
+              privileged_role_id = EXCLUDED.privileged_role_id,
+              booking_channel_ids = EXCLUDED.booking_channel_ids,
+              mirror_bookings = EXCLUDED.mirror_bookings,
+              favourite_spots = EXCLUDED.favourite_spots,
+              branding_title = EXCLUDED.branding_title,
+              branding_url = EXCLUDED.branding_url,
+              branding_description = EXCLUDED.branding_description,
+              branding_color = EXCLUDED.branding_color,
+              branding_thumbnail_url = EXCLUDED.branding_thumbnail_url,
+              branding_pre_message = EXCLUDED.branding_pre_message,
+              branding_hide_pre_message = EXCLUDED.branding_hide_pre_message,
+              reminder_minutes_before = EXCLUDED.reminder_minutes_before,
+              reliability_min_score = EXCLUDED.reliability_min_score,
+              reliability_max_hours_ahead = EXCLUDED.reliability_max_hours_ahead,
+              reliability_overbook_dm = EXCLUDED.reliability_overbook_dm,
+              tibia_guild = EXCLUDED.tibia_guild,
+              spot_requirements = EXCLUDED.spot_requirements,
+              updated_at = now()
+`
+
+type UpsertGuildSettingsParams struct {
+	GuildID                  string
+	SummaryChart             string
+	SummaryLayout            string
+	SummaryChannelID         string
+	CommandChannelID         string
+	PrivilegedRoleID         string
+	BookingChannelIds        []string
+	MirrorBookings           bool
+	FavouriteSpots           []string
+	BrandingTitle            string
+	BrandingUrl              string
+	BrandingDescription      string
+	BrandingColor            int32
+	BrandingThumbnailUrl     string
+	BrandingPreMessage       string
+	BrandingHidePreMessage   bool
+	ReminderMinutesBefore    int32
+	ReliabilityMinScore      int32
+	ReliabilityMaxHoursAhead int32
+	ReliabilityOverbookDm    bool
+	TibiaGuild               string
+	SpotRequirements         bool
+}
+
+func (q *Queries) UpsertGuildSettings(ctx context.Context, arg UpsertGuildSettingsParams) error {
+	_, err := q.db.Exec(ctx, upsertGuildSettings,
+		arg.GuildID,
+		arg.SummaryChart,
+		arg.SummaryLayout,
+		arg.SummaryChannelID,
+		arg.CommandChannelID,
+		arg.PrivilegedRoleID,
+		arg.BookingChannelIds,
+		arg.MirrorBookings,
+		arg.FavouriteSpots,
+		arg.BrandingTitle,
+		arg.BrandingUrl,
+		arg.BrandingDescription,
+		arg.BrandingColor,
+		arg.BrandingThumbnailUrl,
+		arg.BrandingPreMessage,
+		arg.BrandingHidePreMessage,
+		arg.ReminderMinutesBefore,
+		arg.ReliabilityMinScore,
+		arg.ReliabilityMaxHoursAhead,
+		arg.ReliabilityOverbookDm,
+		arg.TibiaGuild,
+		arg.SpotRequirements,
+	)
+	return err
+}
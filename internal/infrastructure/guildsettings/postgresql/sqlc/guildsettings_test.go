@@ -0,0 +1,164 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/guildsettings"
+)
+
+var guildSettingsColumns = []string{"guild_id", "summary_chart", "summary_layout", "summary_channel_id", "command_channel_id", "privileged_role_id", "booking_channel_ids", "mirror_bookings", "favourite_spots", "branding_title", "branding_url", "branding_description", "branding_color", "branding_thumbnail_url", "branding_pre_message", "branding_hide_pre_message", "reminder_minutes_before", "reliability_min_score", "reliability_max_hours_ahead", "reliability_overbook_dm", "tibia_guild", "spot_requirements"}
+
+func TestSelectGuildSettings(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	rows := pgxmock.NewRows(guildSettingsColumns).
+		AddRow("guild-id", "timeline", "compact", "summary-channel-id", "command-channel-id", "role-id", []string{"command-channel-id"}, true, []string{"Flimsy"},
+			"Our Guild", "https://example.com", "Our hunts.", int32(0xff8800), "https://example.com/logo.png", "", true, int32(15), int32(60), int32(12), true, "Red Rose", true)
+	mock.ExpectQuery("SELECT guild_id, summary_chart, summary_layout, summary_channel_id, command_channel_id, privileged_role_id, booking_channel_ids, mirror_bookings, favourite_spots, branding_title, branding_url, branding_description, branding_color, branding_thumbnail_url, branding_pre_message, branding_hide_pre_message, reminder_minutes_before, reliability_min_score, reliability_max_hours_ahead, reliability_overbook_dm, tibia_guild, spot_requirements FROM guild_settings").
+		WithArgs("guild-id").
+		WillReturnRows(rows)
+	repo := NewGuildSettingsRepository(mock)
+
+	// when
+	settings, err := repo.SelectGuildSettings(context.Background(), "guild-id")
+
+	// then
+	assert.NoError(err)
+	assert.Equal("guild-id", settings.GuildID)
+	assert.Equal(guildsettings.SummaryChartTimeline, settings.SummaryChart)
+	assert.Equal(guildsettings.SummaryLayoutCompact, settings.SummaryLayout)
+	assert.Equal("summary-channel-id", settings.SummaryChannelID)
+	assert.Equal("command-channel-id", settings.CommandChannelID)
+	assert.Equal("role-id", settings.PrivilegedRoleID)
+	assert.Equal([]string{"command-channel-id"}, settings.BookingChannelIDs)
+	assert.True(settings.MirrorBookings)
+	assert.Equal([]string{"Flimsy"}, settings.FavouriteSpots)
+	assert.Equal(guildsettings.SummaryBranding{
+		Title:          "Our Guild",
+		URL:            "https://example.com",
+		Description:    "Our hunts.",
+		ThumbnailURL:   "https://example.com/logo.png",
+		Color:          0xff8800,
+		HidePreMessage: true,
+	}, settings.Branding)
+	assert.Equal(15, settings.ReminderMinutesBefore)
+	assert.Equal(guildsettings.ReliabilitySettings{MinScore: 60, MaxHoursAhead: 12, OverbookDM: true}, settings.Reliability)
+	assert.Equal("Red Rose", settings.TibiaGuild)
+	assert.True(settings.SpotRequirements)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestSelectGuildSettings_Defaults(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	mock.ExpectQuery("SELECT guild_id, summary_chart, summary_layout, summary_channel_id, command_channel_id, privileged_role_id, booking_channel_ids, mirror_bookings, favourite_spots, branding_title, branding_url, branding_description, branding_color, branding_thumbnail_url, branding_pre_message, branding_hide_pre_message, reminder_minutes_before, reliability_min_score, reliability_max_hours_ahead, reliability_overbook_dm, tibia_guild, spot_requirements FROM guild_settings").
+		WithArgs("guild-id").
+		WillReturnError(pgx.ErrNoRows)
+	repo := NewGuildSettingsRepository(mock)
+
+	// when
+	settings, err := repo.SelectGuildSettings(context.Background(), "guild-id")
+
+	// then
+	assert.NoError(err)
+	assert.Equal(guildsettings.Default("guild-id"), settings)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestUpdateGuildSettings(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	rows := pgxmock.NewRows(guildSettingsColumns).
+		AddRow("guild-id", "both", "by-hour", "summary-channel-id", "", "role-id", []string{"summary-channel-id"}, false, []string{"Flimsy"},
+			"Our Guild", "", "", int32(0), "", "Welcome!", false, int32(30), int32(50), int32(24), false, "Red Rose", false)
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO guild_settings").
+		WithArgs("guild-id").
+		WillReturnResult(pgxmock.NewResult("INSERT", 0))
+	mock.ExpectQuery("SELECT guild_id, .* FROM guild_settings WHERE guild_id = \\$1 FOR UPDATE").
+		WithArgs("guild-id").
+		WillReturnRows(rows)
+	mock.ExpectExec("INSERT INTO guild_settings").
+		WithArgs("guild-id", "both", "by-hour", "summary-channel-id", "", "role-id", []string{"summary-channel-id"}, false, []string{"Flimsy", "Banuta"},
+			"Our Guild", "", "", int32(0), "", "Welcome!", false, int32(30), int32(50), int32(24), false, "Red Rose", false).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+	repo := NewGuildSettingsRepository(mock)
+
+	// when
+	settings, err := repo.UpdateGuildSettings(context.Background(), "guild-id", func(settings *guildsettings.GuildSettings) error {
+		settings.FavouriteSpots = append(settings.FavouriteSpots, "Banuta")
+
+		return nil
+	})
+
+	// then
+	assert.NoError(err)
+	assert.Equal([]string{"Flimsy", "Banuta"}, settings.FavouriteSpots)
+	assert.Equal("Red Rose", settings.TibiaGuild)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestUpdateGuildSettings_Rejected(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	rows := pgxmock.NewRows(guildSettingsColumns).
+		AddRow("guild-id", "pie", "by-spot", "", "", "", []string{}, false, []string{},
+			"", "", "", int32(0), "", "", false, int32(0), int32(0), int32(24), false, "", false)
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO guild_settings").
+		WithArgs("guild-id").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectQuery("SELECT guild_id, .* FROM guild_settings WHERE guild_id = \\$1 FOR UPDATE").
+		WithArgs("guild-id").
+		WillReturnRows(rows)
+	mock.ExpectRollback()
+	repo := NewGuildSettingsRepository(mock)
+	rejection := errors.New("invalid chart type")
+
+	// when
+	_, err = repo.UpdateGuildSettings(context.Background(), "guild-id", func(*guildsettings.GuildSettings) error {
+		return rejection
+	})
+
+	// then
+	assert.ErrorIs(err, rejection)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestSelectTibiaGuilds(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	mock.ExpectQuery("SELECT DISTINCT tibia_guild FROM guild_settings").
+		WillReturnRows(pgxmock.NewRows([]string{"tibia_guild"}).AddRow("Red Rose").AddRow("White Rose"))
+	repo := NewGuildSettingsRepository(mock)
+
+	// when
+	names, err := repo.SelectTibiaGuilds(context.Background())
+
+	// then
+	assert.NoError(err)
+	assert.Equal([]string{"Red Rose", "White Rose"}, names)
+	assert.NoError(mock.ExpectationsWereMet())
+}
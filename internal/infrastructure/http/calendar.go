@@ -0,0 +1,49 @@
+package http
+
+import (
+	"errors"
+	stdhttp "net/http"
+	"strings"
+
+	"spot-assistant/internal/core/calendar"
+	"spot-assistant/internal/ports"
+)
+
+// WithCalendar registers the /calendar/<token>.ics endpoint, serving iCalendar feeds.
+// Invalid and rotated tokens are answered with 404, so feeds cannot be enumerated.
+func (s *Server) WithCalendar(c ports.CalendarService) *Server {
+	if c == nil || !c.IsConfigured() {
+		return s
+	}
+
+	s.mux.HandleFunc("/calendar/", func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		if r.Method != stdhttp.MethodGet && r.Method != stdhttp.MethodHead {
+			w.WriteHeader(stdhttp.StatusMethodNotAllowed)
+			return
+		}
+
+		token, found := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, "/calendar/"), ".ics")
+		if !found {
+			stdhttp.NotFound(w, r)
+			return
+		}
+
+		feed, err := c.Feed(token)
+		if errors.Is(err, calendar.ErrInvalidToken) {
+			stdhttp.NotFound(w, r)
+			return
+		}
+		if err != nil {
+			s.log.Errorf("could not render calendar feed: %v", err)
+			w.WriteHeader(stdhttp.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("Cache-Control", "private, max-age=300")
+		w.WriteHeader(stdhttp.StatusOK)
+		_, _ = w.Write(feed)
+	})
+
+	return s
+}
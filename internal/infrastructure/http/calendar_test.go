@@ -0,0 +1,61 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mocks "spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/calendar"
+)
+
+func TestCalendarEndpoint(t *testing.T) {
+	// given
+	srv := NewServer(":0", newTestLogger())
+	cal := &mocks.MockCalendarService{}
+	cal.On("IsConfigured").Return(true)
+	cal.On("Feed", "valid").Return([]byte("BEGIN:VCALENDAR\r\n"), nil)
+	cal.On("Feed", "rotated").Return(nil, calendar.ErrInvalidToken)
+	cal.On("Feed", "broken").Return(nil, assert.AnError)
+	srv.WithCalendar(cal)
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		srv.mux.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		return rec
+	}
+
+	// when
+	valid := serve(http.MethodGet, "/calendar/valid.ics")
+	rotated := serve(http.MethodGet, "/calendar/rotated.ics")
+	broken := serve(http.MethodGet, "/calendar/broken.ics")
+	withoutExtension := serve(http.MethodGet, "/calendar/valid")
+	post := serve(http.MethodPost, "/calendar/valid.ics")
+
+	// then
+	assert.Equal(t, http.StatusOK, valid.Code)
+	assert.Equal(t, "text/calendar; charset=utf-8", valid.Header().Get("Content-Type"))
+	assert.Equal(t, "BEGIN:VCALENDAR\r\n", valid.Body.String())
+	assert.Equal(t, http.StatusNotFound, rotated.Code)
+	assert.Equal(t, http.StatusInternalServerError, broken.Code)
+	assert.Equal(t, http.StatusNotFound, withoutExtension.Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, post.Code)
+}
+
+func TestCalendarEndpointNotConfigured(t *testing.T) {
+	// given
+	srv := NewServer(":0", newTestLogger())
+	cal := &mocks.MockCalendarService{}
+	cal.On("IsConfigured").Return(false)
+	srv.WithCalendar(cal)
+
+	// when
+	rec := httptest.NewRecorder()
+	srv.mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/calendar/valid.ics", nil))
+
+	// then
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	cal.AssertNotCalled(t, "Feed", "valid")
+}
@@ -0,0 +1,128 @@
+package http
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	stdhttp "net/http"
+	"strings"
+	"time"
+
+	"spot-assistant/internal/core/dto/export"
+	"spot-assistant/internal/ports"
+)
+
+// MaxImportBodySize limits the size of imported spot lists.
+const MaxImportBodySize = 1 << 20
+
+var exportContentTypes = map[export.Format]string{
+	export.FormatCSV:  "text/csv; charset=utf-8",
+	export.FormatJSON: "application/json",
+}
+
+// WithExport registers endpoints exporting reservations and spots, and importing spots.
+// Requests must carry the token as "Authorization: Bearer <token>"; without a token, endpoints are not registered.
+//
+// - GET /export/reservations?guild_id=<id>&from=YYYY-MM-DD&to=YYYY-MM-DD&format=csv|json
+// - GET /export/spots?format=csv|json
+// - POST /import/spots?format=csv|json&dry_run=true|false, with the file as the body
+func (s *Server) WithExport(e ports.ExportService, token string) *Server {
+	if e == nil || token == "" {
+		return s
+	}
+
+	s.mux.HandleFunc("/export/reservations", s.authorized(token, stdhttp.MethodGet, func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		guildID := r.URL.Query().Get("guild_id")
+		if guildID == "" {
+			stdhttp.Error(w, "guild_id is required", stdhttp.StatusBadRequest)
+			return
+		}
+
+		from, to, err := export.ParseRange(r.URL.Query().Get("from"), r.URL.Query().Get("to"), time.Now())
+		if err != nil {
+			stdhttp.Error(w, err.Error(), stdhttp.StatusBadRequest)
+			return
+		}
+
+		format := requestedFormat(r)
+		data, err := e.ExportReservations(guildID, from, to, format)
+		s.writeExport(w, format, fmt.Sprintf("reservations-%s.%s", guildID, format), data, err)
+	}))
+
+	s.mux.HandleFunc("/export/spots", s.authorized(token, stdhttp.MethodGet, func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		format := requestedFormat(r)
+		data, err := e.ExportSpots(format)
+		s.writeExport(w, format, fmt.Sprintf("spots.%s", format), data, err)
+	}))
+
+	s.mux.HandleFunc("/import/spots", s.authorized(token, stdhttp.MethodPost, func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		format := requestedFormat(r)
+		if !format.IsValid() {
+			stdhttp.Error(w, fmt.Sprintf("invalid format: %s", format), stdhttp.StatusBadRequest)
+			return
+		}
+
+		body, err := io.ReadAll(stdhttp.MaxBytesReader(w, r.Body, MaxImportBodySize))
+		if err != nil {
+			stdhttp.Error(w, "could not read the body", stdhttp.StatusRequestEntityTooLarge)
+			return
+		}
+
+		report, err := e.ImportSpots(body, format, r.URL.Query().Get("dry_run") != "false")
+		if err != nil {
+			stdhttp.Error(w, err.Error(), stdhttp.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(report)
+	}))
+
+	return s
+}
+
+// authorized rejects requests with another method, or without the bearer token.
+func (s *Server) authorized(token, method string, next stdhttp.HandlerFunc) stdhttp.HandlerFunc {
+	return func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		if r.Method != method {
+			w.WriteHeader(stdhttp.StatusMethodNotAllowed)
+			return
+		}
+
+		given, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !found || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			w.WriteHeader(stdhttp.StatusUnauthorized)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+func (s *Server) writeExport(w stdhttp.ResponseWriter, format export.Format, fileName string, data []byte, err error) {
+	if !format.IsValid() {
+		stdhttp.Error(w, fmt.Sprintf("invalid format: %s", format), stdhttp.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		s.log.Errorf("could not export: %v", err)
+		w.WriteHeader(stdhttp.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", exportContentTypes[format])
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	w.WriteHeader(stdhttp.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// requestedFormat returns the format from the query, CSV by default.
+func requestedFormat(r *stdhttp.Request) export.Format {
+	format := export.Format(r.URL.Query().Get("format"))
+	if format == "" {
+		return export.FormatCSV
+	}
+
+	return format
+}
@@ -0,0 +1,88 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mocks "spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/export"
+)
+
+func serveExport(srv *Server, method, target, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	srv.mux.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestExportEndpoints(t *testing.T) {
+	// given
+	srv := NewServer(":0", newTestLogger())
+	exp := &mocks.MockExportService{}
+	exp.On("ExportReservations", "guild-id", mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"), export.FormatJSON).
+		Return([]byte("[]"), nil)
+	exp.On("ExportSpots", export.FormatCSV).Return([]byte("name\nFlimsy\n"), nil)
+	srv.WithExport(exp, "secret")
+
+	// when
+	reservations := serveExport(srv, http.MethodGet, "/export/reservations?guild_id=guild-id&from=2026-10-01&to=2026-10-07&format=json", "secret", "")
+	spots := serveExport(srv, http.MethodGet, "/export/spots", "secret", "")
+	unauthorized := serveExport(srv, http.MethodGet, "/export/spots", "wrong", "")
+	withoutGuild := serveExport(srv, http.MethodGet, "/export/reservations", "secret", "")
+	invalidRange := serveExport(srv, http.MethodGet, "/export/reservations?guild_id=guild-id&from=yesterday", "secret", "")
+
+	// then
+	assert.Equal(t, http.StatusOK, reservations.Code)
+	assert.Equal(t, "application/json", reservations.Header().Get("Content-Type"))
+	assert.Equal(t, "[]", reservations.Body.String())
+	from := exp.Calls[0].Arguments.Get(1).(time.Time)
+	to := exp.Calls[0].Arguments.Get(2).(time.Time)
+	assert.Equal(t, "2026-10-01", from.Format(export.DateFormat))
+	assert.Equal(t, "2026-10-08", to.Format(export.DateFormat))
+	assert.Equal(t, http.StatusOK, spots.Code)
+	assert.Equal(t, `attachment; filename="spots.csv"`, spots.Header().Get("Content-Disposition"))
+	assert.Equal(t, "name\nFlimsy\n", spots.Body.String())
+	assert.Equal(t, http.StatusUnauthorized, unauthorized.Code)
+	assert.Equal(t, http.StatusBadRequest, withoutGuild.Code)
+	assert.Equal(t, http.StatusBadRequest, invalidRange.Code)
+}
+
+func TestImportSpotsEndpoint(t *testing.T) {
+	// given
+	srv := NewServer(":0", newTestLogger())
+	exp := &mocks.MockExportService{}
+	exp.On("ImportSpots", []byte("Flimsy\n"), export.FormatCSV, true).
+		Return(&export.ImportReport{DryRun: true, Added: []string{"Flimsy"}, Skipped: []export.SkippedSpot{}}, nil)
+	srv.WithExport(exp, "secret")
+
+	// when
+	rec := serveExport(srv, http.MethodPost, "/import/spots?format=csv", "secret", "Flimsy\n")
+	get := serveExport(srv, http.MethodGet, "/import/spots?format=csv", "secret", "")
+
+	// then
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"dry_run": true, "added": ["Flimsy"], "skipped": []}`, rec.Body.String())
+	assert.Equal(t, http.StatusMethodNotAllowed, get.Code)
+}
+
+func TestExportEndpointsWithoutToken(t *testing.T) {
+	// given
+	srv := NewServer(":0", newTestLogger())
+	srv.WithExport(&mocks.MockExportService{}, "")
+
+	// when
+	rec := serveExport(srv, http.MethodGet, "/export/spots", "", "")
+
+	// then
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -2,6 +2,7 @@ package prommetrics
 
 import (
 	"strconv"
+	"time"
 
 	prom "github.com/prometheus/client_golang/prometheus"
 )
@@ -15,7 +16,14 @@ type PromMetrics struct {
 	upcomingReservations *prom.GaugeVec
 	ticks                *prom.CounterVec
 	messagesSent         *prom.CounterVec
+	messagesEdited       *prom.CounterVec
 	messagesDeleted      *prom.CounterVec
+	summaryUpdates       *prom.CounterVec
+	summarySkips         *prom.CounterVec
+	domainEvents         *prom.CounterVec
+	worldApiRequests     *prom.HistogramVec
+	worldApiErrors       *prom.CounterVec
+	worldApiCache        *prom.CounterVec
 }
 
 // New creates and registers Prometheus metrics using the default registry.
@@ -57,15 +65,59 @@ func New() *PromMetrics {
 			Name:      "messages_sent_total",
 			Help:      "Total number of messages sent by the bot.",
 		}, []string{"channel_id", "channel_name"}),
+		messagesEdited: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: "letter_bot",
+			Subsystem: "discord",
+			Name:      "messages_edited_total",
+			Help:      "Total number of messages edited by the bot.",
+		}, []string{"channel_id", "channel_name"}),
 		messagesDeleted: prom.NewCounterVec(prom.CounterOpts{
 			Namespace: "letter_bot",
 			Subsystem: "discord",
 			Name:      "messages_deleted_total",
 			Help:      "Total number of messages deleted by the bot.",
 		}, []string{"channel_id", "channel_name"}),
+		summaryUpdates: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: "letter_bot",
+			Subsystem: "summary",
+			Name:      "updates_total",
+			Help:      "Total number of summary letters pushed to guilds.",
+		}, []string{"guild_id", "guild_name"}),
+		summarySkips: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: "letter_bot",
+			Subsystem: "summary",
+			Name:      "skips_total",
+			Help:      "Total number of summary refreshes skipped, because nothing changed.",
+		}, []string{"guild_id", "guild_name"}),
+		domainEvents: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: "letter_bot",
+			Subsystem: "events",
+			Name:      "published_total",
+			Help:      "Total number of domain events, e.g. reservations created or cancelled.",
+		}, []string{"guild_id", "event"}),
+		worldApiRequests: prom.NewHistogramVec(prom.HistogramOpts{
+			Namespace: "letter_bot",
+			Subsystem: "world_api",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of requests to the world API.",
+			Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, []string{"operation", "outcome"}),
+		worldApiErrors: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: "letter_bot",
+			Subsystem: "world_api",
+			Name:      "errors_total",
+			Help:      "Total number of failed world API calls, after retries.",
+		}, []string{"operation", "reason"}),
+		worldApiCache: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: "letter_bot",
+			Subsystem: "world_api",
+			Name:      "cache_lookups_total",
+			Help:      "Total number of lookups in the cache of online players.",
+		}, []string{"result"}),
 	}
 
-	prom.MustRegister(m.slashCommands, m.overbookInvocations, m.commandErrors, m.upcomingReservations, m.ticks, m.messagesSent, m.messagesDeleted)
+	prom.MustRegister(m.slashCommands, m.overbookInvocations, m.commandErrors, m.upcomingReservations, m.ticks, m.messagesSent, m.messagesEdited, m.messagesDeleted, m.summaryUpdates, m.summarySkips, m.domainEvents,
+		m.worldApiRequests, m.worldApiErrors, m.worldApiCache)
 
 	return m
 }
@@ -105,10 +157,45 @@ func (m *PromMetrics) IncMessagesSent(channelID, channelName string) {
 	m.messagesSent.WithLabelValues(channelID, channelName).Inc()
 }
 
+// IncMessagesEdited increments counter of messages edited by the bot.
+func (m *PromMetrics) IncMessagesEdited(channelID, channelName string) {
+	m.messagesEdited.WithLabelValues(channelID, channelName).Inc()
+}
+
 // AddMessagesDeleted adds to counter of messages deleted by the bot.
 func (m *PromMetrics) AddMessagesDeleted(channelID, channelName string, count int) {
 	m.messagesDeleted.WithLabelValues(channelID, channelName).Add(float64(count))
 }
 
+// IncSummaryUpdates increments counter of summary letters pushed to a guild.
+func (m *PromMetrics) IncSummaryUpdates(guildID, guildName string) {
+	m.summaryUpdates.WithLabelValues(guildID, guildName).Inc()
+}
+
+// IncSummarySkips increments counter of summary refreshes skipped, because nothing changed.
+func (m *PromMetrics) IncSummarySkips(guildID, guildName string) {
+	m.summarySkips.WithLabelValues(guildID, guildName).Inc()
+}
+
+// IncDomainEvent increments counter of domain events, e.g. reservations created or cancelled.
+func (m *PromMetrics) IncDomainEvent(guildID, event string) {
+	m.domainEvents.WithLabelValues(guildID, event).Inc()
+}
+
+// ObserveWorldApiRequest observes duration of a request to the world API.
+func (m *PromMetrics) ObserveWorldApiRequest(operation, outcome string, duration time.Duration) {
+	m.worldApiRequests.WithLabelValues(operation, outcome).Observe(duration.Seconds())
+}
+
+// IncWorldApiError increments counter of failed world API calls, after retries.
+func (m *PromMetrics) IncWorldApiError(operation, reason string) {
+	m.worldApiErrors.WithLabelValues(operation, reason).Inc()
+}
+
+// IncWorldApiCache increments counter of lookups in the cache of online players.
+func (m *PromMetrics) IncWorldApiCache(result string) {
+	m.worldApiCache.WithLabelValues(result).Inc()
+}
+
 // helper to quiet import usage in some contexts
 var _ = strconv.Itoa
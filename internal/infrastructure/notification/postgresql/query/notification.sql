@@ -0,0 +1,53 @@
+-- name: SelectNotificationChannel :one
+SELECT channel
+FROM member_notification
+WHERE guild_id = @guild_id AND member_id = @member_id AND kind = @kind
+LIMIT 1;
+
+-- name: SelectNotificationChannels :many
+SELECT kind, channel
+FROM member_notification
+WHERE guild_id = @guild_id AND member_id = @member_id;
+
+-- name: UpsertNotificationChannel :exec
+INSERT INTO member_notification (guild_id, member_id, kind, channel, created_at, updated_at)
+VALUES (@guild_id, @member_id, @kind, @channel, now(), now())
+ON CONFLICT (guild_id, member_id, kind)
+DO UPDATE SET channel = EXCLUDED.channel,
+              updated_at = now();
+
+-- name: ClaimDueNotifications :many
+UPDATE notification_outbox
+SET attempts = attempts + 1,
+    next_attempt_at = @lease_until,
+    updated_at = now()
+WHERE id IN (
+    SELECT due.id
+    FROM notification_outbox due
+    WHERE due.status = 'pending' AND due.next_attempt_at <= @now
+    ORDER BY due.next_attempt_at
+    LIMIT @max_notifications
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, guild_id, recipient_discord_id, kind, payload, attempts;
+
+-- name: MarkNotificationSent :exec
+UPDATE notification_outbox
+SET status = 'sent',
+    last_error = '',
+    updated_at = now()
+WHERE id = @id;
+
+-- name: ScheduleNotificationRetry :exec
+UPDATE notification_outbox
+SET next_attempt_at = @next_attempt_at,
+    last_error = @last_error,
+    updated_at = now()
+WHERE id = @id;
+
+-- name: MarkNotificationFailed :exec
+UPDATE notification_outbox
+SET status = 'failed',
+    last_error = @last_error,
+    updated_at = now()
+WHERE id = @id;
@@ -0,0 +1,70 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"spot-assistant/internal/core/dto/notification"
+)
+
+type NotificationPreferenceRepository struct {
+	q *Queries
+}
+
+func NewNotificationPreferenceRepository(db DBTX) *NotificationPreferenceRepository {
+	return &NotificationPreferenceRepository{
+		q: New(db),
+	}
+}
+
+// SelectNotificationChannel returns the channel chosen by a member for the kind of notifications,
+// or the default channel if the member has not chosen any.
+func (repo *NotificationPreferenceRepository) SelectNotificationChannel(ctx context.Context, guildID, memberID string, kind notification.Kind) (notification.Channel, error) {
+	channel, err := repo.q.SelectNotificationChannel(ctx, SelectNotificationChannelParams{
+		GuildID:  guildID,
+		MemberID: memberID,
+		Kind:     string(kind),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return notification.DefaultChannel, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return notification.Channel(channel), nil
+}
+
+// SelectNotificationChannels returns channels of all kinds of notifications of a member,
+// including the default ones.
+func (repo *NotificationPreferenceRepository) SelectNotificationChannels(ctx context.Context, guildID, memberID string) (map[notification.Kind]notification.Channel, error) {
+	rows, err := repo.q.SelectNotificationChannels(ctx, SelectNotificationChannelsParams{
+		GuildID:  guildID,
+		MemberID: memberID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	channels := make(map[notification.Kind]notification.Channel, len(notification.Kinds))
+	for _, kind := range notification.Kinds {
+		channels[kind] = notification.DefaultChannel
+	}
+	for _, row := range rows {
+		channels[notification.Kind(row.Kind)] = notification.Channel(row.Channel)
+	}
+
+	return channels, nil
+}
+
+// UpsertNotificationChannel saves the channel chosen by a member for the kind of notifications.
+func (repo *NotificationPreferenceRepository) UpsertNotificationChannel(ctx context.Context, guildID, memberID string, kind notification.Kind, channel notification.Channel) error {
+	return repo.q.UpsertNotificationChannel(ctx, UpsertNotificationChannelParams{
+		GuildID:  guildID,
+		MemberID: memberID,
+		Kind:     string(kind),
+		Channel:  string(channel),
+	})
+}
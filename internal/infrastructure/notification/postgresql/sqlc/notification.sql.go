@@ -0,0 +1,201 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: notification.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimDueNotifications = `-- name: ClaimDueNotifications :many
+UPDATE notification_outbox
+SET attempts = attempts + 1,
+    next_attempt_at = $1,
+    updated_at = now()
+WHERE id IN (
+    SELECT due.id
+    FROM notification_outbox due
+    WHERE due.status = 'pending' AND due.next_attempt_at <= $2
+    ORDER BY due.next_attempt_at
+    LIMIT $3
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, guild_id, recipient_discord_id, kind, payload, attempts
+`
+
+type ClaimDueNotificationsParams struct {
+	LeaseUntil       pgtype.Timestamptz
+	Now              pgtype.Timestamptz
+	MaxNotifications int32
+}
+
+type ClaimDueNotificationsRow struct {
+	ID                 int64
+	GuildID            string
+	RecipientDiscordID string
+	Kind               string
+	Payload            []byte
+	Attempts           int32
+}
+
+func (q *Queries) ClaimDueNotifications(ctx context.Context, arg ClaimDueNotificationsParams) ([]ClaimDueNotificationsRow, error) {
+	rows, err := q.db.Query(ctx, claimDueNotifications, arg.LeaseUntil, arg.Now, arg.MaxNotifications)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimDueNotificationsRow
+	for rows.Next() {
+		var i ClaimDueNotificationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GuildID,
+			&i.RecipientDiscordID,
+			&i.Kind,
+			&i.Payload,
+			&i.Attempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markNotificationFailed = `-- name: MarkNotificationFailed :exec
+UPDATE notification_outbox
+SET status = 'failed',
+    last_error = $1,
+    updated_at = now()
+WHERE id = $2
+`
+
+type MarkNotificationFailedParams struct {
+	LastError string
+	ID        int64
+}
+
+func (q *Queries) MarkNotificationFailed(ctx context.Context, arg MarkNotificationFailedParams) error {
+	_, err := q.db.Exec(ctx, markNotificationFailed, arg.LastError, arg.ID)
+	return err
+}
+
+const markNotificationSent = `-- name: MarkNotificationSent :exec
+UPDATE notification_outbox
+SET status = 'sent',
+    last_error = '',
+    updated_at = now()
+WHERE id = $1
+`
+
+func (q *Queries) MarkNotificationSent(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, markNotificationSent, id)
+	return err
+}
+
+const scheduleNotificationRetry = `-- name: ScheduleNotificationRetry :exec
+UPDATE notification_outbox
+SET next_attempt_at = $1,
+    last_error = $2,
+    updated_at = now()
+WHERE id = $3
+`
+
+type ScheduleNotificationRetryParams struct {
+	NextAttemptAt pgtype.Timestamptz
+	LastError     string
+	ID            int64
+}
+
+func (q *Queries) ScheduleNotificationRetry(ctx context.Context, arg ScheduleNotificationRetryParams) error {
+	_, err := q.db.Exec(ctx, scheduleNotificationRetry, arg.NextAttemptAt, arg.LastError, arg.ID)
+	return err
+}
+
+const selectNotificationChannel = `-- name: SelectNotificationChannel :one
+SELECT channel
+FROM member_notification
+WHERE guild_id = $1 AND member_id = $2 AND kind = $3
+LIMIT 1
+`
+
+type SelectNotificationChannelParams struct {
+	GuildID  string
+	MemberID string
+	Kind     string
+}
+
+func (q *Queries) SelectNotificationChannel(ctx context.Context, arg SelectNotificationChannelParams) (string, error) {
+	row := q.db.QueryRow(ctx, selectNotificationChannel, arg.GuildID, arg.MemberID, arg.Kind)
+	var channel string
+	err := row.Scan(&channel)
+	return channel, err
+}
+
+const selectNotificationChannels = `-- name: SelectNotificationChannels :many
+SELECT kind, channel
+FROM member_notification
+WHERE guild_id = $1 AND member_id = $2
+`
+
+type SelectNotificationChannelsParams struct {
+	GuildID  string
+	MemberID string
+}
+
+type SelectNotificationChannelsRow struct {
+	Kind    string
+	Channel string
+}
+
+func (q *Queries) SelectNotificationChannels(ctx context.Context, arg SelectNotificationChannelsParams) ([]SelectNotificationChannelsRow, error) {
+	rows, err := q.db.Query(ctx, selectNotificationChannels, arg.GuildID, arg.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectNotificationChannelsRow
+	for rows.Next() {
+		var i SelectNotificationChannelsRow
+		if err := rows.Scan(&i.Kind, &i.Channel); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertNotificationChannel = `-- name: UpsertNotificationChannel :exec
+INSERT INTO member_notification (guild_id, member_id, kind, channel, created_at, updated_at)
+VALUES ($1, $2, $3, $4, now(), now())
+ON CONFLICT (guild_id, member_id, kind)
+DO UPDATE SET channel = EXCLUDED.channel,
+              updated_at = now()
+`
+
+type UpsertNotificationChannelParams struct {
+	GuildID  string
+	MemberID string
+	Kind     string
+	Channel  string
+}
+
+func (q *Queries) UpsertNotificationChannel(ctx context.Context, arg UpsertNotificationChannelParams) error {
+	_, err := q.db.Exec(ctx, upsertNotificationChannel,
+		arg.GuildID,
+		arg.MemberID,
+		arg.Kind,
+		arg.Channel,
+	)
+	return err
+}
@@ -0,0 +1,61 @@
+package sqlc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/notification"
+)
+
+func TestSelectNotificationChannel(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	mock.ExpectQuery("SELECT channel FROM member_notification").
+		WithArgs("guild-id", "member-id", "overbook").
+		WillReturnRows(pgxmock.NewRows([]string{"channel"}).AddRow("mention"))
+	mock.ExpectQuery("SELECT channel FROM member_notification").
+		WithArgs("guild-id", "member-id", "reminder").
+		WillReturnError(pgx.ErrNoRows)
+	repo := NewNotificationPreferenceRepository(mock)
+
+	// when
+	overbook, overbookErr := repo.SelectNotificationChannel(context.Background(), "guild-id", "member-id", notification.KindOverbook)
+	reminder, reminderErr := repo.SelectNotificationChannel(context.Background(), "guild-id", "member-id", notification.KindReminder)
+
+	// then
+	assert.NoError(overbookErr)
+	assert.Equal(notification.ChannelMention, overbook)
+	assert.NoError(reminderErr)
+	assert.Equal(notification.DefaultChannel, reminder)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestSelectNotificationChannels(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	mock.ExpectQuery("SELECT kind, channel FROM member_notification").
+		WithArgs("guild-id", "member-id").
+		WillReturnRows(pgxmock.NewRows([]string{"kind", "channel"}).AddRow("reminder", "none"))
+	repo := NewNotificationPreferenceRepository(mock)
+
+	// when
+	channels, err := repo.SelectNotificationChannels(context.Background(), "guild-id", "member-id")
+
+	// then
+	assert.NoError(err)
+	assert.Equal(map[notification.Kind]notification.Channel{
+		notification.KindOverbook: notification.ChannelDM,
+		notification.KindReminder: notification.ChannelNone,
+	}, channels)
+	assert.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,71 @@
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"spot-assistant/internal/core/dto/notification"
+)
+
+type NotificationOutboxRepository struct {
+	q *Queries
+}
+
+func NewNotificationOutboxRepository(db DBTX) *NotificationOutboxRepository {
+	return &NotificationOutboxRepository{
+		q: New(db),
+	}
+}
+
+// ClaimDueNotifications counts an attempt of pending notifications due at the time and leases them
+// until the given time. Notifications locked by other instances are skipped, so every notification
+// is attempted by one instance at a time, and a notification of a crashed instance is attempted
+// again once its lease runs out.
+func (repo *NotificationOutboxRepository) ClaimDueNotifications(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*notification.Outbox, error) {
+	rows, err := repo.q.ClaimDueNotifications(ctx, ClaimDueNotificationsParams{
+		LeaseUntil:       pgtype.Timestamptz{Time: leaseUntil, Valid: true},
+		Now:              pgtype.Timestamptz{Time: now, Valid: true},
+		MaxNotifications: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]*notification.Outbox, len(rows))
+	for i, row := range rows {
+		notifications[i] = &notification.Outbox{
+			ID:          row.ID,
+			GuildID:     row.GuildID,
+			RecipientID: row.RecipientDiscordID,
+			Kind:        notification.Kind(row.Kind),
+			Payload:     row.Payload,
+			Attempts:    int(row.Attempts),
+		}
+	}
+
+	return notifications, nil
+}
+
+// MarkNotificationSent marks a notification as sent.
+func (repo *NotificationOutboxRepository) MarkNotificationSent(ctx context.Context, id int64) error {
+	return repo.q.MarkNotificationSent(ctx, id)
+}
+
+// ScheduleNotificationRetry records a failed attempt of a notification and schedules the next one.
+func (repo *NotificationOutboxRepository) ScheduleNotificationRetry(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error {
+	return repo.q.ScheduleNotificationRetry(ctx, ScheduleNotificationRetryParams{
+		NextAttemptAt: pgtype.Timestamptz{Time: nextAttemptAt, Valid: true},
+		LastError:     lastError,
+		ID:            id,
+	})
+}
+
+// MarkNotificationFailed records the last failed attempt of a notification, which is not attempted anymore.
+func (repo *NotificationOutboxRepository) MarkNotificationFailed(ctx context.Context, id int64, lastError string) error {
+	return repo.q.MarkNotificationFailed(ctx, MarkNotificationFailedParams{
+		LastError: lastError,
+		ID:        id,
+	})
+}
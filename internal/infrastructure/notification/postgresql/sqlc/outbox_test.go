@@ -0,0 +1,73 @@
+package sqlc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/notification"
+)
+
+func TestClaimDueNotifications(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	leaseUntil := now.Add(5 * time.Minute)
+	mock.ExpectQuery("UPDATE notification_outbox").
+		WithArgs(mocks.NewPgTimestamptzTime(leaseUntil), mocks.NewPgTimestamptzTime(now), int32(20)).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "guild_id", "recipient_discord_id", "kind", "payload", "attempts"}).
+			AddRow(int64(1), "guild-id", "member-id", "overbook", []byte(`{"spot":"Flimsy"}`), int32(2)))
+	repo := NewNotificationOutboxRepository(mock)
+
+	// when
+	pending, err := repo.ClaimDueNotifications(context.Background(), now, leaseUntil, 20)
+
+	// then
+	assert.NoError(err)
+	assert.Equal([]*notification.Outbox{{
+		ID:          1,
+		GuildID:     "guild-id",
+		RecipientID: "member-id",
+		Kind:        notification.KindOverbook,
+		Payload:     []byte(`{"spot":"Flimsy"}`),
+		Attempts:    2,
+	}}, pending)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestRecordNotificationAttempts(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	nextAttemptAt := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectExec("UPDATE notification_outbox SET status = 'sent'").
+		WithArgs(int64(1)).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec("UPDATE notification_outbox SET next_attempt_at").
+		WithArgs(mocks.NewPgTimestamptzTime(nextAttemptAt), "connection reset", int64(2)).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec("UPDATE notification_outbox SET status = 'failed'").
+		WithArgs("unknown member", int64(3)).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	repo := NewNotificationOutboxRepository(mock)
+
+	// when
+	sentErr := repo.MarkNotificationSent(context.Background(), 1)
+	retryErr := repo.ScheduleNotificationRetry(context.Background(), 2, nextAttemptAt, "connection reset")
+	failedErr := repo.MarkNotificationFailed(context.Background(), 3, "unknown member")
+
+	// then
+	assert.NoError(sentErr)
+	assert.NoError(retryErr)
+	assert.NoError(failedErr)
+	assert.NoError(mock.ExpectationsWereMet())
+}
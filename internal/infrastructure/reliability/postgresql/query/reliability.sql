@@ -0,0 +1,27 @@
+-- name: InsertIncident :exec
+INSERT INTO reservation_incident (guild_id, member_id, reservation_id, kind, start_at, occurred_at)
+VALUES (@guild_id, @member_id, @reservation_id, @kind, @start_at, @occurred_at)
+ON CONFLICT (reservation_id, kind) DO NOTHING;
+
+-- name: SelectMemberHistory :one
+WITH ended AS (
+    SELECT a.samples, a.online_samples
+    FROM web_reservation r
+    LEFT JOIN reservation_attendance a ON a.reservation_id = r.id
+    WHERE r.guild_id = @guild_id
+      AND r.author_discord_id = @member_id
+      AND r.end_at > @since
+      AND r.end_at <= @now
+), incidents AS (
+    SELECT i.kind
+    FROM reservation_incident i
+    WHERE i.guild_id = @guild_id
+      AND i.member_id = @member_id
+      AND i.occurred_at > @since
+      AND i.occurred_at <= @now
+)
+SELECT (SELECT count(*) FROM ended)::integer AS reservations,
+       (SELECT COALESCE(sum(CASE WHEN COALESCE(samples, 0) = 0 THEN 1 ELSE online_samples::float8 / samples END), 0) FROM ended)::float8 AS attended,
+       (SELECT count(*) FROM ended WHERE samples > 0 AND online_samples = 0)::integer AS no_shows,
+       (SELECT count(*) FROM incidents WHERE kind = 'late_cancellation')::integer AS late_cancellations,
+       (SELECT count(*) FROM incidents WHERE kind = 'abandoned')::integer AS abandoned;
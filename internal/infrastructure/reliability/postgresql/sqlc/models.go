@@ -0,0 +1,265 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+
+package sqlc
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type AuthGroup struct {
+	ID   int32
+	Name string
+}
+
+type AuthGroupPermission struct {
+	ID           int64
+	GroupID      int32
+	PermissionID int32
+}
+
+type AuthPermission struct {
+	ID            int32
+	Name          string
+	ContentTypeID int32
+	Codename      string
+}
+
+type AuthUser struct {
+	ID          int32
+	Password    string
+	LastLogin   pgtype.Timestamptz
+	IsSuperuser bool
+	Username    string
+	FirstName   string
+	LastName    string
+	Email       string
+	IsStaff     bool
+	IsActive    bool
+	DateJoined  pgtype.Timestamptz
+}
+
+type AuthUserGroup struct {
+	ID      int64
+	UserID  int32
+	GroupID int32
+}
+
+type AuthUserUserPermission struct {
+	ID           int64
+	UserID       int32
+	PermissionID int32
+}
+
+type CalendarFeed struct {
+	GuildID   string
+	MemberID  string
+	Nonce     string
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type DjangoAdminLog struct {
+	ID            int32
+	ActionTime    pgtype.Timestamptz
+	ObjectID      pgtype.Text
+	ObjectRepr    string
+	ActionFlag    int16
+	ChangeMessage string
+	ContentTypeID pgtype.Int4
+	UserID        int32
+}
+
+type DjangoContentType struct {
+	ID       int32
+	AppLabel string
+	Model    string
+}
+
+type DjangoMigration struct {
+	ID      int64
+	App     string
+	Name    string
+	Applied pgtype.Timestamptz
+}
+
+type DjangoSession struct {
+	SessionKey  string
+	SessionData string
+	ExpireDate  pgtype.Timestamptz
+}
+
+type GuildSetting struct {
+	GuildID                  string
+	SummaryChart             string
+	SummaryLayout            string
+	SummaryChannelID         string
+	CommandChannelID         string
+	PrivilegedRoleID         string
+	BookingChannelIds        []string
+	MirrorBookings           bool
+	FavouriteSpots           []string
+	BrandingTitle            string
+	BrandingUrl              string
+	BrandingDescription      string
+	BrandingColor            int32
+	BrandingThumbnailUrl     string
+	BrandingPreMessage       string
+	BrandingHidePreMessage   bool
+	ReminderMinutesBefore    int32
+	ReliabilityMinScore      int32
+	ReliabilityMaxHoursAhead int32
+	ReliabilityOverbookDm    bool
+	TibiaGuild               string
+	SpotRequirements         bool
+	CreatedAt                pgtype.Timestamptz
+	UpdatedAt                pgtype.Timestamptz
+}
+
+type GuildsWorld struct {
+	ID        int64
+	GuildID   string
+	WorldName string
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type MemberCharacter struct {
+	ID               int64
+	GuildID          string
+	MemberID         string
+	Name             string
+	VerificationCode string
+	VerifiedAt       pgtype.Timestamptz
+	CreatedAt        pgtype.Timestamptz
+	WorldName        string
+}
+
+type MemberNotification struct {
+	GuildID   string
+	MemberID  string
+	Kind      string
+	Channel   string
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type MemberReminder struct {
+	GuildID       string
+	MemberID      string
+	MinutesBefore int32
+	CreatedAt     pgtype.Timestamptz
+	UpdatedAt     pgtype.Timestamptz
+}
+
+type NotificationOutbox struct {
+	ID                 int64
+	GuildID            string
+	RecipientDiscordID string
+	Kind               string
+	Payload            []byte
+	Status             string
+	Attempts           int32
+	NextAttemptAt      pgtype.Timestamptz
+	LastError          string
+	CreatedAt          pgtype.Timestamptz
+	UpdatedAt          pgtype.Timestamptz
+}
+
+type ReservationAttendance struct {
+	ReservationID int64
+	Samples       int32
+	OnlineSamples int32
+	FirstOnlineAt pgtype.Timestamptz
+	LastSampledAt pgtype.Timestamptz
+}
+
+type ReservationIncident struct {
+	ID            int64
+	GuildID       string
+	MemberID      string
+	ReservationID int64
+	Kind          string
+	StartAt       pgtype.Timestamptz
+	OccurredAt    pgtype.Timestamptz
+}
+
+type ReservationReminder struct {
+	GuildID         string
+	AuthorDiscordID string
+	SpotID          int64
+	StartAt         pgtype.Timestamptz
+	SentAt          pgtype.Timestamptz
+}
+
+type SummaryMessage struct {
+	ID        int64
+	GuildID   string
+	ChannelID string
+	MessageID string
+	Position  int32
+	CreatedAt pgtype.Timestamptz
+}
+
+type TibiaWorld struct {
+	Name        string
+	RefreshedAt pgtype.Timestamptz
+}
+
+type WebReservation struct {
+	ID              int64
+	Author          string
+	CreatedAt       pgtype.Timestamptz
+	StartAt         pgtype.Timestamptz
+	EndAt           pgtype.Timestamptz
+	SpotID          int64
+	GuildID         string
+	AuthorDiscordID string
+}
+
+type WebSpot struct {
+	ID        int64
+	Name      string
+	CreatedAt pgtype.Timestamptz
+	MinLevel  int32
+	MaxLevel  int32
+	Vocations []string
+}
+
+type Webhook struct {
+	ID        int64
+	GuildID   string
+	Url       string
+	Secret    string
+	CreatedAt pgtype.Timestamptz
+}
+
+type WebhookDeadLetter struct {
+	ID             int64
+	DeliveryID     int64
+	WebhookID      int64
+	GuildID        string
+	Url            string
+	Event          string
+	Payload        []byte
+	Attempts       int32
+	ResponseStatus int32
+	LastError      string
+	CreatedAt      pgtype.Timestamptz
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	WebhookID      int64
+	GuildID        string
+	Event          string
+	Payload        []byte
+	Status         string
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamptz
+	ResponseStatus int32
+	LastError      string
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+}
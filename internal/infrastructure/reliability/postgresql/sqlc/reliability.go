@@ -0,0 +1,53 @@
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"spot-assistant/internal/core/dto/reliability"
+)
+
+type ReliabilityRepository struct {
+	q *Queries
+}
+
+func NewReliabilityRepository(db DBTX) *ReliabilityRepository {
+	return &ReliabilityRepository{
+		q: New(db),
+	}
+}
+
+// InsertIncident records an incident of a reservation, unless it has been recorded already.
+func (repo *ReliabilityRepository) InsertIncident(ctx context.Context, incident *reliability.Incident) error {
+	return repo.q.InsertIncident(ctx, InsertIncidentParams{
+		GuildID:       incident.GuildID,
+		MemberID:      incident.MemberID,
+		ReservationID: incident.ReservationID,
+		Kind:          string(incident.Kind),
+		StartAt:       pgtype.Timestamptz{Time: incident.StartAt, Valid: true},
+		OccurredAt:    pgtype.Timestamptz{Time: incident.OccurredAt, Valid: true},
+	})
+}
+
+// SelectMemberHistory sums up reservations of a member, which ended, or had an incident, between since and now.
+func (repo *ReliabilityRepository) SelectMemberHistory(ctx context.Context, guildID, memberID string, since, now time.Time) (reliability.History, error) {
+	row, err := repo.q.SelectMemberHistory(ctx, SelectMemberHistoryParams{
+		GuildID:  guildID,
+		MemberID: memberID,
+		Since:    pgtype.Timestamptz{Time: since, Valid: true},
+		Now:      pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		return reliability.History{}, err
+	}
+
+	return reliability.History{
+		Reservations:      int(row.Reservations),
+		Attended:          row.Attended,
+		NoShows:           int(row.NoShows),
+		LateCancellations: int(row.LateCancellations),
+		Abandoned:         int(row.Abandoned),
+	}, nil
+}
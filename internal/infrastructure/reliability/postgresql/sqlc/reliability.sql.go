@@ -0,0 +1,96 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: reliability.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const insertIncident = `-- name: InsertIncident :exec
+INSERT INTO reservation_incident (guild_id, member_id, reservation_id, kind, start_at, occurred_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (reservation_id, kind) DO NOTHING
+`
+
+type InsertIncidentParams struct {
+	GuildID       string
+	MemberID      string
+	ReservationID int64
+	Kind          string
+	StartAt       pgtype.Timestamptz
+	OccurredAt    pgtype.Timestamptz
+}
+
+func (q *Queries) InsertIncident(ctx context.Context, arg InsertIncidentParams) error {
+	_, err := q.db.Exec(ctx, insertIncident,
+		arg.GuildID,
+		arg.MemberID,
+		arg.ReservationID,
+		arg.Kind,
+		arg.StartAt,
+		arg.OccurredAt,
+	)
+	return err
+}
+
+const selectMemberHistory = `-- name: SelectMemberHistory :one
+WITH ended AS (
+    SELECT a.samples, a.online_samples
+    FROM web_reservation r
+    LEFT JOIN reservation_attendance a ON a.reservation_id = r.id
+    WHERE r.guild_id = $1
+      AND r.author_discord_id = $2
+      AND r.end_at > $3
+      AND r.end_at <= $4
+), incidents AS (
+    SELECT i.kind
+    FROM reservation_incident i
+    WHERE i.guild_id = $1
+      AND i.member_id = $2
+      AND i.occurred_at > $3
+      AND i.occurred_at <= $4
+)
+SELECT (SELECT count(*) FROM ended)::integer AS reservations,
+       (SELECT COALESCE(sum(CASE WHEN COALESCE(samples, 0) = 0 THEN 1 ELSE online_samples::float8 / samples END), 0) FROM ended)::float8 AS attended,
+       (SELECT count(*) FROM ended WHERE samples > 0 AND online_samples = 0)::integer AS no_shows,
+       (SELECT count(*) FROM incidents WHERE kind = 'late_cancellation')::integer AS late_cancellations,
+       (SELECT count(*) FROM incidents WHERE kind = 'abandoned')::integer AS abandoned
+`
+
+type SelectMemberHistoryParams struct {
+	GuildID  string
+	MemberID string
+	Since    pgtype.Timestamptz
+	Now      pgtype.Timestamptz
+}
+
+type SelectMemberHistoryRow struct {
+	Reservations      int32
+	Attended          float64
+	NoShows           int32
+	LateCancellations int32
+	Abandoned         int32
+}
+
+func (q *Queries) SelectMemberHistory(ctx context.Context, arg SelectMemberHistoryParams) (SelectMemberHistoryRow, error) {
+	row := q.db.QueryRow(ctx, selectMemberHistory,
+		arg.GuildID,
+		arg.MemberID,
+		arg.Since,
+		arg.Now,
+	)
+	var i SelectMemberHistoryRow
+	err := row.Scan(
+		&i.Reservations,
+		&i.Attended,
+		&i.NoShows,
+		&i.LateCancellations,
+		&i.Abandoned,
+	)
+	return i, err
+}
@@ -0,0 +1,66 @@
+package sqlc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/reliability"
+)
+
+func TestInsertIncident(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	startAt := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	occurredAt := startAt.Add(-30 * time.Minute)
+	mock.ExpectExec("INSERT INTO reservation_incident").
+		WithArgs("guild-id", "member-id", int64(1), "late_cancellation",
+			pgtype.Timestamptz{Time: startAt, Valid: true}, pgtype.Timestamptz{Time: occurredAt, Valid: true}).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	repo := NewReliabilityRepository(mock)
+
+	// when
+	err = repo.InsertIncident(context.Background(), &reliability.Incident{
+		GuildID:       "guild-id",
+		MemberID:      "member-id",
+		ReservationID: 1,
+		Kind:          reliability.IncidentLateCancellation,
+		StartAt:       startAt,
+		OccurredAt:    occurredAt,
+	})
+
+	// then
+	assert.NoError(err)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestSelectMemberHistory(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	now := time.Date(2021, 1, 31, 12, 0, 0, 0, time.UTC)
+	since := now.Add(-reliability.Window)
+	rows := pgxmock.NewRows([]string{"reservations", "attended", "no_shows", "late_cancellations", "abandoned"}).
+		AddRow(int32(5), 3.5, int32(1), int32(2), int32(1))
+	mock.ExpectQuery("WITH ended AS").
+		WithArgs("guild-id", "member-id", pgtype.Timestamptz{Time: since, Valid: true}, pgtype.Timestamptz{Time: now, Valid: true}).
+		WillReturnRows(rows)
+	repo := NewReliabilityRepository(mock)
+
+	// when
+	history, err := repo.SelectMemberHistory(context.Background(), "guild-id", "member-id", since, now)
+
+	// then
+	assert.NoError(err)
+	assert.Equal(reliability.History{Reservations: 5, Attended: 3.5, NoShows: 1, LateCancellations: 2, Abandoned: 1}, history)
+	assert.NoError(mock.ExpectationsWereMet())
+}
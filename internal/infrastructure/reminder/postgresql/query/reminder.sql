@@ -0,0 +1,59 @@
+-- name: SelectDueReminders :many
+SELECT r.id, r.author, r.created_at, r.start_at, r.end_at, r.spot_id, r.guild_id, r.author_discord_id,
+       s.name AS spot_name,
+       COALESCE(mr.minutes_before, gs.reminder_minutes_before, 0)::integer AS minutes_before,
+       COALESCE(prev.author, '')::text AS previous_author,
+       COALESCE(prev.author_discord_id, '')::text AS previous_author_discord_id,
+       prev.end_at AS previous_end_at
+FROM web_reservation r
+JOIN web_spot s ON s.id = r.spot_id
+LEFT JOIN member_reminder mr ON mr.guild_id = r.guild_id AND mr.member_id = r.author_discord_id
+LEFT JOIN guild_settings gs ON gs.guild_id = r.guild_id
+LEFT JOIN LATERAL (
+    SELECT p.author, p.author_discord_id, p.end_at
+    FROM web_reservation p
+    WHERE p.guild_id = r.guild_id
+      AND p.spot_id = r.spot_id
+      AND p.end_at <= r.start_at
+      AND p.end_at > @now
+    ORDER BY p.end_at DESC
+    LIMIT 1
+) prev ON true
+WHERE r.start_at > @now
+  AND COALESCE(mr.minutes_before, gs.reminder_minutes_before, 0) > 0
+  AND LEAST(r.start_at, COALESCE(prev.end_at, r.start_at))
+      - COALESCE(mr.minutes_before, gs.reminder_minutes_before, 0) * interval '1 minute' <= @now
+  AND NOT EXISTS (
+    SELECT 1 FROM reservation_reminder rr
+    WHERE rr.guild_id = r.guild_id
+      AND rr.author_discord_id = r.author_discord_id
+      AND rr.spot_id = r.spot_id
+      AND rr.start_at = r.start_at
+  )
+ORDER BY r.start_at;
+
+-- name: ClaimReminder :execrows
+INSERT INTO reservation_reminder (guild_id, author_discord_id, spot_id, start_at, sent_at)
+VALUES (@guild_id, @author_discord_id, @spot_id, @start_at, now())
+ON CONFLICT (guild_id, author_discord_id, spot_id, start_at) DO NOTHING;
+
+-- name: DeleteReminderClaimsBefore :exec
+DELETE FROM reservation_reminder
+WHERE start_at < @before;
+
+-- name: SelectMemberReminder :one
+SELECT minutes_before
+FROM member_reminder
+WHERE guild_id = @guild_id AND member_id = @member_id
+LIMIT 1;
+
+-- name: UpsertMemberReminder :exec
+INSERT INTO member_reminder (guild_id, member_id, minutes_before, created_at, updated_at)
+VALUES (@guild_id, @member_id, @minutes_before, now(), now())
+ON CONFLICT (guild_id, member_id)
+DO UPDATE SET minutes_before = EXCLUDED.minutes_before,
+              updated_at = now();
+
+-- name: DeleteMemberReminder :exec
+DELETE FROM member_reminder
+WHERE guild_id = @guild_id AND member_id = @member_id;
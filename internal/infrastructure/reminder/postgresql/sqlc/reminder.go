@@ -0,0 +1,125 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"spot-assistant/internal/core/dto/reminder"
+	"spot-assistant/internal/core/dto/reservation"
+)
+
+type ReminderRepository struct {
+	q *Queries
+}
+
+func NewReminderRepository(db DBTX) *ReminderRepository {
+	return &ReminderRepository{
+		q: New(db),
+	}
+}
+
+// SelectDueReminders returns reminders of reservations, which start soon enough for their owners
+// to be reminded, or follow a hunt on the same spot ending soon enough, and have not been reminded of yet.
+func (repo *ReminderRepository) SelectDueReminders(ctx context.Context, now time.Time) ([]*reminder.Reminder, error) {
+	rows, err := repo.q.SelectDueReminders(ctx, pgtype.Timestamptz{Time: now, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	reminders := make([]*reminder.Reminder, len(rows))
+	for i, row := range rows {
+		reminders[i] = &reminder.Reminder{
+			Reservation: reservation.ReservationWithSpot{
+				Reservation: reservation.Reservation{
+					ID:              row.ID,
+					Author:          row.Author,
+					CreatedAt:       row.CreatedAt.Time,
+					StartAt:         row.StartAt.Time,
+					EndAt:           row.EndAt.Time,
+					SpotID:          row.SpotID,
+					GuildID:         row.GuildID,
+					AuthorDiscordID: row.AuthorDiscordID,
+				},
+				Spot: reservation.Spot{
+					ID:   row.SpotID,
+					Name: row.SpotName,
+				},
+			},
+			MinutesBefore: int(row.MinutesBefore),
+		}
+		if row.PreviousEndAt.Valid {
+			reminders[i].Previous = &reminder.PreviousHunt{
+				Author:          row.PreviousAuthor,
+				AuthorDiscordID: row.PreviousAuthorDiscordID,
+				EndAt:           row.PreviousEndAt.Time,
+			}
+		}
+	}
+
+	return reminders, nil
+}
+
+// ClaimReminder marks the hunt of the reservation as reminded of. Returns false if it already was,
+// so every hunt is reminded of at most once, no matter how many instances run. The claim is kept
+// by the owner, spot and start of the hunt, rather than the reservation, so what is left
+// of a reservation after being overbooked is not reminded of again.
+func (repo *ReminderRepository) ClaimReminder(ctx context.Context, res reservation.Reservation) (bool, error) {
+	claimed, err := repo.q.ClaimReminder(ctx, ClaimReminderParams{
+		GuildID:         res.GuildID,
+		AuthorDiscordID: res.AuthorDiscordID,
+		SpotID:          res.SpotID,
+		StartAt:         pgtype.Timestamptz{Time: res.StartAt, Valid: true},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return claimed == 1, nil
+}
+
+// DeleteReminderClaimsBefore removes claims of hunts which started before the given time,
+// as they cannot be reminded of anymore.
+func (repo *ReminderRepository) DeleteReminderClaimsBefore(ctx context.Context, before time.Time) error {
+	return repo.q.DeleteReminderClaimsBefore(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+}
+
+// SelectMemberReminder returns the reminder preference of a member, or nil if the member follows the guild default.
+func (repo *ReminderRepository) SelectMemberReminder(ctx context.Context, guildID, memberID string) (*reminder.MemberReminder, error) {
+	minutesBefore, err := repo.q.SelectMemberReminder(ctx, SelectMemberReminderParams{
+		GuildID:  guildID,
+		MemberID: memberID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &reminder.MemberReminder{
+		GuildID:       guildID,
+		MemberID:      memberID,
+		MinutesBefore: int(minutesBefore),
+	}, nil
+}
+
+// UpsertMemberReminder saves the reminder preference of a member.
+func (repo *ReminderRepository) UpsertMemberReminder(ctx context.Context, memberReminder *reminder.MemberReminder) error {
+	return repo.q.UpsertMemberReminder(ctx, UpsertMemberReminderParams{
+		GuildID:       memberReminder.GuildID,
+		MemberID:      memberReminder.MemberID,
+		MinutesBefore: int32(memberReminder.MinutesBefore),
+	})
+}
+
+// DeleteMemberReminder removes the reminder preference of a member, so the guild default applies.
+func (repo *ReminderRepository) DeleteMemberReminder(ctx context.Context, guildID, memberID string) error {
+	return repo.q.DeleteMemberReminder(ctx, DeleteMemberReminderParams{
+		GuildID:  guildID,
+		MemberID: memberID,
+	})
+}
@@ -0,0 +1,120 @@
+package sqlc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/reminder"
+	"spot-assistant/internal/core/dto/reservation"
+)
+
+func TestSelectDueReminders(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	startAt := pgtype.Timestamptz{Time: now.Add(10 * time.Minute), Valid: true}
+	endAt := pgtype.Timestamptz{Time: now.Add(2 * time.Hour), Valid: true}
+	rows := pgxmock.NewRows([]string{
+		"id", "author", "created_at", "start_at", "end_at", "spot_id", "guild_id", "author_discord_id",
+		"spot_name", "minutes_before", "previous_author", "previous_author_discord_id", "previous_end_at",
+	}).
+		AddRow(int64(1), "author", startAt, startAt, endAt, int64(2), "guild-id", "author-id", "Flimsy", int32(15), "", "", pgtype.Timestamptz{}).
+		AddRow(int64(3), "author", startAt, startAt, endAt, int64(4), "guild-id", "author-id", "Issavi", int32(30), "previous", "previous-id", startAt)
+	mock.ExpectQuery("SELECT (.+) FROM web_reservation r").
+		WithArgs(pgtype.Timestamptz{Time: now, Valid: true}).
+		WillReturnRows(rows)
+	repo := NewReminderRepository(mock)
+
+	// when
+	reminders, err := repo.SelectDueReminders(context.Background(), now)
+
+	// then
+	assert.NoError(err)
+	assert.Len(reminders, 2)
+	assert.Equal(int64(1), reminders[0].Reservation.Reservation.ID)
+	assert.Equal("Flimsy", reminders[0].Reservation.Spot.Name)
+	assert.Equal(15, reminders[0].MinutesBefore)
+	assert.Nil(reminders[0].Previous)
+	assert.Equal(&reminder.PreviousHunt{Author: "previous", AuthorDiscordID: "previous-id", EndAt: startAt.Time}, reminders[1].Previous)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestClaimReminder(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	startAt := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	res := reservation.Reservation{ID: 1, GuildID: "guild-id", AuthorDiscordID: "author-id", SpotID: 2, StartAt: startAt}
+	clipped := res
+	clipped.ID = 3
+	args := []any{"guild-id", "author-id", int64(2), pgtype.Timestamptz{Time: startAt, Valid: true}}
+	mock.ExpectExec("INSERT INTO reservation_reminder").
+		WithArgs(args...).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec("INSERT INTO reservation_reminder").
+		WithArgs(args...).
+		WillReturnResult(pgxmock.NewResult("INSERT", 0))
+	repo := NewReminderRepository(mock)
+
+	// when
+	first, firstErr := repo.ClaimReminder(context.Background(), res)
+	second, secondErr := repo.ClaimReminder(context.Background(), clipped)
+
+	// then
+	assert.NoError(firstErr)
+	assert.True(first)
+	assert.NoError(secondErr)
+	assert.False(second)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDeleteReminderClaimsBefore(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectExec("DELETE FROM reservation_reminder").
+		WithArgs(pgtype.Timestamptz{Time: now, Valid: true}).
+		WillReturnResult(pgxmock.NewResult("DELETE", 2))
+	repo := NewReminderRepository(mock)
+
+	// when
+	err = repo.DeleteReminderClaimsBefore(context.Background(), now)
+
+	// then
+	assert.NoError(err)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestSelectMemberReminderNotSet(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	mock.ExpectQuery("SELECT minutes_before FROM member_reminder").
+		WithArgs("guild-id", "member-id").
+		WillReturnError(pgx.ErrNoRows)
+	repo := NewReminderRepository(mock)
+
+	// when
+	memberReminder, err := repo.SelectMemberReminder(context.Background(), "guild-id", "member-id")
+
+	// then
+	assert.NoError(err)
+	assert.Nil(memberReminder)
+	assert.NoError(mock.ExpectationsWereMet())
+}
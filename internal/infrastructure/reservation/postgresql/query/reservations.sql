@@ -73,4 +73,78 @@ where end_at >= now()
   AND lower(web_spot.name) = lower($2);
 -- name: DeleteReservation :exec
 DELETE FROM web_reservation
-WHERE web_reservation.id = $1;
\ No newline at end of file
+WHERE web_reservation.id = $1;
+
+-- name: SelectFilteredReservationsWithSpots :many
+select sqlc.embed(web_spot),
+  sqlc.embed(web_reservation)
+from web_reservation
+  inner join web_spot on web_reservation.spot_id = web_spot.id
+where web_reservation.end_at >= now()
+  AND web_reservation.guild_id = @guild_id
+  AND (
+    sqlc.narg(respawn)::text IS NULL
+    OR lower(web_spot.name) = lower(sqlc.narg(respawn))
+  )
+  AND (
+    sqlc.narg(author_discord_id)::text IS NULL
+    OR web_reservation.author_discord_id = sqlc.narg(author_discord_id)
+  )
+  AND (
+    sqlc.narg(window_start)::timestamptz IS NULL
+    OR web_reservation.end_at > sqlc.narg(window_start)
+  )
+  AND (
+    sqlc.narg(window_end)::timestamptz IS NULL
+    OR web_reservation.start_at < sqlc.narg(window_end)
+  )
+order by web_reservation.start_at asc;
+-- name: SelectFreeSpots :many
+select web_spot.name,
+  (
+    select min(upcoming.start_at)
+    from web_reservation upcoming
+    where upcoming.spot_id = web_spot.id
+      AND upcoming.guild_id = @guild_id
+      AND upcoming.start_at > now()
+  )::timestamptz AS next_start_at
+from web_spot
+where (
+    lower(web_spot.name) IN (
+      SELECT lower(unnest(guild_settings.favourite_spots))
+      FROM guild_settings
+      WHERE guild_settings.guild_id = @guild_id
+    )
+    OR EXISTS (
+      SELECT 1
+      FROM web_reservation upcoming
+      WHERE upcoming.spot_id = web_spot.id
+        AND upcoming.guild_id = @guild_id
+        AND upcoming.end_at >= now()
+    )
+  )
+  AND (
+    sqlc.narg(respawn)::text IS NULL
+    OR lower(web_spot.name) = lower(sqlc.narg(respawn))
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM web_reservation occupying
+    WHERE occupying.spot_id = web_spot.id
+      AND occupying.guild_id = @guild_id
+      AND occupying.start_at <= now()
+      AND occupying.end_at > now()
+  )
+order by web_spot.name asc;
+-- name: SelectReservationsWithSpotsInRange :many
+select sqlc.embed(web_spot),
+  sqlc.embed(web_reservation)
+from web_reservation
+  inner join web_spot on web_reservation.spot_id = web_spot.id
+where web_reservation.guild_id = @guild_id
+  AND web_reservation.end_at > @range_start
+  AND web_reservation.start_at < @range_end
+order by web_reservation.start_at asc;
+-- name: InsertNotificationOutbox :exec
+INSERT INTO notification_outbox (guild_id, recipient_discord_id, kind, payload, status, attempts, next_attempt_at, created_at, updated_at)
+VALUES (@guild_id, @recipient_discord_id, @kind, @payload, 'pending', 0, now(), now(), now());
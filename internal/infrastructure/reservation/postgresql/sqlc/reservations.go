@@ -2,6 +2,7 @@ package sqlc
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -11,7 +12,9 @@ import (
 	"spot-assistant/internal/common/errors"
 	"spot-assistant/internal/core/dto/guild"
 	"spot-assistant/internal/core/dto/member"
+	"spot-assistant/internal/core/dto/notification"
 	"spot-assistant/internal/core/dto/reservation"
+	"spot-assistant/internal/core/dto/spot"
 )
 
 type DBTXWrapper interface {
@@ -94,6 +97,65 @@ func (t *ReservationRepository) SelectUpcomingReservationsWithSpotForSpot(ctx co
 	return reservationsWithSpots, nil
 }
 
+func (t *ReservationRepository) SelectFilteredReservationsWithSpot(ctx context.Context, filter reservation.Filter) ([]*reservation.ReservationWithSpot, error) {
+	res, err := t.q.SelectFilteredReservationsWithSpots(ctx, SelectFilteredReservationsWithSpotsParams{
+		GuildID:         filter.GuildID,
+		Respawn:         optionalText(filter.SpotName),
+		AuthorDiscordID: optionalText(filter.AuthorDiscordID),
+		WindowStart:     optionalTimestamptz(filter.From),
+		WindowEnd:       optionalTimestamptz(filter.To),
+	})
+	if err != nil {
+		return []*reservation.ReservationWithSpot{}, err
+	}
+
+	reservationsWithSpots := make([]*reservation.ReservationWithSpot, len(res))
+	for i, reservationWithSpotRow := range res {
+		reservationsWithSpots[i] = mapReservationWithSpot(reservationWithSpotRow.WebReservation, reservationWithSpotRow.WebSpot)
+	}
+
+	return reservationsWithSpots, nil
+}
+
+// SelectFreeSpots returns spots of a guild, which nobody is hunting on right now, along with the start
+// of their next reservation. Spots of a guild are its favourites and the ones it has upcoming reservations of.
+// Only the spot with a given name is returned, unless the name is empty.
+func (t *ReservationRepository) SelectFreeSpots(ctx context.Context, guildID, spotName string) ([]*reservation.FreeSpot, error) {
+	res, err := t.q.SelectFreeSpots(ctx, SelectFreeSpotsParams{
+		GuildID: guildID,
+		Respawn: optionalText(spotName),
+	})
+	if err != nil {
+		return []*reservation.FreeSpot{}, err
+	}
+
+	freeSpots := make([]*reservation.FreeSpot, len(res))
+	for i, row := range res {
+		freeSpots[i] = &reservation.FreeSpot{Name: row.Name, NextStartAt: row.NextStartAt.Time}
+	}
+
+	return freeSpots, nil
+}
+
+// SelectReservationsWithSpotInRange returns reservations of a guild overlapping the range, including past ones.
+func (t *ReservationRepository) SelectReservationsWithSpotInRange(ctx context.Context, guildID string, from, to time.Time) ([]*reservation.ReservationWithSpot, error) {
+	res, err := t.q.SelectReservationsWithSpotsInRange(ctx, SelectReservationsWithSpotsInRangeParams{
+		GuildID:    guildID,
+		RangeStart: pgtype.Timestamptz{Time: from, Valid: true},
+		RangeEnd:   pgtype.Timestamptz{Time: to, Valid: true},
+	})
+	if err != nil {
+		return []*reservation.ReservationWithSpot{}, err
+	}
+
+	reservationsWithSpots := make([]*reservation.ReservationWithSpot, len(res))
+	for i, reservationWithSpotRow := range res {
+		reservationsWithSpots[i] = mapReservationWithSpot(reservationWithSpotRow.WebReservation, reservationWithSpotRow.WebSpot)
+	}
+
+	return reservationsWithSpots, nil
+}
+
 func (t *ReservationRepository) SelectOverlappingReservations(ctx context.Context, spot string, startAt time.Time, endAt time.Time, guildId string) ([]*reservation.Reservation, error) {
 	res, err := t.q.SelectOverlappingReservations(ctx, SelectOverlappingReservationsParams{
 		StartAt: startAt,
@@ -120,11 +182,11 @@ func (t *ReservationRepository) SelectOverlappingReservations(ctx context.Contex
 	return reservations, nil
 }
 
-func (t *ReservationRepository) CreateAndDeleteConflicting(ctx context.Context, member *member.Member, guild *guild.Guild, conflicts []*reservation.Reservation, spotId int64, startAt time.Time, endAt time.Time) ([]*reservation.ClippedOrRemovedReservation, error) {
+func (t *ReservationRepository) CreateAndDeleteConflicting(ctx context.Context, member *member.Member, guild *guild.Guild, conflicts []*reservation.Reservation, spot *spot.Spot, startAt time.Time, endAt time.Time) (*reservation.Reservation, []*reservation.ClippedOrRemovedReservation, error) {
 	modifiedConflicts := make([]*reservation.ClippedOrRemovedReservation, len(conflicts))
 	tx, err := t.db.Begin(ctx)
 	if err != nil {
-		return modifiedConflicts, err
+		return nil, modifiedConflicts, err
 	}
 	defer errors.ExecuteAndIgnoreErrorF(tx.Rollback, ctx)
 	qtx := t.q.WithTx(tx)
@@ -136,13 +198,13 @@ func (t *ReservationRepository) CreateAndDeleteConflicting(ctx context.Context,
 		}
 		err = qtx.DeleteReservation(ctx, conflictingReservation.ID)
 		if err != nil {
-			return modifiedConflicts, err
+			return nil, modifiedConflicts, err
 		}
 
 		if conflictingReservation.AuthorDiscordID != member.ID {
-			createdLeftovers, err := t.createOverbookedLeftovers(ctx, qtx, conflictingReservation, spotId, startAt, endAt)
+			createdLeftovers, err := t.createOverbookedLeftovers(ctx, qtx, conflictingReservation, spot.ID, startAt, endAt)
 			if err != nil {
-				return modifiedConflicts, err
+				return nil, modifiedConflicts, err
 			}
 
 			for _, leftover := range createdLeftovers {
@@ -159,19 +221,24 @@ func (t *ReservationRepository) CreateAndDeleteConflicting(ctx context.Context,
 					},
 				)
 			}
+
+			err = t.enqueueOverbookNotification(ctx, qtx, member, guild, spot, modifiedConflicts[index])
+			if err != nil {
+				return nil, modifiedConflicts, err
+			}
 		}
 	}
 
 	startAtInput := pgtype.Timestamptz{}
 	err = startAtInput.Scan(startAt)
 	if err != nil {
-		return modifiedConflicts, err
+		return nil, modifiedConflicts, err
 	}
 
 	endAtInput := pgtype.Timestamptz{}
 	err = endAtInput.Scan(endAt)
 	if err != nil {
-		return modifiedConflicts, err
+		return nil, modifiedConflicts, err
 	}
 
 	var author string
@@ -181,19 +248,38 @@ func (t *ReservationRepository) CreateAndDeleteConflicting(ctx context.Context,
 		author = member.Username
 	}
 
-	_, err = qtx.CreateReservation(ctx, CreateReservationParams{
+	created, err := qtx.CreateReservation(ctx, CreateReservationParams{
 		Author:          author,
 		AuthorDiscordID: member.ID,
 		StartAt:         startAtInput,
 		EndAt:           endAtInput,
-		SpotID:          spotId,
+		SpotID:          spot.ID,
 		GuildID:         guild.ID,
 	})
 	if err != nil {
-		return modifiedConflicts, err
+		return nil, modifiedConflicts, err
 	}
 
-	return modifiedConflicts, tx.Commit(ctx)
+	if err = tx.Commit(ctx); err != nil {
+		return nil, modifiedConflicts, err
+	}
+	createdReservation := mapWebReservation(created)
+
+	return &createdReservation, modifiedConflicts, nil
+}
+
+func (t *ReservationRepository) enqueueOverbookNotification(ctx context.Context, qtx *Queries, member *member.Member, guild *guild.Guild, spot *spot.Spot, res *reservation.ClippedOrRemovedReservation) error {
+	payload, err := json.Marshal(notification.NewOverbook(member, spot.Name, res))
+	if err != nil {
+		return err
+	}
+
+	return qtx.InsertNotificationOutbox(ctx, InsertNotificationOutboxParams{
+		GuildID:            guild.ID,
+		RecipientDiscordID: res.Original.AuthorDiscordID,
+		Kind:               string(notification.KindOverbook),
+		Payload:            payload,
+	})
 }
 
 func (t *ReservationRepository) SelectUpcomingMemberReservationsWithSpots(ctx context.Context, guild *guild.Guild, member *member.Member) ([]*reservation.ReservationWithSpot, error) {
@@ -321,3 +407,13 @@ func mapReservationWithSpot(res WebReservation, spot WebSpot) *reservation.Reser
 		Spot:        mapWebSpot(spot),
 	}
 }
+
+// optionalText maps an empty string to NULL.
+func optionalText(value string) pgtype.Text {
+	return pgtype.Text{String: value, Valid: value != ""}
+}
+
+// optionalTimestamptz maps a zero time to NULL.
+func optionalTimestamptz(value time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: value, Valid: !value.IsZero()}
+}
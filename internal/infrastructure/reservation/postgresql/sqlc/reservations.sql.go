@@ -86,6 +86,177 @@ func (q *Queries) DeleteReservation(ctx context.Context, id int64) error {
 	return err
 }
 
+const insertNotificationOutbox = `-- name: InsertNotificationOutbox :exec
+INSERT INTO notification_outbox (guild_id, recipient_discord_id, kind, payload, status, attempts, next_attempt_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, 'pending', 0, now(), now(), now())
+`
+
+type InsertNotificationOutboxParams struct {
+	GuildID            string
+	RecipientDiscordID string
+	Kind               string
+	Payload            []byte
+}
+
+func (q *Queries) InsertNotificationOutbox(ctx context.Context, arg InsertNotificationOutboxParams) error {
+	_, err := q.db.Exec(ctx, insertNotificationOutbox,
+		arg.GuildID,
+		arg.RecipientDiscordID,
+		arg.Kind,
+		arg.Payload,
+	)
+	return err
+}
+
+const selectFilteredReservationsWithSpots = `-- name: SelectFilteredReservationsWithSpots :many
+select web_spot.id, web_spot.name, web_spot.created_at, web_spot.min_level, web_spot.max_level, web_spot.vocations,
+  web_reservation.id, web_reservation.author, web_reservation.created_at, web_reservation.start_at, web_reservation.end_at, web_reservation.spot_id, web_reservation.guild_id, web_reservation.author_discord_id
+from web_reservation
+  inner join web_spot on web_reservation.spot_id = web_spot.id
+where web_reservation.end_at >= now()
+  AND web_reservation.guild_id = $1
+  AND (
+    $2::text IS NULL
+    OR lower(web_spot.name) = lower($2)
+  )
+  AND (
+    $3::text IS NULL
+    OR web_reservation.author_discord_id = $3
+  )
+  AND (
+    $4::timestamptz IS NULL
+    OR web_reservation.end_at > $4
+  )
+  AND (
+    $5::timestamptz IS NULL
+    OR web_reservation.start_at < $5
+  )
+order by web_reservation.start_at asc
+`
+
+type SelectFilteredReservationsWithSpotsParams struct {
+	GuildID         string
+	Respawn         pgtype.Text
+	AuthorDiscordID pgtype.Text
+	WindowStart     pgtype.Timestamptz
+	WindowEnd       pgtype.Timestamptz
+}
+
+type SelectFilteredReservationsWithSpotsRow struct {
+	WebSpot        WebSpot
+	WebReservation WebReservation
+}
+
+func (q *Queries) SelectFilteredReservationsWithSpots(ctx context.Context, arg SelectFilteredReservationsWithSpotsParams) ([]SelectFilteredReservationsWithSpotsRow, error) {
+	rows, err := q.db.Query(ctx, selectFilteredReservationsWithSpots,
+		arg.GuildID,
+		arg.Respawn,
+		arg.AuthorDiscordID,
+		arg.WindowStart,
+		arg.WindowEnd,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectFilteredReservationsWithSpotsRow
+	for rows.Next() {
+		var i SelectFilteredReservationsWithSpotsRow
+		if err := rows.Scan(
+			&i.WebSpot.ID,
+			&i.WebSpot.Name,
+			&i.WebSpot.CreatedAt,
+			&i.WebSpot.MinLevel,
+			&i.WebSpot.MaxLevel,
+			&i.WebSpot.Vocations,
+			&i.WebReservation.ID,
+			&i.WebReservation.Author,
+			&i.WebReservation.CreatedAt,
+			&i.WebReservation.StartAt,
+			&i.WebReservation.EndAt,
+			&i.WebReservation.SpotID,
+			&i.WebReservation.GuildID,
+			&i.WebReservation.AuthorDiscordID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectFreeSpots = `-- name: SelectFreeSpots :many
+select web_spot.name,
+  (
+    select min(upcoming.start_at)
+    from web_reservation upcoming
+    where upcoming.spot_id = web_spot.id
+      AND upcoming.guild_id = $1
+      AND upcoming.start_at > now()
+  )::timestamptz AS next_start_at
+from web_spot
+where (
+    lower(web_spot.name) IN (
+      SELECT lower(unnest(guild_settings.favourite_spots))
+      FROM guild_settings
+      WHERE guild_settings.guild_id = $1
+    )
+    OR EXISTS (
+      SELECT 1
+      FROM web_reservation upcoming
+      WHERE upcoming.spot_id = web_spot.id
+        AND upcoming.guild_id = $1
+        AND upcoming.end_at >= now()
+    )
+  )
+  AND (
+    $2::text IS NULL
+    OR lower(web_spot.name) = lower($2)
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM web_reservation occupying
+    WHERE occupying.spot_id = web_spot.id
+      AND occupying.guild_id = $1
+      AND occupying.start_at <= now()
+      AND occupying.end_at > now()
+  )
+order by web_spot.name asc
+`
+
+type SelectFreeSpotsParams struct {
+	GuildID string
+	Respawn pgtype.Text
+}
+
+type SelectFreeSpotsRow struct {
+	Name        string
+	NextStartAt pgtype.Timestamptz
+}
+
+func (q *Queries) SelectFreeSpots(ctx context.Context, arg SelectFreeSpotsParams) ([]SelectFreeSpotsRow, error) {
+	rows, err := q.db.Query(ctx, selectFreeSpots, arg.GuildID, arg.Respawn)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectFreeSpotsRow
+	for rows.Next() {
+		var i SelectFreeSpotsRow
+		if err := rows.Scan(&i.Name, &i.NextStartAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const selectOverlappingReservations = `-- name: SelectOverlappingReservations :many
 SELECT web_reservation.id,
   web_reservation.author,
@@ -178,7 +349,7 @@ func (q *Queries) SelectReservation(ctx context.Context, id int64) (WebReservati
 
 const selectReservationWithSpot = `-- name: SelectReservationWithSpot :one
 SELECT reservations.id, reservations.author, reservations.created_at, reservations.start_at, reservations.end_at, reservations.spot_id, reservations.guild_id, reservations.author_discord_id,
-  spots.id, spots.name, spots.created_at
+  spots.id, spots.name, spots.created_at, spots.min_level, spots.max_level, spots.vocations
 FROM web_reservation reservations
   JOIN web_spot spots ON spots.id = reservations.spot_id
 WHERE reservations.id = $1
@@ -213,12 +384,15 @@ func (q *Queries) SelectReservationWithSpot(ctx context.Context, arg SelectReser
 		&i.WebSpot.ID,
 		&i.WebSpot.Name,
 		&i.WebSpot.CreatedAt,
+		&i.WebSpot.MinLevel,
+		&i.WebSpot.MaxLevel,
+		&i.WebSpot.Vocations,
 	)
 	return i, err
 }
 
 const selectReservationsWithSpots = `-- name: SelectReservationsWithSpots :many
-select web_spot.id, web_spot.name, web_spot.created_at,
+select web_spot.id, web_spot.name, web_spot.created_at, web_spot.min_level, web_spot.max_level, web_spot.vocations,
   web_reservation.id, web_reservation.author, web_reservation.created_at, web_reservation.start_at, web_reservation.end_at, web_reservation.spot_id, web_reservation.guild_id, web_reservation.author_discord_id
 from web_reservation
   inner join web_spot on web_reservation.spot_id = web_spot.id
@@ -244,6 +418,9 @@ func (q *Queries) SelectReservationsWithSpots(ctx context.Context, guildID strin
 			&i.WebSpot.ID,
 			&i.WebSpot.Name,
 			&i.WebSpot.CreatedAt,
+			&i.WebSpot.MinLevel,
+			&i.WebSpot.MaxLevel,
+			&i.WebSpot.Vocations,
 			&i.WebReservation.ID,
 			&i.WebReservation.Author,
 			&i.WebReservation.CreatedAt,
@@ -264,7 +441,7 @@ func (q *Queries) SelectReservationsWithSpots(ctx context.Context, guildID strin
 }
 
 const selectReservationsWithSpotsForSpot = `-- name: SelectReservationsWithSpotsForSpot :many
-select web_spot.id, web_spot.name, web_spot.created_at,
+select web_spot.id, web_spot.name, web_spot.created_at, web_spot.min_level, web_spot.max_level, web_spot.vocations,
   web_reservation.id, web_reservation.author, web_reservation.created_at, web_reservation.start_at, web_reservation.end_at, web_reservation.spot_id, web_reservation.guild_id, web_reservation.author_discord_id
 from web_reservation
   inner join web_spot on web_reservation.spot_id = web_spot.id
@@ -296,6 +473,66 @@ func (q *Queries) SelectReservationsWithSpotsForSpot(ctx context.Context, arg Se
 			&i.WebSpot.ID,
 			&i.WebSpot.Name,
 			&i.WebSpot.CreatedAt,
+			&i.WebSpot.MinLevel,
+			&i.WebSpot.MaxLevel,
+			&i.WebSpot.Vocations,
+			&i.WebReservation.ID,
+			&i.WebReservation.Author,
+			&i.WebReservation.CreatedAt,
+			&i.WebReservation.StartAt,
+			&i.WebReservation.EndAt,
+			&i.WebReservation.SpotID,
+			&i.WebReservation.GuildID,
+			&i.WebReservation.AuthorDiscordID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectReservationsWithSpotsInRange = `-- name: SelectReservationsWithSpotsInRange :many
+select web_spot.id, web_spot.name, web_spot.created_at, web_spot.min_level, web_spot.max_level, web_spot.vocations,
+  web_reservation.id, web_reservation.author, web_reservation.created_at, web_reservation.start_at, web_reservation.end_at, web_reservation.spot_id, web_reservation.guild_id, web_reservation.author_discord_id
+from web_reservation
+  inner join web_spot on web_reservation.spot_id = web_spot.id
+where web_reservation.guild_id = $1
+  AND web_reservation.end_at > $2
+  AND web_reservation.start_at < $3
+order by web_reservation.start_at asc
+`
+
+type SelectReservationsWithSpotsInRangeParams struct {
+	GuildID    string
+	RangeStart pgtype.Timestamptz
+	RangeEnd   pgtype.Timestamptz
+}
+
+type SelectReservationsWithSpotsInRangeRow struct {
+	WebSpot        WebSpot
+	WebReservation WebReservation
+}
+
+func (q *Queries) SelectReservationsWithSpotsInRange(ctx context.Context, arg SelectReservationsWithSpotsInRangeParams) ([]SelectReservationsWithSpotsInRangeRow, error) {
+	rows, err := q.db.Query(ctx, selectReservationsWithSpotsInRange, arg.GuildID, arg.RangeStart, arg.RangeEnd)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectReservationsWithSpotsInRangeRow
+	for rows.Next() {
+		var i SelectReservationsWithSpotsInRangeRow
+		if err := rows.Scan(
+			&i.WebSpot.ID,
+			&i.WebSpot.Name,
+			&i.WebSpot.CreatedAt,
+			&i.WebSpot.MinLevel,
+			&i.WebSpot.MaxLevel,
+			&i.WebSpot.Vocations,
 			&i.WebReservation.ID,
 			&i.WebReservation.Author,
 			&i.WebReservation.CreatedAt,
@@ -316,7 +553,7 @@ func (q *Queries) SelectReservationsWithSpotsForSpot(ctx context.Context, arg Se
 }
 
 const selectUpcomingMemberReservationsWithSpots = `-- name: SelectUpcomingMemberReservationsWithSpots :many
-select web_spot.id, web_spot.name, web_spot.created_at,
+select web_spot.id, web_spot.name, web_spot.created_at, web_spot.min_level, web_spot.max_level, web_spot.vocations,
   web_reservation.id, web_reservation.author, web_reservation.created_at, web_reservation.start_at, web_reservation.end_at, web_reservation.spot_id, web_reservation.guild_id, web_reservation.author_discord_id
 from web_reservation
   inner join web_spot on web_reservation.spot_id = web_spot.id
@@ -349,6 +586,9 @@ func (q *Queries) SelectUpcomingMemberReservationsWithSpots(ctx context.Context,
 			&i.WebSpot.ID,
 			&i.WebSpot.Name,
 			&i.WebSpot.CreatedAt,
+			&i.WebSpot.MinLevel,
+			&i.WebSpot.MaxLevel,
+			&i.WebSpot.Vocations,
 			&i.WebReservation.ID,
 			&i.WebReservation.Author,
 			&i.WebReservation.CreatedAt,
@@ -2,17 +2,22 @@ package sqlc
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"spot-assistant/internal/core/dto/guild"
 	"spot-assistant/internal/core/dto/member"
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/pashagolub/pgxmock/v3"
 	"github.com/stretchr/testify/assert"
 
 	"spot-assistant/internal/common/collections"
 	"spot-assistant/internal/common/test/mocks"
+	"spot-assistant/internal/core/dto/notification"
 	"spot-assistant/internal/core/dto/reservation"
+	"spot-assistant/internal/core/dto/spot"
 )
 
 func newReservationRows() *pgxmock.Rows {
@@ -35,6 +40,7 @@ func TestCreateAndDeleteConflictingWithNoConflicting(t *testing.T) {
 		Name: "test-guild-name",
 	}
 	spotId := int64(1)
+	testSpot := &spot.Spot{ID: spotId, Name: "test-spot"}
 	tNow := time.Now()
 	startAt := time.Date(tNow.Year(), tNow.Month(), tNow.Day(), 21, 1, 0, 0, time.UTC)
 	endAt := time.Date(tNow.Year(), tNow.Month(), tNow.Day(), 23, 1, 0, 0, time.UTC)
@@ -55,10 +61,12 @@ func TestCreateAndDeleteConflictingWithNoConflicting(t *testing.T) {
 	repository := NewReservationRepository(mock)
 
 	// when
-	removed, err := repository.CreateAndDeleteConflicting(context.Background(), testMember, testGuild, make([]*reservation.Reservation, 0), spotId, startAt, endAt)
+	created, removed, err := repository.CreateAndDeleteConflicting(context.Background(), testMember, testGuild, make([]*reservation.Reservation, 0), testSpot, startAt, endAt)
 
 	// assert
 	assert.Nil(err)
+	assert.Equal(int64(1), created.ID)
+	assert.Equal(testMember.Nick, created.Author)
 	assert.Empty(removed)
 	assert.Nil(mock.ExpectationsWereMet())
 }
@@ -81,6 +89,7 @@ func TestCreateAndDeleteConflictingWithOneConflicting(t *testing.T) {
 		Name: "test-guild-name",
 	}
 	spotId := int64(1)
+	testSpot := &spot.Spot{ID: spotId, Name: "test-spot"}
 	tNow := time.Now()
 	conflictingReservations := []*reservation.Reservation{
 		{
@@ -118,6 +127,8 @@ func TestCreateAndDeleteConflictingWithOneConflicting(t *testing.T) {
 		reservationInput.EndAt.Add(1*time.Minute), conflictingReservations[0].EndAt,
 		spotId, testGuild.ID, testMember.ID,
 	))
+	mock.ExpectExec("INSERT INTO notification_outbox").WithArgs(testGuild.ID, testMember2.ID, "overbook", pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
 	mock.ExpectQuery("INSERT INTO web_reservation").WithArgs(
 		reservationInput.Author, reservationInput.AuthorDiscordID,
 		mocks.NewPgTimestamptzTime(reservationInput.StartAt), mocks.NewPgTimestamptzTime(reservationInput.EndAt),
@@ -131,7 +142,7 @@ func TestCreateAndDeleteConflictingWithOneConflicting(t *testing.T) {
 	repository := NewReservationRepository(mock)
 
 	// when
-	removed, err := repository.CreateAndDeleteConflicting(context.Background(), testMember, testGuild, conflictingReservations, spotId, reservationInput.StartAt, reservationInput.EndAt)
+	_, removed, err := repository.CreateAndDeleteConflicting(context.Background(), testMember, testGuild, conflictingReservations, testSpot, reservationInput.StartAt, reservationInput.EndAt)
 
 	// assert
 	assert.Nil(err)
@@ -165,6 +176,7 @@ func TestCreateAndDeleteConflictingWithTwoConflicting(t *testing.T) {
 		Name: "test-guild-name",
 	}
 	spotId := int64(1)
+	testSpot := &spot.Spot{ID: spotId, Name: "test-spot"}
 	tNow := time.Now()
 	conflictingReservations := []*reservation.Reservation{
 		{
@@ -208,12 +220,16 @@ func TestCreateAndDeleteConflictingWithTwoConflicting(t *testing.T) {
 	).WillReturnRows(newReservationRows().AddRow(
 		int64(3), testMember2.Nick, time.Now(), conflictingReservations[0].StartAt, reservationInput.StartAt.Add(-1*time.Minute), spotId, testGuild.ID, testMember.ID,
 	))
+	mock.ExpectExec("INSERT INTO notification_outbox").WithArgs(testGuild.ID, testMember2.ID, "overbook", pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
 	mock.ExpectExec("DELETE FROM web_reservation").WithArgs(conflictingReservations[1].ID).WillReturnResult(pgxmock.NewResult("DELETE", 1))
 	mock.ExpectQuery("INSERT INTO web_reservation").WithArgs(
 		conflictingReservations[1].Author, conflictingReservations[1].AuthorDiscordID,
 		mocks.NewPgTimestamptzTime(reservationInput.EndAt.Add(1*time.Minute)), mocks.NewPgTimestamptzTime(conflictingReservations[1].EndAt),
 		conflictingReservations[1].SpotID, conflictingReservations[1].GuildID,
 	).WillReturnRows(newReservationRows().AddRow(int64(4), testMember3.Nick, time.Now(), reservationInput.EndAt.Add(1*time.Minute), conflictingReservations[1].EndAt, spotId, testGuild.ID, testMember.ID))
+	mock.ExpectExec("INSERT INTO notification_outbox").WithArgs(testGuild.ID, testMember3.ID, "overbook", pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
 	mock.ExpectQuery("INSERT INTO web_reservation").WithArgs(
 		reservationInput.Author, reservationInput.AuthorDiscordID,
 		mocks.NewPgTimestamptzTime(reservationInput.StartAt), mocks.NewPgTimestamptzTime(reservationInput.EndAt),
@@ -223,7 +239,7 @@ func TestCreateAndDeleteConflictingWithTwoConflicting(t *testing.T) {
 	repository := NewReservationRepository(mock)
 
 	// when
-	removed, err := repository.CreateAndDeleteConflicting(context.Background(), testMember, testGuild, conflictingReservations, spotId, reservationInput.StartAt, reservationInput.EndAt)
+	_, removed, err := repository.CreateAndDeleteConflicting(context.Background(), testMember, testGuild, conflictingReservations, testSpot, reservationInput.StartAt, reservationInput.EndAt)
 
 	// assert
 	assert.Nil(err)
@@ -252,6 +268,7 @@ func TestCreateAndDeleteConflictingWithTwoConflictingButSecondOneFromTheSameAuth
 		Name: "test-guild-name",
 	}
 	spotId := int64(1)
+	testSpot := &spot.Spot{ID: spotId, Name: "test-spot"}
 	tNow := time.Now()
 	conflictingReservations := []*reservation.Reservation{
 		{
@@ -294,6 +311,8 @@ func TestCreateAndDeleteConflictingWithTwoConflictingButSecondOneFromTheSameAuth
 		conflictingReservations[0].SpotID, conflictingReservations[0].GuildID,
 	).WillReturnRows(newReservationRows().AddRow(
 		int64(3), testMember2.Nick, time.Now(), conflictingReservations[0].StartAt, reservationInput.StartAt.Add(-1*time.Minute), spotId, testGuild.ID, testMember.ID))
+	mock.ExpectExec("INSERT INTO notification_outbox").WithArgs(testGuild.ID, testMember2.ID, "overbook", pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
 	mock.ExpectExec("DELETE FROM web_reservation").WithArgs(conflictingReservations[1].ID).WillReturnResult(pgxmock.NewResult("DELETE", 1))
 	mock.ExpectQuery("INSERT INTO web_reservation").WithArgs(
 		reservationInput.Author, reservationInput.AuthorDiscordID,
@@ -306,7 +325,7 @@ func TestCreateAndDeleteConflictingWithTwoConflictingButSecondOneFromTheSameAuth
 	repository := NewReservationRepository(mock)
 
 	// when
-	removed, err := repository.CreateAndDeleteConflicting(context.Background(), testMember, testGuild, conflictingReservations, spotId, reservationInput.StartAt, reservationInput.EndAt)
+	_, removed, err := repository.CreateAndDeleteConflicting(context.Background(), testMember, testGuild, conflictingReservations, testSpot, reservationInput.StartAt, reservationInput.EndAt)
 
 	// assert
 	assert.Nil(err)
@@ -320,7 +339,7 @@ func TestCreateAndDeleteConflictingWithTwoConflictingButSecondOneFromTheSameAuth
 func newReservationWithSpotRows() *pgxmock.Rows {
 	return pgxmock.NewRows([]string{
 		// web_spot
-		"id", "name", "created_at",
+		"id", "name", "created_at", "min_level", "max_level", "vocations",
 		// web_reservation
 		"id", "author", "created_at", "start_at", "end_at", "spot_id", "guild_id", "author_discord_id",
 	})
@@ -339,11 +358,11 @@ func TestSelectUpcomingReservationsWithSpotForSpot_FiltersBySpotAndGuild(t *test
 		WithArgs("guild-1", "Flimsy").
 		WillReturnRows(newReservationWithSpotRows().
 			AddRow(
-				int64(10), "Flimsy", time.Now(),
+				int64(10), "Flimsy", time.Now(), int32(0), int32(0), []string{},
 				int64(101), "Mariysz", time.Now(), time.Now(), time.Now().Add(time.Hour), int64(10), "guild-1", "mariysz#1",
 			).
 			AddRow(
-				int64(10), "Flimsy", time.Now(),
+				int64(10), "Flimsy", time.Now(), int32(0), int32(0), []string{},
 				int64(102), "Asar", time.Now(), time.Now().Add(time.Hour), time.Now().Add(2*time.Hour), int64(10), "guild-1", "asar#1",
 			))
 
@@ -377,3 +396,133 @@ func TestSelectUpcomingReservationsWithSpotForSpot_EmptyWhenNoMatches(t *testing
 	assert.Len(res, 0)
 	assert.NoError(mock.ExpectationsWereMet())
 }
+
+func TestSelectFilteredReservationsWithSpot_PassesOnlyGivenFilters(t *testing.T) {
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.Close()
+
+	from := time.Date(2026, 10, 19, 18, 0, 0, 0, time.UTC)
+	to := from.Add(3 * time.Hour)
+	mock.ExpectQuery("select web_spot.id, web_spot.name").
+		WithArgs(
+			"guild-1",
+			pgtype.Text{},
+			pgtype.Text{String: "mariysz#1", Valid: true},
+			pgtype.Timestamptz{Time: from, Valid: true},
+			pgtype.Timestamptz{Time: to, Valid: true},
+		).
+		WillReturnRows(newReservationWithSpotRows().
+			AddRow(
+				int64(10), "Flimsy", time.Now(), int32(0), int32(0), []string{},
+				int64(101), "Mariysz", time.Now(), from, from.Add(time.Hour), int64(10), "guild-1", "mariysz#1",
+			))
+
+	repo := NewReservationRepository(mock)
+	res, err := repo.SelectFilteredReservationsWithSpot(context.Background(), reservation.Filter{
+		GuildID:         "guild-1",
+		AuthorDiscordID: "mariysz#1",
+		From:            from,
+		To:              to,
+	})
+	assert.NoError(err)
+	assert.Len(res, 1)
+	assert.Equal("mariysz#1", res[0].AuthorDiscordID)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestSelectFreeSpots(t *testing.T) {
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.Close()
+
+	nextStartAt := time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("select web_spot.name").
+		WithArgs("guild-1", pgtype.Text{}).
+		WillReturnRows(pgxmock.NewRows([]string{"name", "next_start_at"}).
+			AddRow("Flimsy", pgtype.Timestamptz{Time: nextStartAt, Valid: true}).
+			AddRow("Issavi", pgtype.Timestamptz{}))
+
+	repo := NewReservationRepository(mock)
+	res, err := repo.SelectFreeSpots(context.Background(), "guild-1", "")
+	assert.NoError(err)
+	assert.Equal([]*reservation.FreeSpot{{Name: "Flimsy", NextStartAt: nextStartAt}, {Name: "Issavi"}}, res)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestSelectReservationsWithSpotInRange(t *testing.T) {
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.Close()
+
+	from := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	mock.ExpectQuery("select web_spot.id, web_spot.name").
+		WithArgs("guild-1", pgtype.Timestamptz{Time: from, Valid: true}, pgtype.Timestamptz{Time: to, Valid: true}).
+		WillReturnRows(newReservationWithSpotRows().
+			AddRow(
+				int64(10), "Flimsy", time.Now(), int32(0), int32(0), []string{},
+				int64(101), "Mariysz", time.Now(), from.Add(time.Hour), from.Add(2*time.Hour), int64(10), "guild-1", "mariysz#1",
+			))
+
+	repo := NewReservationRepository(mock)
+	res, err := repo.SelectReservationsWithSpotInRange(context.Background(), "guild-1", from, to)
+	assert.NoError(err)
+	assert.Len(res, 1)
+	assert.Equal("Flimsy", res[0].Spot.Name)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestCreateAndDeleteConflictingRollsBackWhenNotificationIsNotSaved(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	testMember := &member.Member{ID: "test-member-id", Nick: "test-member-nick"}
+	testGuild := &guild.Guild{ID: "test-guild-id"}
+	testSpot := &spot.Spot{ID: 1, Name: "test-spot"}
+	startAt := time.Date(2021, 1, 1, 16, 0, 0, 0, time.UTC)
+	endAt := startAt.Add(2 * time.Hour)
+	conflicting := &reservation.Reservation{
+		ID:              1,
+		Author:          "test-member-nick-2",
+		AuthorDiscordID: "test-member-id-2",
+		StartAt:         startAt,
+		EndAt:           endAt,
+		SpotID:          testSpot.ID,
+		GuildID:         testGuild.ID,
+	}
+	payload, err := json.Marshal(notification.Overbook{
+		Spot:     testSpot.Name,
+		ByID:     testMember.ID,
+		ByNick:   testMember.Nick,
+		Original: conflicting,
+		New:      []*reservation.Reservation{},
+	})
+	assert.NoError(err)
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.Close()
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM web_reservation").WithArgs(conflicting.ID).WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectExec("INSERT INTO notification_outbox").WithArgs(testGuild.ID, conflicting.AuthorDiscordID, "overbook", payload).
+		WillReturnError(errors.New("connection reset"))
+	mock.ExpectRollback()
+	repository := NewReservationRepository(mock)
+
+	// when
+	_, _, err = repository.CreateAndDeleteConflicting(context.Background(), testMember, testGuild, []*reservation.Reservation{conflicting}, testSpot, startAt, endAt)
+
+	// assert
+	assert.Error(err)
+	assert.Nil(mock.ExpectationsWereMet())
+}
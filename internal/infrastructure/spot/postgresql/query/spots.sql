@@ -2,19 +2,33 @@
 SELECT
     id,
     name,
-    created_at
+    created_at,
+    min_level,
+    max_level,
+    vocations
 FROM
     web_spot;
 
 -- name: SelectSpotByName :one
-SELECT id, name, created_at
+SELECT id, name, created_at, min_level, max_level, vocations
 FROM web_spot
 WHERE lower(name) = lower(@name)
 LIMIT 1;
 
 -- name: SelectSpotsByNameCaseInsensitiveLike :many
-SELECT id, name, created_at
+SELECT id, name, created_at, min_level, max_level, vocations
 FROM web_spot
 WHERE lower(name) LIKE '%' || lower(@name_pattern) || '%'
 ORDER BY name
-LIMIT 15;
\ No newline at end of file
+LIMIT 15;
+
+-- name: InsertSpot :exec
+INSERT INTO web_spot (name, created_at)
+VALUES (@name, now());
+
+-- name: UpdateSpotRequirements :execrows
+UPDATE web_spot
+SET min_level = @min_level,
+    max_level = @max_level,
+    vocations = @vocations
+WHERE lower(name) = lower(@name);
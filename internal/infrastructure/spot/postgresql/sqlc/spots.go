@@ -3,17 +3,28 @@ package sqlc
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5"
+
 	"spot-assistant/internal/common/collections"
+	"spot-assistant/internal/common/errors"
 	"spot-assistant/internal/core/dto/spot"
 )
 
+type DBTXWrapper interface {
+	DBTX
+
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
 type SpotRepository struct {
-	q *Queries
+	q  *Queries
+	db DBTXWrapper
 }
 
-func NewSpotRepository(db DBTX) *SpotRepository {
+func NewSpotRepository(db DBTXWrapper) *SpotRepository {
 	return &SpotRepository{
-		q: New(db),
+		q:  New(db),
+		db: db,
 	}
 }
 
@@ -23,13 +34,7 @@ func (repo *SpotRepository) SelectAllSpots(ctx context.Context) ([]*spot.Spot, e
 		return []*spot.Spot{}, err
 	}
 
-	return collections.PoorMansMap(res, func(s WebSpot) *spot.Spot {
-		return &spot.Spot{
-			ID:        s.ID,
-			Name:      s.Name,
-			CreatedAt: s.CreatedAt.Time,
-		}
-	}), nil
+	return collections.PoorMansMap(res, mapSpot), nil
 }
 
 func (repo *SpotRepository) SelectSpotByName(ctx context.Context, name string) (*spot.Spot, error) {
@@ -38,11 +43,7 @@ func (repo *SpotRepository) SelectSpotByName(ctx context.Context, name string) (
 		return nil, err
 	}
 
-	return &spot.Spot{
-		ID:        res.ID,
-		Name:      res.Name,
-		CreatedAt: res.CreatedAt.Time,
-	}, nil
+	return mapSpot(res), nil
 }
 
 func (repo *SpotRepository) SelectSpotsByNameCaseInsensitiveLike(ctx context.Context, namePattern string) ([]*spot.Spot, error) {
@@ -51,11 +52,53 @@ func (repo *SpotRepository) SelectSpotsByNameCaseInsensitiveLike(ctx context.Con
 		return []*spot.Spot{}, err
 	}
 
-	return collections.PoorMansMap(res, func(s WebSpot) *spot.Spot {
-		return &spot.Spot{
-			ID:        s.ID,
-			Name:      s.Name,
-			CreatedAt: s.CreatedAt.Time,
+	return collections.PoorMansMap(res, mapSpot), nil
+}
+
+// CreateSpots adds spots with the given names, all of them or none.
+func (repo *SpotRepository) CreateSpots(ctx context.Context, names []string) error {
+	tx, err := repo.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer errors.ExecuteAndIgnoreErrorF(tx.Rollback, ctx)
+	qtx := repo.q.WithTx(tx)
+
+	for _, name := range names {
+		if err = qtx.InsertSpot(ctx, name); err != nil {
+			return err
 		}
-	}), nil
+	}
+
+	return tx.Commit(ctx)
+}
+
+// UpdateSpotRequirements replaces requirements of a spot. Returns false if there is no such spot.
+func (repo *SpotRepository) UpdateSpotRequirements(ctx context.Context, name string, requirements spot.Requirements) (bool, error) {
+	vocations := requirements.Vocations
+	if vocations == nil {
+		vocations = []string{}
+	}
+
+	updated, err := repo.q.UpdateSpotRequirements(ctx, UpdateSpotRequirementsParams{
+		Name:      name,
+		MinLevel:  int32(requirements.MinLevel),
+		MaxLevel:  int32(requirements.MaxLevel),
+		Vocations: vocations,
+	})
+
+	return updated > 0, err
+}
+
+func mapSpot(s WebSpot) *spot.Spot {
+	return &spot.Spot{
+		ID:        s.ID,
+		Name:      s.Name,
+		CreatedAt: s.CreatedAt.Time,
+		Requirements: spot.Requirements{
+			MinLevel:  int(s.MinLevel),
+			MaxLevel:  int(s.MaxLevel),
+			Vocations: s.Vocations,
+		},
+	}
 }
@@ -9,11 +9,24 @@ import (
 	"context"
 )
 
+const insertSpot = `-- name: InsertSpot :exec
+INSERT INTO web_spot (name, created_at)
+VALUES ($1, now())
+`
+
+func (q *Queries) InsertSpot(ctx context.Context, name string) error {
+	_, err := q.db.Exec(ctx, insertSpot, name)
+	return err
+}
+
 const selectAllSpots = `-- name: SelectAllSpots :many
 SELECT
     id,
     name,
-    created_at
+    created_at,
+    min_level,
+    max_level,
+    vocations
 FROM
     web_spot
 `
@@ -27,7 +40,14 @@ func (q *Queries) SelectAllSpots(ctx context.Context) ([]WebSpot, error) {
 	var items []WebSpot
 	for rows.Next() {
 		var i WebSpot
-		if err := rows.Scan(&i.ID, &i.Name, &i.CreatedAt); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.MinLevel,
+			&i.MaxLevel,
+			&i.Vocations,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -39,7 +59,7 @@ func (q *Queries) SelectAllSpots(ctx context.Context) ([]WebSpot, error) {
 }
 
 const selectSpotByName = `-- name: SelectSpotByName :one
-SELECT id, name, created_at
+SELECT id, name, created_at, min_level, max_level, vocations
 FROM web_spot
 WHERE lower(name) = lower($1)
 LIMIT 1
@@ -48,12 +68,19 @@ LIMIT 1
 func (q *Queries) SelectSpotByName(ctx context.Context, name string) (WebSpot, error) {
 	row := q.db.QueryRow(ctx, selectSpotByName, name)
 	var i WebSpot
-	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.MinLevel,
+		&i.MaxLevel,
+		&i.Vocations,
+	)
 	return i, err
 }
 
 const selectSpotsByNameCaseInsensitiveLike = `-- name: SelectSpotsByNameCaseInsensitiveLike :many
-SELECT id, name, created_at
+SELECT id, name, created_at, min_level, max_level, vocations
 FROM web_spot
 WHERE lower(name) LIKE '%' || lower($1) || '%'
 ORDER BY name
@@ -69,7 +96,14 @@ func (q *Queries) SelectSpotsByNameCaseInsensitiveLike(ctx context.Context, name
 	var items []WebSpot
 	for rows.Next() {
 		var i WebSpot
-		if err := rows.Scan(&i.ID, &i.Name, &i.CreatedAt); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.MinLevel,
+			&i.MaxLevel,
+			&i.Vocations,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -79,3 +113,31 @@ func (q *Queries) SelectSpotsByNameCaseInsensitiveLike(ctx context.Context, name
 	}
 	return items, nil
 }
+
+const updateSpotRequirements = `-- name: UpdateSpotRequirements :execrows
+UPDATE web_spot
+SET min_level = $1,
+    max_level = $2,
+    vocations = $3
+WHERE lower(name) = lower($4)
+`
+
+type UpdateSpotRequirementsParams struct {
+	MinLevel  int32
+	MaxLevel  int32
+	Vocations []string
+	Name      string
+}
+
+func (q *Queries) UpdateSpotRequirements(ctx context.Context, arg UpdateSpotRequirementsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateSpotRequirements,
+		arg.MinLevel,
+		arg.MaxLevel,
+		arg.Vocations,
+		arg.Name,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
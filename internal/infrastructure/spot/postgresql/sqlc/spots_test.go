@@ -2,14 +2,17 @@ package sqlc
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/pashagolub/pgxmock/v3"
 	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/spot"
 )
 
 func newSpotRows() *pgxmock.Rows {
-	return pgxmock.NewRows([]string{"id", "name", "created_at"})
+	return pgxmock.NewRows([]string{"id", "name", "created_at", "min_level", "max_level", "vocations"})
 }
 
 func TestSelectSpotsByNameCaseInsensitiveLike_EmptyFilter(t *testing.T) {
@@ -21,7 +24,7 @@ func TestSelectSpotsByNameCaseInsensitiveLike_EmptyFilter(t *testing.T) {
 	}
 	defer mock.Close()
 
-	mock.ExpectQuery("SELECT id, name, created_at FROM web_spot").
+	mock.ExpectQuery("SELECT id, name, created_at, min_level, max_level, vocations FROM web_spot").
 		WithArgs("").
 		WillReturnRows(newSpotRows())
 
@@ -45,9 +48,9 @@ func TestSelectSpotsByNameCaseInsensitiveLike_NormalInput(t *testing.T) {
 	}
 	defer mock.Close()
 
-	mock.ExpectQuery("SELECT id, name, created_at FROM web_spot").
+	mock.ExpectQuery("SELECT id, name, created_at, min_level, max_level, vocations FROM web_spot").
 		WithArgs("dragon").
-		WillReturnRows(newSpotRows().AddRow(int64(1), "Dragon Lords", nil))
+		WillReturnRows(newSpotRows().AddRow(int64(1), "Dragon Lords", nil, int32(100), int32(0), []string{"Knight"}))
 
 	repository := NewSpotRepository(mock)
 
@@ -58,5 +61,78 @@ func TestSelectSpotsByNameCaseInsensitiveLike_NormalInput(t *testing.T) {
 	assert.NoError(err)
 	assert.Len(spots, 1)
 	assert.Equal("Dragon Lords", spots[0].Name)
+	assert.Equal(spot.Requirements{MinLevel: 100, Vocations: []string{"Knight"}}, spots[0].Requirements)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestCreateSpots(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO web_spot").WithArgs("Flimsy").WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec("INSERT INTO web_spot").WithArgs("Lost Souls").WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	repository := NewSpotRepository(mock)
+
+	// when
+	err = repository.CreateSpots(context.Background(), []string{"Flimsy", "Lost Souls"})
+
+	// then
+	assert.NoError(err)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestCreateSpots_RollsBackOnError(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	errInsert := errors.New("duplicate spot")
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO web_spot").WithArgs("Flimsy").WillReturnError(errInsert)
+	mock.ExpectRollback()
+
+	repository := NewSpotRepository(mock)
+
+	// when
+	err = repository.CreateSpots(context.Background(), []string{"Flimsy", "Lost Souls"})
+
+	// then
+	assert.ErrorIs(err, errInsert)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestUpdateSpotRequirements(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.Close()
+
+	mock.ExpectExec("UPDATE web_spot").
+		WithArgs(int32(0), int32(0), []string{}, "flimsy").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	repository := NewSpotRepository(mock)
+
+	// when
+	updated, err := repository.UpdateSpotRequirements(context.Background(), "flimsy", spot.Requirements{})
+
+	// then
+	assert.NoError(err)
+	assert.True(updated)
 	assert.NoError(mock.ExpectationsWereMet())
 }
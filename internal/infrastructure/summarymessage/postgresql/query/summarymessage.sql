@@ -0,0 +1,15 @@
+-- name: SelectSummaryMessages :many
+SELECT message_id
+FROM summary_message
+WHERE guild_id = @guild_id
+  AND channel_id = @channel_id
+ORDER BY position ASC;
+
+-- name: DeleteSummaryMessages :exec
+DELETE FROM summary_message
+WHERE guild_id = @guild_id
+  AND channel_id = @channel_id;
+
+-- name: InsertSummaryMessage :exec
+INSERT INTO summary_message (guild_id, channel_id, message_id, position, created_at)
+VALUES (@guild_id, @channel_id, @message_id, @position, now());
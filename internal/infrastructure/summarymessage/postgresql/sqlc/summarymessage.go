@@ -0,0 +1,72 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"spot-assistant/internal/common/errors"
+)
+
+type DBTXWrapper interface {
+	DBTX
+
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+type SummaryMessageRepository struct {
+	q  *Queries
+	db DBTXWrapper
+}
+
+func NewSummaryMessageRepository(db DBTXWrapper) *SummaryMessageRepository {
+	return &SummaryMessageRepository{
+		q:  New(db),
+		db: db,
+	}
+}
+
+// SelectSummaryMessageIDs returns IDs of summary messages tracked in a channel, in order of their position.
+func (repo *SummaryMessageRepository) SelectSummaryMessageIDs(ctx context.Context, guildID, channelID string) ([]string, error) {
+	ids, err := repo.q.SelectSummaryMessages(ctx, SelectSummaryMessagesParams{
+		GuildID:   guildID,
+		ChannelID: channelID,
+	})
+	if err != nil {
+		return []string{}, err
+	}
+
+	return ids, nil
+}
+
+// ReplaceSummaryMessageIDs replaces all tracked summary messages of a channel with the given ones.
+func (repo *SummaryMessageRepository) ReplaceSummaryMessageIDs(ctx context.Context, guildID, channelID string, messageIDs []string) error {
+	tx, err := repo.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer errors.ExecuteAndIgnoreErrorF(tx.Rollback, ctx)
+	qtx := repo.q.WithTx(tx)
+
+	err = qtx.DeleteSummaryMessages(ctx, DeleteSummaryMessagesParams{
+		GuildID:   guildID,
+		ChannelID: channelID,
+	})
+	if err != nil {
+		return err
+	}
+
+	for position, messageID := range messageIDs {
+		err = qtx.InsertSummaryMessage(ctx, InsertSummaryMessageParams{
+			GuildID:   guildID,
+			ChannelID: channelID,
+			MessageID: messageID,
+			Position:  int32(position),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
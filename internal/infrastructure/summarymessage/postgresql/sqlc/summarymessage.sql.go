@@ -0,0 +1,81 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: summarymessage.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const deleteSummaryMessages = `-- name: DeleteSummaryMessages :exec
+DELETE FROM summary_message
+WHERE guild_id = $1
+  AND channel_id = $2
+`
+
+type DeleteSummaryMessagesParams struct {
+	GuildID   string
+	ChannelID string
+}
+
+func (q *Queries) DeleteSummaryMessages(ctx context.Context, arg DeleteSummaryMessagesParams) error {
+	_, err := q.db.Exec(ctx, deleteSummaryMessages, arg.GuildID, arg.ChannelID)
+	return err
+}
+
+const insertSummaryMessage = `-- name: InsertSummaryMessage :exec
+INSERT INTO summary_message (guild_id, channel_id, message_id, position, created_at)
+VALUES ($1, $2, $3, $4, now())
+`
+
+type InsertSummaryMessageParams struct {
+	GuildID   string
+	ChannelID string
+	MessageID string
+	Position  int32
+}
+
+func (q *Queries) InsertSummaryMessage(ctx context.Context, arg InsertSummaryMessageParams) error {
+	_, err := q.db.Exec(ctx, insertSummaryMessage,
+		arg.GuildID,
+		arg.ChannelID,
+		arg.MessageID,
+		arg.Position,
+	)
+	return err
+}
+
+const selectSummaryMessages = `-- name: SelectSummaryMessages :many
+SELECT message_id
+FROM summary_message
+WHERE guild_id = $1
+  AND channel_id = $2
+ORDER BY position ASC
+`
+
+type SelectSummaryMessagesParams struct {
+	GuildID   string
+	ChannelID string
+}
+
+func (q *Queries) SelectSummaryMessages(ctx context.Context, arg SelectSummaryMessagesParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, selectSummaryMessages, arg.GuildID, arg.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var message_id string
+		if err := rows.Scan(&message_id); err != nil {
+			return nil, err
+		}
+		items = append(items, message_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,83 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectSummaryMessageIDs(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.Close()
+	mock.ExpectQuery("SELECT message_id FROM summary_message").
+		WithArgs("guild-id", "channel-id").
+		WillReturnRows(pgxmock.NewRows([]string{"message_id"}).AddRow("message-1").AddRow("message-2"))
+	repository := NewSummaryMessageRepository(mock)
+
+	// when
+	ids, err := repository.SelectSummaryMessageIDs(context.Background(), "guild-id", "channel-id")
+
+	// then
+	assert.NoError(err)
+	assert.Equal([]string{"message-1", "message-2"}, ids)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestSelectSummaryMessageIDs_Error(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.Close()
+	mock.ExpectQuery("SELECT message_id FROM summary_message").
+		WithArgs("guild-id", "channel-id").
+		WillReturnError(errors.New("connection lost"))
+	repository := NewSummaryMessageRepository(mock)
+
+	// when
+	ids, err := repository.SelectSummaryMessageIDs(context.Background(), "guild-id", "channel-id")
+
+	// then
+	assert.Error(err)
+	assert.Empty(ids)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestReplaceSummaryMessageIDs(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.Close()
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM summary_message").
+		WithArgs("guild-id", "channel-id").
+		WillReturnResult(pgxmock.NewResult("DELETE", 3))
+	mock.ExpectExec("INSERT INTO summary_message").
+		WithArgs("guild-id", "channel-id", "message-1", int32(0)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec("INSERT INTO summary_message").
+		WithArgs("guild-id", "channel-id", "message-2", int32(1)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+	repository := NewSummaryMessageRepository(mock)
+
+	// when
+	err = repository.ReplaceSummaryMessageIDs(context.Background(), "guild-id", "channel-id", []string{"message-1", "message-2"})
+
+	// then
+	assert.NoError(err)
+	assert.NoError(mock.ExpectationsWereMet())
+}
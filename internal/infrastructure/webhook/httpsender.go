@@ -0,0 +1,89 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"spot-assistant/internal/core/dto/webhook"
+)
+
+// maxDrainedResponse limits how much of a response body is read before the connection is reused.
+const maxDrainedResponse = 64 << 10
+
+type HttpSender struct {
+	Client *http.Client
+}
+
+// NewHttpSender returns a sender connecting to public addresses only. Proxies are not used,
+// as the address could not be checked then.
+func NewHttpSender() *HttpSender {
+	dialer := &net.Dialer{Timeout: 5 * time.Second, Control: publicAddressOnly}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &HttpSender{
+		Client: &http.Client{Timeout: 10 * time.Second, Transport: transport},
+	}
+}
+
+// publicAddressOnly refuses to connect to non-public addresses. It is called with the resolved address,
+// so hosts resolving to another address than when the webhook was added (DNS rebinding) are refused too.
+func publicAddressOnly(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if !webhook.IsPublicIP(net.ParseIP(host)) {
+		return webhook.ErrNonPublicAddress
+	}
+
+	return nil
+}
+
+// Post sends a JSON body to the URL, returning the HTTP status of the response.
+// Redirects are not followed, so a signed payload is only sent where it was meant to go.
+// Errors leave the URL out, as its path and query often carry tokens, and errors are shown to the guild.
+func (h *HttpSender) Post(ctx context.Context, rawURL string, headers map[string]string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rawURL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("error creating POST request: %w", withoutURL(err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "spot-assistant-webhooks")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	client := *h.Client
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("error making POST request: %w", withoutURL(err))
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainedResponse))
+
+	return resp.StatusCode, nil
+}
+
+// withoutURL unwraps the cause of an url.Error, which quotes the whole URL.
+func withoutURL(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+
+	return err
+}
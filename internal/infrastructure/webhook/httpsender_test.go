@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/webhook"
+)
+
+func TestHttpSender_Post(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	var (
+		receivedBody      []byte
+		receivedSignature string
+		receivedType      string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedBody, _ = io.ReadAll(r.Body)
+		receivedSignature = r.Header.Get("X-Spot-Assistant-Signature")
+		receivedType = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	sender := NewHttpSender()
+	sender.Client = server.Client()
+
+	// when
+	status, err := sender.Post(context.Background(), server.URL, map[string]string{
+		"X-Spot-Assistant-Signature": "sha256=abc",
+	}, []byte(`{"event":"reservation.created"}`))
+
+	// then
+	assert.NoError(err)
+	assert.Equal(http.StatusNoContent, status)
+	assert.Equal(`{"event":"reservation.created"}`, string(receivedBody))
+	assert.Equal("sha256=abc", receivedSignature)
+	assert.Equal("application/json", receivedType)
+}
+
+func TestHttpSender_PostDoesNotFollowRedirects(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	redirected := false
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer server.Close()
+	sender := NewHttpSender()
+	sender.Client = server.Client()
+
+	// when
+	status, err := sender.Post(context.Background(), server.URL, nil, []byte(`{}`))
+
+	// then
+	assert.NoError(err)
+	assert.Equal(http.StatusTemporaryRedirect, status)
+	assert.False(redirected)
+}
+
+func TestHttpSender_PostErrorLeavesOutURL(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+	sender := NewHttpSender()
+	sender.Client = server.Client()
+
+	// when
+	_, err := sender.Post(context.Background(), server.URL+"/hooks/secret-token?key=secret", nil, []byte(`{}`))
+
+	// then
+	assert.Error(err)
+	assert.NotContains(err.Error(), "secret")
+	assert.NotContains(err.Error(), server.URL)
+}
+
+func TestHttpSender_PostRefusesNonPublicAddresses(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+	sender := NewHttpSender()
+
+	// when
+	_, err := sender.Post(context.Background(), server.URL, nil, []byte(`{}`))
+
+	// then
+	assert.ErrorIs(err, webhook.ErrNonPublicAddress)
+	assert.False(called)
+}
@@ -0,0 +1,77 @@
+-- name: InsertWebhook :one
+INSERT INTO webhook (guild_id, url, secret, created_at)
+VALUES (@guild_id, @url, @secret, now())
+RETURNING id, guild_id, url, secret, created_at;
+
+-- name: DeleteWebhook :execrows
+DELETE FROM webhook
+WHERE id = @id AND guild_id = @guild_id;
+
+-- name: SelectWebhooks :many
+SELECT id, guild_id, url, secret, created_at
+FROM webhook
+WHERE guild_id = @guild_id
+ORDER BY id;
+
+-- name: EnqueueDeliveries :execrows
+INSERT INTO webhook_delivery (webhook_id, guild_id, event, payload, status, attempts, next_attempt_at, created_at, updated_at)
+SELECT w.id, w.guild_id, @event, @payload, 'pending', 0, now(), now(), now()
+FROM webhook w
+WHERE w.guild_id = @guild_id;
+
+-- name: ClaimDueDeliveries :many
+UPDATE webhook_delivery d
+SET attempts = d.attempts + 1,
+    next_attempt_at = @lease_until,
+    updated_at = now()
+FROM webhook w
+WHERE w.id = d.webhook_id
+  AND d.id IN (
+    SELECT due.id
+    FROM webhook_delivery due
+    WHERE due.status = 'pending' AND due.next_attempt_at <= @now
+    ORDER BY due.next_attempt_at
+    LIMIT @max_deliveries
+    FOR UPDATE SKIP LOCKED
+  )
+RETURNING d.id, d.webhook_id, d.guild_id, d.event, d.payload, d.attempts, w.url, w.secret;
+
+-- name: MarkDelivered :exec
+UPDATE webhook_delivery
+SET status = 'delivered',
+    response_status = @response_status,
+    last_error = '',
+    updated_at = now()
+WHERE id = @id;
+
+-- name: ScheduleRetry :exec
+UPDATE webhook_delivery
+SET next_attempt_at = @next_attempt_at,
+    response_status = @response_status,
+    last_error = @last_error,
+    updated_at = now()
+WHERE id = @id;
+
+-- name: DeadLetterDelivery :exec
+WITH dead AS (
+    UPDATE webhook_delivery d
+    SET status = 'dead',
+        response_status = @response_status,
+        last_error = @last_error,
+        updated_at = now()
+    FROM webhook w
+    WHERE w.id = d.webhook_id AND d.id = @id
+    RETURNING d.id, d.webhook_id, d.guild_id, w.url, d.event, d.payload, d.attempts, d.response_status, d.last_error
+)
+INSERT INTO webhook_dead_letter (delivery_id, webhook_id, guild_id, url, event, payload, attempts, response_status, last_error, created_at)
+SELECT dead.id, dead.webhook_id, dead.guild_id, dead.url, dead.event, dead.payload, dead.attempts, dead.response_status, dead.last_error, now()
+FROM dead;
+
+-- name: SelectRecentDeliveries :many
+SELECT d.id, d.webhook_id, d.guild_id, d.event, d.status, d.attempts, d.next_attempt_at,
+       d.response_status, d.last_error, d.created_at, d.updated_at, w.url
+FROM webhook_delivery d
+JOIN webhook w ON w.id = d.webhook_id
+WHERE d.guild_id = @guild_id
+ORDER BY d.created_at DESC, d.id DESC
+LIMIT @max_deliveries;
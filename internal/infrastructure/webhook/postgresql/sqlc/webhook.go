@@ -0,0 +1,177 @@
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"spot-assistant/internal/core/dto/webhook"
+)
+
+type WebhookRepository struct {
+	q *Queries
+}
+
+func NewWebhookRepository(db DBTX) *WebhookRepository {
+	return &WebhookRepository{
+		q: New(db),
+	}
+}
+
+// InsertWebhook adds a webhook to a guild.
+func (repo *WebhookRepository) InsertWebhook(ctx context.Context, guildID, url, secret string) (*webhook.Webhook, error) {
+	row, err := repo.q.InsertWebhook(ctx, InsertWebhookParams{
+		GuildID: guildID,
+		Url:     url,
+		Secret:  secret,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhook(row), nil
+}
+
+// DeleteWebhook removes a webhook of a guild together with its pending deliveries.
+// Returns false if the guild has no such webhook.
+func (repo *WebhookRepository) DeleteWebhook(ctx context.Context, guildID string, id int64) (bool, error) {
+	deleted, err := repo.q.DeleteWebhook(ctx, DeleteWebhookParams{
+		ID:      id,
+		GuildID: guildID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return deleted > 0, nil
+}
+
+// SelectWebhooks returns all webhooks of a guild.
+func (repo *WebhookRepository) SelectWebhooks(ctx context.Context, guildID string) ([]*webhook.Webhook, error) {
+	rows, err := repo.q.SelectWebhooks(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]*webhook.Webhook, len(rows))
+	for i, row := range rows {
+		webhooks[i] = toWebhook(row)
+	}
+
+	return webhooks, nil
+}
+
+// EnqueueDeliveries schedules delivery of an event to every webhook of a guild.
+// Returns the number of scheduled deliveries.
+func (repo *WebhookRepository) EnqueueDeliveries(ctx context.Context, guildID string, event webhook.EventType, payload []byte) (int, error) {
+	enqueued, err := repo.q.EnqueueDeliveries(ctx, EnqueueDeliveriesParams{
+		Event:   string(event),
+		Payload: payload,
+		GuildID: guildID,
+	})
+
+	return int(enqueued), err
+}
+
+// ClaimDueDeliveries counts an attempt of pending deliveries due at the time and leases them
+// until the given time. Deliveries locked by other instances are skipped, so every delivery
+// is attempted by one instance at a time, and a delivery of a crashed instance is attempted
+// again once its lease runs out.
+func (repo *WebhookRepository) ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*webhook.Delivery, error) {
+	rows, err := repo.q.ClaimDueDeliveries(ctx, ClaimDueDeliveriesParams{
+		LeaseUntil:    pgtype.Timestamptz{Time: leaseUntil, Valid: true},
+		Now:           pgtype.Timestamptz{Time: now, Valid: true},
+		MaxDeliveries: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*webhook.Delivery, len(rows))
+	for i, row := range rows {
+		deliveries[i] = &webhook.Delivery{
+			ID:            row.ID,
+			WebhookID:     row.WebhookID,
+			GuildID:       row.GuildID,
+			URL:           row.Url,
+			Secret:        row.Secret,
+			Event:         webhook.EventType(row.Event),
+			Payload:       row.Payload,
+			Status:        webhook.DeliveryPending,
+			Attempts:      int(row.Attempts),
+			NextAttemptAt: leaseUntil,
+		}
+	}
+
+	return deliveries, nil
+}
+
+// MarkDelivered marks a delivery as delivered.
+func (repo *WebhookRepository) MarkDelivered(ctx context.Context, id int64, attempt webhook.Attempt) error {
+	return repo.q.MarkDelivered(ctx, MarkDeliveredParams{
+		ResponseStatus: int32(attempt.ResponseStatus),
+		ID:             id,
+	})
+}
+
+// ScheduleRetry records a failed attempt of a delivery and schedules the next one.
+func (repo *WebhookRepository) ScheduleRetry(ctx context.Context, id int64, nextAttemptAt time.Time, attempt webhook.Attempt) error {
+	return repo.q.ScheduleRetry(ctx, ScheduleRetryParams{
+		NextAttemptAt:  pgtype.Timestamptz{Time: nextAttemptAt, Valid: true},
+		ResponseStatus: int32(attempt.ResponseStatus),
+		LastError:      attempt.Error,
+		ID:             id,
+	})
+}
+
+// DeadLetterDelivery records the last failed attempt of a delivery and moves it to the dead letters
+// in a single statement, so a delivery is never lost in between.
+func (repo *WebhookRepository) DeadLetterDelivery(ctx context.Context, id int64, attempt webhook.Attempt) error {
+	return repo.q.DeadLetterDelivery(ctx, DeadLetterDeliveryParams{
+		ResponseStatus: int32(attempt.ResponseStatus),
+		LastError:      attempt.Error,
+		ID:             id,
+	})
+}
+
+// SelectRecentDeliveries returns the latest deliveries of a guild, newest first.
+func (repo *WebhookRepository) SelectRecentDeliveries(ctx context.Context, guildID string, limit int) ([]*webhook.Delivery, error) {
+	rows, err := repo.q.SelectRecentDeliveries(ctx, SelectRecentDeliveriesParams{
+		GuildID:       guildID,
+		MaxDeliveries: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*webhook.Delivery, len(rows))
+	for i, row := range rows {
+		deliveries[i] = &webhook.Delivery{
+			ID:             row.ID,
+			WebhookID:      row.WebhookID,
+			GuildID:        row.GuildID,
+			URL:            row.Url,
+			Event:          webhook.EventType(row.Event),
+			Status:         webhook.DeliveryStatus(row.Status),
+			Attempts:       int(row.Attempts),
+			NextAttemptAt:  row.NextAttemptAt.Time,
+			ResponseStatus: int(row.ResponseStatus),
+			LastError:      row.LastError,
+			CreatedAt:      row.CreatedAt.Time,
+			UpdatedAt:      row.UpdatedAt.Time,
+		}
+	}
+
+	return deliveries, nil
+}
+
+func toWebhook(row Webhook) *webhook.Webhook {
+	return &webhook.Webhook{
+		ID:        row.ID,
+		GuildID:   row.GuildID,
+		URL:       row.Url,
+		Secret:    row.Secret,
+		CreatedAt: row.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,308 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: webhook.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimDueDeliveries = `-- name: ClaimDueDeliveries :many
+UPDATE webhook_delivery d
+SET attempts = d.attempts + 1,
+    next_attempt_at = $1,
+    updated_at = now()
+FROM webhook w
+WHERE w.id = d.webhook_id
+  AND d.id IN (
+    SELECT due.id
+    FROM webhook_delivery due
+    WHERE due.status = 'pending' AND due.next_attempt_at <= $2
+    ORDER BY due.next_attempt_at
+    LIMIT $3
+    FOR UPDATE SKIP LOCKED
+  )
+RETURNING d.id, d.webhook_id, d.guild_id, d.event, d.payload, d.attempts, w.url, w.secret
+`
+
+type ClaimDueDeliveriesParams struct {
+	LeaseUntil    pgtype.Timestamptz
+	Now           pgtype.Timestamptz
+	MaxDeliveries int32
+}
+
+type ClaimDueDeliveriesRow struct {
+	ID        int64
+	WebhookID int64
+	GuildID   string
+	Event     string
+	Payload   []byte
+	Attempts  int32
+	Url       string
+	Secret    string
+}
+
+func (q *Queries) ClaimDueDeliveries(ctx context.Context, arg ClaimDueDeliveriesParams) ([]ClaimDueDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, claimDueDeliveries, arg.LeaseUntil, arg.Now, arg.MaxDeliveries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimDueDeliveriesRow
+	for rows.Next() {
+		var i ClaimDueDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.GuildID,
+			&i.Event,
+			&i.Payload,
+			&i.Attempts,
+			&i.Url,
+			&i.Secret,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deadLetterDelivery = `-- name: DeadLetterDelivery :exec
+WITH dead AS (
+    UPDATE webhook_delivery d
+    SET status = 'dead',
+        response_status = $1,
+        last_error = $2,
+        updated_at = now()
+    FROM webhook w
+    WHERE w.id = d.webhook_id AND d.id = $3
+    RETURNING d.id, d.webhook_id, d.guild_id, w.url, d.event, d.payload, d.attempts, d.response_status, d.last_error
+)
+INSERT INTO webhook_dead_letter (delivery_id, webhook_id, guild_id, url, event, payload, attempts, response_status, last_error, created_at)
+SELECT dead.id, dead.webhook_id, dead.guild_id, dead.url, dead.event, dead.payload, dead.attempts, dead.response_status, dead.last_error, now()
+FROM dead
+`
+
+type DeadLetterDeliveryParams struct {
+	ResponseStatus int32
+	LastError      string
+	ID             int64
+}
+
+func (q *Queries) DeadLetterDelivery(ctx context.Context, arg DeadLetterDeliveryParams) error {
+	_, err := q.db.Exec(ctx, deadLetterDelivery, arg.ResponseStatus, arg.LastError, arg.ID)
+	return err
+}
+
+const deleteWebhook = `-- name: DeleteWebhook :execrows
+DELETE FROM webhook
+WHERE id = $1 AND guild_id = $2
+`
+
+type DeleteWebhookParams struct {
+	ID      int64
+	GuildID string
+}
+
+func (q *Queries) DeleteWebhook(ctx context.Context, arg DeleteWebhookParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhook, arg.ID, arg.GuildID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const enqueueDeliveries = `-- name: EnqueueDeliveries :execrows
+INSERT INTO webhook_delivery (webhook_id, guild_id, event, payload, status, attempts, next_attempt_at, created_at, updated_at)
+SELECT w.id, w.guild_id, $1, $2, 'pending', 0, now(), now(), now()
+FROM webhook w
+WHERE w.guild_id = $3
+`
+
+type EnqueueDeliveriesParams struct {
+	Event   string
+	Payload []byte
+	GuildID string
+}
+
+func (q *Queries) EnqueueDeliveries(ctx context.Context, arg EnqueueDeliveriesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, enqueueDeliveries, arg.Event, arg.Payload, arg.GuildID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertWebhook = `-- name: InsertWebhook :one
+INSERT INTO webhook (guild_id, url, secret, created_at)
+VALUES ($1, $2, $3, now())
+RETURNING id, guild_id, url, secret, created_at
+`
+
+type InsertWebhookParams struct {
+	GuildID string
+	Url     string
+	Secret  string
+}
+
+func (q *Queries) InsertWebhook(ctx context.Context, arg InsertWebhookParams) (Webhook, error) {
+	row := q.db.QueryRow(ctx, insertWebhook, arg.GuildID, arg.Url, arg.Secret)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.GuildID,
+		&i.Url,
+		&i.Secret,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const markDelivered = `-- name: MarkDelivered :exec
+UPDATE webhook_delivery
+SET status = 'delivered',
+    response_status = $1,
+    last_error = '',
+    updated_at = now()
+WHERE id = $2
+`
+
+type MarkDeliveredParams struct {
+	ResponseStatus int32
+	ID             int64
+}
+
+func (q *Queries) MarkDelivered(ctx context.Context, arg MarkDeliveredParams) error {
+	_, err := q.db.Exec(ctx, markDelivered, arg.ResponseStatus, arg.ID)
+	return err
+}
+
+const scheduleRetry = `-- name: ScheduleRetry :exec
+UPDATE webhook_delivery
+SET next_attempt_at = $1,
+    response_status = $2,
+    last_error = $3,
+    updated_at = now()
+WHERE id = $4
+`
+
+type ScheduleRetryParams struct {
+	NextAttemptAt  pgtype.Timestamptz
+	ResponseStatus int32
+	LastError      string
+	ID             int64
+}
+
+func (q *Queries) ScheduleRetry(ctx context.Context, arg ScheduleRetryParams) error {
+	_, err := q.db.Exec(ctx, scheduleRetry,
+		arg.NextAttemptAt,
+		arg.ResponseStatus,
+		arg.LastError,
+		arg.ID,
+	)
+	return err
+}
+
+const selectRecentDeliveries = `-- name: SelectRecentDeliveries :many
+SELECT d.id, d.webhook_id, d.guild_id, d.event, d.status, d.attempts, d.next_attempt_at,
+       d.response_status, d.last_error, d.created_at, d.updated_at, w.url
+FROM webhook_delivery d
+JOIN webhook w ON w.id = d.webhook_id
+WHERE d.guild_id = $1
+ORDER BY d.created_at DESC, d.id DESC
+LIMIT $2
+`
+
+type SelectRecentDeliveriesParams struct {
+	GuildID       string
+	MaxDeliveries int32
+}
+
+type SelectRecentDeliveriesRow struct {
+	ID             int64
+	WebhookID      int64
+	GuildID        string
+	Event          string
+	Status         string
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamptz
+	ResponseStatus int32
+	LastError      string
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+	Url            string
+}
+
+func (q *Queries) SelectRecentDeliveries(ctx context.Context, arg SelectRecentDeliveriesParams) ([]SelectRecentDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, selectRecentDeliveries, arg.GuildID, arg.MaxDeliveries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectRecentDeliveriesRow
+	for rows.Next() {
+		var i SelectRecentDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.GuildID,
+			&i.Event,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.ResponseStatus,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Url,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectWebhooks = `-- name: SelectWebhooks :many
+SELECT id, guild_id, url, secret, created_at
+FROM webhook
+WHERE guild_id = $1
+ORDER BY id
+`
+
+func (q *Queries) SelectWebhooks(ctx context.Context, guildID string) ([]Webhook, error) {
+	rows, err := q.db.Query(ctx, selectWebhooks, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Webhook
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.GuildID,
+			&i.Url,
+			&i.Secret,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,190 @@
+package sqlc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/webhook"
+)
+
+func TestInsertWebhook(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	createdAt := pgtype.Timestamptz{Time: time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC), Valid: true}
+	mock.ExpectQuery("INSERT INTO webhook").
+		WithArgs("guild-id", "https://example.com/hook", "secret").
+		WillReturnRows(pgxmock.NewRows([]string{"id", "guild_id", "url", "secret", "created_at"}).
+			AddRow(int64(1), "guild-id", "https://example.com/hook", "secret", createdAt))
+	repo := NewWebhookRepository(mock)
+
+	// when
+	w, err := repo.InsertWebhook(context.Background(), "guild-id", "https://example.com/hook", "secret")
+
+	// then
+	assert.NoError(err)
+	assert.Equal(&webhook.Webhook{
+		ID:        1,
+		GuildID:   "guild-id",
+		URL:       "https://example.com/hook",
+		Secret:    "secret",
+		CreatedAt: createdAt.Time,
+	}, w)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	mock.ExpectExec("DELETE FROM webhook").
+		WithArgs(int64(1), "guild-id").
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectExec("DELETE FROM webhook").
+		WithArgs(int64(2), "guild-id").
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	repo := NewWebhookRepository(mock)
+
+	// when
+	deleted, deletedErr := repo.DeleteWebhook(context.Background(), "guild-id", 1)
+	missing, missingErr := repo.DeleteWebhook(context.Background(), "guild-id", 2)
+
+	// then
+	assert.NoError(deletedErr)
+	assert.True(deleted)
+	assert.NoError(missingErr)
+	assert.False(missing)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestEnqueueDeliveries(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	payload := []byte(`{"event":"reservation.created"}`)
+	mock.ExpectExec("INSERT INTO webhook_delivery").
+		WithArgs("reservation.created", payload, "guild-id").
+		WillReturnResult(pgxmock.NewResult("INSERT", 2))
+	repo := NewWebhookRepository(mock)
+
+	// when
+	enqueued, err := repo.EnqueueDeliveries(context.Background(), "guild-id", webhook.EventReservationCreated, payload)
+
+	// then
+	assert.NoError(err)
+	assert.Equal(2, enqueued)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestClaimDueDeliveries(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	leaseUntil := now.Add(5 * time.Minute)
+	payload := []byte(`{"event":"reservation.created"}`)
+	mock.ExpectQuery("UPDATE webhook_delivery d (.+) FOR UPDATE SKIP LOCKED").
+		WithArgs(pgtype.Timestamptz{Time: leaseUntil, Valid: true}, pgtype.Timestamptz{Time: now, Valid: true}, int32(20)).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "webhook_id", "guild_id", "event", "payload", "attempts", "url", "secret"}).
+			AddRow(int64(3), int64(1), "guild-id", "reservation.created", payload, int32(2), "https://example.com/hook", "secret"))
+	repo := NewWebhookRepository(mock)
+
+	// when
+	deliveries, err := repo.ClaimDueDeliveries(context.Background(), now, leaseUntil, 20)
+
+	// then
+	assert.NoError(err)
+	assert.Equal([]*webhook.Delivery{{
+		ID:            3,
+		WebhookID:     1,
+		GuildID:       "guild-id",
+		URL:           "https://example.com/hook",
+		Secret:        "secret",
+		Event:         webhook.EventReservationCreated,
+		Payload:       payload,
+		Status:        webhook.DeliveryPending,
+		Attempts:      2,
+		NextAttemptAt: leaseUntil,
+	}}, deliveries)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestScheduleRetry(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	nextAttemptAt := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectExec("UPDATE webhook_delivery").
+		WithArgs(pgtype.Timestamptz{Time: nextAttemptAt, Valid: true}, int32(503), "unexpected HTTP status: 503", int64(3)).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	repo := NewWebhookRepository(mock)
+
+	// when
+	err = repo.ScheduleRetry(context.Background(), 3, nextAttemptAt, webhook.Attempt{ResponseStatus: 503, Error: "unexpected HTTP status: 503"})
+
+	// then
+	assert.NoError(err)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDeadLetterDelivery(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	mock.ExpectExec("WITH dead AS (.+) INSERT INTO webhook_dead_letter").
+		WithArgs(int32(0), "connection refused", int64(3)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	repo := NewWebhookRepository(mock)
+
+	// when
+	err = repo.DeadLetterDelivery(context.Background(), 3, webhook.Attempt{Error: "connection refused"})
+
+	// then
+	assert.NoError(err)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestSelectRecentDeliveries(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	at := pgtype.Timestamptz{Time: time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC), Valid: true}
+	mock.ExpectQuery("SELECT (.+) FROM webhook_delivery d").
+		WithArgs("guild-id", int32(10)).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "webhook_id", "guild_id", "event", "status", "attempts", "next_attempt_at",
+			"response_status", "last_error", "created_at", "updated_at", "url",
+		}).AddRow(int64(3), int64(1), "guild-id", "reservation.cancelled", "delivered", int32(1), at, int32(204), "", at, at, "https://example.com/hook"))
+	repo := NewWebhookRepository(mock)
+
+	// when
+	deliveries, err := repo.SelectRecentDeliveries(context.Background(), "guild-id", 10)
+
+	// then
+	assert.NoError(err)
+	assert.Len(deliveries, 1)
+	assert.Equal(webhook.EventReservationCancelled, deliveries[0].Event)
+	assert.Equal(webhook.DeliveryDelivered, deliveries[0].Status)
+	assert.Equal(204, deliveries[0].ResponseStatus)
+	assert.Equal("https://example.com/hook", deliveries[0].URL)
+	assert.NoError(mock.ExpectationsWereMet())
+}
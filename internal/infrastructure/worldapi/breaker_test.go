@@ -0,0 +1,35 @@
+package worldapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreakerAllowsSingleProbeWhenHalfOpen(t *testing.T) {
+	// given
+	now := time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC)
+	b := newBreaker(2, time.Minute)
+	b.now = func() time.Time { return now }
+	b.failure()
+	b.failure()
+	now = now.Add(time.Minute)
+
+	// when
+	probe := b.allow()
+	rejected := b.allow()
+	b.failure()
+	reopened := b.allow()
+	now = now.Add(time.Minute)
+	secondProbe := b.allow()
+	b.success()
+	closed := b.allow()
+
+	// then
+	assert.True(t, probe)
+	assert.False(t, rejected)
+	assert.False(t, reopened)
+	assert.True(t, secondProbe)
+	assert.True(t, closed)
+}
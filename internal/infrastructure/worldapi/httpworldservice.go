@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"spot-assistant/internal/common/collections"
@@ -47,6 +48,90 @@ func (h *HttpWorldService) GetOnlinePlayerNames(worldName string) ([]string, err
 	return names, nil
 }
 
+// GetCharacter returns the public profile of a character, including its comment.
+func (h *HttpWorldService) GetCharacter(name string) (*world.Character, error) {
+	resp, err := h.Client.Get(fmt.Sprintf("%s/character/%s", h.BaseURL, url.PathEscape(name)))
+	if err != nil {
+		return nil, fmt.Errorf("error making GET request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, world.ErrCharacterNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status: %d", resp.StatusCode)
+	}
+
+	var data world.CharacterResponse
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	// Unknown characters are returned with an empty profile.
+	if data.Character.Character.Name == "" {
+		return nil, world.ErrCharacterNotFound
+	}
+
+	return &data.Character.Character, nil
+}
+
+// GetWorldNames returns names of all Tibia worlds.
+func (h *HttpWorldService) GetWorldNames() ([]string, error) {
+	resp, err := h.Client.Get(fmt.Sprintf("%s/worlds", h.BaseURL))
+	if err != nil {
+		return nil, fmt.Errorf("error making GET request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status: %d", resp.StatusCode)
+	}
+
+	var data world.WorldsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	names := collections.PoorMansMap(data.Worlds.RegularWorlds, func(w world.Overview) string {
+		return w.Name
+	})
+
+	return names, nil
+}
+
+// GetGuildMembers returns names of characters in an in-game guild.
+func (h *HttpWorldService) GetGuildMembers(guildName string) ([]string, error) {
+	resp, err := h.Client.Get(fmt.Sprintf("%s/guild/%s", h.BaseURL, url.PathEscape(guildName)))
+	if err != nil {
+		return nil, fmt.Errorf("error making GET request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, world.ErrGuildNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status: %d", resp.StatusCode)
+	}
+
+	var data world.GuildResponse
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	// Unknown guilds are returned without a name, like unknown characters.
+	if data.Guild.Name == "" {
+		return nil, world.ErrGuildNotFound
+	}
+
+	names := collections.PoorMansMap(data.Guild.Members, func(p world.Player) string {
+		return p.Name
+	})
+
+	return names, nil
+}
+
 func (h *HttpWorldService) GetBaseURL() string {
 	return h.BaseURL
 }
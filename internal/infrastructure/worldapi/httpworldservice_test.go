@@ -130,3 +130,110 @@ func TestGetOnlinePlayerNames_Timeout(t *testing.T) {
 	require.Nil(t, names)
 	require.Less(t, elapsed, 200*time.Millisecond, "should timeout before server responds")
 }
+
+func TestGetCharacter_Success(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/character/Asar Cham", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"character":{"character":{"name":"Asar Cham","world":"Celesta","level":312,"vocation":"Elite Knight","comment":"SA-1234"}}}`))
+	}))
+	defer server.Close()
+
+	service := NewHttpWorldService(server.URL)
+
+	// when
+	character, err := service.GetCharacter("Asar Cham")
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, &dto.Character{Name: "Asar Cham", World: "Celesta", Level: 312, Vocation: "Elite Knight", Comment: "SA-1234"}, character)
+}
+
+func TestGetCharacter_NotFound(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"character":{"character":{"name":""}}}`))
+	}))
+	defer server.Close()
+
+	service := NewHttpWorldService(server.URL)
+
+	// when
+	_, err := service.GetCharacter("Nobody")
+
+	// then
+	require.ErrorIs(t, err, dto.ErrCharacterNotFound)
+}
+
+func TestGetWorldNames_Success(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/worlds", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"worlds":{"regular_worlds":[{"name":"Antica"},{"name":"Celesta"}],"tournament_worlds":[]}}`))
+	}))
+	defer server.Close()
+
+	service := NewHttpWorldService(server.URL)
+
+	// when
+	names, err := service.GetWorldNames()
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, []string{"Antica", "Celesta"}, names)
+}
+
+func TestGetWorldNames_Non200Status(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	service := NewHttpWorldService(server.URL)
+
+	// when
+	_, err := service.GetWorldNames()
+
+	// then
+	require.Error(t, err)
+}
+
+func TestGetGuildMembers_Success(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/guild/Red Rose", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"guild":{"name":"Red Rose","members":[{"name":"Asar Cham"},{"name":"Mariysz"}]}}`))
+	}))
+	defer server.Close()
+
+	service := NewHttpWorldService(server.URL)
+
+	// when
+	names, err := service.GetGuildMembers("Red Rose")
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, []string{"Asar Cham", "Mariysz"}, names)
+}
+
+func TestGetGuildMembers_NotFound(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"guild":{"name":""}}`))
+	}))
+	defer server.Close()
+
+	service := NewHttpWorldService(server.URL)
+
+	// when
+	_, err := service.GetGuildMembers("Nobody")
+
+	// then
+	require.ErrorIs(t, err, dto.ErrGuildNotFound)
+}
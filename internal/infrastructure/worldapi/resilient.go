@@ -0,0 +1,219 @@
+package worldapi
+
+import (
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"spot-assistant/internal/core/dto/world"
+	"spot-assistant/internal/ports"
+)
+
+const (
+	// DefaultCacheTTL is how long online players of a world are reused. It is shorter than a tick,
+	// so every tick sees fresh players, while guilds on the same world share a single call.
+	DefaultCacheTTL = time.Minute
+
+	// DefaultAttempts of a call to the world API, including the first one.
+	DefaultAttempts = 3
+
+	// DefaultBackoff before the first retry. It doubles with every retry, and is jittered.
+	DefaultBackoff = 500 * time.Millisecond
+
+	// DefaultBreakerThreshold is how many calls in a row have to fail, after retries, to open the breaker.
+	DefaultBreakerThreshold = 3
+
+	// DefaultBreakerCooldown is how long the breaker stays open.
+	DefaultBreakerCooldown = time.Minute
+)
+
+// Operations of the world API, as labelled in metrics.
+const (
+	operationOnlinePlayers = "online_players"
+	operationCharacter     = "character"
+	operationWorlds        = "worlds"
+	operationGuild         = "guild"
+)
+
+var ErrCircuitOpen = errors.New("world API is unavailable, try again later")
+
+// ResilientWorldService decorates the world API for many guilds playing on the same worlds.
+// Concurrent fetches of online players of a world are made once, and the players are cached
+// for a while. Failed calls are retried with a jittered backoff, and once calls keep failing,
+// the circuit breaker fails them fast for a while, instead of waiting for timeouts. Each operation
+// has its own breaker, so a failing endpoint does not take down the others.
+type ResilientWorldService struct {
+	api      ports.WorldApi
+	metrics  ports.MetricsPort
+	log      *zap.SugaredLogger
+	group    singleflight.Group
+	cache    cmap.ConcurrentMap[string, cachedPlayers]
+	breakers map[string]*breaker
+	cacheTTL time.Duration
+	attempts int
+	backoff  time.Duration
+	now      func() time.Time
+	sleep    func(time.Duration)
+}
+
+type cachedPlayers struct {
+	names     []string
+	fetchedAt time.Time
+}
+
+func NewResilientWorldService(api ports.WorldApi) *ResilientWorldService {
+	return &ResilientWorldService{
+		api:   api,
+		log:   zap.NewNop().Sugar(),
+		cache: cmap.New[cachedPlayers](),
+		breakers: map[string]*breaker{
+			operationOnlinePlayers: newBreaker(DefaultBreakerThreshold, DefaultBreakerCooldown),
+			operationCharacter:     newBreaker(DefaultBreakerThreshold, DefaultBreakerCooldown),
+			operationWorlds:        newBreaker(DefaultBreakerThreshold, DefaultBreakerCooldown),
+			operationGuild:         newBreaker(DefaultBreakerThreshold, DefaultBreakerCooldown),
+		},
+		cacheTTL: DefaultCacheTTL,
+		attempts: DefaultAttempts,
+		backoff:  DefaultBackoff,
+		now:      time.Now,
+		sleep:    time.Sleep,
+	}
+}
+
+func (s *ResilientWorldService) WithMetrics(m ports.MetricsPort) *ResilientWorldService {
+	s.metrics = m
+	return s
+}
+
+func (s *ResilientWorldService) WithLogger(log *zap.SugaredLogger) *ResilientWorldService {
+	s.log = log.With("layer", "infrastructure", "name", "resilientWorldService")
+	return s
+}
+
+// GetOnlinePlayerNames returns names of players online in the world. The names are shared
+// by all callers, so they must not be modified.
+func (s *ResilientWorldService) GetOnlinePlayerNames(worldName string) ([]string, error) {
+	key := strings.ToLower(worldName)
+	if cached, ok := s.cache.Get(key); ok && s.now().Sub(cached.fetchedAt) < s.cacheTTL {
+		s.observeCache("hit")
+		return cached.names, nil
+	}
+	s.observeCache("miss")
+
+	names, err, _ := s.group.Do(key, func() (any, error) {
+		names, err := call(s, operationOnlinePlayers, func() ([]string, error) {
+			return s.api.GetOnlinePlayerNames(worldName)
+		})
+		if err != nil {
+			return nil, err
+		}
+		s.cache.Set(key, cachedPlayers{names: names, fetchedAt: s.now()})
+
+		return names, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return names.([]string), nil
+}
+
+// GetCharacter returns the public profile of a character. Profiles are not cached, so a character
+// is verified against its latest comment.
+func (s *ResilientWorldService) GetCharacter(name string) (*world.Character, error) {
+	return call(s, operationCharacter, func() (*world.Character, error) {
+		return s.api.GetCharacter(name)
+	})
+}
+
+// GetWorldNames returns names of all Tibia worlds. They are cached by the caller, rather than here.
+func (s *ResilientWorldService) GetWorldNames() ([]string, error) {
+	return call(s, operationWorlds, s.api.GetWorldNames)
+}
+
+// GetGuildMembers returns names of characters in an in-game guild. They are cached by the caller.
+func (s *ResilientWorldService) GetGuildMembers(guildName string) ([]string, error) {
+	return call(s, operationGuild, func() ([]string, error) {
+		return s.api.GetGuildMembers(guildName)
+	})
+}
+
+func (s *ResilientWorldService) GetBaseURL() string {
+	return s.api.GetBaseURL()
+}
+
+// call calls the world API through the circuit breaker, retrying failures. A character or guild
+// not found is an answer of the API, rather than its failure, so it is neither retried nor held against it.
+func call[T any](s *ResilientWorldService, operation string, fn func() (T, error)) (T, error) {
+	var zero T
+	breaker := s.breakers[operation]
+	if !breaker.allow() {
+		s.observeError(operation, "circuit_open")
+		return zero, ErrCircuitOpen
+	}
+
+	var err error
+	for attempt := 0; attempt < s.attempts; attempt++ {
+		if attempt > 0 {
+			s.sleep(s.backoffBefore(attempt))
+		}
+
+		start := time.Now()
+		var res T
+		res, err = fn()
+		s.observeRequest(operation, err, time.Since(start))
+		if err == nil || isNotFound(err) {
+			breaker.success()
+			return res, err
+		}
+		s.log.With("operation", operation, "attempt", attempt+1).Warnf("world API call failed: %s", err)
+	}
+
+	breaker.failure()
+	s.observeError(operation, "unavailable")
+	return zero, err
+}
+
+func isNotFound(err error) bool {
+	return errors.Is(err, world.ErrCharacterNotFound) || errors.Is(err, world.ErrGuildNotFound)
+}
+
+// backoffBefore returns how long to wait before the retry, between a half and the whole
+// of the backoff doubled with every retry, so instances do not retry all at once.
+func (s *ResilientWorldService) backoffBefore(retry int) time.Duration {
+	backoff := s.backoff << (retry - 1)
+
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+func (s *ResilientWorldService) observeRequest(operation string, err error, duration time.Duration) {
+	if s.metrics == nil {
+		return
+	}
+
+	outcome := "ok"
+	switch {
+	case isNotFound(err):
+		outcome = "not_found"
+	case err != nil:
+		outcome = "error"
+	}
+	s.metrics.ObserveWorldApiRequest(operation, outcome, duration)
+}
+
+func (s *ResilientWorldService) observeError(operation, reason string) {
+	if s.metrics != nil {
+		s.metrics.IncWorldApiError(operation, reason)
+	}
+}
+
+func (s *ResilientWorldService) observeCache(result string) {
+	if s.metrics != nil {
+		s.metrics.IncWorldApiCache(result)
+	}
+}
@@ -0,0 +1,161 @@
+package worldapi
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"spot-assistant/internal/common/test/mocks"
+	dto "spot-assistant/internal/core/dto/world"
+)
+
+func newTestResilientWorldService(t *testing.T, api *mocks.MockWorldApi, now *time.Time) *ResilientWorldService {
+	metrics := mocks.NewMockMetricsPort(t)
+	metrics.On("ObserveWorldApiRequest", mock.Anything, mock.Anything, mock.Anything).Maybe()
+	metrics.On("IncWorldApiError", mock.Anything, mock.Anything).Maybe()
+	metrics.On("IncWorldApiCache", mock.Anything).Maybe()
+
+	s := NewResilientWorldService(api).WithMetrics(metrics)
+	s.now = func() time.Time { return *now }
+	for _, b := range s.breakers {
+		b.now = s.now
+	}
+	s.sleep = func(time.Duration) {}
+	return s
+}
+
+func TestResilientGetOnlinePlayerNamesCachesPlayers(t *testing.T) {
+	// given
+	now := time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC)
+	api := mocks.NewMockWorldApi(t)
+	api.On("GetOnlinePlayerNames", "Celesta").Return([]string{"Mariysz"}, nil).Twice()
+	s := newTestResilientWorldService(t, api, &now)
+
+	// when
+	first, err1 := s.GetOnlinePlayerNames("Celesta")
+	cached, err2 := s.GetOnlinePlayerNames("celesta")
+	now = now.Add(DefaultCacheTTL)
+	expired, err3 := s.GetOnlinePlayerNames("Celesta")
+
+	// then
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.NoError(t, err3)
+	assert.Equal(t, []string{"Mariysz"}, first)
+	assert.Equal(t, []string{"Mariysz"}, cached)
+	assert.Equal(t, []string{"Mariysz"}, expired)
+}
+
+func TestResilientGetOnlinePlayerNamesDedupesConcurrentCalls(t *testing.T) {
+	// given
+	now := time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC)
+	release := make(chan time.Time)
+	api := mocks.NewMockWorldApi(t)
+	api.On("GetOnlinePlayerNames", "Celesta").
+		WaitUntil(release).
+		Return([]string{"Mariysz"}, nil).Once()
+	s := newTestResilientWorldService(t, api, &now)
+
+	// when
+	var wg sync.WaitGroup
+	results := make([][]string, 5)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = s.GetOnlinePlayerNames("Celesta")
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// then
+	for _, names := range results {
+		assert.Equal(t, []string{"Mariysz"}, names)
+	}
+}
+
+func TestResilientGetOnlinePlayerNamesRetriesFailures(t *testing.T) {
+	// given
+	now := time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC)
+	api := mocks.NewMockWorldApi(t)
+	api.On("GetOnlinePlayerNames", "Celesta").Return(nil, errors.New("timeout")).Twice()
+	api.On("GetOnlinePlayerNames", "Celesta").Return([]string{"Mariysz"}, nil).Once()
+	s := newTestResilientWorldService(t, api, &now)
+
+	// when
+	names, err := s.GetOnlinePlayerNames("Celesta")
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Mariysz"}, names)
+}
+
+func TestResilientCircuitBreakerOpensAfterFailures(t *testing.T) {
+	// given
+	now := time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC)
+	api := mocks.NewMockWorldApi(t)
+	api.On("GetOnlinePlayerNames", "Celesta").
+		Return(nil, errors.New("timeout")).Times(DefaultAttempts * DefaultBreakerThreshold)
+	s := newTestResilientWorldService(t, api, &now)
+	for range DefaultBreakerThreshold {
+		_, err := s.GetOnlinePlayerNames("Celesta")
+		assert.Error(t, err)
+	}
+
+	// when
+	_, open := s.GetOnlinePlayerNames("Celesta")
+	now = now.Add(DefaultBreakerCooldown)
+	api.On("GetOnlinePlayerNames", "Celesta").Return([]string{"Mariysz"}, nil).Once()
+	names, closed := s.GetOnlinePlayerNames("Celesta")
+
+	// then
+	assert.ErrorIs(t, open, ErrCircuitOpen)
+	assert.NoError(t, closed)
+	assert.Equal(t, []string{"Mariysz"}, names)
+}
+
+func TestResilientCircuitBreakerIsPerOperation(t *testing.T) {
+	// given
+	now := time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC)
+	api := mocks.NewMockWorldApi(t)
+	api.On("GetOnlinePlayerNames", "Celesta").
+		Return(nil, errors.New("timeout")).Times(DefaultAttempts * DefaultBreakerThreshold)
+	api.On("GetGuildMembers", "Red Rose").Return([]string{"Mariysz"}, nil).Once()
+	s := newTestResilientWorldService(t, api, &now)
+	for range DefaultBreakerThreshold {
+		_, err := s.GetOnlinePlayerNames("Celesta")
+		assert.Error(t, err)
+	}
+
+	// when
+	_, open := s.GetOnlinePlayerNames("Celesta")
+	members, err := s.GetGuildMembers("Red Rose")
+
+	// then
+	assert.ErrorIs(t, open, ErrCircuitOpen)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Mariysz"}, members)
+}
+
+func TestResilientGetCharacterDoesNotRetryNotFound(t *testing.T) {
+	// given
+	now := time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC)
+	api := mocks.NewMockWorldApi(t)
+	api.On("GetCharacter", "Nobody").Return(nil, dto.ErrCharacterNotFound).Times(DefaultBreakerThreshold + 1)
+	s := newTestResilientWorldService(t, api, &now)
+
+	// when
+	var err error
+	for range DefaultBreakerThreshold + 1 {
+		_, err = s.GetCharacter("Nobody")
+	}
+
+	// then
+	assert.ErrorIs(t, err, dto.ErrCharacterNotFound)
+}
@@ -0,0 +1,13 @@
+-- name: UpsertWorlds :exec
+INSERT INTO tibia_world (name, refreshed_at)
+SELECT unnest(@names::text[]), @refreshed_at::timestamptz
+ON CONFLICT (name) DO UPDATE SET refreshed_at = EXCLUDED.refreshed_at;
+
+-- name: DeleteWorldsRefreshedBefore :exec
+DELETE FROM tibia_world
+WHERE refreshed_at < @refreshed_at;
+
+-- name: SelectWorlds :many
+SELECT name, refreshed_at
+FROM tibia_world
+ORDER BY name;
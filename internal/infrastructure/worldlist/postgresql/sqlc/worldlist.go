@@ -0,0 +1,69 @@
+package sqlc
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"spot-assistant/internal/common/errors"
+	"spot-assistant/internal/core/dto/world"
+)
+
+type DBTXWrapper interface {
+	DBTX
+
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+type WorldListRepository struct {
+	q  *Queries
+	db DBTXWrapper
+}
+
+func NewWorldListRepository(db DBTXWrapper) *WorldListRepository {
+	return &WorldListRepository{
+		q:  New(db),
+		db: db,
+	}
+}
+
+// ReplaceWorlds stores names of Tibia worlds fetched at the time, removing worlds, which are gone,
+// e.g. merged into other worlds.
+func (repo *WorldListRepository) ReplaceWorlds(ctx context.Context, names []string, refreshedAt time.Time) error {
+	tx, err := repo.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer errors.ExecuteAndIgnoreErrorF(tx.Rollback, ctx)
+	qtx := repo.q.WithTx(tx)
+
+	at := pgtype.Timestamptz{Time: refreshedAt, Valid: true}
+	if err = qtx.UpsertWorlds(ctx, UpsertWorldsParams{Names: names, RefreshedAt: at}); err != nil {
+		return err
+	}
+	if err = qtx.DeleteWorldsRefreshedBefore(ctx, at); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// SelectWorlds returns names of Tibia worlds, by name, as they were when last fetched.
+func (repo *WorldListRepository) SelectWorlds(ctx context.Context) (*world.List, error) {
+	rows, err := repo.q.SelectWorlds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &world.List{Names: make([]string, len(rows))}
+	for i, row := range rows {
+		list.Names[i] = row.Name
+		if list.RefreshedAt.IsZero() || row.RefreshedAt.Time.Before(list.RefreshedAt) {
+			list.RefreshedAt = row.RefreshedAt.Time
+		}
+	}
+
+	return list, nil
+}
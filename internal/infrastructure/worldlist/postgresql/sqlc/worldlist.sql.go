@@ -0,0 +1,64 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: worldlist.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteWorldsRefreshedBefore = `-- name: DeleteWorldsRefreshedBefore :exec
+DELETE FROM tibia_world
+WHERE refreshed_at < $1
+`
+
+func (q *Queries) DeleteWorldsRefreshedBefore(ctx context.Context, refreshedAt pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, deleteWorldsRefreshedBefore, refreshedAt)
+	return err
+}
+
+const selectWorlds = `-- name: SelectWorlds :many
+SELECT name, refreshed_at
+FROM tibia_world
+ORDER BY name
+`
+
+func (q *Queries) SelectWorlds(ctx context.Context) ([]TibiaWorld, error) {
+	rows, err := q.db.Query(ctx, selectWorlds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TibiaWorld
+	for rows.Next() {
+		var i TibiaWorld
+		if err := rows.Scan(&i.Name, &i.RefreshedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertWorlds = `-- name: UpsertWorlds :exec
+INSERT INTO tibia_world (name, refreshed_at)
+SELECT unnest($1::text[]), $2::timestamptz
+ON CONFLICT (name) DO UPDATE SET refreshed_at = EXCLUDED.refreshed_at
+`
+
+type UpsertWorldsParams struct {
+	Names       []string
+	RefreshedAt pgtype.Timestamptz
+}
+
+func (q *Queries) UpsertWorlds(ctx context.Context, arg UpsertWorldsParams) error {
+	_, err := q.db.Exec(ctx, upsertWorlds, arg.Names, arg.RefreshedAt)
+	return err
+}
@@ -0,0 +1,83 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/assert"
+
+	"spot-assistant/internal/core/dto/world"
+)
+
+func TestReplaceWorlds(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	now := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	at := pgtype.Timestamptz{Time: now, Valid: true}
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO tibia_world").
+		WithArgs([]string{"Antica", "Celesta"}, at).
+		WillReturnResult(pgxmock.NewResult("INSERT", 2))
+	mock.ExpectExec("DELETE FROM tibia_world").
+		WithArgs(at).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectCommit()
+	repo := NewWorldListRepository(mock)
+
+	// when
+	err = repo.ReplaceWorlds(context.Background(), []string{"Antica", "Celesta"}, now)
+
+	// then
+	assert.NoError(err)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestReplaceWorldsRollsBackOnError(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	errInsert := errors.New("insert failed")
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO tibia_world").WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnError(errInsert)
+	mock.ExpectRollback()
+	repo := NewWorldListRepository(mock)
+
+	// when
+	err = repo.ReplaceWorlds(context.Background(), []string{"Antica"}, time.Now())
+
+	// then
+	assert.ErrorIs(err, errInsert)
+	assert.NoError(mock.ExpectationsWereMet())
+}
+
+func TestSelectWorlds(t *testing.T) {
+	// given
+	assert := assert.New(t)
+	mock, err := pgxmock.NewPool()
+	assert.NoError(err)
+	defer mock.Close()
+	earlier := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Hour)
+	mock.ExpectQuery("SELECT name, refreshed_at FROM tibia_world").
+		WillReturnRows(pgxmock.NewRows([]string{"name", "refreshed_at"}).
+			AddRow("Antica", pgtype.Timestamptz{Time: later, Valid: true}).
+			AddRow("Celesta", pgtype.Timestamptz{Time: earlier, Valid: true}))
+	repo := NewWorldListRepository(mock)
+
+	// when
+	list, err := repo.SelectWorlds(context.Background())
+
+	// then
+	assert.NoError(err)
+	assert.Equal(&world.List{Names: []string{"Antica", "Celesta"}, RefreshedAt: earlier}, list)
+	assert.NoError(mock.ExpectationsWereMet())
+}
@@ -1,11 +1,14 @@
--- name: UpsertGuildWorld :exec
+-- name: InsertGuildWorld :execrows
 INSERT INTO guilds_world (guild_id, world_name, created_at, updated_at)
 VALUES ($1, $2, now(), now())
-ON CONFLICT (guild_id)
-DO UPDATE SET world_name = EXCLUDED.world_name, updated_at = now();
+ON CONFLICT (guild_id, world_name) DO NOTHING;
 
--- name: SelectGuildWorld :one
+-- name: DeleteGuildWorld :execrows
+DELETE FROM guilds_world
+WHERE guild_id = $1 AND world_name = $2;
+
+-- name: SelectGuildWorlds :many
 SELECT id, guild_id, world_name
 FROM guilds_world
 WHERE guild_id = $1
-LIMIT 1;
+ORDER BY world_name;
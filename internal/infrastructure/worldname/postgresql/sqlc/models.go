@@ -52,6 +52,14 @@ type AuthUserUserPermission struct {
 	PermissionID int32
 }
 
+type CalendarFeed struct {
+	GuildID   string
+	MemberID  string
+	Nonce     string
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
 type DjangoAdminLog struct {
 	ID            int32
 	ActionTime    pgtype.Timestamptz
@@ -82,6 +90,33 @@ type DjangoSession struct {
 	ExpireDate  pgtype.Timestamptz
 }
 
+type GuildSetting struct {
+	GuildID                  string
+	SummaryChart             string
+	SummaryLayout            string
+	SummaryChannelID         string
+	CommandChannelID         string
+	PrivilegedRoleID         string
+	BookingChannelIds        []string
+	MirrorBookings           bool
+	FavouriteSpots           []string
+	BrandingTitle            string
+	BrandingUrl              string
+	BrandingDescription      string
+	BrandingColor            int32
+	BrandingThumbnailUrl     string
+	BrandingPreMessage       string
+	BrandingHidePreMessage   bool
+	ReminderMinutesBefore    int32
+	ReliabilityMinScore      int32
+	ReliabilityMaxHoursAhead int32
+	ReliabilityOverbookDm    bool
+	TibiaGuild               string
+	SpotRequirements         bool
+	CreatedAt                pgtype.Timestamptz
+	UpdatedAt                pgtype.Timestamptz
+}
+
 type GuildsWorld struct {
 	ID        int64
 	GuildID   string
@@ -90,6 +125,88 @@ type GuildsWorld struct {
 	UpdatedAt pgtype.Timestamptz
 }
 
+type MemberCharacter struct {
+	ID               int64
+	GuildID          string
+	MemberID         string
+	Name             string
+	VerificationCode string
+	VerifiedAt       pgtype.Timestamptz
+	CreatedAt        pgtype.Timestamptz
+	WorldName        string
+}
+
+type MemberNotification struct {
+	GuildID   string
+	MemberID  string
+	Kind      string
+	Channel   string
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type MemberReminder struct {
+	GuildID       string
+	MemberID      string
+	MinutesBefore int32
+	CreatedAt     pgtype.Timestamptz
+	UpdatedAt     pgtype.Timestamptz
+}
+
+type NotificationOutbox struct {
+	ID                 int64
+	GuildID            string
+	RecipientDiscordID string
+	Kind               string
+	Payload            []byte
+	Status             string
+	Attempts           int32
+	NextAttemptAt      pgtype.Timestamptz
+	LastError          string
+	CreatedAt          pgtype.Timestamptz
+	UpdatedAt          pgtype.Timestamptz
+}
+
+type ReservationAttendance struct {
+	ReservationID int64
+	Samples       int32
+	OnlineSamples int32
+	FirstOnlineAt pgtype.Timestamptz
+	LastSampledAt pgtype.Timestamptz
+}
+
+type ReservationIncident struct {
+	ID            int64
+	GuildID       string
+	MemberID      string
+	ReservationID int64
+	Kind          string
+	StartAt       pgtype.Timestamptz
+	OccurredAt    pgtype.Timestamptz
+}
+
+type ReservationReminder struct {
+	GuildID         string
+	AuthorDiscordID string
+	SpotID          int64
+	StartAt         pgtype.Timestamptz
+	SentAt          pgtype.Timestamptz
+}
+
+type SummaryMessage struct {
+	ID        int64
+	GuildID   string
+	ChannelID string
+	MessageID string
+	Position  int32
+	CreatedAt pgtype.Timestamptz
+}
+
+type TibiaWorld struct {
+	Name        string
+	RefreshedAt pgtype.Timestamptz
+}
+
 type WebReservation struct {
 	ID              int64
 	Author          string
@@ -105,4 +222,44 @@ type WebSpot struct {
 	ID        int64
 	Name      string
 	CreatedAt pgtype.Timestamptz
+	MinLevel  int32
+	MaxLevel  int32
+	Vocations []string
+}
+
+type Webhook struct {
+	ID        int64
+	GuildID   string
+	Url       string
+	Secret    string
+	CreatedAt pgtype.Timestamptz
+}
+
+type WebhookDeadLetter struct {
+	ID             int64
+	DeliveryID     int64
+	WebhookID      int64
+	GuildID        string
+	Url            string
+	Event          string
+	Payload        []byte
+	Attempts       int32
+	ResponseStatus int32
+	LastError      string
+	CreatedAt      pgtype.Timestamptz
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	WebhookID      int64
+	GuildID        string
+	Event          string
+	Payload        []byte
+	Status         string
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamptz
+	ResponseStatus int32
+	LastError      string
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
 }
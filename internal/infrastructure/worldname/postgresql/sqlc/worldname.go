@@ -15,21 +15,47 @@ func NewWorldNameRepository(db DBTX) *WorldNameRepository {
 	}
 }
 
-func (repo *WorldNameRepository) UpsertGuildWorld(ctx context.Context, guildID string, worldName string) error {
-	return repo.q.UpsertGuildWorld(ctx, UpsertGuildWorldParams{
+// InsertGuildWorld registers a world of a guild. Returns false if the world is already registered.
+func (repo *WorldNameRepository) InsertGuildWorld(ctx context.Context, guildID string, worldName string) (bool, error) {
+	inserted, err := repo.q.InsertGuildWorld(ctx, InsertGuildWorldParams{
 		GuildID:   guildID,
 		WorldName: worldName,
 	})
+	if err != nil {
+		return false, err
+	}
+
+	return inserted > 0, nil
+}
+
+// DeleteGuildWorld removes a world of a guild. Returns false if the world is not registered.
+func (repo *WorldNameRepository) DeleteGuildWorld(ctx context.Context, guildID string, worldName string) (bool, error) {
+	deleted, err := repo.q.DeleteGuildWorld(ctx, DeleteGuildWorldParams{
+		GuildID:   guildID,
+		WorldName: worldName,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return deleted > 0, nil
 }
 
-func (repo *WorldNameRepository) SelectGuildWorld(ctx context.Context, guildID string) (*guildsworld.GuildsWorld, error) {
-	res, err := repo.q.SelectGuildWorld(ctx, guildID)
+// SelectGuildWorlds returns worlds of a guild, by name.
+func (repo *WorldNameRepository) SelectGuildWorlds(ctx context.Context, guildID string) ([]*guildsworld.GuildsWorld, error) {
+	rows, err := repo.q.SelectGuildWorlds(ctx, guildID)
 	if err != nil {
 		return nil, err
 	}
-	return &guildsworld.GuildsWorld{
-		ID:        res.ID,
-		GuildID:   res.GuildID,
-		WorldName: res.WorldName,
-	}, nil
+
+	worlds := make([]*guildsworld.GuildsWorld, len(rows))
+	for i, row := range rows {
+		worlds[i] = &guildsworld.GuildsWorld{
+			ID:        row.ID,
+			GuildID:   row.GuildID,
+			WorldName: row.WorldName,
+		}
+	}
+
+	return worlds, nil
 }
@@ -9,39 +9,72 @@ import (
 	"context"
 )
 
-const selectGuildWorld = `-- name: SelectGuildWorld :one
-SELECT id, guild_id, world_name
-FROM guilds_world
-WHERE guild_id = $1
-LIMIT 1
+const deleteGuildWorld = `-- name: DeleteGuildWorld :execrows
+DELETE FROM guilds_world
+WHERE guild_id = $1 AND world_name = $2
 `
 
-type SelectGuildWorldRow struct {
-	ID        int64
+type DeleteGuildWorldParams struct {
 	GuildID   string
 	WorldName string
 }
 
-func (q *Queries) SelectGuildWorld(ctx context.Context, guildID string) (SelectGuildWorldRow, error) {
-	row := q.db.QueryRow(ctx, selectGuildWorld, guildID)
-	var i SelectGuildWorldRow
-	err := row.Scan(&i.ID, &i.GuildID, &i.WorldName)
-	return i, err
+func (q *Queries) DeleteGuildWorld(ctx context.Context, arg DeleteGuildWorldParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteGuildWorld, arg.GuildID, arg.WorldName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const upsertGuildWorld = `-- name: UpsertGuildWorld :exec
+const insertGuildWorld = `-- name: InsertGuildWorld :execrows
 INSERT INTO guilds_world (guild_id, world_name, created_at, updated_at)
 VALUES ($1, $2, now(), now())
-ON CONFLICT (guild_id)
-DO UPDATE SET world_name = EXCLUDED.world_name, updated_at = now()
+ON CONFLICT (guild_id, world_name) DO NOTHING
+`
+
+type InsertGuildWorldParams struct {
+	GuildID   string
+	WorldName string
+}
+
+func (q *Queries) InsertGuildWorld(ctx context.Context, arg InsertGuildWorldParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertGuildWorld, arg.GuildID, arg.WorldName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const selectGuildWorlds = `-- name: SelectGuildWorlds :many
+SELECT id, guild_id, world_name
+FROM guilds_world
+WHERE guild_id = $1
+ORDER BY world_name
 `
 
-type UpsertGuildWorldParams struct {
+type SelectGuildWorldsRow struct {
+	ID        int64
 	GuildID   string
 	WorldName string
 }
 
-func (q *Queries) UpsertGuildWorld(ctx context.Context, arg UpsertGuildWorldParams) error {
-	_, err := q.db.Exec(ctx, upsertGuildWorld, arg.GuildID, arg.WorldName)
-	return err
+func (q *Queries) SelectGuildWorlds(ctx context.Context, guildID string) ([]SelectGuildWorldsRow, error) {
+	rows, err := q.db.Query(ctx, selectGuildWorlds, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectGuildWorldsRow
+	for rows.Next() {
+		var i SelectGuildWorldsRow
+		if err := rows.Scan(&i.ID, &i.GuildID, &i.WorldName); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
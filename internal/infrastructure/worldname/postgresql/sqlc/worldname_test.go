@@ -11,7 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestUpsertGuildWorld(t *testing.T) {
+func TestInsertGuildWorld(t *testing.T) {
 	mock, err := pgxmock.NewPool()
 	assert.NoError(t, err)
 	defer mock.Close()
@@ -25,12 +25,47 @@ func TestUpsertGuildWorld(t *testing.T) {
 		WithArgs(guildID, worldName).
 		WillReturnResult(pgxmock.NewResult("INSERT", 1))
 
-	err = repo.UpsertGuildWorld(context.Background(), guildID, worldName)
+	inserted, err := repo.InsertGuildWorld(context.Background(), guildID, worldName)
 	assert.NoError(t, err)
+	assert.True(t, inserted)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestSelectGuildWorld(t *testing.T) {
+func TestInsertGuildWorld_AlreadyRegistered(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := NewWorldNameRepository(mock)
+
+	mock.ExpectExec("INSERT INTO guilds_world").
+		WithArgs("guild123", "Celesta").
+		WillReturnResult(pgxmock.NewResult("INSERT", 0))
+
+	inserted, err := repo.InsertGuildWorld(context.Background(), "guild123", "Celesta")
+	assert.NoError(t, err)
+	assert.False(t, inserted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteGuildWorld(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := NewWorldNameRepository(mock)
+
+	mock.ExpectExec("DELETE FROM guilds_world").
+		WithArgs("guild123", "Celesta").
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+	deleted, err := repo.DeleteGuildWorld(context.Background(), "guild123", "Celesta")
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSelectGuildWorlds(t *testing.T) {
 	mock, err := pgxmock.NewPool()
 	assert.NoError(t, err)
 	defer mock.Close()
@@ -38,28 +73,26 @@ func TestSelectGuildWorld(t *testing.T) {
 	repo := NewWorldNameRepository(mock)
 
 	guildID := "guild123"
-	expected := &guildsworld.GuildsWorld{
-		ID:        1,
-		GuildID:   guildID,
-		WorldName: "Celesta",
+	expected := []*guildsworld.GuildsWorld{
+		{ID: 1, GuildID: guildID, WorldName: "Celesta"},
+		{ID: 2, GuildID: guildID, WorldName: "Secura"},
 	}
 
 	rows := pgxmock.NewRows([]string{"id", "guild_id", "world_name"}).
-		AddRow(expected.ID, expected.GuildID, expected.WorldName)
+		AddRow(expected[0].ID, expected[0].GuildID, expected[0].WorldName).
+		AddRow(expected[1].ID, expected[1].GuildID, expected[1].WorldName)
 
 	mock.ExpectQuery("SELECT id, guild_id, world_name FROM guilds_world").
 		WithArgs(guildID).
 		WillReturnRows(rows)
 
-	got, err := repo.SelectGuildWorld(context.Background(), guildID)
+	got, err := repo.SelectGuildWorlds(context.Background(), guildID)
 	assert.NoError(t, err)
-	assert.Equal(t, expected.ID, got.ID)
-	assert.Equal(t, expected.GuildID, got.GuildID)
-	assert.Equal(t, expected.WorldName, got.WorldName)
+	assert.Equal(t, expected, got)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestSelectGuildWorld_NotFound(t *testing.T) {
+func TestSelectGuildWorlds_Error(t *testing.T) {
 	mock, err := pgxmock.NewPool()
 	assert.NoError(t, err)
 	defer mock.Close()
@@ -69,9 +102,9 @@ func TestSelectGuildWorld_NotFound(t *testing.T) {
 	guildID := "guild123"
 	mock.ExpectQuery("SELECT id, guild_id, world_name FROM guilds_world").
 		WithArgs(guildID).
-		WillReturnError(errors.New("no rows in result set"))
+		WillReturnError(errors.New("connection reset"))
 
-	got, err := repo.SelectGuildWorld(context.Background(), guildID)
+	got, err := repo.SelectGuildWorlds(context.Background(), guildID)
 	assert.Error(t, err)
 	assert.Nil(t, got)
 	assert.NoError(t, mock.ExpectationsWereMet())
@@ -2,10 +2,17 @@ package ports
 
 import (
 	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/character"
+	"spot-assistant/internal/core/dto/event"
+	"spot-assistant/internal/core/dto/export"
 	"spot-assistant/internal/core/dto/guild"
 	"spot-assistant/internal/core/dto/member"
+	"spot-assistant/internal/core/dto/reliability"
+	"spot-assistant/internal/core/dto/reminder"
 	"spot-assistant/internal/core/dto/reservation"
+	"spot-assistant/internal/core/dto/spot"
 	"spot-assistant/internal/core/dto/summary"
+	"spot-assistant/internal/core/dto/webhook"
 	"time"
 )
 
@@ -21,15 +28,75 @@ type APIPort interface {
 }
 
 type CommunicationService interface {
+	// NotifyOverbookedMember notifies the author of the overbooked reservation.
 	NotifyOverbookedMember(
 		request book.BookRequest,
-		res *reservation.ClippedOrRemovedReservation)
+		res *reservation.ClippedOrRemovedReservation) error
 	SendGuildSummary(guild *guild.Guild, summary *summary.Summary) error
 	SendPrivateSummary(request summary.PrivateSummaryRequest, summary *summary.Summary) error
+
+	// NotifyReminder sends a reminder to the owner of the reservation.
+	NotifyReminder(r *reminder.Reminder) error
+}
+
+type ReminderService interface {
+	// SendDueReminders reminds owners of reservations starting soon, each reservation at most once.
+	SendDueReminders(now time.Time)
+}
+
+type AttendanceService interface {
+	// SampleAttendance records whether owners of ongoing reservations of the guild are online.
+	// It is called right after online players of the guild have been refreshed.
+	SampleAttendance(guildID string, now time.Time)
+}
+
+type ReliabilityService interface {
+	// Score scores how reliably a member hunts on their reservations, by their history within reliability.Window before now.
+	Score(guildID, memberID string, now time.Time) (reliability.Score, error)
+}
+
+// BookingRule restricts who can book what. Rules are checked before a reservation is created,
+// and the error of the first rule broken is returned to the member.
+type BookingRule interface {
+	CheckBooking(request book.BookRequest) error
+}
+
+type SpotRequirementsService interface {
+	// SetRequirements replaces level and vocation requirements of a spot, returning the spot.
+	SetRequirements(spotName string, requirements spot.Requirements) (*spot.Spot, error)
+}
+
+type CharacterService interface {
+	// AddCharacter registers a Tibia character of the member, returning it with its verification code.
+	// The world is optional, and has to be one of the worlds of the guild.
+	AddCharacter(guildID, memberID, name, world string) (*character.Character, error)
+
+	// RemoveCharacter removes a character registered by the member.
+	RemoveCharacter(guildID, memberID, name string) error
+
+	// ListCharacters returns characters registered by the member.
+	ListCharacters(guildID, memberID string) ([]*character.Character, error)
+
+	// CanVerify tells whether characters can be verified through the world API.
+	CanVerify() bool
+
+	// VerifyCharacter checks whether the comment of the character holds its verification code.
+	VerifyCharacter(guildID, memberID, name string) (*character.Character, error)
+}
+
+type OutboxService interface {
+	// DispatchDue delivers notifications from the outbox due at the time, retrying failed ones with a backoff.
+	DispatchDue(now time.Time)
 }
 
 type SummaryService interface {
-	PrepareSummary(reservations []*reservation.ReservationWithSpot) (*summary.Summary, error)
+	PrepareSummary(guildID string, reservations []*reservation.ReservationWithSpot) (*summary.Summary, error)
+
+	// PrepareLedger prepares the summary of reservations without rendering its charts.
+	PrepareLedger(guildID string, reservations []*reservation.ReservationWithSpot) (*summary.Summary, error)
+
+	// RenderCharts renders the charts chosen by the guild for a summary prepared by PrepareLedger.
+	RenderCharts(guildID string, sum *summary.Summary) error
 }
 
 type BookingService interface {
@@ -49,12 +116,91 @@ type BookingService interface {
 }
 
 type OnlineCheckService interface {
-	IsOnline(guildID, characterName string) bool
-	PlayerStatus(guildID, characterName string) summary.OnlineStatus
+	// IsOnline checks characters registered by the member, or the character name guessed from their nick,
+	// if the member has not registered any. Characters are looked for on their world, if it is known,
+	// or on all worlds of the guild otherwise.
+	IsOnline(guildID, memberID, characterName string) bool
+	PlayerStatus(guildID, memberID, characterName string) summary.OnlineStatus
 	RefreshOnlinePlayers(guildID string) error
+
+	// RefreshCharacters reloads characters registered by members of the guild.
+	RefreshCharacters(guildID string) error
 	IsConfigured() bool
 	TryRefresh(guildID string)
-	ConfigureWorldName(guildID, world string)
-	SetGuildWorld(guildID, world string) error
-	ConfigureWorldNameForGuild(guildID string) error
+
+	// AddGuildWorld registers a Tibia world members of the guild play on.
+	AddGuildWorld(guildID, world string) error
+
+	// RemoveGuildWorld removes a Tibia world of the guild.
+	RemoveGuildWorld(guildID, world string) error
+
+	// GuildWorlds returns Tibia worlds of the guild, by name.
+	GuildWorlds(guildID string) []string
+
+	// ConfigureGuildWorlds loads Tibia worlds of the guild.
+	ConfigureGuildWorlds(guildID string) error
+}
+
+// GuildMembershipService lets only members of the in-game guild chosen by the server book.
+type GuildMembershipService interface {
+	BookingRule
+
+	// RefreshDue fetches members of the in-game guilds chosen by servers, once they get stale.
+	RefreshDue(now time.Time)
+}
+
+type WorldListService interface {
+	// Worlds returns names of Tibia worlds, or the built-in list, until they are fetched from the world API.
+	Worlds() []string
+
+	// RefreshDue fetches names of Tibia worlds from the world API, once they get stale.
+	RefreshDue(now time.Time)
+}
+
+type CalendarService interface {
+	// IsConfigured tells whether feed links can be signed and served.
+	IsConfigured() bool
+
+	// FeedURL returns the link to a calendar feed, issuing it if needed.
+	// An empty member ID stands for the feed of the whole guild.
+	FeedURL(guildID, memberID string) (string, error)
+
+	// RotateFeedURL issues a new link to a calendar feed, so the previous one stops working.
+	RotateFeedURL(guildID, memberID string) (string, error)
+
+	// Feed renders the iCalendar feed a signed token grants access to.
+	Feed(token string) ([]byte, error)
+}
+
+type ExportService interface {
+	// ExportReservations encodes reservations of a guild overlapping the range.
+	ExportReservations(guildID string, from, to time.Time, format export.Format) ([]byte, error)
+
+	// ExportSpots encodes all spots.
+	ExportSpots(format export.Format) ([]byte, error)
+
+	// ImportSpots adds spots, which are valid and do not exist yet. Nothing is saved during a dry run.
+	ImportSpots(data []byte, format export.Format, dryRun bool) (*export.ImportReport, error)
+}
+
+type WebhookService interface {
+	// DeliverDue attempts deliveries due at the time, retrying failed ones with a backoff.
+	DeliverDue(now time.Time)
+
+	// AddWebhook adds a webhook to the guild, returning it with its signing secret.
+	AddWebhook(guildID, url string) (*webhook.Webhook, error)
+
+	// RemoveWebhook removes a webhook of the guild.
+	RemoveWebhook(guildID string, id int64) error
+
+	// ListWebhooks returns all webhooks of the guild.
+	ListWebhooks(guildID string) ([]*webhook.Webhook, error)
+
+	// RecentDeliveries returns the latest deliveries of the guild, newest first.
+	RecentDeliveries(guildID string, limit int) ([]*webhook.Delivery, error)
+}
+
+type EventSubscriber interface {
+	// HandleEvent reacts to a domain event, ignoring events the subscriber is not interested in.
+	HandleEvent(e event.Event)
 }
@@ -8,11 +8,19 @@ import (
 	"spot-assistant/internal/core/dto/member"
 
 	"spot-assistant/internal/core/dto/book"
+	"spot-assistant/internal/core/dto/character"
 	"spot-assistant/internal/core/dto/discord"
+	"spot-assistant/internal/core/dto/event"
+	"spot-assistant/internal/core/dto/guildsettings"
 	"spot-assistant/internal/core/dto/guildsworld"
+	"spot-assistant/internal/core/dto/notification"
+	"spot-assistant/internal/core/dto/reliability"
+	"spot-assistant/internal/core/dto/reminder"
 	"spot-assistant/internal/core/dto/reservation"
 	"spot-assistant/internal/core/dto/spot"
 	"spot-assistant/internal/core/dto/summary"
+	"spot-assistant/internal/core/dto/webhook"
+	"spot-assistant/internal/core/dto/world"
 )
 
 type ReservationRepository interface {
@@ -20,18 +28,34 @@ type ReservationRepository interface {
 	FindReservationWithSpot(ctx context.Context, id int64, guildID, authorDiscordID string) (*reservation.ReservationWithSpot, error)
 	SelectUpcomingReservationsWithSpot(ctx context.Context, guildId string) ([]*reservation.ReservationWithSpot, error)
 	SelectUpcomingReservationsWithSpotForSpot(ctx context.Context, guildId, spotName string) ([]*reservation.ReservationWithSpot, error)
+	SelectFilteredReservationsWithSpot(ctx context.Context, filter reservation.Filter) ([]*reservation.ReservationWithSpot, error)
+
+	// SelectFreeSpots returns favourite and booked spots of a guild, which nobody is hunting on right now.
+	SelectFreeSpots(ctx context.Context, guildID, spotName string) ([]*reservation.FreeSpot, error)
+
+	// SelectReservationsWithSpotInRange returns reservations of a guild overlapping the range, including past ones.
+	SelectReservationsWithSpotInRange(ctx context.Context, guildID string, from, to time.Time) ([]*reservation.ReservationWithSpot, error)
 	SelectOverlappingReservations(ctx context.Context, spot string, startAt time.Time, endAt time.Time, guildId string) ([]*reservation.Reservation, error)
 	SelectUpcomingMemberReservationsWithSpots(ctx context.Context, guild *guild.Guild, member *member.Member) ([]*reservation.ReservationWithSpot, error)
 
 	// Creates a new reservation, and removes or shorten any existing conflicting reservations.
-	// Returns removed or shortened conflicting reservations.
-	CreateAndDeleteConflicting(ctx context.Context, member *member.Member, guild *guild.Guild, conflicts []*reservation.Reservation, spotId int64, startAt time.Time, endAt time.Time) ([]*reservation.ClippedOrRemovedReservation, error)
+	// Returns the created reservation, and removed or shortened conflicting reservations. Authors of the overbooked reservations
+	// are notified through the notification outbox, written in the same transaction.
+	CreateAndDeleteConflicting(ctx context.Context, member *member.Member, guild *guild.Guild, conflicts []*reservation.Reservation, spot *spot.Spot, startAt time.Time, endAt time.Time) (*reservation.Reservation, []*reservation.ClippedOrRemovedReservation, error)
 
 	// Deletes one of the upcoming member reservations in a given guild. Returns error if operation
 	// did not succeed.
 	DeletePresentMemberReservation(ctx context.Context, g *guild.Guild, m *member.Member, reservationId int64) error
 }
 
+type SummaryMessageRepository interface {
+	// SelectSummaryMessageIDs returns IDs of summary messages tracked in a channel, in order of their position.
+	SelectSummaryMessageIDs(ctx context.Context, guildID, channelID string) ([]string, error)
+
+	// ReplaceSummaryMessageIDs replaces all tracked summary messages of a channel with the given ones.
+	ReplaceSummaryMessageIDs(ctx context.Context, guildID, channelID string, messageIDs []string) error
+}
+
 type SpotRepository interface {
 	// SelectAllSpots returns all spots.
 	SelectAllSpots(ctx context.Context) ([]*spot.Spot, error)
@@ -41,6 +65,12 @@ type SpotRepository interface {
 
 	// SelectSpotsByNameCaseInsensitiveLike returns spots matching the name pattern.
 	SelectSpotsByNameCaseInsensitiveLike(ctx context.Context, namePattern string) ([]*spot.Spot, error)
+
+	// CreateSpots adds spots with the given names, all of them or none.
+	CreateSpots(ctx context.Context, names []string) error
+
+	// UpdateSpotRequirements replaces requirements of a spot. Returns false if there is no such spot.
+	UpdateSpotRequirements(ctx context.Context, name string, requirements spot.Requirements) (bool, error)
 }
 
 type BotPort interface {
@@ -57,6 +87,15 @@ type BotPort interface {
 	// SendDMOverbookedNotification sends a DM to a member about overbooking.
 	SendDMOverbookedNotification(member *member.Member, request book.BookRequest, res *reservation.ClippedOrRemovedReservation) error
 
+	// SendDMReminder sends a DM to a member about their reservation starting soon.
+	SendDMReminder(member *member.Member, r *reminder.Reminder) error
+
+	// MentionOverbookedNotification mentions a member about overbooking in the command channel of the guild.
+	MentionOverbookedNotification(g *guild.Guild, member *member.Member, request book.BookRequest, res *reservation.ClippedOrRemovedReservation) error
+
+	// MentionReminder mentions a member about their reservation starting soon in the command channel of the guild.
+	MentionReminder(g *guild.Guild, member *member.Member, r *reminder.Reminder) error
+
 	// OpenDM opens a DM channel with a member.
 	OpenDM(m *member.Member) (*discord.Channel, error)
 }
@@ -75,11 +114,23 @@ type MemberRepository interface {
 
 type WorldApi interface {
 	GetOnlinePlayerNames(worldName string) ([]string, error)
+
+	// GetCharacter returns the public profile of a character, or world.ErrCharacterNotFound.
+	GetCharacter(name string) (*world.Character, error)
+
+	// GetWorldNames returns names of all Tibia worlds.
+	GetWorldNames() ([]string, error)
+
+	// GetGuildMembers returns names of characters in an in-game guild, or world.ErrGuildNotFound.
+	GetGuildMembers(guildName string) ([]string, error)
 	GetBaseURL() string
 }
 
 type ChartAdapter interface {
 	NewChart(values []float64, legend []string) ([]byte, error)
+
+	// NewTimeline renders bookings of each spot over time.
+	NewTimeline(timeline summary.Timeline) ([]byte, error)
 }
 
 type TextFormatter interface {
@@ -89,9 +140,176 @@ type TextFormatter interface {
 	FormatOverbookedMemberNotification(member *member.Member,
 		request book.BookRequest,
 		res *reservation.ClippedOrRemovedReservation) string
+	FormatReminder(r *reminder.Reminder) string
+}
+
+type GuildSettingsRepository interface {
+	// SelectGuildSettings returns settings of a guild, or the defaults if the guild has not saved any.
+	SelectGuildSettings(ctx context.Context, guildID string) (*guildsettings.GuildSettings, error)
+
+	// SelectTibiaGuilds returns names of in-game guilds, which any guild restricts booking to.
+	SelectTibiaGuilds(ctx context.Context) ([]string, error)
+
+	// UpdateGuildSettings changes settings of a guild with the update and saves them, unless the update
+	// returns an error. Concurrent updates of a guild wait for each other. Returns the saved settings.
+	UpdateGuildSettings(ctx context.Context, guildID string, update func(*guildsettings.GuildSettings) error) (*guildsettings.GuildSettings, error)
+}
+
+type WorldListRepository interface {
+	// ReplaceWorlds stores names of Tibia worlds fetched at the time, removing worlds, which are gone.
+	ReplaceWorlds(ctx context.Context, names []string, refreshedAt time.Time) error
+
+	// SelectWorlds returns names of Tibia worlds, as they were when last fetched.
+	SelectWorlds(ctx context.Context) (*world.List, error)
 }
 
 type WorldNameRepository interface {
-	UpsertGuildWorld(ctx context.Context, guildID string, worldName string) error
-	SelectGuildWorld(ctx context.Context, guildID string) (*guildsworld.GuildsWorld, error)
+	// InsertGuildWorld registers a world of a guild. Returns false if the world is already registered.
+	InsertGuildWorld(ctx context.Context, guildID string, worldName string) (bool, error)
+
+	// DeleteGuildWorld removes a world of a guild. Returns false if the world is not registered.
+	DeleteGuildWorld(ctx context.Context, guildID string, worldName string) (bool, error)
+
+	// SelectGuildWorlds returns worlds of a guild, by name.
+	SelectGuildWorlds(ctx context.Context, guildID string) ([]*guildsworld.GuildsWorld, error)
+}
+
+type CalendarFeedRepository interface {
+	// SelectCalendarFeedNonce returns the nonce of a calendar feed, or an empty string if the feed was never issued.
+	// An empty member ID stands for the feed of the whole guild.
+	SelectCalendarFeedNonce(ctx context.Context, guildID, memberID string) (string, error)
+
+	// UpsertCalendarFeedNonce saves the nonce of a calendar feed, invalidating links signed with the previous one.
+	UpsertCalendarFeedNonce(ctx context.Context, guildID, memberID, nonce string) error
+}
+
+type ReminderRepository interface {
+	// SelectDueReminders returns reminders of reservations, which start soon enough for their owners
+	// to be reminded, or follow a hunt on the same spot ending soon enough, and have not been reminded of yet.
+	SelectDueReminders(ctx context.Context, now time.Time) ([]*reminder.Reminder, error)
+
+	// ClaimReminder marks the hunt of the reservation as reminded of. Returns false if it already was.
+	ClaimReminder(ctx context.Context, res reservation.Reservation) (bool, error)
+
+	// DeleteReminderClaimsBefore removes claims of hunts which started before the given time.
+	DeleteReminderClaimsBefore(ctx context.Context, before time.Time) error
+
+	// SelectMemberReminder returns the reminder preference of a member, or nil if the member follows the guild default.
+	SelectMemberReminder(ctx context.Context, guildID, memberID string) (*reminder.MemberReminder, error)
+
+	// UpsertMemberReminder saves the reminder preference of a member.
+	UpsertMemberReminder(ctx context.Context, memberReminder *reminder.MemberReminder) error
+
+	// DeleteMemberReminder removes the reminder preference of a member, so the guild default applies.
+	DeleteMemberReminder(ctx context.Context, guildID, memberID string) error
+}
+
+type AttendanceRepository interface {
+	// SelectOngoingReservations returns reservations of the guild, which have started and not ended yet.
+	SelectOngoingReservations(ctx context.Context, guildID string, now time.Time) ([]*reservation.Reservation, error)
+
+	// RecordAttendanceSample counts whether the owner of the reservation was online at the time, unless
+	// the reservation has been sampled after sampledBefore already. Returns false if the sample was skipped.
+	RecordAttendanceSample(ctx context.Context, reservationID int64, online bool, now, sampledBefore time.Time) (bool, error)
+}
+
+type ReliabilityRepository interface {
+	// InsertIncident records an incident of a reservation, unless it has been recorded already.
+	InsertIncident(ctx context.Context, incident *reliability.Incident) error
+
+	// SelectMemberHistory sums up reservations of a member, which ended, or had an incident, between since and now.
+	SelectMemberHistory(ctx context.Context, guildID, memberID string, since, now time.Time) (reliability.History, error)
+}
+
+type NotificationPreferenceRepository interface {
+	// SelectNotificationChannel returns the channel chosen by a member for the kind of notifications,
+	// or the default channel if the member has not chosen any.
+	SelectNotificationChannel(ctx context.Context, guildID, memberID string, kind notification.Kind) (notification.Channel, error)
+
+	// SelectNotificationChannels returns channels of all kinds of notifications of a member,
+	// including the default ones.
+	SelectNotificationChannels(ctx context.Context, guildID, memberID string) (map[notification.Kind]notification.Channel, error)
+
+	// UpsertNotificationChannel saves the channel chosen by a member for the kind of notifications.
+	UpsertNotificationChannel(ctx context.Context, guildID, memberID string, kind notification.Kind, channel notification.Channel) error
+}
+
+type CharacterRepository interface {
+	// InsertCharacter registers a character of a member, or returns character.ErrCharacterTaken
+	// if the member already registered it, or another member verified it.
+	InsertCharacter(ctx context.Context, c *character.Character) (*character.Character, error)
+
+	// DeleteCharacter removes a character of a member. Returns false if the member has no such character.
+	DeleteCharacter(ctx context.Context, guildID, memberID, name string) (bool, error)
+
+	// SelectMemberCharacters returns characters of a member, in the order they were registered.
+	SelectMemberCharacters(ctx context.Context, guildID, memberID string) ([]*character.Character, error)
+
+	// SelectGuildCharacters returns characters of all members of a guild.
+	SelectGuildCharacters(ctx context.Context, guildID string) ([]*character.Character, error)
+
+	// MarkCharacterVerified marks a character of a member as verified, on the world found in its profile,
+	// removing unverified registrations of the character by other members. Returns false if the member
+	// has no such character, or character.ErrCharacterTaken if another member verified it.
+	MarkCharacterVerified(ctx context.Context, guildID, memberID, name, world string, at time.Time) (bool, error)
+
+	// ClearCharacterWorld unbinds characters of a guild from a world, which the guild no longer plays on.
+	ClearCharacterWorld(ctx context.Context, guildID, world string) error
+}
+
+type NotificationOutboxRepository interface {
+	// ClaimDueNotifications counts an attempt of pending notifications due at the time and leases them
+	// until the given time, skipping notifications leased by other instances.
+	ClaimDueNotifications(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*notification.Outbox, error)
+
+	// MarkNotificationSent marks a notification as sent.
+	MarkNotificationSent(ctx context.Context, id int64) error
+
+	// ScheduleNotificationRetry records a failed attempt of a notification and schedules the next one.
+	ScheduleNotificationRetry(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error
+
+	// MarkNotificationFailed records the last failed attempt of a notification, which is not attempted anymore.
+	MarkNotificationFailed(ctx context.Context, id int64, lastError string) error
+}
+
+type WebhookRepository interface {
+	// InsertWebhook adds a webhook to a guild.
+	InsertWebhook(ctx context.Context, guildID, url, secret string) (*webhook.Webhook, error)
+
+	// DeleteWebhook removes a webhook of a guild together with its pending deliveries.
+	// Returns false if the guild has no such webhook.
+	DeleteWebhook(ctx context.Context, guildID string, id int64) (bool, error)
+
+	// SelectWebhooks returns all webhooks of a guild.
+	SelectWebhooks(ctx context.Context, guildID string) ([]*webhook.Webhook, error)
+
+	// EnqueueDeliveries schedules delivery of an event to every webhook of a guild.
+	// Returns the number of scheduled deliveries.
+	EnqueueDeliveries(ctx context.Context, guildID string, event webhook.EventType, payload []byte) (int, error)
+
+	// ClaimDueDeliveries counts an attempt of pending deliveries due at the time and leases them
+	// until the given time, so no one else attempts them meanwhile.
+	ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*webhook.Delivery, error)
+
+	// MarkDelivered marks a delivery as delivered.
+	MarkDelivered(ctx context.Context, id int64, attempt webhook.Attempt) error
+
+	// ScheduleRetry records a failed attempt of a delivery and schedules the next one.
+	ScheduleRetry(ctx context.Context, id int64, nextAttemptAt time.Time, attempt webhook.Attempt) error
+
+	// DeadLetterDelivery records the last failed attempt of a delivery and moves it to the dead letters.
+	DeadLetterDelivery(ctx context.Context, id int64, attempt webhook.Attempt) error
+
+	// SelectRecentDeliveries returns the latest deliveries of a guild, newest first.
+	SelectRecentDeliveries(ctx context.Context, guildID string, limit int) ([]*webhook.Delivery, error)
+}
+
+type WebhookSender interface {
+	// Post sends a JSON body to the URL, returning the HTTP status of the response.
+	Post(ctx context.Context, url string, headers map[string]string, body []byte) (int, error)
+}
+
+type EventPublisher interface {
+	// Publish notifies subscribers of a domain event, without waiting for them to react.
+	Publish(e event.Event)
 }
@@ -1,5 +1,7 @@
 package ports
 
+import "time"
+
 // MetricsPort defines metrics operations that the core/infrastructure can use
 // without depending on a specific metrics backend implementation.
 // Implementations should be safe for concurrent use.
@@ -29,6 +31,33 @@ type MetricsPort interface {
 	// IncMessagesSent increments counter of messages sent by the bot.
 	IncMessagesSent(channelID, channelName string)
 
+	// IncMessagesEdited increments counter of messages edited by the bot.
+	IncMessagesEdited(channelID, channelName string)
+
+	// IncSummaryUpdates increments counter of summary letters pushed to a guild.
+	// Labels: guild_id, guild_name
+	IncSummaryUpdates(guildID, guildName string)
+
+	// IncSummarySkips increments counter of summary refreshes skipped, because nothing changed.
+	// Labels: guild_id, guild_name
+	IncSummarySkips(guildID, guildName string)
+
+	// IncDomainEvent increments counter of domain events, e.g. reservations created or cancelled.
+	// Labels: guild_id, event
+	IncDomainEvent(guildID, event string)
+
 	// AddMessagesDeleted increments counter of messages deleted by the bot.
 	AddMessagesDeleted(channelID, channelName string, count int)
+
+	// ObserveWorldApiRequest observes duration of a request to the world API.
+	// Labels: operation, outcome
+	ObserveWorldApiRequest(operation, outcome string, duration time.Duration)
+
+	// IncWorldApiError increments counter of failed world API calls, after retries.
+	// Labels: operation, reason
+	IncWorldApiError(operation, reason string)
+
+	// IncWorldApiCache increments counter of lookups in the cache of online players.
+	// Labels: result
+	IncWorldApiCache(result string)
 }